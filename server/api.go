@@ -2,14 +2,20 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
 	"github.com/mattermost/mattermost-plugin-approver2/server/command"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
 	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
 	"github.com/mattermost/mattermost-plugin-approver2/server/store"
 	"github.com/mattermost/mattermost/server/public/model"
@@ -21,16 +27,19 @@ import (
 func (p *Plugin) ServeHTTP(c *plugin.Context, w http.ResponseWriter, r *http.Request) {
 	router := mux.NewRouter()
 
-	// Button action endpoint (no auth required - authenticated via PostActionIntegrationRequest)
-	router.HandleFunc("/action", p.handleAction).Methods(http.MethodPost)
-
-	// Dialog submission endpoint (no auth required - handled by Mattermost)
-	router.HandleFunc("/dialog/submit", p.handleDialogSubmit).Methods(http.MethodPost)
+	// Interactive endpoints: the server injects Mattermost-User-ID for the session that clicked
+	// or submitted; handlers verify it matches the user ID in the request body
+	router.Handle("/action", p.MattermostAuthorizationRequired(http.HandlerFunc(p.handleAction))).Methods(http.MethodPost)
+	router.Handle("/dialog/submit", p.MattermostAuthorizationRequired(http.HandlerFunc(p.handleDialogSubmit))).Methods(http.MethodPost)
+	router.Handle("/list/page", p.MattermostAuthorizationRequired(http.HandlerFunc(p.handleListPage))).Methods(http.MethodPost)
+	router.Handle("/bulk", p.MattermostAuthorizationRequired(http.HandlerFunc(p.handleBulkAction))).Methods(http.MethodPost)
 
 	// API routes with authentication middleware
 	apiRouter := router.PathPrefix("/api/v1").Subrouter()
 	apiRouter.Use(p.MattermostAuthorizationRequired)
 	apiRouter.HandleFunc("/hello", p.HelloWorld).Methods(http.MethodGet)
+	apiRouter.Handle("/metrics", p.SystemAdminRequired(http.HandlerFunc(p.handleMetrics))).Methods(http.MethodGet)
+	apiRouter.Handle("/health", p.SystemAdminRequired(http.HandlerFunc(p.handleHealth))).Methods(http.MethodGet)
 
 	router.ServeHTTP(w, r)
 }
@@ -47,6 +56,78 @@ func (p *Plugin) MattermostAuthorizationRequired(next http.Handler) http.Handler
 	})
 }
 
+// SystemAdminRequired rejects requests from users without the system_admin role (403).
+// It must run after MattermostAuthorizationRequired, which guarantees the user ID header.
+func (p *Plugin) SystemAdminRequired(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID := r.Header.Get("Mattermost-User-ID")
+		user, appErr := p.API.GetUser(userID)
+		if appErr != nil {
+			p.API.LogError("Failed to get user for admin endpoint", "user_id", userID, "error", appErr.Error())
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		if !approval.IsSystemAdminUser(user) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyRequestUser checks that the user ID in an interactive request body matches the
+// authenticated Mattermost-User-ID header. Mismatches are rejected with 403 and logged as a
+// security audit entry, since the body is client-controlled and could name another user.
+func (p *Plugin) verifyRequestUser(w http.ResponseWriter, r *http.Request, bodyUserID string) bool {
+	headerUserID := r.Header.Get("Mattermost-User-ID")
+	if bodyUserID != "" && bodyUserID == headerUserID {
+		return true
+	}
+
+	p.API.LogWarn("Audit: rejected interactive request with mismatched user ID",
+		"endpoint", r.URL.Path,
+		"header_user_id", headerUserID,
+		"body_user_id", bodyUserID,
+		"remote_addr", r.RemoteAddr,
+	)
+	http.Error(w, "Forbidden", http.StatusForbidden)
+	return false
+}
+
+// verifyActionContext checks the signature and expiry of an Approve/Deny button context.
+// On failure it writes an ephemeral error, logs an audit entry, and returns false.
+func (p *Plugin) verifyActionContext(w http.ResponseWriter, r *http.Request, userID string, contextData map[string]any) bool {
+	if p.actionSigner == nil {
+		p.API.LogError("Action signer not initialized")
+		p.writeActionError(w, "Invalid request")
+		return false
+	}
+
+	err := p.actionSigner.Verify(contextData, time.Now())
+	if err == nil {
+		return true
+	}
+
+	approvalID, _ := contextData["approval_id"].(string)
+	p.API.LogWarn("Audit: rejected action with invalid or expired signature",
+		"endpoint", r.URL.Path,
+		"user_id", userID,
+		"approval_id", approvalID,
+		"error", err.Error(),
+		"remote_addr", r.RemoteAddr,
+	)
+
+	// Unsigned buttons predate signing and are at least as old as expired ones; point both to the commands
+	locale, _ := p.userPreferences(userID)
+	if errors.Is(err, notifications.ErrActionContextExpired) || errors.Is(err, notifications.ErrActionContextUnsigned) {
+		p.writeActionError(w, i18n.T(locale, "action.expired"))
+		return false
+	}
+	p.writeActionError(w, i18n.T(locale, "action.invalid_request"))
+	return false
+}
+
 func (p *Plugin) HelloWorld(w http.ResponseWriter, r *http.Request) {
 	if _, err := w.Write([]byte("Hello, world!")); err != nil {
 		p.API.LogError("Failed to write response", "error", err)
@@ -54,6 +135,23 @@ func (p *Plugin) HelloWorld(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleMetrics serves the request counters, notification failures, latency histograms and the
+// pending gauge in the Prometheus text exposition format. Counters cover this node since activation;
+// the pending gauge is recounted at most once per metrics.PendingCacheTTL.
+func (p *Plugin) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	pending, err := p.metrics.PendingCount(store.NewKVStore(p.API).CountPendingRequests)
+	if err != nil {
+		p.API.LogError("Failed to count pending requests for metrics", "error", err.Error())
+		http.Error(w, "Failed to collect metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := p.metrics.WritePrometheus(w, pending); err != nil {
+		p.API.LogWarn("Failed to write metrics response", "error", err.Error())
+	}
+}
+
 // handleDialogSubmit processes dialog submissions
 func (p *Plugin) handleDialogSubmit(w http.ResponseWriter, r *http.Request) {
 	// Read request body
@@ -77,6 +175,11 @@ func (p *Plugin) handleDialogSubmit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The submitting user must be the authenticated session user
+	if !p.verifyRequestUser(w, r, payload.UserId) {
+		return
+	}
+
 	// Validate submission based on callback ID
 	var response *model.SubmitDialogResponse
 
@@ -86,6 +189,8 @@ func (p *Plugin) handleDialogSubmit(w http.ResponseWriter, r *http.Request) {
 		response = p.handleConfirmDecision(payload)
 	case payload.CallbackId == "approve_new":
 		response = p.handleApproveNew(payload)
+	case payload.CallbackId == bulkDecisionCallbackID:
+		response = p.handleBulkDecision(payload)
 	case strings.HasPrefix(payload.CallbackId, "cancel_approval_"):
 		response = p.handleCancelModalSubmission(payload)
 	default:
@@ -112,18 +217,28 @@ func (p *Plugin) handleDialogSubmit(w http.ResponseWriter, r *http.Request) {
 // All errors are logged at this highest layer per Mattermost conventions.
 // Field-specific errors return to modal; general errors close modal.
 func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.SubmitDialogResponse {
+	// Dialog State carries the template name and, for group requests, the approver group or role
+	state := command.ParseDialogState(payload.State)
+
+	// Responses are rendered in the requester's locale, carried in the dialog State
+	locale := state.Locale
+
 	// Layer 1: Basic field presence validation
-	response := command.HandleDialogSubmission(payload.Submission)
+	response := command.HandleDialogSubmission(payload.Submission, locale)
+	if state.IsGroupApproval() {
+		// Group and role dialogs have no approver element; members are resolved below
+		delete(response.Errors, "approver")
+	}
 	if len(response.Errors) > 0 {
 		return response
 	}
 
 	// Extract validated data with safe type assertions
 	approverID, ok := payload.Submission["approver"].(string)
-	if !ok {
+	if !ok && !state.IsGroupApproval() {
 		p.API.LogError("Invalid approver type in submission", "type", fmt.Sprintf("%T", payload.Submission["approver"]))
 		return &model.SubmitDialogResponse{
-			Error: "Invalid submission format. Please try again.",
+			Error: i18n.T(locale, "new.invalid_submission"),
 		}
 	}
 
@@ -131,7 +246,7 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 	if !ok {
 		p.API.LogError("Invalid description type in submission", "type", fmt.Sprintf("%T", payload.Submission["description"]))
 		return &model.SubmitDialogResponse{
-			Error: "Invalid submission format. Please try again.",
+			Error: i18n.T(locale, "new.invalid_submission"),
 		}
 	}
 
@@ -140,43 +255,140 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 	// Validate description length (AC4: Validate Description Length)
 	if err := approval.ValidateDescription(description); err != nil {
 		p.API.LogError("Description validation failed", "error", err.Error(), "description_length", len(description))
+		message := i18n.T(locale, "new.description_required")
+		if errors.Is(err, approval.ErrDescriptionTooLong) {
+			message = i18n.T(locale, "new.description_too_long", len(description), approval.MaxDescriptionLength)
+		}
 		return &model.SubmitDialogResponse{
 			Errors: map[string]string{
-				"description": err.Error(),
+				"description": message,
 			},
 		}
 	}
 
-	// Validate approver exists and is active (AC3: Validate Invalid Approver)
-	// AC6: Handle Mattermost API Errors - error wrapping is done in ValidateApprover
-	// Returns validated user object to avoid redundant API call
-	approver, err := approval.ValidateApprover(approverID, p.API)
+	// Optional channel status card; older dialogs have no visibility element
+	visibility, _ := payload.Submission["visibility"].(string)
+	shareInChannel, private, err := approval.ParseVisibility(visibility)
 	if err != nil {
-		p.API.LogError("Approver validation failed", "error", err.Error(), "approver_id", approverID)
 		return &model.SubmitDialogResponse{
 			Errors: map[string]string{
-				"approver": err.Error(),
+				"visibility": i18n.T(locale, "new.invalid_visibility", visibility),
 			},
 		}
 	}
 
+	// Create KV store for template lookup and code uniqueness checking
+	kvStore := store.NewKVStore(p.API)
+
+	// Validate template custom fields when the dialog was opened from a template (State carries the name)
+	var customFields []approval.CustomFieldValue
+	if state.Template != "" {
+		tmpl, err := kvStore.GetTemplate(state.Template)
+		if err != nil {
+			p.API.LogError("Failed to load request template for submission", "template", state.Template, "error", err.Error())
+			return &model.SubmitDialogResponse{
+				Error: i18n.T(locale, "new.template_unavailable"),
+			}
+		}
+
+		values, fieldErrors := approval.ValidateCustomFieldValues(tmpl, payload.Submission)
+		if len(fieldErrors) > 0 {
+			response := &model.SubmitDialogResponse{Errors: make(map[string]string, len(fieldErrors))}
+			for element, fieldErr := range fieldErrors {
+				response.Errors[element] = formatCustomFieldError(fieldErr, locale)
+			}
+			return response
+		}
+		customFields = values
+	}
+
+	// Resolve the approver: a selected user, or every eligible member of a group/role
+	var approverUserID, approverUsername, approverDisplayName, approverLocale, approverTimezone string
+	var candidates []*model.User
+	if state.IsGroupApproval() {
+		var err error
+		approverUsername, approverDisplayName, candidates, err = p.resolveGroupApprovers(kvStore, payload, state)
+		if err != nil {
+			if errors.Is(err, approval.ErrApproverGroupNotFound) || errors.Is(err, approval.ErrNoEligibleApprovers) || errors.Is(err, approval.ErrTooManyApprovers) {
+				p.API.LogInfo("Group approver resolution rejected", "group", state.ApproverGroup, "role", state.ApproverRole, "error", err.Error())
+				return &model.SubmitDialogResponse{
+					Error: i18n.T(locale, "new.group_rejected", err.Error()),
+				}
+			}
+			p.API.LogError("Failed to resolve group approvers", "group", state.ApproverGroup, "role", state.ApproverRole, "error", err.Error())
+			return &model.SubmitDialogResponse{
+				Error: i18n.T(locale, "new.group_failed"),
+			}
+		}
+	} else {
+		// Validate approver exists and is active (AC3: Validate Invalid Approver)
+		// AC6: Handle Mattermost API Errors - error wrapping is done in ValidateApprover
+		// Returns validated user object to avoid redundant API call
+		approver, err := approval.ValidateApprover(approverID, payload.UserId, p.API)
+		if err != nil {
+			if errors.Is(err, approval.ErrSelfApproval) {
+				approval.RecordSoDViolation(kvStore, p.API, &approval.SoDViolation{
+					Rule:        approval.SoDRuleSelfApproval,
+					UserID:      payload.UserId,
+					RequesterID: payload.UserId,
+				})
+			}
+			p.API.LogError("Approver validation failed", "error", err.Error(), "approver_id", approverID)
+			return &model.SubmitDialogResponse{
+				Errors: map[string]string{
+					"approver": p.formatApproverError(err, locale),
+				},
+			}
+		}
+
+		// Enforce admin-managed approver policies for the team and template
+		if err := approval.CheckApproverPolicy(kvStore, p.API, approver.Id, payload.TeamId, payload.ChannelId, state.Template); err != nil {
+			if errors.Is(err, approval.ErrApproverNotAllowed) {
+				p.API.LogInfo("Approver rejected by policy", "approver_id", approver.Id, "team_id", payload.TeamId, "template", state.Template)
+				return &model.SubmitDialogResponse{
+					Errors: map[string]string{
+						"approver": p.formatApproverError(err, locale),
+					},
+				}
+			}
+			p.API.LogError("Failed to check approver policy", "error", err.Error(), "approver_id", approver.Id)
+			return &model.SubmitDialogResponse{
+				Error: i18n.T(locale, "new.policy_failed"),
+			}
+		}
+
+		approverUserID = approver.Id
+		approverUsername = approver.Username
+		approverDisplayName = approver.GetDisplayName(model.ShowFullName)
+		approverLocale = approver.Locale
+		approverTimezone = approver.GetPreferredTimezone()
+	}
+
 	// Get requester info
 	requester, appErr := p.API.GetUser(payload.UserId)
 	if appErr != nil {
 		p.API.LogError("Failed to get requester user", "user_id", payload.UserId, "error", appErr.Error())
 		return &model.SubmitDialogResponse{
-			Error: "Failed to retrieve requester information",
+			Error: i18n.T(locale, "new.requester_failed"),
 		}
 	}
 
-	// Create KV store for code uniqueness checking
-	kvStore := store.NewKVStore(p.API)
+	// Optional send time, entered in the requester's timezone; older dialogs have no send_at element
+	sendAtValue, _ := payload.Submission["send_at"].(string)
+	sendAt, err := approval.ParseSendAt(sendAtValue, requester.GetPreferredTimezone(), time.Now())
+	if err != nil {
+		return &model.SubmitDialogResponse{
+			Errors: map[string]string{
+				"send_at": formatSendAtError(err, sendAtValue, locale),
+			},
+		}
+	}
 
 	// Create approval record with unique code
 	record, err := approval.NewApprovalRecord(
 		kvStore,
 		requester.Id, requester.Username, requester.GetDisplayName(model.ShowFullName),
-		approver.Id, approver.Username, approver.GetDisplayName(model.ShowFullName),
+		approverUserID, approverUsername, approverDisplayName,
 		description,
 		payload.ChannelId,
 		payload.TeamId,
@@ -186,14 +398,53 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 		p.API.LogError("Failed to create approval record",
 			"error", err.Error(),
 			"requester_id", requester.Id,
-			"approver_id", approver.Id,
+			"approver_id", approverUserID,
 		)
 		// General error closes modal (system failure, not validation failure)
 		return &model.SubmitDialogResponse{
-			Error: "Failed to generate unique approval code. Please try again.",
+			Error: i18n.T(locale, "new.code_failed"),
+		}
+	}
+	if state.Template != "" {
+		record.TemplateName = state.Template
+		record.CustomFields = customFields
+	}
+	record.ShareInChannel = shareInChannel
+	record.Private = private
+	record.RequesterLocale = requester.Locale
+	record.ApproverLocale = approverLocale
+	record.RequesterTimezone = requester.GetPreferredTimezone()
+	record.ApproverTimezone = approverTimezone
+	if state.IsGroupApproval() {
+		record.ApproverGroupName = state.ApproverGroup
+		record.ApproverRole = state.ApproverRole
+		for _, candidate := range candidates {
+			record.CandidateApproverIDs = append(record.CandidateApproverIDs, candidate.Id)
+			if candidate.Locale != "" {
+				if record.CandidateLocales == nil {
+					record.CandidateLocales = make(map[string]string, len(candidates))
+				}
+				record.CandidateLocales[candidate.Id] = candidate.Locale
+			}
+			if timezone := candidate.GetPreferredTimezone(); timezone != "" {
+				if record.CandidateTimezones == nil {
+					record.CandidateTimezones = make(map[string]string, len(candidates))
+				}
+				record.CandidateTimezones[candidate.Id] = timezone
+			}
 		}
 	}
 
+	// Snapshot the most specific SLA target; tracking is best effort and never blocks the request
+	slaTarget, err := approval.ResolveSLATarget(kvStore, payload.TeamId, state.Template)
+	if err != nil {
+		p.API.LogWarn("Failed to resolve SLA target", "team_id", payload.TeamId, "template", state.Template, "error", err.Error())
+	}
+	record.ApplySLATarget(slaTarget)
+	if !sendAt.IsZero() {
+		record.Schedule(sendAt)
+	}
+
 	// Task 4 (AC5): Handle KV Store Unavailability with proper error wrapping
 	err = kvStore.SaveApproval(record)
 	if err != nil {
@@ -203,19 +454,121 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 			"record_id", record.ID,
 			"code", record.Code,
 			"requester_id", requester.Id,
-			"approver_id", approver.Id,
+			"approver_id", approverUserID,
 		)
 		// AC5: User-friendly message for KV store failures
 		return &model.SubmitDialogResponse{
-			Error: "Failed to create approval request. The system is temporarily unavailable. Please try again.",
+			Error: i18n.T(locale, "new.save_failed"),
+		}
+	}
+
+	p.metrics.RequestEvent(metrics.EventCreated)
+
+	// Story 2.1: Send DM notification to approver (best effort, graceful degradation).
+	// Scheduled requests are delivered by the request scheduler at their send time instead.
+	if record.Status != approval.StatusScheduled {
+		p.deliverApprovalRequest(kvStore, record)
+	}
+
+	// Send ephemeral confirmation message to requester (visible only to them)
+	approverLine := i18n.T(locale, "new.submitted.approver", approverUsername, approverDisplayName)
+	if record.IsGroupApproval() {
+		approverLine = i18n.T(locale, "new.submitted.group", record.ApproverGroupLabelIn(locale), len(candidates))
+	}
+	confirmMsg := i18n.T(locale, "new.submitted", approverLine, record.Code)
+	if record.Status == approval.StatusScheduled {
+		sendAtText := i18n.FormatTime(record.ScheduledAt, approval.SendAtLayout+" MST", record.RequesterTimezone)
+		confirmMsg = i18n.T(locale, "new.scheduled", approverLine, record.Code, sendAtText, record.Code)
+	}
+	if record.ChannelPostID != "" {
+		confirmMsg += i18n.T(locale, "new.submitted.channel_card")
+	}
+
+	post := &model.Post{
+		UserId:    "", // Empty for system/bot message
+		ChannelId: payload.ChannelId,
+		Message:   confirmMsg,
+	}
+
+	// Send ephemeral post (only requester sees it)
+	ephemeralPost := p.API.SendEphemeralPost(payload.UserId, post)
+	if ephemeralPost == nil {
+		p.API.LogError("Failed to send ephemeral confirmation post", "user_id", payload.UserId, "record_id", record.ID, "code", record.Code)
+		// AC3: Fallback to regular post as generic success indicator if ephemeral fails
+		// This ensures user sees confirmation even if ephemeral delivery fails
+		post.UserId = payload.UserId
+		if _, appErr := p.API.CreatePost(post); appErr != nil {
+			p.API.LogError("Failed to send fallback confirmation post", "user_id", payload.UserId, "record_id", record.ID, "code", record.Code, "error", appErr.Error())
+			// Don't fail the whole operation - record is already saved
+		}
+	}
+
+	p.API.LogInfo("Approval request created successfully",
+		"record_id", record.ID,
+		"code", record.Code,
+		"requester", requester.Username,
+		"approver", approverUsername)
+
+	return &model.SubmitDialogResponse{}
+}
+
+// resolveGroupApprovers resolves the members of the approver group or role for a new request,
+// keeping only those allowed by approver policies. Returns the record's approver label and
+// display name, in the requester's locale, along with the candidate members.
+func (p *Plugin) resolveGroupApprovers(kvStore *store.KVStore, payload *model.SubmitDialogRequest, state command.DialogState) (string, string, []*model.User, error) {
+	var label, displayName string
+	var members []*model.User
+	if state.ApproverGroup != "" {
+		group, groupMembers, err := approval.ResolveGroupApprovers(p.API, state.ApproverGroup, payload.UserId)
+		if err != nil {
+			return "", "", nil, err
 		}
+		label = state.ApproverGroup
+		displayName = i18n.T(state.Locale, "new.group_display_name", group.DisplayName)
+		members = groupMembers
+	} else {
+		roleMembers, err := approval.ResolveRoleApprovers(p.API, payload.ChannelId, state.ApproverRole, payload.UserId)
+		if err != nil {
+			return "", "", nil, err
+		}
+		label = state.ApproverRole
+		displayName = i18n.T(state.Locale, "new.role_display_name")
+		members = roleMembers
 	}
 
-	// Story 2.1: Send DM notification to approver (best effort, graceful degradation)
-	postID, err := notifications.SendApprovalRequestDM(p.API, p.botUserID, record)
+	allowed, err := approval.FilterApproversByPolicy(kvStore, p.API, members, payload.TeamId, payload.ChannelId, state.Template)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if len(allowed) == 0 {
+		return "", "", nil, fmt.Errorf("%w: approver policies exclude every member", approval.ErrNoEligibleApprovers)
+	}
+
+	return label, displayName, allowed, nil
+}
+
+// deliverApprovalRequest sends a request to its approvers: the approver DM, the requester's
+// confirmation thread and the optional channel status card. Called on creation, or by the request
+// scheduler once a scheduled request's send time arrives.
+func (p *Plugin) deliverApprovalRequest(kvStore *store.KVStore, record *approval.ApprovalRecord) {
+	p.sendApprovalRequestNotification(kvStore, record)
+	p.sendRequesterConfirmation(kvStore, record)
+	p.postChannelStatusCard(kvStore, record)
+}
+
+// sendApprovalRequestNotification sends the approver DM for a newly created record and updates
+// the delivery tracking fields. Failures are logged and never block request creation.
+func (p *Plugin) sendApprovalRequestNotification(kvStore *store.KVStore, record *approval.ApprovalRecord) {
+	if record.IsGroupApproval() {
+		p.sendGroupApprovalRequestNotifications(kvStore, record)
+		return
+	}
+
+	postID, err := notifications.SendApprovalRequestDM(p.API, p.botUserID, p.actionSigner, record)
 	if err != nil {
 		// Story 2.6: Classify error and provide resolution suggestion (AC6)
 		errorType, suggestion := notifications.ClassifyDMError(err)
+		p.metrics.NotificationFailed(errorType)
 
 		// Log warning but continue - approval record already saved (data integrity priority)
 		p.API.LogWarn("DM notification failed but approval created",
@@ -227,7 +580,8 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 			"error_type", errorType,
 			"suggestion", suggestion,
 		)
-		// NotificationSent flag remains false (default value)
+		// NotificationSent flag remains false until the retry queue delivers it
+		p.queueNotificationRetry(approval.RetryKindApproverRequest, record, err)
 	} else {
 		// Notification sent successfully - update flags and post ID (best effort)
 		record.NotificationSent = true
@@ -241,41 +595,136 @@ func (p *Plugin) handleApproveNew(payload *model.SubmitDialogRequest) *model.Sub
 			)
 		}
 	}
+}
 
-	// Send ephemeral confirmation message to requester (visible only to them)
-	confirmMsg := fmt.Sprintf("✅ **Approval Request Submitted**\n\n"+
-		"**Approver:** @%s (%s)\n"+
-		"**Request ID:** `%s`\n\n"+
-		"You will be notified when a decision is made.",
-		approver.Username, approver.GetDisplayName(model.ShowFullName),
-		record.Code)
+// sendGroupApprovalRequestNotifications DMs every candidate of a group approval (best effort).
+// Post IDs are tracked per member so every DM can be updated once someone decides.
+func (p *Plugin) sendGroupApprovalRequestNotifications(kvStore *store.KVStore, record *approval.ApprovalRecord) {
+	record.CandidatePostIDs = make(map[string]string, len(record.CandidateApproverIDs))
+	var firstErr error
+	for _, approverID := range record.CandidateApproverIDs {
+		postID, err := notifications.SendApprovalRequestDMTo(p.API, p.botUserID, p.actionSigner, record, approverID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			errorType, suggestion := notifications.ClassifyDMError(err)
+			p.metrics.NotificationFailed(errorType)
+			p.API.LogWarn("DM notification to group approver failed",
+				"approval_id", record.ID,
+				"code", record.Code,
+				"approver_id", approverID,
+				"error", err.Error(),
+				"error_type", errorType,
+				"suggestion", suggestion,
+			)
+			continue
+		}
 
-	post := &model.Post{
-		UserId:    "", // Empty for system/bot message
-		ChannelId: payload.ChannelId,
-		Message:   confirmMsg,
+		record.CandidatePostIDs[approverID] = postID
+		if record.NotificationPostID == "" {
+			record.NotificationPostID = postID
+		}
 	}
 
-	// Send ephemeral post (only requester sees it)
-	ephemeralPost := p.API.SendEphemeralPost(payload.UserId, post)
-	if ephemeralPost == nil {
-		p.API.LogError("Failed to send ephemeral confirmation post", "user_id", payload.UserId, "record_id", record.ID, "code", record.Code)
-		// AC3: Fallback to regular post as generic success indicator if ephemeral fails
-		// This ensures user sees confirmation even if ephemeral delivery fails
-		post.UserId = payload.UserId
-		if _, appErr := p.API.CreatePost(post); appErr != nil {
-			p.API.LogError("Failed to send fallback confirmation post", "user_id", payload.UserId, "record_id", record.ID, "code", record.Code, "error", appErr.Error())
-			// Don't fail the whole operation - record is already saved
+	// NotificationSent is true when at least one member was reached; otherwise queue a retry
+	if len(record.CandidatePostIDs) == 0 {
+		if firstErr != nil {
+			p.queueNotificationRetry(approval.RetryKindApproverRequest, record, firstErr)
 		}
+		return
+	}
+	record.NotificationSent = true
+	if err := kvStore.SaveApproval(record); err != nil {
+		p.API.LogWarn("Failed to update notification tracking fields",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"error", err.Error(),
+		)
+	}
+}
+
+// queueNotificationRetry hands a failed approver or outcome DM to the retry queue (best effort).
+func (p *Plugin) queueNotificationRetry(kind string, record *approval.ApprovalRecord, sendErr error) {
+	if p.retryWorker == nil {
+		return
 	}
 
-	p.API.LogInfo("Approval request created successfully",
-		"record_id", record.ID,
-		"code", record.Code,
-		"requester", requester.Username,
-		"approver", approver.Username)
+	if err := p.retryWorker.Enqueue(kind, record, sendErr); err != nil {
+		p.API.LogWarn("Failed to queue notification retry",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"kind", kind,
+			"error", err.Error(),
+		)
+	}
+}
 
-	return &model.SubmitDialogResponse{}
+// sendRequesterConfirmation DMs the requester a confirmation that roots their thread for later
+// updates and records its post ID. Failures are logged and never block request creation.
+func (p *Plugin) sendRequesterConfirmation(kvStore *store.KVStore, record *approval.ApprovalRecord) {
+	postID, err := notifications.SendRequesterConfirmationDM(p.API, p.botUserID, record)
+	if err != nil {
+		errorType, _ := notifications.ClassifyDMError(err)
+		p.metrics.NotificationFailed(errorType)
+		p.API.LogWarn("Failed to send requester confirmation DM",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"requester_id", record.RequesterID,
+			"error", err.Error(),
+			"error_type", errorType,
+		)
+		return
+	}
+
+	record.RequesterPostID = postID
+	if err := kvStore.SaveApproval(record); err != nil {
+		p.API.LogWarn("Failed to save requester confirmation post ID",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"error", err.Error(),
+		)
+	}
+}
+
+// postChannelStatusCard posts the status card to the request channel when the requester asked for one
+// and records its post ID for in-place updates. Failures are logged and never block request creation.
+func (p *Plugin) postChannelStatusCard(kvStore *store.KVStore, record *approval.ApprovalRecord) {
+	if !record.ShareInChannel {
+		return
+	}
+
+	postID, err := notifications.PostChannelStatusCard(p.API, p.botUserID, record)
+	if err != nil {
+		p.API.LogWarn("Failed to post channel status card",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"channel_id", record.RequestChannelID,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	record.ChannelPostID = postID
+	if err := kvStore.SaveApproval(record); err != nil {
+		p.API.LogWarn("Failed to save channel status card post ID",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"error", err.Error(),
+		)
+	}
+}
+
+// updateChannelStatusCard refreshes a request's channel status card after a state change (best effort)
+func (p *Plugin) updateChannelStatusCard(record *approval.ApprovalRecord) {
+	if err := notifications.UpdateChannelStatusCard(p.API, record); err != nil {
+		p.API.LogWarn("Failed to update channel status card",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"post_id", record.ChannelPostID,
+			"error", err.Error(),
+		)
+	}
 }
 
 // handleAction processes button click actions from approval request notifications
@@ -288,9 +737,19 @@ func (p *Plugin) handleAction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The clicking user must be the authenticated session user
+	if !p.verifyRequestUser(w, r, request.UserId) {
+		return
+	}
+
 	// Extract context data - Context is a map[string]any
 	contextData := request.Context
 
+	// Reject stale or crafted button payloads before touching the record
+	if !p.verifyActionContext(w, r, request.UserId, contextData) {
+		return
+	}
+
 	approvalID, ok := contextData["approval_id"].(string)
 	if !ok || approvalID == "" {
 		p.API.LogError("Missing or invalid approval_id in context")
@@ -312,36 +771,39 @@ func (p *Plugin) handleAction(w http.ResponseWriter, r *http.Request) {
 			"approval_id", approvalID,
 			"error", err.Error(),
 		)
-		p.writeActionError(w, "Approval not found")
+		locale, _ := p.userPreferences(request.UserId)
+		p.writeActionError(w, i18n.T(locale, "confirm.not_found"))
 		return
 	}
 
-	// Verify authenticated user is the designated approver
+	// Verify authenticated user is the designated approver (or a member of the approver group)
 	approverID := request.UserId
-	if record.ApproverID != approverID {
+	if !record.CanDecide(approverID) {
 		p.API.LogError("Unauthorized approval attempt",
 			"approval_id", approvalID,
 			"authenticated_user", approverID,
 			"designated_approver", record.ApproverID,
 		)
-		p.writeActionError(w, "Permission denied")
+		locale, _ := p.userPreferences(approverID)
+		p.writeActionError(w, i18n.T(locale, "confirm.permission_denied"))
 		return
 	}
 
 	// Check status (immutability guard)
+	locale := record.LocaleFor(approverID)
 	if record.Status != approval.StatusPending {
-		p.writeActionError(w, fmt.Sprintf("Decision already recorded: %s", record.Status))
+		p.writeActionError(w, formatAlreadyDecided(record, locale))
 		return
 	}
 
 	// Open confirmation modal
-	if err := p.openConfirmationModal(request.TriggerId, record, action); err != nil {
+	if err := p.openConfirmationModal(request.TriggerId, record, action, locale); err != nil {
 		p.API.LogError("Failed to open confirmation modal",
 			"approval_id", approvalID,
 			"action", action,
 			"error", err.Error(),
 		)
-		p.writeActionError(w, "Failed to open confirmation modal")
+		p.writeActionError(w, i18n.T(locale, "confirm.dialog_failed"))
 		return
 	}
 
@@ -349,22 +811,92 @@ func (p *Plugin) handleAction(w http.ResponseWriter, r *http.Request) {
 	p.writeActionSuccess(w)
 }
 
-// openConfirmationModal opens an interactive dialog for approval/denial confirmation
-func (p *Plugin) openConfirmationModal(triggerID string, record *approval.ApprovalRecord, action string) error {
+// handleListPage replaces an /approve list post with the page chosen by its Next or Previous button
+func (p *Plugin) handleListPage(w http.ResponseWriter, r *http.Request) {
+	var request model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.API.LogError("Failed to decode list page request", "error", err.Error())
+		p.writeActionError(w, "Invalid request")
+		return
+	}
+
+	// The clicking user must be the authenticated session user; the page is built from their index
+	if !p.verifyRequestUser(w, r, request.UserId) {
+		return
+	}
+
+	query, err := command.ListQueryFromContext(request.Context)
+	if err != nil {
+		p.API.LogError("Invalid list page context", "user_id", request.UserId, "error", err.Error())
+		p.writeActionError(w, "Invalid request")
+		return
+	}
+
+	locale, timezone := p.userPreferences(request.UserId)
+	router := p.newCommandRouter(locale, timezone)
+
+	post, err := router.ListPagePost(request.UserId, request.ChannelId, query)
+	if err != nil {
+		p.API.LogError("Failed to retrieve approval records for list page",
+			"user_id", request.UserId,
+			"error", err.Error(),
+		)
+		p.writeActionError(w, i18n.T(locale, "list.failed"))
+		return
+	}
+
+	post.Id = request.PostId
+	p.API.UpdateEphemeralPost(request.UserId, post)
+	p.writeActionSuccess(w)
+}
+
+// handleBulkAction opens the bulk decision dialog from the inbox list button
+func (p *Plugin) handleBulkAction(w http.ResponseWriter, r *http.Request) {
+	var request model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.API.LogError("Failed to decode bulk decision request", "error", err.Error())
+		p.writeActionError(w, "Invalid request")
+		return
+	}
+
+	// The dialog lists the authenticated user's own pending requests
+	if !p.verifyRequestUser(w, r, request.UserId) {
+		return
+	}
+
+	locale, timezone := p.userPreferences(request.UserId)
+	if err := p.openBulkDecisionModal(request.TriggerId, request.UserId, locale, timezone); err != nil {
+		if errors.Is(err, errNoPendingDecisions) {
+			p.writeActionError(w, i18n.T(locale, "bulk.none"))
+			return
+		}
+		p.API.LogError("Failed to open bulk decision dialog",
+			"error", err.Error(),
+			"user_id", request.UserId,
+		)
+		p.writeActionError(w, i18n.T(locale, "bulk.dialog_failed"))
+		return
+	}
+
+	p.writeActionSuccess(w)
+}
+
+// openConfirmationModal opens an interactive dialog for approval/denial confirmation in the approver's locale.
+// The locale is carried in the dialog State for the submission response.
+func (p *Plugin) openConfirmationModal(triggerID string, record *approval.ApprovalRecord, action, locale string) error {
 	// Determine modal title and confirmation text
-	title := "Confirm Approval"
-	confirmText := "Confirm you are approving:"
+	title := i18n.T(locale, "confirm.approve_title")
+	confirmText := i18n.T(locale, "confirm.approve_text")
 	if action == "deny" {
-		title = "Confirm Denial"
-		confirmText = "Confirm you are denying:"
+		title = i18n.T(locale, "confirm.deny_title")
+		confirmText = i18n.T(locale, "confirm.deny_text")
 	}
 
 	// Format description (quoted)
 	quotedDescription := fmt.Sprintf("> %s", record.Description)
 
 	// Construct modal introduction text
-	introText := fmt.Sprintf(
-		"%s\n\n%s\n\n**From:** @%s (%s)\n**Request ID:** `%s`\n\n**This decision will be recorded and cannot be edited.**",
+	introText := i18n.T(locale, "confirm.intro",
 		confirmText,
 		quotedDescription,
 		record.RequesterUsername,
@@ -382,15 +914,16 @@ func (p *Plugin) openConfirmationModal(triggerID string, record *approval.Approv
 			IntroductionText: introText,
 			Elements: []model.DialogElement{
 				{
-					DisplayName: "Add comment (optional)",
+					DisplayName: i18n.T(locale, "confirm.comment"),
 					Name:        "comment",
 					Type:        "textarea",
-					Placeholder: "Optional: Provide context or reasoning for your decision",
-					MaxLength:   500,
+					Placeholder: i18n.T(locale, "confirm.comment_placeholder"),
+					MaxLength:   maxDecisionCommentLength,
 					Optional:    true,
 				},
 			},
-			SubmitLabel: "Confirm",
+			SubmitLabel: i18n.T(locale, "confirm.submit"),
+			State:       locale,
 		},
 	}
 
@@ -418,14 +951,27 @@ func (p *Plugin) writeActionError(w http.ResponseWriter, message string) {
 	_ = json.NewEncoder(w).Encode(response)
 }
 
+// formatAlreadyDecided describes a finalized request for approvers who try to decide it,
+// naming the group member who claimed it
+func formatAlreadyDecided(record *approval.ApprovalRecord, locale string) string {
+	status := i18n.T(locale, "filter."+record.Status)
+	if record.IsGroupApproval() && record.ApproverID != "" {
+		return i18n.T(locale, "confirm.already_decided_by", status, record.ApproverUsername)
+	}
+	return i18n.T(locale, "confirm.already_decided", status)
+}
+
 // handleConfirmDecision processes confirmation modal submissions (approve/deny decisions)
 func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *model.SubmitDialogResponse {
+	// The dialog State carries the approver's locale
+	locale := payload.State
+
 	// Parse callback ID: "confirm_approve_recordID" or "confirm_deny_recordID"
 	parts := strings.Split(payload.CallbackId, "_")
 	if len(parts) != 3 {
 		p.API.LogError("Invalid callback ID format", "callback_id", payload.CallbackId)
 		return &model.SubmitDialogResponse{
-			Error: "Invalid request format",
+			Error: i18n.T(locale, "dialog.invalid_request"),
 		}
 	}
 
@@ -436,7 +982,7 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 	if action != "approve" && action != "deny" {
 		p.API.LogError("Invalid action in callback ID", "action", action, "callback_id", payload.CallbackId)
 		return &model.SubmitDialogResponse{
-			Error: "Invalid action",
+			Error: i18n.T(locale, "confirm.invalid_action"),
 		}
 	}
 
@@ -457,18 +1003,18 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 			"error", err.Error(),
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Approval not found",
+			Error: i18n.T(locale, "confirm.not_found"),
 		}
 	}
 
-	if record.ApproverID != approverID {
+	if !record.CanDecide(approverID) {
 		p.API.LogError("Unauthorized decision attempt",
 			"approval_id", approvalID,
 			"authenticated_user", approverID,
 			"designated_approver", record.ApproverID,
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Permission denied",
+			Error: i18n.T(locale, "confirm.permission_denied"),
 		}
 	}
 
@@ -479,7 +1025,7 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 			"current_status", record.Status,
 		)
 		return &model.SubmitDialogResponse{
-			Error: fmt.Sprintf("Decision already recorded: %s", record.Status),
+			Error: formatAlreadyDecided(record, locale),
 		}
 	}
 
@@ -497,15 +1043,205 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 			"error", err.Error(),
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Failed to record decision. Please try again.",
+			Error: p.formatDecisionError(err, approvalID, locale),
+		}
+	}
+
+	p.completeDecision(updatedRecord, approverID, decision)
+
+	// Return success - modal will close
+	return &model.SubmitDialogResponse{}
+}
+
+// handleBulkDecision records one decision and comment for every request checked in the bulk
+// decision dialog. Each request is checked and decided like a single confirmation, and the
+// approver gets an ephemeral report with the result per code.
+func (p *Plugin) handleBulkDecision(payload *model.SubmitDialogRequest) *model.SubmitDialogResponse {
+	// The dialog State carries the approver's locale
+	locale := payload.State
+
+	action, _ := payload.Submission["decision"].(string)
+	if action != "approve" && action != "deny" {
+		return &model.SubmitDialogResponse{
+			Errors: map[string]string{"decision": i18n.T(locale, "bulk.decision_required")},
+		}
+	}
+
+	comment, _ := payload.Submission["comment"].(string)
+	comment = strings.TrimSpace(comment)
+
+	var approvalIDs []string
+	for name, value := range payload.Submission {
+		approvalID, found := strings.CutPrefix(name, bulkRecordPrefix)
+		if checked, _ := value.(bool); found && checked && approvalID != "" {
+			approvalIDs = append(approvalIDs, approvalID)
+		}
+	}
+	if len(approvalIDs) == 0 {
+		return &model.SubmitDialogResponse{
+			Error: i18n.T(locale, "bulk.none_selected"),
+		}
+	}
+	sort.Strings(approvalIDs)
+
+	// Decide in dialog order, oldest first; records that fail to load are reported last
+	approverID := payload.UserId
+	records := make([]*approval.ApprovalRecord, 0, len(approvalIDs))
+	var notFound []string
+	for _, approvalID := range approvalIDs {
+		record, err := p.store.GetApproval(approvalID)
+		if err != nil {
+			p.API.LogError("Failed to get approval record in bulk decision",
+				"approval_id", approvalID,
+				"error", err.Error(),
+			)
+			notFound = append(notFound, i18n.T(locale, "bulk.result_failed", approvalID, i18n.T(locale, "confirm.not_found")))
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].CreatedAt < records[j].CreatedAt
+	})
+
+	decision := "approved"
+	if action == "deny" {
+		decision = "denied"
+	}
+
+	var lines []string
+	decided := 0
+	for _, record := range records {
+		var failure string
+		switch {
+		case !record.CanDecide(approverID):
+			p.API.LogError("Unauthorized decision attempt",
+				"approval_id", record.ID,
+				"authenticated_user", approverID,
+				"designated_approver", record.ApproverID,
+			)
+			failure = i18n.T(locale, "confirm.permission_denied")
+		case record.Status != approval.StatusPending:
+			failure = formatAlreadyDecided(record, locale)
+		default:
+			updatedRecord, err := p.service.RecordDecision(record.ID, approverID, decision, comment)
+			if err != nil {
+				p.API.LogError("Failed to record decision",
+					"approval_id", record.ID,
+					"action", action,
+					"error", err.Error(),
+				)
+				failure = p.formatDecisionError(err, record.ID, locale)
+				break
+			}
+			p.completeDecision(updatedRecord, approverID, decision)
+			decided++
+		}
+
+		if failure != "" {
+			lines = append(lines, i18n.T(locale, "bulk.result_failed", record.Code, failure))
+		} else {
+			lines = append(lines, i18n.T(locale, "bulk.result_ok", record.Code, i18n.T(locale, "filter."+decision)))
+		}
+	}
+
+	p.API.LogInfo("Bulk decision recorded",
+		"approver_id", approverID,
+		"decision", decision,
+		"selected", len(approvalIDs),
+		"decided", decided,
+	)
+
+	message := i18n.T(locale, "bulk.result_header", decided, len(approvalIDs)) + strings.Join(append(lines, notFound...), "")
+	p.API.SendEphemeralPost(approverID, &model.Post{
+		UserId:    p.botUserID,
+		ChannelId: payload.ChannelId,
+		Message:   message,
+	})
+
+	// Close the dialog; the report shows each result
+	return &model.SubmitDialogResponse{}
+}
+
+// formatDecisionError converts a RecordDecision error into a message in the approver's locale
+func (p *Plugin) formatDecisionError(err error, approvalID, locale string) string {
+	switch {
+	case errors.Is(err, approval.ErrSelfApproval):
+		return i18n.T(locale, "confirm.self_approval")
+	case errors.Is(err, approval.ErrReciprocalApproval):
+		return i18n.T(locale, "confirm.reciprocal", err.Error())
+	case errors.Is(err, approval.ErrDecisionClaimed), errors.Is(err, approval.ErrRecordImmutable):
+		// Another group member confirmed first; report who decided
+		if current, getErr := p.store.GetApproval(approvalID); getErr == nil && current.Status != approval.StatusPending {
+			return formatAlreadyDecided(current, locale)
 		}
+		return i18n.T(locale, "confirm.claimed")
+	default:
+		return i18n.T(locale, "confirm.failed")
+	}
+}
+
+// formatCustomFieldError converts a template custom field error into a message in the requester's locale
+func formatCustomFieldError(err *approval.CustomFieldError, locale string) string {
+	label := err.Field.Label
+	switch {
+	case errors.Is(err, approval.ErrCustomFieldRequired):
+		return i18n.T(locale, "new.field_required", label)
+	case errors.Is(err, approval.ErrCustomFieldTooLong):
+		return i18n.T(locale, "new.field_too_long", label, err.Length, approval.MaxCustomFieldValueLength)
+	case errors.Is(err, approval.ErrCustomFieldNotNumber):
+		return i18n.T(locale, "new.field_not_number", label)
+	case errors.Is(err, approval.ErrCustomFieldNotDate):
+		return i18n.T(locale, "new.field_not_date", label)
+	default:
+		return i18n.T(locale, "new.field_not_option", label, strings.Join(err.Field.Options, ", "))
+	}
+}
+
+// formatApproverError converts a ValidateApprover or CheckApproverPolicy error into a message
+// in the requester's locale
+func (p *Plugin) formatApproverError(err error, locale string) string {
+	var violation *approval.PolicyViolationError
+	switch {
+	case errors.Is(err, approval.ErrSelfApproval):
+		return i18n.T(locale, "new.self_approval")
+	case errors.Is(err, approval.ErrInvalidApprover):
+		return i18n.T(locale, "new.approver_inactive")
+	case errors.As(err, &violation):
+		scope := i18n.T(locale, "new.policy_scope_team")
+		if kind, name, _ := strings.Cut(violation.Scope, ":"); kind == approval.PolicyScopeTemplate {
+			scope = i18n.T(locale, "new.policy_scope_template", name)
+		}
+		return i18n.T(locale, "new.approver_not_allowed", scope, approval.DescribePolicyRules(violation.Policy, p.API, locale))
+	default:
+		return i18n.T(locale, "new.approver_lookup_failed")
 	}
+}
+
+// formatSendAtError converts a ParseSendAt error into a message in the requester's locale
+func formatSendAtError(err error, value, locale string) string {
+	value = strings.TrimSpace(value)
+	switch {
+	case errors.Is(err, approval.ErrSendAtInPast):
+		return i18n.T(locale, "new.send_at_past", value)
+	case errors.Is(err, approval.ErrSendAtTooFar):
+		return i18n.T(locale, "new.send_at_too_far", value, int(approval.MaxScheduleAhead.Hours()/24))
+	default:
+		return i18n.T(locale, "new.send_at_invalid", value)
+	}
+}
+
+// completeDecision runs the best-effort follow-ups of a recorded decision: the requester's
+// outcome DM, the approver DM buttons and the channel status card
+func (p *Plugin) completeDecision(updatedRecord *approval.ApprovalRecord, approverID, decision string) {
+	approvalID := updatedRecord.ID
 
 	// BEST EFFORT: Send outcome notification to requester (Story 2.5, graceful degradation)
 	postID, notifErr := notifications.SendOutcomeNotificationDM(p.API, p.botUserID, updatedRecord)
 	if notifErr != nil {
 		// Story 2.6: Classify error and provide resolution suggestion (AC6)
 		errorType, suggestion := notifications.ClassifyDMError(notifErr)
+		p.metrics.NotificationFailed(errorType)
 
 		// Log warning but DO NOT return error (decision is already recorded successfully)
 		p.API.LogWarn("Failed to send outcome notification",
@@ -515,6 +1251,7 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 			"error_type", errorType,
 			"suggestion", suggestion,
 		)
+		p.queueNotificationRetry(approval.RetryKindOutcome, updatedRecord, notifErr)
 	} else {
 		// Success - update OutcomeNotified flag (also best effort)
 		p.API.LogInfo("Outcome notification sent",
@@ -534,8 +1271,8 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 		}
 	}
 
-	// Disable buttons in original DM notification (best effort)
-	if err := p.disableButtonsInDM(record, decision); err != nil {
+	// Disable buttons in original DM notification(s) (best effort)
+	if err := p.disableButtonsInDM(updatedRecord, decision); err != nil {
 		// Log warning but continue - decision already recorded
 		p.API.LogWarn("Failed to disable buttons in DM notification",
 			"approval_id", approvalID,
@@ -544,41 +1281,62 @@ func (p *Plugin) handleConfirmDecision(payload *model.SubmitDialogRequest) *mode
 		)
 	}
 
+	// Reflect the decision on the channel status card (best effort)
+	p.updateChannelStatusCard(updatedRecord)
+
 	p.API.LogInfo("Approval decision recorded",
 		"approval_id", approvalID,
-		"code", record.Code,
+		"code", updatedRecord.Code,
 		"decision", decision,
 		"approver_id", approverID,
 	)
-
-	// Return success - modal will close
-	return &model.SubmitDialogResponse{}
 }
 
 // disableButtonsInDM disables the action buttons in the original DM notification
 func (p *Plugin) disableButtonsInDM(record *approval.ApprovalRecord, decision string) error {
-	// Check if we have the notification post ID
-	if record.NotificationPostID == "" {
+	// Check if we have the notification post IDs (one per member for group approvals)
+	postIDs := record.ApproverPostIDs()
+	if len(postIDs) == 0 {
 		// Fallback: send new message if post ID not available
 		return p.sendDecisionConfirmationFallback(record, decision)
 	}
 
-	// Get the original post
-	post, appErr := p.API.GetPost(record.NotificationPostID)
-	if appErr != nil {
-		return fmt.Errorf("failed to get original post: %w", appErr)
+	// Update every post in its recipient's locale, reporting the first failure
+	var firstErr error
+	for _, postID := range postIDs {
+		header := decisionHeader(record, decision, record.ApproverPostLocale(postID))
+		if err := p.markApproverPostDecided(postID, header); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
 
-	// Create disabled buttons with updated message
-	statusEmoji := "✅"
-	statusText := "Approved"
+	return firstErr
+}
+
+// decisionHeader is the header prepended to approver DM posts once a decision is recorded
+func decisionHeader(record *approval.ApprovalRecord, decision, locale string) string {
+	key := "decision.approved"
 	if decision == "denied" {
-		statusEmoji = "❌"
-		statusText = "Denied"
+		key = "decision.denied"
+	}
+
+	if record.IsGroupApproval() {
+		// Tell every group member who claimed the decision
+		return i18n.T(locale, key+"_by", record.ApproverUsername)
+	}
+	return i18n.T(locale, key)
+}
+
+// markApproverPostDecided prefixes an approver DM post with the decision header and removes its buttons
+func (p *Plugin) markApproverPostDecided(postID, header string) error {
+	// Get the original post
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return fmt.Errorf("failed to get original post: %w", appErr)
 	}
 
 	// Update the message to show decision recorded
-	post.Message = fmt.Sprintf("%s **Decision Recorded: %s**\n\n%s", statusEmoji, statusText, post.Message)
+	post.Message = fmt.Sprintf("%s\n\n%s", header, post.Message)
 
 	// Remove action buttons by clearing all Props (Story 4.7: same approach as cancellation)
 	// WHY clear all Props instead of selective removal:
@@ -604,20 +1362,13 @@ func (p *Plugin) sendDecisionConfirmationFallback(record *approval.ApprovalRecor
 		return fmt.Errorf("failed to get DM channel: %w", err)
 	}
 
-	// Create informational message
-	statusEmoji := "✅"
-	statusText := "Approved"
+	// Create informational message in the approver's locale
+	locale := record.LocaleFor(record.ApproverID)
+	key := "decision.approved"
 	if decision == "denied" {
-		statusEmoji = "❌"
-		statusText = "Denied"
+		key = "decision.denied"
 	}
-
-	message := fmt.Sprintf(
-		"%s **Decision Recorded: %s**\n\nApproval request `%s` has been decided.\n\nThe requester will be notified of your decision.",
-		statusEmoji,
-		statusText,
-		record.Code,
-	)
+	message := i18n.T(locale, key) + i18n.T(locale, "decision.fallback", record.Code)
 
 	post := &model.Post{
 		UserId:    p.botUserID,
@@ -632,15 +1383,179 @@ func (p *Plugin) sendDecisionConfirmationFallback(record *approval.ApprovalRecor
 	return nil
 }
 
+// resendHeader is prepended to earlier approver DM posts that still show buttons when a notification
+// is resent, so only the newest message can be acted on
+func resendHeader(record *approval.ApprovalRecord, locale string) string {
+	switch record.Status {
+	case approval.StatusPending:
+		return i18n.T(locale, "resend.header")
+	case approval.StatusApproved, approval.StatusDenied:
+		return decisionHeader(record, record.Status, locale)
+	default:
+		return i18n.T(locale, "resend.canceled_header")
+	}
+}
+
+// resendNotification rebuilds and sends the DM matching the record's current state to the target
+// (approver or requester) and updates the record's delivery tracking fields. It does not save the record.
+// Returns the resent notification ("request", "outcome", ...) and the first new post ID.
+func (p *Plugin) resendNotification(record *approval.ApprovalRecord, target string) (string, string, error) {
+	if target == approval.RedeliveryTargetApprover {
+		return p.resendApproverNotification(record)
+	}
+
+	switch record.Status {
+	case approval.StatusPending:
+		postID, err := notifications.SendRequesterConfirmationDM(p.API, p.botUserID, record)
+		if err != nil {
+			return "", "", err
+		}
+		record.RequesterPostID = postID
+		return "confirmation", postID, nil
+	case approval.StatusApproved, approval.StatusDenied:
+		postID, err := notifications.SendOutcomeNotificationDM(p.API, p.botUserID, record)
+		if err != nil {
+			return "", "", err
+		}
+		record.OutcomeNotified = true
+		return "outcome", postID, nil
+	default:
+		if notifications.IsAutoCanceled(record) {
+			postID, err := notifications.SendTimeoutNotificationDM(p.API, p.botUserID, record)
+			return "timeout", postID, err
+		}
+		postID, err := notifications.SendRequesterCancellationNotificationDM(p.API, p.botUserID, record)
+		return "cancellation", postID, err
+	}
+}
+
+// resendApproverNotification resends the approver side: the request with live buttons while pending,
+// otherwise the decision or cancellation notice. Earlier posts that still show buttons are disabled.
+func (p *Plugin) resendApproverNotification(record *approval.ApprovalRecord) (string, string, error) {
+	earlierPostIDs := record.ApproverPostIDs()
+
+	switch record.Status {
+	case approval.StatusPending:
+		postID, replaced, err := p.resendApprovalRequest(record)
+		if err != nil {
+			return "", "", err
+		}
+		p.removeStaleButtons(replaced, record)
+		return "request", postID, nil
+	case approval.StatusApproved, approval.StatusDenied:
+		if err := p.sendDecisionConfirmationFallback(record, record.Status); err != nil {
+			return "", "", err
+		}
+		p.removeStaleButtons(earlierPostIDs, record)
+		return "decision", "", nil
+	default:
+		canceledBy := record.RequesterUsername
+		if notifications.IsAutoCanceled(record) {
+			canceledBy = "System"
+		}
+		postID, err := notifications.SendCancellationNotificationDM(p.API, p.botUserID, record, canceledBy)
+		if err != nil {
+			return "", "", err
+		}
+		p.removeStaleButtons(earlierPostIDs, record)
+		return "cancellation", postID, nil
+	}
+}
+
+// resendApprovalRequest sends a fresh approval request DM with live buttons to the approver, or to every
+// group candidate. Returns the first new post ID and the earlier post IDs it replaced; fails only if no
+// one was reached.
+func (p *Plugin) resendApprovalRequest(record *approval.ApprovalRecord) (string, []string, error) {
+	if !record.IsGroupApproval() {
+		postID, err := notifications.SendApprovalRequestDM(p.API, p.botUserID, p.actionSigner, record)
+		if err != nil {
+			return "", nil, err
+		}
+		var replaced []string
+		if record.NotificationPostID != "" {
+			replaced = append(replaced, record.NotificationPostID)
+		}
+		record.NotificationSent = true
+		record.NotificationPostID = postID
+		return postID, replaced, nil
+	}
+
+	candidatePostIDs := make(map[string]string, len(record.CandidateApproverIDs))
+	maps.Copy(candidatePostIDs, record.CandidatePostIDs)
+
+	var firstPostID string
+	var replaced []string
+	var firstErr error
+	for _, approverID := range record.CandidateApproverIDs {
+		postID, err := notifications.SendApprovalRequestDMTo(p.API, p.botUserID, p.actionSigner, record, approverID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if earlier := candidatePostIDs[approverID]; earlier != "" {
+			replaced = append(replaced, earlier)
+		}
+		candidatePostIDs[approverID] = postID
+		if firstPostID == "" {
+			firstPostID = postID
+		}
+	}
+
+	if firstPostID == "" {
+		return "", nil, firstErr
+	}
+	record.CandidatePostIDs = candidatePostIDs
+	record.NotificationSent = true
+	record.NotificationPostID = firstPostID
+	return firstPostID, replaced, nil
+}
+
+// removeStaleButtons prefixes earlier approver DM posts that still show buttons with the resend header
+// for the record's state and removes the buttons (best effort)
+func (p *Plugin) removeStaleButtons(postIDs []string, record *approval.ApprovalRecord) {
+	for _, postID := range postIDs {
+		post, appErr := p.API.GetPost(postID)
+		if appErr != nil {
+			p.API.LogWarn("Failed to get earlier approval post", "post_id", postID, "error", appErr.Error())
+			continue
+		}
+		if !hasActionButtons(post) {
+			continue
+		}
+
+		header := resendHeader(record, record.ApproverPostLocale(postID))
+		post.Message = fmt.Sprintf("%s\n\n%s", header, post.Message)
+		post.Props = model.StringInterface{}
+		if _, appErr := p.API.UpdatePost(post); appErr != nil {
+			p.API.LogWarn("Failed to disable buttons on earlier approval post", "post_id", postID, "error", appErr.Error())
+		}
+	}
+}
+
+// hasActionButtons reports whether a post still carries interactive buttons
+func hasActionButtons(post *model.Post) bool {
+	for _, attachment := range post.Attachments() {
+		if len(attachment.Actions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // handleCancelModalSubmission processes cancellation modal submissions
 // Story 4.3: Handles user-selected cancellation reason and performs cancellation
 func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest) *model.SubmitDialogResponse {
+	// The dialog State carries the requester's locale
+	locale := payload.State
+
 	// Parse callback ID: "cancel_approval_{approvalID}"
 	parts := strings.Split(payload.CallbackId, "_")
 	if len(parts) != 3 {
 		p.API.LogError("Invalid callback ID format", "callback_id", payload.CallbackId)
 		return &model.SubmitDialogResponse{
-			Error: "Invalid request format",
+			Error: i18n.T(locale, "dialog.invalid_request"),
 		}
 	}
 
@@ -651,7 +1566,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 	if !ok || reasonCode == "" {
 		return &model.SubmitDialogResponse{
 			Errors: map[string]string{
-				"reason_code": "Reason is required",
+				"reason_code": i18n.T(locale, "cancel.reason_required"),
 			},
 		}
 	}
@@ -668,7 +1583,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 	if reasonCode == "other" && additionalDetails == "" {
 		return &model.SubmitDialogResponse{
 			Errors: map[string]string{
-				"additional_details": "Please provide details when selecting 'Other reason'",
+				"additional_details": i18n.T(locale, "cancel.details_required"),
 			},
 		}
 	}
@@ -684,7 +1599,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 			"error", err.Error(),
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Approval request not found",
+			Error: i18n.T(locale, "cancel.not_found"),
 		}
 	}
 
@@ -696,7 +1611,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 			"requester_id", record.RequesterID,
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Only the requester can cancel this approval",
+			Error: i18n.T(locale, "cancel.requester_only"),
 		}
 	}
 
@@ -708,7 +1623,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 			"user_id", payload.UserId,
 		)
 		return &model.SubmitDialogResponse{
-			Error: "Failed to retrieve user information. Please try again.",
+			Error: i18n.T(locale, "cancel.user_failed"),
 		}
 	}
 
@@ -721,7 +1636,7 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 			"user_id", payload.UserId,
 		)
 		return &model.SubmitDialogResponse{
-			Error: p.formatCancelError(err, record.Code),
+			Error: p.formatCancelError(err, record.Code, locale),
 		}
 	}
 
@@ -733,34 +1648,42 @@ func (p *Plugin) handleCancelModalSubmission(payload *model.SubmitDialogRequest)
 			"approval_code", record.Code,
 		)
 	} else {
-		// Update the original post (Story 4.1)
-		err = notifications.UpdateApprovalPostForCancellation(p.API, updatedRecord, requester.Username)
-		if err != nil {
-			p.API.LogWarn("Failed to update approver post",
-				"error", err.Error(),
-				"approval_code", record.Code,
-				"approver_post_id", updatedRecord.NotificationPostID,
-			)
+		// Scheduled requests were never sent, so the approver has nothing to update or be told
+		if record.Status != approval.StatusScheduled {
+			// Update the original post (Story 4.1)
+			err = notifications.UpdateApprovalPostForCancellation(p.API, updatedRecord, requester.Username)
+			if err != nil {
+				p.API.LogWarn("Failed to update approver post",
+					"error", err.Error(),
+					"approval_code", record.Code,
+					"approver_post_id", updatedRecord.NotificationPostID,
+				)
+			}
+
+			// Send cancellation notification DM to approver (Story 4.2)
+			_, err = notifications.SendCancellationNotificationDM(p.API, p.botUserID, updatedRecord, requester.Username)
+			if err != nil {
+				errorType, suggestion := notifications.ClassifyDMError(err)
+				p.metrics.NotificationFailed(errorType)
+				p.API.LogWarn("Failed to send cancellation notification to approver",
+					"error", err.Error(),
+					"error_type", errorType,
+					"suggestion", suggestion,
+					"approval_code", updatedRecord.Code,
+					"approver_id", updatedRecord.ApproverID,
+				)
+				// Continue - cancellation already recorded, notification is best-effort
+			}
 		}
 
-		// Send cancellation notification DM to approver (Story 4.2)
-		_, err = notifications.SendCancellationNotificationDM(p.API, p.botUserID, updatedRecord, requester.Username)
-		if err != nil {
-			errorType, suggestion := notifications.ClassifyDMError(err)
-			p.API.LogWarn("Failed to send cancellation notification to approver",
-				"error", err.Error(),
-				"error_type", errorType,
-				"suggestion", suggestion,
-				"approval_code", updatedRecord.Code,
-				"approver_id", updatedRecord.ApproverID,
-			)
-			// Continue - cancellation already recorded, notification is best-effort
-		}
+		// Reflect the cancellation on the channel status card
+		p.updateChannelStatusCard(updatedRecord)
 
 		// Send cancellation notification DM to requestor (Story 7.1)
 		_, err = notifications.SendRequesterCancellationNotificationDM(p.API, p.botUserID, updatedRecord)
 		if err != nil {
 			errorType, suggestion := notifications.ClassifyDMError(err)
+			p.metrics.NotificationFailed(errorType)
 			p.API.LogWarn("Failed to send cancellation notification to requestor",
 				"error", err.Error(),
 				"error_type", errorType,
@@ -7,20 +7,29 @@ import (
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/command"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/retry"
 	"github.com/mattermost/mattermost-plugin-approver2/server/store"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
+// testActionSigner signs and verifies DM button contexts in plugin tests
+var testActionSigner = notifications.NewActionSigner([]byte("test-action-signing-secret"))
+
 func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 	t.Run("ephemeral confirmation sent with correct format", func(t *testing.T) {
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		// Mock user lookups
 		requester := &model.User{
@@ -44,7 +53,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
 			// Should query approval:record:, approval:code:, or approval:index: keys
 			return len(key) > 10 && (key[:16] == "approval:record:" ||
-				key[:14] == "approval:code:" ||
+				key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") ||
 				(len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 		api.On("KVSet", mock.MatchedBy(func(key string) bool {
@@ -56,6 +65,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			// This is the DM notification to approver
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
@@ -109,9 +119,11 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 	t.Run("ephemeral post uses correct user ID", func(t *testing.T) {
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		requester := &model.User{
 			Id:       "user999",
@@ -130,7 +142,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 		api.On("GetUser", "user999").Return(requester, nil)
 		api.On("GetUser", "user888").Return(approver, nil)
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
-			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
+			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") || (len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 		api.On("KVSet", mock.MatchedBy(func(key string) bool {
 			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
@@ -138,6 +150,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "user888").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "user999").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -176,9 +189,11 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 	t.Run("approval saved even if ephemeral confirmation fails", func(t *testing.T) {
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		requester := &model.User{
 			Id:       "requester111",
@@ -224,6 +239,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "approver222").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester111").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -279,9 +295,11 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 	t.Run("message format matches AC2 exactly", func(t *testing.T) {
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		requester := &model.User{
 			Id:       "req555",
@@ -300,7 +318,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 		api.On("GetUser", "req555").Return(requester, nil)
 		api.On("GetUser", "app666").Return(approver, nil)
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
-			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
+			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") || (len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 		api.On("KVSet", mock.MatchedBy(func(key string) bool {
 			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
@@ -308,6 +326,7 @@ func TestHandleApproveNew_EphemeralConfirmation(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "app666").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "req555").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -360,9 +379,11 @@ func TestHandleApproveNew_Performance(t *testing.T) {
 	t.Run("operation completes within 2 seconds", func(t *testing.T) {
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		requester := &model.User{
 			Id:       "perf123",
@@ -381,7 +402,7 @@ func TestHandleApproveNew_Performance(t *testing.T) {
 		api.On("GetUser", "perf123").Return(requester, nil)
 		api.On("GetUser", "perf456").Return(approver, nil)
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
-			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
+			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") || (len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 		api.On("KVSet", mock.MatchedBy(func(key string) bool {
 			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
@@ -389,6 +410,7 @@ func TestHandleApproveNew_Performance(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "perf456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "perf123").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -439,9 +461,11 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		// AC4: Mattermost authentication - user identity from authenticated session
 		requester := &model.User{
@@ -463,7 +487,7 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Mock KV store operations for approval persistence with key validation
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
-			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
+			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") || (len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 
 		// AC1: Capture the ApprovalRecord to verify complete data
@@ -486,6 +510,7 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "integration-approver").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "integration-requester").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -583,9 +608,11 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Setup
 		api := &plugintest.API{}
+		mockSearchIndex(api)
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123" // Set bot user ID for notification
+		plugin.actionSigner = testActionSigner
 
 		requester := &model.User{
 			Id:       "req-fail-test",
@@ -606,7 +633,7 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Mock successful KV operations with key validation
 		api.On("KVGet", mock.MatchedBy(func(key string) bool {
-			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || (len(key) > 15 && key[:15] == "approval:index:"))
+			return len(key) > 10 && (key[:16] == "approval:record:" || key[:14] == "approval:code:" || strings.HasPrefix(key, "approval:policy:") || strings.HasPrefix(key, "approval:sla:target:") || (len(key) > 15 && key[:15] == "approval:index:"))
 		})).Return(nil, nil)
 		var recordSaved bool
 		api.On("KVSet", mock.MatchedBy(func(key string) bool {
@@ -624,6 +651,7 @@ func TestHandleApproveNew_IntegrationFlow(t *testing.T) {
 
 		// Story 2.1: Mock notification DM calls
 		api.On("GetDirectChannel", "bot123", "app-fail-test").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "req-fail-test").Return(&model.Channel{Id: "dm_channel"}, nil)
 		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
 			return post.UserId == "bot123" && post.ChannelId == "dm_channel"
 		})).Return(&model.Post{}, nil)
@@ -686,7 +714,9 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Create an approval record that will trigger modal
 		record := &approval.ApprovalRecord{
@@ -730,6 +760,7 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 			TriggerId: "trigger123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 
 		// Verify success (modal opened, no cancellation yet)
@@ -763,7 +794,9 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Record already canceled
 		canceledRecord := &approval.ApprovalRecord{
@@ -799,6 +832,7 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 		}
 
 		// Modal opens successfully (validation happens on submit)
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -815,7 +849,9 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Record owned by alice123
 		record := &approval.ApprovalRecord{
@@ -847,6 +883,7 @@ func TestHandleCancelCommand_Integration(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -870,7 +907,9 @@ func TestHandleCancelCommand_Performance(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Setup test record
 		record := &approval.ApprovalRecord{
@@ -907,6 +946,7 @@ func TestHandleCancelCommand_Performance(t *testing.T) {
 
 		// Execute with timing
 		start := time.Now()
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		elapsed := time.Since(start)
 
@@ -1016,7 +1056,7 @@ func TestHandleCancelModalSubmission(t *testing.T) {
 		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-		p := &Plugin{botUserID: "bot123"}
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
 		p.SetAPI(api)
 		p.store = store.NewKVStore(api)
 		p.service = approval.NewService(p.store, api, "bot123")
@@ -1038,6 +1078,50 @@ func TestHandleCancelModalSubmission(t *testing.T) {
 		api.AssertExpectations(t)
 	})
 
+	t.Run("scheduled request is canceled without notifying the approver", func(t *testing.T) {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+
+		recordJSON := `{
+			"id": "record123",
+			"code": "A-X7K9Q2",
+			"requesterId": "user123",
+			"approverId": "approver456",
+			"status": "scheduled",
+			"createdAt": 1704931200000,
+			"scheduledAt": 1704934800000,
+			"schemaVersion": 1
+		}`
+		api.On("KVGet", "approval:record:record123").Return([]byte(recordJSON), nil)
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "testuser"}, nil)
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("KVDelete", "approval:schedule:record123").Return(nil)
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_requester"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post123"}, nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		p.service = approval.NewService(p.store, api, "bot123")
+
+		response := p.handleCancelModalSubmission(&model.SubmitDialogRequest{
+			CallbackId: "cancel_approval_record123",
+			UserId:     "user123",
+			Submission: map[string]any{
+				"reason_code": "no_longer_needed",
+			},
+		})
+
+		assert.Empty(t, response.Error)
+		api.AssertCalled(t, "KVDelete", "approval:schedule:record123")
+		api.AssertNotCalled(t, "GetDirectChannel", "bot123", "approver456")
+		api.AssertNotCalled(t, "GetPost", mock.Anything)
+		api.AssertNumberOfCalls(t, "CreatePost", 1)
+	})
+
 	t.Run("validation error when other selected without text", func(t *testing.T) {
 		p := &Plugin{}
 
@@ -1212,7 +1296,7 @@ func TestHandleCancelModalSubmission_MaxLengthHandling(t *testing.T) {
 		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-		p := &Plugin{botUserID: "bot123"}
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
 		p.SetAPI(api)
 		p.store = store.NewKVStore(api)
 		p.service = approval.NewService(p.store, api, "bot123")
@@ -1269,7 +1353,7 @@ func TestHandleCancelModalSubmission_MaxLengthHandling(t *testing.T) {
 		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
-		p := &Plugin{botUserID: "bot123"}
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
 		p.SetAPI(api)
 		p.store = store.NewKVStore(api)
 		p.service = approval.NewService(p.store, api, "bot123")
@@ -1370,7 +1454,7 @@ func TestHandleCancelModalSubmission_CapturesDetailsForAllReasons(t *testing.T)
 			api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
 
 			// Initialize plugin with real service
-			p := &Plugin{botUserID: "bot123"}
+			p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
 			p.SetAPI(api)
 			p.store = store.NewKVStore(api)
 			p.service = approval.NewService(p.store, api, "bot123")
@@ -1548,6 +1632,7 @@ func TestDisableButtonsInDM(t *testing.T) {
 		plugin := &Plugin{}
 		plugin.SetAPI(api)
 		plugin.botUserID = "bot123"
+		plugin.actionSigner = testActionSigner
 
 		// Mock GetDirectChannel for fallback (botUserID, targetUserID)
 		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_channel"}, nil)
@@ -1604,3 +1689,1001 @@ func TestDisableButtonsInDM(t *testing.T) {
 		// that the caller logs with LogWarn and continues processing.
 	})
 }
+
+func TestHandleApproveNew_Template(t *testing.T) {
+	templateJSON := `{"name":"prod-access","fields":[` +
+		`{"name":"system","label":"System","type":"select","required":true,"options":["database","kubernetes"]},` +
+		`{"name":"hours","label":"Duration (hours)","type":"number","required":true}]}`
+
+	setup := func() *plugintest.API {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("KVGet", "approval:template:prod-access").Return([]byte(templateJSON), nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api
+	}
+
+	t.Run("invalid custom fields keep modal open with field errors", func(t *testing.T) {
+		api := setup()
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		response := p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			CallbackId: "approve_new",
+			State:      "prod-access",
+			Submission: map[string]any{
+				"approver":     "approver456",
+				"description":  "Need database access",
+				"field_system": "mainframe",
+				"field_hours":  "",
+			},
+		})
+
+		assert.Empty(t, response.Error)
+		assert.Contains(t, response.Errors["field_system"], "must be one of")
+		assert.Contains(t, response.Errors["field_hours"], "is required")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("custom field errors are shown in the requester's locale", func(t *testing.T) {
+		api := setup()
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		response := p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			CallbackId: "approve_new",
+			State:      command.DialogState{Template: "prod-access", Locale: "de"}.Encode(),
+			Submission: map[string]any{
+				"approver":     "approver456",
+				"description":  "Need database access",
+				"field_system": "mainframe",
+				"field_hours":  "four",
+			},
+		})
+
+		assert.Equal(t, "System muss einer der folgenden Werte sein: database, kubernetes.", response.Errors["field_system"])
+		assert.Equal(t, "Duration (hours) muss eine Zahl sein.", response.Errors["field_hours"])
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("valid custom fields are persisted on the record", func(t *testing.T) {
+		api := setup()
+		p := &Plugin{}
+		p.SetAPI(api)
+		p.botUserID = "bot123"
+		p.actionSigner = testActionSigner
+
+		api.On("GetUser", "requester123").Return(&model.User{Id: "requester123", Username: "alice"}, nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post123"}, nil)
+		api.On("SendEphemeralPost", "requester123", mock.Anything).Return(&model.Post{})
+
+		response := p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			ChannelId:  "channel123",
+			CallbackId: "approve_new",
+			State:      "prod-access",
+			Submission: map[string]any{
+				"approver":     "approver456",
+				"description":  "Need database access",
+				"field_system": "database",
+				"field_hours":  "4",
+			},
+		})
+
+		assert.Empty(t, response.Error)
+		assert.Empty(t, response.Errors)
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), mock.MatchedBy(func(data []byte) bool {
+			var record approval.ApprovalRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return false
+			}
+			return record.TemplateName == "prod-access" &&
+				len(record.CustomFields) == 2 &&
+				record.CustomFields[0].Value == "database" &&
+				record.CustomFields[1].Value == "4"
+		}))
+	})
+
+	t.Run("deleted template closes modal with error", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:template:gone").Return(nil, nil)
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		response := p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId: "requester123",
+			State:  "gone",
+			Submission: map[string]any{
+				"approver":    "approver456",
+				"description": "Need database access",
+			},
+		})
+
+		assert.Contains(t, response.Error, "no longer available")
+	})
+}
+
+func TestHandleApproveNew_ApproverPolicy(t *testing.T) {
+	const teamID = "teamaaaaaaaaaaaaaaaaaaaaaa"
+
+	setup := func(policy *approval.ApproverPolicy) *plugintest.API {
+		api := &plugintest.API{}
+		if policy != nil {
+			data, _ := json.Marshal(policy)
+			api.On("KVGet", "approval:policy:team:"+teamID).Return(data, nil)
+		}
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api
+	}
+
+	payload := func() *model.SubmitDialogRequest {
+		return &model.SubmitDialogRequest{
+			UserId:     "requester123",
+			ChannelId:  "channel123",
+			TeamId:     teamID,
+			CallbackId: "approve_new",
+			Submission: map[string]any{
+				"approver":    "approver456",
+				"description": "Need database access",
+			},
+		}
+	}
+
+	t.Run("approver outside team policy gets field error", func(t *testing.T) {
+		api := setup(&approval.ApproverPolicy{Scope: "team:" + teamID, Groups: []string{"sre"}})
+		api.On("GetGroupsForUser", "approver456").Return([]*model.Group{}, nil)
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		response := p.handleApproveNew(payload())
+
+		assert.Empty(t, response.Error)
+		assert.Contains(t, response.Errors["approver"], "not allowed by policy for this team")
+		assert.Contains(t, response.Errors["approver"], "Allowed approvers: group sre")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("policy violation is shown in the requester's locale", func(t *testing.T) {
+		api := setup(&approval.ApproverPolicy{Scope: "team:" + teamID, Groups: []string{"sre"}})
+		api.On("GetGroupsForUser", "approver456").Return([]*model.Group{}, nil)
+		p := &Plugin{}
+		p.SetAPI(api)
+		request := payload()
+		request.State = command.DialogState{Locale: "de"}.Encode()
+
+		response := p.handleApproveNew(request)
+
+		assert.Equal(t, "Der ausgewählte Genehmiger ist laut Richtlinie für dieses Team nicht zulässig. Zulässige Genehmiger: Gruppe sre", response.Errors["approver"])
+	})
+
+	t.Run("policy lookup failure closes modal with error", func(t *testing.T) {
+		api := setup(nil)
+		api.On("KVGet", "approval:policy:team:"+teamID).Return(nil, &model.AppError{Message: "KV error"})
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		response := p.handleApproveNew(payload())
+
+		assert.Contains(t, response.Error, "Failed to check approver policy")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleApproveNew_SLATarget(t *testing.T) {
+	const teamID = "teamaaaaaaaaaaaaaaaaaaaaaa"
+
+	setup := func() *plugintest.API {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("GetUser", "requester123").Return(&model.User{Id: "requester123", Username: "alice"}, nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post123"}, nil)
+		api.On("SendEphemeralPost", "requester123", mock.Anything).Return(&model.Post{})
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api
+	}
+
+	submit := func(api *plugintest.API) *model.SubmitDialogResponse {
+		p := &Plugin{}
+		p.SetAPI(api)
+		p.botUserID = "bot123"
+		p.actionSigner = testActionSigner
+
+		return p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			ChannelId:  "channel123",
+			TeamId:     teamID,
+			CallbackId: "approve_new",
+			Submission: map[string]any{
+				"approver":    "approver456",
+				"description": "Need database access",
+			},
+		})
+	}
+
+	savedRecord := func(match func(record approval.ApprovalRecord) bool) interface{} {
+		return mock.MatchedBy(func(data []byte) bool {
+			var record approval.ApprovalRecord
+			return json.Unmarshal(data, &record) == nil && record.Code != "" && match(record)
+		})
+	}
+
+	t.Run("team target is snapshotted on the record", func(t *testing.T) {
+		api := setup()
+		target, _ := json.Marshal(&approval.SLATarget{Scope: "team:" + teamID, Minutes: 15, EscalationChannelID: "escalation123"})
+		api.On("KVGet", "approval:sla:target:team:"+teamID).Return(target, nil)
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+
+		response := submit(api)
+
+		assert.Empty(t, response.Error)
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), savedRecord(func(record approval.ApprovalRecord) bool {
+			return record.SLAMinutes == 15 && record.SLAEscalationChannelID == "escalation123"
+		}))
+	})
+
+	t.Run("SLA lookup failure still creates the request untracked", func(t *testing.T) {
+		api := setup()
+		api.On("KVGet", "approval:sla:target:team:"+teamID).Return(nil, &model.AppError{Message: "KV error"})
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+
+		response := submit(api)
+
+		assert.Empty(t, response.Error)
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), savedRecord(func(record approval.ApprovalRecord) bool {
+			return record.SLAMinutes == 0
+		}))
+	})
+}
+
+func TestHandleApproveNew_SelfApproval(t *testing.T) {
+	api := &plugintest.API{}
+	api.On("KVGet", "approval:sod:violations").Return(nil, nil)
+	api.On("KVSetWithOptions", "approval:sod:violations", mock.MatchedBy(func(data []byte) bool {
+		var violations []*approval.SoDViolation
+		return json.Unmarshal(data, &violations) == nil &&
+			len(violations) == 1 &&
+			violations[0].Rule == approval.SoDRuleSelfApproval &&
+			violations[0].UserID == "requester123"
+	}), mock.Anything).Return(true, nil)
+	api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	p := &Plugin{}
+	p.SetAPI(api)
+
+	response := p.handleApproveNew(&model.SubmitDialogRequest{
+		UserId:     "requester123",
+		CallbackId: "approve_new",
+		Submission: map[string]any{
+			"approver":    "requester123",
+			"description": "Approve my own deployment",
+		},
+	})
+
+	assert.Empty(t, response.Error)
+	assert.Contains(t, response.Errors["approver"], "You cannot approve your own request")
+	api.AssertNotCalled(t, "GetUser", mock.Anything)
+	api.AssertExpectations(t)
+}
+
+func TestHandleConfirmDecision_SeparationOfDuties(t *testing.T) {
+	newRecord := func(requesterID string) []byte {
+		data, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-PEND01",
+			RequesterID:       requesterID,
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			Description:       "Deploy",
+			Status:            approval.StatusPending,
+			CreatedAt:         model.GetMillis(),
+			SchemaVersion:     1,
+		})
+		return data
+	}
+
+	setup := func(requesterID string) (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:record:record123").Return(newRecord(requesterID), nil)
+		api.On("KVGet", "approval:sod:violations").Return(nil, nil)
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.Anything, mock.Anything).Return(true, nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		p.service = approval.NewService(p.store, api, "bot123")
+		return api, p
+	}
+
+	t.Run("legacy self-approval record is blocked", func(t *testing.T) {
+		api, p := setup("approver456")
+
+		response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+			UserId:     "approver456",
+			CallbackId: "confirm_approve_record123",
+		})
+
+		assert.Contains(t, response.Error, "cannot decide your own approval request")
+		api.AssertCalled(t, "KVSetWithOptions", "approval:sod:violations", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reciprocal approval within window is blocked", func(t *testing.T) {
+		api, p := setup("alice123")
+		p.service.SetSeparationOfDuties(approval.SeparationOfDuties{ReciprocalWindow: 24 * time.Hour})
+
+		reciprocal, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:          "earlier",
+			Code:        "A-EARLY1",
+			RequesterID: "approver456",
+			ApproverID:  "alice123",
+			Status:      approval.StatusApproved,
+			DecidedAt:   model.GetMillis() - time.Hour.Milliseconds(),
+		})
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return([]string{"approval:index:requester:approver456:1:earlier"}, nil)
+		api.On("KVGet", "approval:index:requester:approver456:1:earlier").Return([]byte(`"earlier"`), nil)
+		api.On("KVGet", "approval:record:earlier").Return(reciprocal, nil)
+
+		response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+			UserId:     "approver456",
+			CallbackId: "confirm_approve_record123",
+		})
+
+		assert.Contains(t, response.Error, "Cannot approve: reciprocal approval blocked by separation of duties")
+		assert.Contains(t, response.Error, "@alice approved your request A-EARLY1 within the last 24h")
+		api.AssertNotCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+}
+
+func TestHandleApproveNew_GroupApprover(t *testing.T) {
+	groupName := "sre-oncall"
+	state := `{"approverGroup":"sre-oncall"}`
+
+	setup := func(members []*model.User) (*plugintest.API, *Plugin, *approval.ApprovalRecord) {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("KVGet", mock.Anything).Return(nil, nil)
+		api.On("GetGroupByName", "sre-oncall").Return(&model.Group{Id: "group1", Name: &groupName, DisplayName: "SRE On-Call"}, nil)
+		api.On("GetGroupMemberUsers", "group1", 0, 200).Return(members, nil)
+		api.On("GetUser", "requester123").Return(&model.User{Id: "requester123", Username: "alice"}, nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		saved := &approval.ApprovalRecord{}
+		api.On("KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			_ = json.Unmarshal(args.Get(1).([]byte), saved)
+		}).Return(nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		return api, p, saved
+	}
+
+	payload := &model.SubmitDialogRequest{
+		UserId:     "requester123",
+		ChannelId:  "channel123",
+		CallbackId: "approve_new",
+		State:      state,
+		Submission: map[string]any{"description": "Restart the payments cluster"},
+	}
+
+	t.Run("notifies every member and records candidates", func(t *testing.T) {
+		api, p, saved := setup([]*model.User{
+			{Id: "requester123", Username: "alice"},
+			{Id: "bob123", Username: "bob"},
+			{Id: "carol123", Username: "carol"},
+		})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("GetDirectChannel", "bot123", "carol123").Return(&model.Channel{Id: "dm_carol"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == "dm_bob" })).Return(&model.Post{Id: "post_bob"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == "dm_carol" })).Return(&model.Post{Id: "post_carol"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == "dm_alice" })).Return(&model.Post{Id: "post_alice"}, nil)
+
+		var confirmation string
+		api.On("SendEphemeralPost", "requester123", mock.MatchedBy(func(post *model.Post) bool {
+			confirmation = post.Message
+			return true
+		})).Return(&model.Post{})
+
+		response := p.handleApproveNew(payload)
+
+		assert.Empty(t, response.Error)
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "", saved.ApproverID)
+		assert.Equal(t, "sre-oncall", saved.ApproverGroupName)
+		assert.Equal(t, "SRE On-Call group", saved.ApproverDisplayName)
+		assert.Equal(t, []string{"bob123", "carol123"}, saved.CandidateApproverIDs)
+		assert.Equal(t, map[string]string{"bob123": "post_bob", "carol123": "post_carol"}, saved.CandidatePostIDs)
+		assert.True(t, saved.NotificationSent)
+		assert.Equal(t, "post_alice", saved.RequesterPostID)
+		assert.Contains(t, confirmation, "**Approvers:** @sre-oncall (2 members, first to confirm decides)")
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:index:approver:bob123:")
+		}), mock.Anything)
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:index:approver:carol123:")
+		}), mock.Anything)
+	})
+
+	t.Run("group with no eligible members closes modal with error", func(t *testing.T) {
+		api, p, _ := setup([]*model.User{{Id: "requester123", Username: "alice"}})
+
+		response := p.handleApproveNew(payload)
+
+		assert.Contains(t, response.Error, "Cannot create group approval request: no eligible approvers in group @sre-oncall")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleConfirmDecision_GroupApproval(t *testing.T) {
+	groupRecord := func(status, approverID, approverUsername string) []byte {
+		data, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-GROUP1",
+			RequesterID:          "requester123",
+			RequesterUsername:    "alice",
+			ApproverID:           approverID,
+			ApproverUsername:     approverUsername,
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			CandidatePostIDs:     map[string]string{"bob123": "post_bob", "carol123": "post_carol"},
+			NotificationPostID:   "post_bob",
+			Description:          "Deploy",
+			Status:               status,
+			CreatedAt:            model.GetMillis(),
+			SchemaVersion:        1,
+		})
+		return data
+	}
+
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		api.On("GetUser", "carol123").Return(&model.User{Id: "carol123", Username: "carol"}, nil).Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		p.service = approval.NewService(p.store, api, "bot123")
+		return api, p
+	}
+
+	t.Run("first member to confirm decides and every DM is updated", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVGet", "approval:record:record123").Return(groupRecord(approval.StatusPending, "", "sre-oncall"), nil)
+		api.On("KVSetWithOptions", "approval:claim:record123", []byte("carol123"), mock.Anything).Return(true, nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "outcome_post"}, nil)
+
+		var updated []string
+		for _, postID := range []string{"post_bob", "post_carol"} {
+			api.On("GetPost", postID).Return(&model.Post{Id: postID, Message: "📋 **Approval Request**"}, nil)
+		}
+		api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			updated = append(updated, post.Id)
+			return strings.HasPrefix(post.Message, "✅ **Decision Recorded: Approved by @carol**") && len(post.Props) == 0
+		})).Return(&model.Post{}, nil)
+
+		response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+			UserId:     "carol123",
+			CallbackId: "confirm_approve_record123",
+		})
+
+		assert.Empty(t, response.Error)
+		assert.ElementsMatch(t, []string{"post_bob", "post_carol"}, updated)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.MatchedBy(func(data []byte) bool {
+			var saved approval.ApprovalRecord
+			return json.Unmarshal(data, &saved) == nil &&
+				saved.Status == approval.StatusApproved &&
+				saved.ApproverID == "carol123" &&
+				saved.ApproverUsername == "carol"
+		}))
+	})
+
+	t.Run("member who loses the claim is told who decided", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVGet", "approval:record:record123").Return(groupRecord(approval.StatusPending, "", "sre-oncall"), nil).Times(2)
+		api.On("KVGet", "approval:record:record123").Return(groupRecord(approval.StatusDenied, "bob123", "bob"), nil)
+		api.On("KVSetWithOptions", "approval:claim:record123", []byte("carol123"), mock.Anything).Return(false, nil)
+
+		response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+			UserId:     "carol123",
+			CallbackId: "confirm_approve_record123",
+		})
+
+		assert.Equal(t, "Decision already recorded: denied by @bob", response.Error)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("non-member is denied", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVGet", "approval:record:record123").Return(groupRecord(approval.StatusPending, "", "sre-oncall"), nil)
+
+		response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+			UserId:     "mallory123",
+			CallbackId: "confirm_approve_record123",
+		})
+
+		assert.Equal(t, "Permission denied", response.Error)
+		api.AssertNotCalled(t, "KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleApproveNew_ChannelStatusCard(t *testing.T) {
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("GetUser", "requester123").Return(&model.User{Id: "requester123", Username: "alice"}, nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_channel"
+		})).Return(&model.Post{Id: "dm_post"}, nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		return api, p
+	}
+
+	submit := func(p *Plugin, visibility string) *model.SubmitDialogResponse {
+		return p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			ChannelId:  "channel123",
+			TeamId:     "team789",
+			CallbackId: "approve_new",
+			Submission: map[string]any{
+				"approver":    "approver456",
+				"description": "Rotate the production TLS certificate",
+				"visibility":  visibility,
+			},
+		})
+	}
+
+	savedWithCard := func(private bool) any {
+		return mock.MatchedBy(func(data []byte) bool {
+			var record approval.ApprovalRecord
+			return json.Unmarshal(data, &record) == nil &&
+				record.ShareInChannel &&
+				record.Private == private &&
+				record.ChannelPostID == "card123"
+		})
+	}
+
+	t.Run("channel visibility posts a card and saves its post ID", func(t *testing.T) {
+		api, p := setup()
+		var card *model.Post
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			card = post
+			return post.ChannelId == "channel123"
+		})).Return(&model.Post{Id: "card123"}, nil)
+		api.On("SendEphemeralPost", "requester123", mock.MatchedBy(func(post *model.Post) bool {
+			return strings.Contains(post.Message, "A status card was posted in this channel")
+		})).Return(&model.Post{})
+
+		response := submit(p, approval.VisibilityChannel)
+
+		assert.Empty(t, response.Error)
+		assert.Empty(t, response.Errors)
+		require.NotNil(t, card)
+		assert.Equal(t, "bot123", card.UserId)
+		assert.Contains(t, card.Message, "**Status:** ⏳ Pending")
+		assert.Contains(t, card.Message, "Rotate the production TLS certificate")
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), savedWithCard(false))
+	})
+
+	t.Run("private card hides the description", func(t *testing.T) {
+		api, p := setup()
+		var card *model.Post
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			card = post
+			return post.ChannelId == "channel123"
+		})).Return(&model.Post{Id: "card123"}, nil)
+		api.On("SendEphemeralPost", "requester123", mock.Anything).Return(&model.Post{})
+
+		response := submit(p, approval.VisibilityChannelPrivate)
+
+		assert.Empty(t, response.Error)
+		require.NotNil(t, card)
+		assert.NotContains(t, card.Message, "Rotate the production TLS certificate")
+		assert.Contains(t, card.Message, "Details are private")
+		api.AssertCalled(t, "KVSet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:record:")
+		}), savedWithCard(true))
+	})
+
+	t.Run("DM-only request posts nothing to the channel", func(t *testing.T) {
+		api, p := setup()
+		api.On("SendEphemeralPost", "requester123", mock.MatchedBy(func(post *model.Post) bool {
+			return !strings.Contains(post.Message, "status card")
+		})).Return(&model.Post{})
+
+		response := submit(p, approval.VisibilityDirect)
+
+		assert.Empty(t, response.Error)
+		api.AssertNotCalled(t, "CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "channel123"
+		}))
+	})
+
+	t.Run("card failure does not block the request", func(t *testing.T) {
+		api, p := setup()
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "channel123"
+		})).Return(nil, &model.AppError{Message: "no permission"})
+		api.On("SendEphemeralPost", "requester123", mock.MatchedBy(func(post *model.Post) bool {
+			return strings.Contains(post.Message, "Approval Request Submitted") && !strings.Contains(post.Message, "status card")
+		})).Return(&model.Post{})
+
+		response := submit(p, approval.VisibilityChannel)
+
+		assert.Empty(t, response.Error)
+		api.AssertCalled(t, "LogWarn", "Failed to post channel status card",
+			"approval_id", mock.Anything, "code", mock.Anything, "channel_id", "channel123", "error", mock.Anything)
+	})
+
+	t.Run("unknown visibility keeps modal open", func(t *testing.T) {
+		api, p := setup()
+
+		response := submit(p, "everyone")
+
+		assert.Contains(t, response.Errors["visibility"], "Invalid visibility 'everyone'")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleConfirmDecision_UpdatesChannelStatusCard(t *testing.T) {
+	recordJSON, _ := json.Marshal(&approval.ApprovalRecord{
+		ID:                 "record123",
+		Code:               "A-CARD01",
+		RequesterID:        "requester123",
+		RequesterUsername:  "alice",
+		ApproverID:         "approver456",
+		ApproverUsername:   "bob",
+		Description:        "Deploy",
+		Status:             approval.StatusPending,
+		CreatedAt:          model.GetMillis(),
+		RequestChannelID:   "channel123",
+		ShareInChannel:     true,
+		ChannelPostID:      "card123",
+		NotificationSent:   true,
+		NotificationPostID: "dm_post",
+		SchemaVersion:      1,
+	})
+
+	api := &plugintest.API{}
+	api.On("GetPost", "dm_post").Return(&model.Post{Id: "dm_post"}, nil)
+	api.On("KVGet", "approval:record:record123").Return(recordJSON, nil)
+	api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+	api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_alice"}, nil)
+	api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "outcome_post"}, nil)
+	api.On("GetPost", "card123").Return(&model.Post{Id: "card123", ChannelId: "channel123"}, nil)
+	api.On("UpdatePost", mock.Anything).Return(&model.Post{}, nil)
+	api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+	p.SetAPI(api)
+	p.store = store.NewKVStore(api)
+	p.service = approval.NewService(p.store, api, "bot123")
+
+	response := p.handleConfirmDecision(&model.SubmitDialogRequest{
+		UserId:     "approver456",
+		CallbackId: "confirm_approve_record123",
+	})
+
+	assert.Empty(t, response.Error)
+	api.AssertCalled(t, "UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+		return post.Id == "card123" && strings.Contains(post.Message, "**Status:** ✅ Approved by @bob")
+	}))
+}
+
+func TestSendApprovalRequestNotification_QueuesRetry(t *testing.T) {
+	newRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusPending,
+			RequesterID:       "requester123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Deploy v2.5.0 to production",
+		}
+	}
+
+	t.Run("failed approver DM is queued for retry", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "approver456").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("KVSet", "approval:retry:approver_request:record123", mock.MatchedBy(func(data []byte) bool {
+			var retry approval.NotificationRetry
+			return json.Unmarshal(data, &retry) == nil &&
+				retry.Attempts == 1 &&
+				retry.Code == "A-X7K9Q2" &&
+				retry.LastErrorType == "user_dms_disabled"
+		})).Return(nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		kvStore := store.NewKVStore(api)
+		p.retryWorker = retry.NewWorker(kvStore, api, "bot123", testActionSigner, nil, func() int { return 5 })
+
+		p.sendApprovalRequestNotification(kvStore, newRecord())
+
+		api.AssertExpectations(t)
+	})
+
+	t.Run("nothing is queued without a retry worker", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "approver456").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+
+		p.sendApprovalRequestNotification(store.NewKVStore(api), newRecord())
+
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleBulkDecision(t *testing.T) {
+	bulkRecord := func(id string, status, approverID string, createdAt int64) []byte {
+		data, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:                 id,
+			Code:               "A-" + strings.ToUpper(id),
+			RequesterID:        "requester123",
+			RequesterUsername:  "alice",
+			ApproverID:         approverID,
+			ApproverUsername:   "bob",
+			Description:        "Deploy " + id,
+			Status:             status,
+			CreatedAt:          createdAt,
+			NotificationPostID: "dm_" + id,
+			SchemaVersion:      1,
+		})
+		return data
+	}
+
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		p.service = approval.NewService(p.store, api, "bot123")
+		return api, p
+	}
+
+	t.Run("decides each checked request and reports per code", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVGet", "approval:record:rec1").Return(bulkRecord("rec1", approval.StatusPending, "approver456", 2000), nil)
+		api.On("KVGet", "approval:record:rec2").Return(bulkRecord("rec2", approval.StatusPending, "approver456", 1000), nil)
+		api.On("KVGet", "approval:record:rec3").Return(bulkRecord("rec3", approval.StatusApproved, "approver456", 3000), nil)
+		api.On("KVGet", "approval:record:rec4").Return(bulkRecord("rec4", approval.StatusPending, "someone789", 4000), nil)
+		api.On("KVGet", "approval:record:missing").Return(nil, nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "outcome_post"}, nil)
+		api.On("GetPost", mock.Anything).Return(&model.Post{Message: "📋 **Approval Request**"}, nil)
+		api.On("UpdatePost", mock.Anything).Return(&model.Post{}, nil)
+
+		var report *model.Post
+		api.On("SendEphemeralPost", "approver456", mock.MatchedBy(func(post *model.Post) bool {
+			report = post
+			return true
+		})).Return(&model.Post{})
+
+		response := p.handleBulkDecision(&model.SubmitDialogRequest{
+			UserId:     "approver456",
+			ChannelId:  "channel123",
+			CallbackId: bulkDecisionCallbackID,
+			Submission: map[string]any{
+				"decision":       "approve",
+				"comment":        "  release day  ",
+				"record_rec1":    true,
+				"record_rec2":    true,
+				"record_rec3":    true,
+				"record_rec4":    true,
+				"record_rec5":    false,
+				"record_missing": true,
+			},
+		})
+
+		assert.Empty(t, response.Error)
+		require.NotNil(t, report)
+		assert.Equal(t, "channel123", report.ChannelId)
+		assert.Equal(t, "### Bulk decision: 2 of 5 recorded\n\n"+
+			"- ✅ **A-REC2** approved\n"+
+			"- ✅ **A-REC1** approved\n"+
+			"- ❌ **A-REC3**: Decision already recorded: approved\n"+
+			"- ❌ **A-REC4**: Permission denied\n"+
+			"- ❌ **missing**: Approval not found\n", report.Message)
+
+		for _, id := range []string{"rec1", "rec2"} {
+			api.AssertCalled(t, "KVSet", "approval:record:"+id, mock.MatchedBy(func(data []byte) bool {
+				var saved approval.ApprovalRecord
+				return json.Unmarshal(data, &saved) == nil &&
+					saved.Status == approval.StatusApproved &&
+					saved.DecisionComment == "release day"
+			}))
+		}
+		api.AssertNotCalled(t, "KVSet", "approval:record:rec4", mock.Anything)
+		api.AssertCalled(t, "CreatePost", mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == "dm_alice" }))
+	})
+
+	t.Run("decision is required", func(t *testing.T) {
+		_, p := setup()
+
+		response := p.handleBulkDecision(&model.SubmitDialogRequest{
+			UserId:     "approver456",
+			Submission: map[string]any{"record_rec1": true},
+		})
+
+		assert.Equal(t, map[string]string{"decision": "Choose Approve or Deny."}, response.Errors)
+	})
+
+	t.Run("at least one request must be checked", func(t *testing.T) {
+		api, p := setup()
+
+		response := p.handleBulkDecision(&model.SubmitDialogRequest{
+			UserId:     "approver456",
+			State:      "de",
+			Submission: map[string]any{"decision": "deny", "record_rec1": false},
+		})
+
+		assert.Equal(t, "Markieren Sie mindestens eine Anfrage.", response.Error)
+		api.AssertNotCalled(t, "KVGet", mock.Anything)
+	})
+}
+
+func TestHandleApproveNew_SendAt(t *testing.T) {
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("GetUser", "requester123").Return(&model.User{
+			Id:       "requester123",
+			Username: "alice",
+			Timezone: model.StringMap{"useAutomaticTimezone": "false", "manualTimezone": "Europe/Berlin"},
+		}, nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		return api, p
+	}
+
+	submitIn := func(p *Plugin, sendAt, locale string) *model.SubmitDialogResponse {
+		return p.handleApproveNew(&model.SubmitDialogRequest{
+			UserId:     "requester123",
+			ChannelId:  "channel123",
+			TeamId:     "team789",
+			CallbackId: "approve_new",
+			State:      command.DialogState{Locale: locale}.Encode(),
+			Submission: map[string]any{
+				"approver":    "approver456",
+				"description": "Rotate the production TLS certificate",
+				"visibility":  approval.VisibilityChannel,
+				"send_at":     sendAt,
+			},
+		})
+	}
+
+	submit := func(p *Plugin, sendAt string) *model.SubmitDialogResponse {
+		return submitIn(p, sendAt, "")
+	}
+
+	t.Run("send time schedules the request without notifying anyone", func(t *testing.T) {
+		api, p := setup()
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		sendAt := time.Now().In(berlin).Add(2 * time.Hour).Format(approval.SendAtLayout)
+		var confirmation *model.Post
+		api.On("SendEphemeralPost", "requester123", mock.Anything).Run(func(args mock.Arguments) {
+			confirmation = args.Get(1).(*model.Post)
+		}).Return(&model.Post{})
+
+		response := submit(p, sendAt)
+
+		assert.Empty(t, response.Error)
+		assert.Empty(t, response.Errors)
+		var saved approval.ApprovalRecord
+		for _, call := range api.Calls {
+			if call.Method == "KVSet" && strings.HasPrefix(call.Arguments.String(0), "approval:record:") {
+				require.NoError(t, json.Unmarshal(call.Arguments.Get(1).([]byte), &saved))
+			}
+		}
+		assert.Equal(t, approval.StatusScheduled, saved.Status)
+		assert.Equal(t, sendAt, time.UnixMilli(saved.ScheduledAt).In(berlin).Format(approval.SendAtLayout))
+		assert.Zero(t, saved.SentAt)
+		api.AssertCalled(t, "KVSet", "approval:schedule:"+saved.ID, mock.Anything)
+		api.AssertNotCalled(t, "GetDirectChannel", mock.Anything, mock.Anything)
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+
+		require.NotNil(t, confirmation)
+		assert.Contains(t, confirmation.Message, "Approval Request Scheduled")
+		assert.Contains(t, confirmation.Message, "**Send at:** "+sendAt)
+		assert.Contains(t, confirmation.Message, "/approve cancel "+saved.Code)
+	})
+
+	t.Run("invalid send time keeps modal open", func(t *testing.T) {
+		api, p := setup()
+
+		response := submit(p, "next tuesday")
+
+		assert.Contains(t, response.Errors["send_at"], "Invalid send time")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("past send time keeps modal open", func(t *testing.T) {
+		api, p := setup()
+
+		response := submit(p, "2020-01-01 09:00")
+
+		assert.Contains(t, response.Errors["send_at"], "is in the past. Leave it empty")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("send time errors are shown in the requester's locale", func(t *testing.T) {
+		api, p := setup()
+
+		response := submitIn(p, "2020-01-01 09:00", "de")
+
+		assert.Equal(t, "Der Sendezeitpunkt 2020-01-01 09:00 liegt in der Vergangenheit. Lassen Sie das Feld leer, um sofort zu senden.", response.Errors["send_at"])
+
+		response = submitIn(p, time.Now().AddDate(0, 2, 0).Format(approval.SendAtLayout), "de")
+
+		assert.Contains(t, response.Errors["send_at"], "bis zu 30 Tage im Voraus")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
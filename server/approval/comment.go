@@ -0,0 +1,91 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// MaxCommentLength is the maximum length of a comment relayed with /approve comment
+const MaxCommentLength = 1000
+
+// ErrNotParticipant is returned when a user who is neither requester nor approver comments on a request
+var ErrNotParticipant = errors.New("only the requester and approvers can comment")
+
+// ApprovalComment is a message relayed between the requester and approver about a request
+type ApprovalComment struct {
+	UserID    string `json:"userId"`
+	Username  string `json:"username"`
+	Message   string `json:"message"`
+	CreatedAt int64  `json:"createdAt"` // UTC epoch milliseconds
+}
+
+// IsParticipant reports whether the user is the requester, the approver, or a candidate
+// member of the approver group
+func (r *ApprovalRecord) IsParticipant(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	return r.RequesterID == userID || r.ApproverID == userID || r.CanDecide(userID)
+}
+
+// AddComment appends a comment from a requester or approver to the request's history.
+// Comments are allowed in any status once the request is sent, so questions can follow a decision.
+// Returns the updated record; the new comment is its last entry.
+func (s *Service) AddComment(approvalCode, userID, message string) (*ApprovalRecord, error) {
+	approvalCode = strings.TrimSpace(approvalCode)
+	if approvalCode == "" {
+		return nil, fmt.Errorf("approval code is required")
+	}
+	if !approvalCodePattern.MatchString(approvalCode) {
+		return nil, fmt.Errorf("invalid approval code format: expected format like 'A-X7K9Q2'")
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return nil, fmt.Errorf("comment text is required")
+	}
+	if len(message) > MaxCommentLength {
+		return nil, fmt.Errorf("comment is %d characters (max %d)", len(message), MaxCommentLength)
+	}
+
+	record, err := s.store.GetByCode(approvalCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve approval %s: %w", approvalCode, err)
+	}
+
+	if !record.IsParticipant(userID) {
+		return nil, fmt.Errorf("cannot comment on approval %s: %w", approvalCode, ErrNotParticipant)
+	}
+
+	// The approver has no thread to reply in until a scheduled request is sent
+	if record.Status == StatusScheduled {
+		return nil, fmt.Errorf("cannot comment on approval %s: %w", approvalCode, ErrRequestScheduled)
+	}
+
+	user, appErr := s.api.GetUser(userID)
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", userID, appErr)
+	}
+
+	record.Comments = append(record.Comments, ApprovalComment{
+		UserID:    user.Id,
+		Username:  user.Username,
+		Message:   message,
+		CreatedAt: model.GetMillis(),
+	})
+
+	if err := s.store.SaveApproval(record); err != nil {
+		return nil, fmt.Errorf("failed to save comment on approval %s: %w", approvalCode, err)
+	}
+
+	s.api.LogInfo("Approval comment added",
+		"approval_id", record.ID,
+		"code", record.Code,
+		"user_id", userID,
+	)
+
+	return record, nil
+}
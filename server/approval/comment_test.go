@@ -0,0 +1,138 @@
+package approval
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsParticipant(t *testing.T) {
+	record := &ApprovalRecord{Code: "A-X7K9Q2", RequesterID: "user123", ApproverID: "approver456", Status: StatusPending}
+	assert.True(t, record.IsParticipant("user123"))
+	assert.True(t, record.IsParticipant("approver456"))
+	assert.False(t, record.IsParticipant("mallory789"))
+	assert.False(t, record.IsParticipant(""))
+
+	group := &ApprovalRecord{
+		Code:                 "A-X7K9Q2",
+		RequesterID:          "user123",
+		ApproverGroupName:    "sre-oncall",
+		CandidateApproverIDs: []string{"bob123", "carol123"},
+		Status:               StatusPending,
+	}
+	assert.True(t, group.IsParticipant("carol123"), "pending group members can comment")
+	assert.False(t, group.IsParticipant(""))
+}
+
+func TestAddComment(t *testing.T) {
+	t.Run("requester comment is appended and saved", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+
+		existing := ApprovalComment{UserID: "approver456", Username: "bob", Message: "Which cluster?", CreatedAt: 1704931300000}
+		record := &ApprovalRecord{
+			ID:          "abc123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "user123",
+			ApproverID:  "approver456",
+			Status:      StatusPending,
+			Comments:    []ApprovalComment{existing},
+		}
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(record, nil)
+		mockStore.On("SaveApproval", record).Return(nil)
+		mockAPI.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice"}, nil)
+		mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		updated, err := service.AddComment("A-X7K9Q2", "user123", "  The EU one  ")
+
+		require.NoError(t, err)
+		require.Len(t, updated.Comments, 2)
+		assert.Equal(t, existing, updated.Comments[0])
+		assert.Equal(t, "user123", updated.Comments[1].UserID)
+		assert.Equal(t, "alice", updated.Comments[1].Username)
+		assert.Equal(t, "The EU one", updated.Comments[1].Message)
+		assert.NotZero(t, updated.Comments[1].CreatedAt)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("comments are allowed after a decision", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+
+		record := &ApprovalRecord{ID: "abc123", Code: "A-X7K9Q2", RequesterID: "user123", ApproverID: "approver456", Status: StatusDenied}
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(record, nil)
+		mockStore.On("SaveApproval", record).Return(nil)
+		mockAPI.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		updated, err := service.AddComment("A-X7K9Q2", "approver456", "Please attach the rollback plan")
+
+		require.NoError(t, err)
+		assert.Len(t, updated.Comments, 1)
+	})
+
+	t.Run("non-participant is rejected", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(&ApprovalRecord{ID: "abc123", Code: "A-X7K9Q2", RequesterID: "user123", ApproverID: "approver456", Status: StatusPending}, nil)
+
+		service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+		_, err := service.AddComment("A-X7K9Q2", "mallory789", "hello")
+
+		assert.True(t, errors.Is(err, ErrNotParticipant))
+		mockStore.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	tests := []struct {
+		name    string
+		code    string
+		message string
+		wantErr string
+	}{
+		{name: "empty code", code: " ", message: "hello", wantErr: "approval code is required"},
+		{name: "invalid code", code: "bogus", message: "hello", wantErr: "invalid approval code format"},
+		{name: "empty message", code: "A-X7K9Q2", message: "   ", wantErr: "comment text is required"},
+		{name: "message too long", code: "A-X7K9Q2", message: strings.Repeat("x", MaxCommentLength+1), wantErr: "characters (max 1000)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := new(MockApprovalStore)
+
+			service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+			_, err := service.AddComment(tt.code, "user123", tt.message)
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			mockStore.AssertNotCalled(t, "GetByCode", mock.Anything)
+		})
+	}
+
+	t.Run("record not found", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(nil, errors.New("approval record not found"))
+
+		service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+		_, err := service.AddComment("A-X7K9Q2", "user123", "hello")
+
+		assert.ErrorContains(t, err, "approval record not found")
+	})
+
+	t.Run("save error is returned", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(&ApprovalRecord{ID: "abc123", Code: "A-X7K9Q2", RequesterID: "user123", ApproverID: "approver456", Status: StatusPending}, nil)
+		mockStore.On("SaveApproval", mock.Anything).Return(errors.New("kv unavailable"))
+		mockAPI.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice"}, nil)
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		_, err := service.AddComment("A-X7K9Q2", "user123", "hello")
+
+		assert.ErrorContains(t, err, "failed to save comment on approval A-X7K9Q2")
+	})
+}
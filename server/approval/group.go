@@ -0,0 +1,209 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+const (
+	// MaxGroupApprovers caps how many members are notified for a group or role approval
+	MaxGroupApprovers = 50
+
+	// groupMembersPerPage is the page size used when listing group and channel members
+	groupMembersPerPage = 200
+)
+
+var (
+	// ErrApproverGroupNotFound is returned when the approver group does not exist
+	ErrApproverGroupNotFound = errors.New("approver group not found")
+
+	// ErrNoEligibleApprovers is returned when a group or role has no members who may decide
+	ErrNoEligibleApprovers = errors.New("no eligible approvers")
+
+	// ErrTooManyApprovers is returned when a group or role has more than MaxGroupApprovers eligible members
+	ErrTooManyApprovers = errors.New("too many eligible approvers")
+
+	// ErrDecisionClaimed is returned when another member already claimed a group approval decision
+	ErrDecisionClaimed = errors.New("decision already claimed by another approver")
+)
+
+// IsGroupApproval reports whether the request was sent to a group or role rather than a single approver
+func (r *ApprovalRecord) IsGroupApproval() bool {
+	return r.ApproverGroupName != "" || r.ApproverRole != ""
+}
+
+// CanDecide reports whether the user may decide the request: the designated approver,
+// or any candidate member for group approvals
+func (r *ApprovalRecord) CanDecide(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	if r.IsGroupApproval() {
+		return slices.Contains(r.CandidateApproverIDs, userID)
+	}
+	return r.ApproverID == userID
+}
+
+// ApproverRecipientIDs returns the users who receive approver notifications for the request
+func (r *ApprovalRecord) ApproverRecipientIDs() []string {
+	if r.IsGroupApproval() {
+		return slices.Clone(r.CandidateApproverIDs)
+	}
+	if r.ApproverID == "" {
+		return nil
+	}
+	return []string{r.ApproverID}
+}
+
+// ApproverPostIDs returns the approver DM notification posts, one per notified approver
+func (r *ApprovalRecord) ApproverPostIDs() []string {
+	postIDs := make([]string, 0, len(r.CandidatePostIDs)+1)
+	if r.NotificationPostID != "" {
+		postIDs = append(postIDs, r.NotificationPostID)
+	}
+	for _, userID := range r.CandidateApproverIDs {
+		if postID := r.CandidatePostIDs[userID]; postID != "" && !slices.Contains(postIDs, postID) {
+			postIDs = append(postIDs, postID)
+		}
+	}
+	return postIDs
+}
+
+// ApproverGroupLabel formats the approver group or role for display, e.g. "@sre-oncall" or
+// "channel role `channel_admin`". Returns an empty string for single-approver requests.
+func (r *ApprovalRecord) ApproverGroupLabel() string {
+	return r.ApproverGroupLabelIn(i18n.DefaultLocale)
+}
+
+// ApproverGroupLabelIn formats the approver group or role for display in the given locale
+func (r *ApprovalRecord) ApproverGroupLabelIn(locale string) string {
+	switch {
+	case r.ApproverGroupName != "":
+		return "@" + r.ApproverGroupName
+	case r.ApproverRole != "":
+		return i18n.T(locale, "approval.role_label", r.ApproverRole)
+	default:
+		return ""
+	}
+}
+
+// ResolveGroupApprovers returns the group and its members who may decide a request from requesterID.
+// The requester, deactivated users and bots are excluded.
+func ResolveGroupApprovers(api plugin.API, groupName, requesterID string) (*model.Group, []*model.User, error) {
+	groupName = strings.TrimPrefix(strings.TrimSpace(groupName), "@")
+	if groupName == "" {
+		return nil, nil, fmt.Errorf("group name is required")
+	}
+
+	group, appErr := api.GetGroupByName(groupName)
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return nil, nil, fmt.Errorf("%w: @%s", ErrApproverGroupNotFound, groupName)
+		}
+		return nil, nil, fmt.Errorf("failed to get group %s: %w", groupName, appErr)
+	}
+	if group.DeleteAt != 0 {
+		return nil, nil, fmt.Errorf("%w: @%s", ErrApproverGroupNotFound, groupName)
+	}
+
+	members := make([]*model.User, 0)
+	for page := 0; ; page++ {
+		users, appErr := api.GetGroupMemberUsers(group.Id, page, groupMembersPerPage)
+		if appErr != nil {
+			return nil, nil, fmt.Errorf("failed to get members of group %s: %w", groupName, appErr)
+		}
+
+		for _, user := range users {
+			if isEligibleGroupApprover(user, requesterID) {
+				members = append(members, user)
+			}
+		}
+		if len(members) > MaxGroupApprovers {
+			return nil, nil, fmt.Errorf("%w: group @%s has more than %d eligible members", ErrTooManyApprovers, groupName, MaxGroupApprovers)
+		}
+
+		if len(users) < groupMembersPerPage {
+			break
+		}
+	}
+
+	if len(members) == 0 {
+		return nil, nil, fmt.Errorf("%w in group @%s", ErrNoEligibleApprovers, groupName)
+	}
+
+	return group, members, nil
+}
+
+// ResolveRoleApprovers returns the channel members holding role who may decide a request from requesterID.
+// The requester, deactivated users and bots are excluded.
+func ResolveRoleApprovers(api plugin.API, channelID, role, requesterID string) ([]*model.User, error) {
+	role = strings.TrimSpace(role)
+	if !IsValidRole(role) {
+		return nil, fmt.Errorf("invalid role '%s'", role)
+	}
+
+	members := make([]*model.User, 0)
+	for page := 0; ; page++ {
+		channelMembers, appErr := api.GetChannelMembers(channelID, page, groupMembersPerPage)
+		if appErr != nil {
+			return nil, fmt.Errorf("failed to get members of channel %s: %w", channelID, appErr)
+		}
+
+		for i := range channelMembers {
+			member := &channelMembers[i]
+			if member.UserId == requesterID || !slices.Contains(channelMemberRoles(member), role) {
+				continue
+			}
+
+			user, appErr := api.GetUser(member.UserId)
+			if appErr != nil {
+				return nil, fmt.Errorf("failed to get user %s: %w", member.UserId, appErr)
+			}
+			if isEligibleGroupApprover(user, requesterID) {
+				members = append(members, user)
+			}
+		}
+		if len(members) > MaxGroupApprovers {
+			return nil, fmt.Errorf("%w: more than %d channel members hold role %s", ErrTooManyApprovers, MaxGroupApprovers, role)
+		}
+
+		if len(channelMembers) < groupMembersPerPage {
+			break
+		}
+	}
+
+	if len(members) == 0 {
+		return nil, fmt.Errorf("%w with role %s in this channel", ErrNoEligibleApprovers, role)
+	}
+
+	return members, nil
+}
+
+// FilterApproversByPolicy drops candidates that the approver policies for the request do not allow.
+// Policy lookup failures are returned (fail closed).
+func FilterApproversByPolicy(store PolicyStore, api plugin.API, candidates []*model.User, teamID, channelID, templateName string) ([]*model.User, error) {
+	allowed := make([]*model.User, 0, len(candidates))
+	for _, candidate := range candidates {
+		err := CheckApproverPolicy(store, api, candidate.Id, teamID, channelID, templateName)
+		if errors.Is(err, ErrApproverNotAllowed) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		allowed = append(allowed, candidate)
+	}
+	return allowed, nil
+}
+
+// isEligibleGroupApprover reports whether a group member may be notified as approver
+func isEligibleGroupApprover(user *model.User, requesterID string) bool {
+	return user != nil && user.Id != requesterID && user.DeleteAt == 0 && !user.IsBot
+}
@@ -0,0 +1,287 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalRecord_GroupHelpers(t *testing.T) {
+	single := &ApprovalRecord{ApproverID: "bob", NotificationPostID: "post1"}
+	group := &ApprovalRecord{
+		ApproverGroupName:    "sre-oncall",
+		CandidateApproverIDs: []string{"bob", "carol"},
+		CandidatePostIDs:     map[string]string{"bob": "post1", "carol": "post2"},
+		NotificationPostID:   "post1",
+	}
+	role := &ApprovalRecord{ApproverRole: "channel_admin", CandidateApproverIDs: []string{"dave"}}
+
+	t.Run("IsGroupApproval", func(t *testing.T) {
+		assert.False(t, single.IsGroupApproval())
+		assert.True(t, group.IsGroupApproval())
+		assert.True(t, role.IsGroupApproval())
+	})
+
+	t.Run("CanDecide", func(t *testing.T) {
+		assert.True(t, single.CanDecide("bob"))
+		assert.False(t, single.CanDecide("carol"))
+		assert.True(t, group.CanDecide("carol"))
+		assert.False(t, group.CanDecide("mallory"))
+		assert.False(t, group.CanDecide(""))
+	})
+
+	t.Run("ApproverRecipientIDs", func(t *testing.T) {
+		assert.Equal(t, []string{"bob"}, single.ApproverRecipientIDs())
+		assert.Equal(t, []string{"bob", "carol"}, group.ApproverRecipientIDs())
+		assert.Empty(t, (&ApprovalRecord{}).ApproverRecipientIDs())
+	})
+
+	t.Run("ApproverPostIDs deduplicates notification post", func(t *testing.T) {
+		assert.Equal(t, []string{"post1"}, single.ApproverPostIDs())
+		assert.Equal(t, []string{"post1", "post2"}, group.ApproverPostIDs())
+		assert.Empty(t, (&ApprovalRecord{}).ApproverPostIDs())
+	})
+
+	t.Run("ApproverGroupLabel", func(t *testing.T) {
+		assert.Equal(t, "", single.ApproverGroupLabel())
+		assert.Equal(t, "@sre-oncall", group.ApproverGroupLabel())
+		assert.Equal(t, "channel role `channel_admin`", role.ApproverGroupLabel())
+	})
+}
+
+func TestResolveGroupApprovers(t *testing.T) {
+	groupName := "sre-oncall"
+	sre := &model.Group{Id: "group1", Name: &groupName, DisplayName: "SRE On-Call"}
+
+	t.Run("returns active human members except requester", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupByName", "sre-oncall").Return(sre, nil)
+		api.On("GetGroupMemberUsers", "group1", 0, groupMembersPerPage).Return([]*model.User{
+			{Id: "alice", Username: "alice"},
+			{Id: "bob", Username: "bob"},
+			{Id: "gone", Username: "gone", DeleteAt: 1},
+			{Id: "botty", Username: "botty", IsBot: true},
+			{Id: "carol", Username: "carol"},
+		}, nil)
+
+		group, members, err := ResolveGroupApprovers(api, "@sre-oncall", "alice")
+
+		require.NoError(t, err)
+		assert.Equal(t, sre, group)
+		require.Len(t, members, 2)
+		assert.Equal(t, "bob", members[0].Id)
+		assert.Equal(t, "carol", members[1].Id)
+	})
+
+	t.Run("unknown group", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupByName", "nope").Return(nil, model.NewAppError("GetGroupByName", "not_found", nil, "", 404))
+
+		_, _, err := ResolveGroupApprovers(api, "nope", "alice")
+
+		assert.ErrorIs(t, err, ErrApproverGroupNotFound)
+		assert.Contains(t, err.Error(), "@nope")
+	})
+
+	t.Run("lookup failure is not reported as missing group", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupByName", "sre-oncall").Return(nil, model.NewAppError("GetGroupByName", "db", nil, "", 500))
+
+		_, _, err := ResolveGroupApprovers(api, "sre-oncall", "alice")
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrApproverGroupNotFound)
+	})
+
+	t.Run("group with only the requester has no eligible approvers", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupByName", "sre-oncall").Return(sre, nil)
+		api.On("GetGroupMemberUsers", "group1", 0, groupMembersPerPage).Return([]*model.User{{Id: "alice"}}, nil)
+
+		_, _, err := ResolveGroupApprovers(api, "sre-oncall", "alice")
+
+		assert.ErrorIs(t, err, ErrNoEligibleApprovers)
+	})
+
+	t.Run("pages through members and enforces the cap", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupByName", "sre-oncall").Return(sre, nil)
+		page := make([]*model.User, groupMembersPerPage)
+		for i := range page {
+			page[i] = &model.User{Id: fmt.Sprintf("user%d", i)}
+		}
+		api.On("GetGroupMemberUsers", "group1", 0, groupMembersPerPage).Return(page, nil)
+
+		_, _, err := ResolveGroupApprovers(api, "sre-oncall", "alice")
+
+		assert.ErrorIs(t, err, ErrTooManyApprovers)
+	})
+}
+
+func TestResolveRoleApprovers(t *testing.T) {
+	t.Run("returns channel members holding the role", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetChannelMembers", "channel1", 0, groupMembersPerPage).Return(model.ChannelMembers{
+			{UserId: "alice", SchemeAdmin: true},
+			{UserId: "bob", SchemeAdmin: true},
+			{UserId: "carol", SchemeUser: true},
+			{UserId: "dave", Roles: "channel_admin"},
+			{UserId: "botty", SchemeAdmin: true},
+		}, nil)
+		api.On("GetUser", "bob").Return(&model.User{Id: "bob"}, nil)
+		api.On("GetUser", "dave").Return(&model.User{Id: "dave"}, nil)
+		api.On("GetUser", "botty").Return(&model.User{Id: "botty", IsBot: true}, nil)
+
+		members, err := ResolveRoleApprovers(api, "channel1", "channel_admin", "alice")
+
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, "bob", members[0].Id)
+		assert.Equal(t, "dave", members[1].Id)
+		api.AssertNotCalled(t, "GetUser", "alice")
+		api.AssertNotCalled(t, "GetUser", "carol")
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		_, err := ResolveRoleApprovers(&plugintest.API{}, "channel1", "Not A Role", "alice")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid role")
+	})
+
+	t.Run("no holders", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetChannelMembers", "channel1", 0, groupMembersPerPage).Return(model.ChannelMembers{{UserId: "carol", SchemeUser: true}}, nil)
+
+		_, err := ResolveRoleApprovers(api, "channel1", "channel_admin", "alice")
+
+		assert.ErrorIs(t, err, ErrNoEligibleApprovers)
+	})
+}
+
+func TestFilterApproversByPolicy(t *testing.T) {
+	candidates := []*model.User{{Id: "bob"}, {Id: "carol"}}
+
+	t.Run("drops candidates the policy does not allow", func(t *testing.T) {
+		store := new(MockApprovalStore)
+		store.On("GetPolicy", "team:team1").Return(&ApproverPolicy{Scope: "team:team1", UserIDs: []string{"carol"}}, nil)
+		api := &plugintest.API{}
+		api.On("GetUser", "carol").Return(&model.User{Id: "carol", Username: "carol"}, nil)
+
+		allowed, err := FilterApproversByPolicy(store, api, candidates, "team1", "channel1", "")
+
+		require.NoError(t, err)
+		require.Len(t, allowed, 1)
+		assert.Equal(t, "carol", allowed[0].Id)
+	})
+
+	t.Run("policy lookup failure fails closed", func(t *testing.T) {
+		store := new(MockApprovalStore)
+		store.On("GetPolicy", "team:team1").Return(nil, errors.New("KV unavailable"))
+
+		_, err := FilterApproversByPolicy(store, &plugintest.API{}, candidates, "team1", "channel1", "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRecordDecision_GroupApproval(t *testing.T) {
+	pending := func() *ApprovalRecord {
+		return &ApprovalRecord{
+			ID:                   "record1",
+			Code:                 "A-GROUP1",
+			RequesterID:          "alice",
+			RequesterUsername:    "alice",
+			ApproverUsername:     "sre-oncall",
+			ApproverDisplayName:  "SRE On-Call (group)",
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob", "carol"},
+			Description:          "Deploy",
+			Status:               StatusPending,
+			CreatedAt:            model.GetMillis(),
+			SchemaVersion:        1,
+		}
+	}
+
+	setup := func() (*MockApprovalStore, *plugintest.API, *Service) {
+		store := new(MockApprovalStore)
+		api := &plugintest.API{}
+		store.On("GetApproval", "record1").Return(pending(), nil)
+		api.On("GetUser", "bob").Return(&model.User{Id: "bob", Username: "bob", FirstName: "Bob", LastName: "Smith"}, nil).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return store, api, NewService(store, api, "bot")
+	}
+
+	t.Run("first member to confirm claims the decision", func(t *testing.T) {
+		store, _, service := setup()
+		store.On("ClaimDecision", "record1", "bob").Return(true, nil)
+		store.On("SaveApproval", mock.MatchedBy(func(r *ApprovalRecord) bool {
+			return r.ApproverID == "bob" && r.ApproverUsername == "bob" && r.Status == StatusApproved
+		})).Return(nil)
+
+		record, err := service.RecordDecision("record1", "bob", "approved", "lgtm")
+
+		require.NoError(t, err)
+		assert.Equal(t, "bob", record.ApproverID)
+		assert.Equal(t, "Bob Smith", record.ApproverDisplayName)
+		assert.Equal(t, "sre-oncall", record.ApproverGroupName)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("losing the claim race is rejected", func(t *testing.T) {
+		store, _, service := setup()
+		store.On("ClaimDecision", "record1", "bob").Return(false, nil)
+
+		_, err := service.RecordDecision("record1", "bob", "denied", "")
+
+		assert.ErrorIs(t, err, ErrDecisionClaimed)
+		store.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("non-member cannot decide", func(t *testing.T) {
+		store, _, service := setup()
+
+		_, err := service.RecordDecision("record1", "mallory", "approved", "")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "permission denied")
+		store.AssertNotCalled(t, "ClaimDecision", mock.Anything, mock.Anything)
+	})
+
+	t.Run("claim is released when saving fails", func(t *testing.T) {
+		store, _, service := setup()
+		store.On("ClaimDecision", "record1", "bob").Return(true, nil)
+		store.On("SaveApproval", mock.Anything).Return(errors.New("KV unavailable"))
+		store.On("ReleaseDecisionClaim", "record1").Return(nil)
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		assert.Error(t, err)
+		store.AssertExpectations(t)
+	})
+}
+
+func TestResubmitApproval_GroupApproval(t *testing.T) {
+	store := new(MockApprovalStore)
+	store.On("GetByCode", "A-GROUP1").Return(&ApprovalRecord{
+		Code:              "A-GROUP1",
+		RequesterID:       "alice",
+		ApproverID:        "bob",
+		ApproverGroupName: "sre-oncall",
+		Status:            StatusDenied,
+	}, nil)
+	service := NewService(store, &plugintest.API{}, "bot")
+
+	_, err := service.ResubmitApproval("A-GROUP1", "alice")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "group approval requests")
+}
@@ -0,0 +1,25 @@
+package approval
+
+import "strings"
+
+// Index roles select which of a user's index keys to read
+const (
+	IndexRoleRequester = "requester" // Records the user requested
+	IndexRoleApprover  = "approver"  // Records the user was asked to decide, including group requests
+)
+
+// IndexEntry is one record in a user's requester/approver index
+type IndexEntry struct {
+	Position string // "<inverted CreatedAt>:<record ID>"; ascending order is newest first
+	RecordID string
+}
+
+// DescriptionSummary returns the description on one line, truncated to 60 characters, for
+// list rows and checkbox labels
+func (r *ApprovalRecord) DescriptionSummary() string {
+	runes := []rune(strings.Join(strings.Fields(r.Description), " "))
+	if len(runes) > 60 {
+		return string(runes[:57]) + "..."
+	}
+	return string(runes)
+}
@@ -0,0 +1,16 @@
+package approval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalRecord_DescriptionSummary(t *testing.T) {
+	record := &ApprovalRecord{Description: "Deploy\n  to prod "}
+	assert.Equal(t, "Deploy to prod", record.DescriptionSummary())
+
+	record.Description = strings.Repeat("ü", 70)
+	assert.Equal(t, strings.Repeat("ü", 57)+"...", record.DescriptionSummary())
+}
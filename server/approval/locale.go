@@ -0,0 +1,56 @@
+package approval
+
+// LocaleFor returns the snapshotted Mattermost locale of a participant: the requester, a group
+// candidate or the approver. Returns an empty string (the default locale) for other users and
+// for records created before locales were recorded.
+func (r *ApprovalRecord) LocaleFor(userID string) string {
+	return r.participantValue(userID, r.RequesterLocale, r.ApproverLocale, r.CandidateLocales)
+}
+
+// TimezoneFor returns the snapshotted Mattermost timezone of a participant, like LocaleFor.
+// Returns an empty string (UTC) for other users and for records created before timezones were recorded.
+func (r *ApprovalRecord) TimezoneFor(userID string) string {
+	return r.participantValue(userID, r.RequesterTimezone, r.ApproverTimezone, r.CandidateTimezones)
+}
+
+// ApproverPostLocale returns the locale of the approver who received an approver DM post.
+// Every approver post of a single-approver request belongs to the approver.
+func (r *ApprovalRecord) ApproverPostLocale(postID string) string {
+	return r.LocaleFor(r.approverPostRecipient(postID))
+}
+
+// ApproverPostTimezone returns the timezone of the approver who received an approver DM post
+func (r *ApprovalRecord) ApproverPostTimezone(postID string) string {
+	return r.TimezoneFor(r.approverPostRecipient(postID))
+}
+
+// approverPostRecipient returns the user ID an approver DM post was sent to, or an empty string
+// for unknown group posts
+func (r *ApprovalRecord) approverPostRecipient(postID string) string {
+	if !r.IsGroupApproval() {
+		return r.ApproverID
+	}
+	for userID, candidatePostID := range r.CandidatePostIDs {
+		if candidatePostID == postID {
+			return userID
+		}
+	}
+	return ""
+}
+
+// participantValue picks the snapshotted value recorded for the requester, a group candidate
+// or the approver
+func (r *ApprovalRecord) participantValue(userID, requester, approver string, candidates map[string]string) string {
+	switch {
+	case userID == "":
+		return ""
+	case userID == r.RequesterID:
+		return requester
+	case candidates[userID] != "":
+		return candidates[userID]
+	case userID == r.ApproverID:
+		return approver
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,109 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalRecord_LocaleFor(t *testing.T) {
+	record := &ApprovalRecord{
+		RequesterID:      "requester1",
+		RequesterLocale:  "de",
+		ApproverID:       "approver1",
+		ApproverLocale:   "ja",
+		CandidateLocales: map[string]string{"member1": "de"},
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{name: "requester", userID: "requester1", want: "de"},
+		{name: "approver", userID: "approver1", want: "ja"},
+		{name: "group candidate", userID: "member1", want: "de"},
+		{name: "other user", userID: "stranger", want: ""},
+		{name: "empty user", userID: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, record.LocaleFor(tt.userID))
+		})
+	}
+
+	t.Run("records without locales use the default", func(t *testing.T) {
+		assert.Equal(t, "", (&ApprovalRecord{RequesterID: "requester1"}).LocaleFor("requester1"))
+	})
+}
+
+func TestApprovalRecord_ApproverPostLocale(t *testing.T) {
+	t.Run("single approver", func(t *testing.T) {
+		record := &ApprovalRecord{ApproverID: "approver1", ApproverLocale: "ja", NotificationPostID: "post1"}
+
+		assert.Equal(t, "ja", record.ApproverPostLocale("post1"))
+		assert.Equal(t, "ja", record.ApproverPostLocale("replaced-post"))
+	})
+
+	t.Run("group candidates", func(t *testing.T) {
+		record := &ApprovalRecord{
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"member1", "member2"},
+			CandidatePostIDs:     map[string]string{"member1": "post1", "member2": "post2"},
+			CandidateLocales:     map[string]string{"member2": "de"},
+			NotificationPostID:   "post1",
+		}
+
+		assert.Equal(t, "", record.ApproverPostLocale("post1"))
+		assert.Equal(t, "de", record.ApproverPostLocale("post2"))
+		assert.Equal(t, "", record.ApproverPostLocale("unknown-post"))
+	})
+}
+
+func TestApprovalRecord_TimezoneFor(t *testing.T) {
+	record := &ApprovalRecord{
+		RequesterID:        "requester1",
+		RequesterTimezone:  "Europe/Berlin",
+		ApproverID:         "approver1",
+		ApproverTimezone:   "Asia/Tokyo",
+		CandidateTimezones: map[string]string{"member1": "America/New_York"},
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{name: "requester", userID: "requester1", want: "Europe/Berlin"},
+		{name: "approver", userID: "approver1", want: "Asia/Tokyo"},
+		{name: "group candidate", userID: "member1", want: "America/New_York"},
+		{name: "other user", userID: "stranger", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, record.TimezoneFor(tt.userID))
+		})
+	}
+}
+
+func TestApprovalRecord_ApproverPostTimezone(t *testing.T) {
+	t.Run("single approver", func(t *testing.T) {
+		record := &ApprovalRecord{ApproverID: "approver1", ApproverTimezone: "Asia/Tokyo"}
+
+		assert.Equal(t, "Asia/Tokyo", record.ApproverPostTimezone("post1"))
+	})
+
+	t.Run("group candidates", func(t *testing.T) {
+		record := &ApprovalRecord{
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"member1", "member2"},
+			CandidatePostIDs:     map[string]string{"member1": "post1", "member2": "post2"},
+			CandidateTimezones:   map[string]string{"member1": "Europe/Berlin"},
+		}
+
+		assert.Equal(t, "Europe/Berlin", record.ApproverPostTimezone("post1"))
+		assert.Equal(t, "", record.ApproverPostTimezone("post2"))
+	})
+}
@@ -15,22 +15,47 @@ type ApprovalRecord struct {
 	RequesterUsername    string `json:"requesterUsername"`
 	RequesterDisplayName string `json:"requesterDisplayName"`
 
-	// Approver (snapshot at creation time)
+	// Approver (snapshot at creation time). For group approvals these hold the group or role
+	// label while pending (ApproverID empty) and the acting approver once decided.
 	ApproverID          string `json:"approverId"`
 	ApproverUsername    string `json:"approverUsername"`
 	ApproverDisplayName string `json:"approverDisplayName"`
 
+	// Group approval fields - any candidate may decide; the first to confirm claims the decision
+	ApproverGroupName    string            `json:"approverGroupName,omitempty"`    // Mattermost group whose members may decide
+	ApproverRole         string            `json:"approverRole,omitempty"`         // Channel role whose holders may decide
+	CandidateApproverIDs []string          `json:"candidateApproverIds,omitempty"` // Eligible members snapshotted at creation
+	CandidatePostIDs     map[string]string `json:"candidatePostIds,omitempty"`     // Candidate user ID -> DM notification post ID
+
+	// Locales (snapshot at creation time) - Mattermost user locales that bot DMs are rendered in
+	RequesterLocale  string            `json:"requesterLocale,omitempty"`
+	ApproverLocale   string            `json:"approverLocale,omitempty"`
+	CandidateLocales map[string]string `json:"candidateLocales,omitempty"` // Candidate user ID -> locale
+
+	// Timezones (snapshot at creation time) - IANA names that DM timestamps are rendered in; empty is UTC
+	RequesterTimezone  string            `json:"requesterTimezone,omitempty"`
+	ApproverTimezone   string            `json:"approverTimezone,omitempty"`
+	CandidateTimezones map[string]string `json:"candidateTimezones,omitempty"` // Candidate user ID -> timezone
+
 	// Request details
 	Description string `json:"description"`
 
+	// Template fields - structured values collected by a request template
+	TemplateName string             `json:"templateName,omitempty"` // Template used to create the request
+	CustomFields []CustomFieldValue `json:"customFields,omitempty"` // Validated values in template order
+
 	// State
-	Status          string `json:"status"` // "pending" | "approved" | "denied" | "canceled"
+	Status          string `json:"status"` // "scheduled" | "pending" | "approved" | "denied" | "canceled"
 	DecisionComment string `json:"decisionComment,omitempty"`
 
 	// Timestamps (UTC epoch milliseconds)
 	CreatedAt int64 `json:"createdAt"`
 	DecidedAt int64 `json:"decidedAt"` // 0 if pending
 
+	// Scheduling - a request created with a send time waits in StatusScheduled until it is sent
+	ScheduledAt int64 `json:"scheduledAt,omitempty"` // Requested send time; 0 if sent on creation
+	SentAt      int64 `json:"sentAt,omitempty"`      // When the scheduler sent the request to the approver
+
 	// Cancellation fields (v0.2.0+)
 	CanceledReason  string `json:"canceledReason,omitempty"`  // Reason for cancellation
 	CanceledDetails string `json:"canceledDetails,omitempty"` // Additional context (optional, Story 7.3)
@@ -41,25 +66,53 @@ type ApprovalRecord struct {
 	VerifiedAt          int64  `json:"verifiedAt,omitempty"`          // Timestamp when verified (0 if not verified)
 	VerificationComment string `json:"verificationComment,omitempty"` // Optional comment from requester
 
+	// Lineage fields - links a resubmitted request to the request it was cloned from
+	PreviousCode string `json:"previousCode,omitempty"` // Code of the finalized request this one was resubmitted from
+	NextCode     string `json:"nextCode,omitempty"`     // Code of the request created by resubmitting this one
+
+	// Conversation - comments relayed between requester and approver (append-only)
+	Comments []ApprovalComment `json:"comments,omitempty"`
+
 	// Context
 	RequestChannelID string `json:"requestChannelId"`
 	TeamID           string `json:"teamId,omitempty"`
 
+	// SLA (snapshot at creation time) - time to decision from the most specific SLA target; 0 is untracked
+	SLAMinutes             int    `json:"slaMinutes,omitempty"`
+	SLAEscalationChannelID string `json:"slaEscalationChannelId,omitempty"` // Channel alerted when the SLA is breached
+
+	// Channel status card - optional summary posted to RequestChannelID and updated in place
+	ShareInChannel bool   `json:"shareInChannel,omitempty"` // Requester asked for a channel status card
+	Private        bool   `json:"private,omitempty"`        // Hide description, fields and comments from the card
+	ChannelPostID  string `json:"channelPostId,omitempty"`  // Post ID of the channel status card
+
 	// Delivery tracking flags
 	NotificationSent   bool   `json:"notificationSent"`
 	NotificationPostID string `json:"notificationPostId,omitempty"` // Post ID of the DM notification with buttons
+	RequesterPostID    string `json:"requesterPostId,omitempty"`    // Post ID of the requester's confirmation DM (thread root)
 	OutcomeNotified    bool   `json:"outcomeNotified"`
 
+	// Admin redeliveries of notification DMs (append-only)
+	Redeliveries []NotificationRedelivery `json:"redeliveries,omitempty"`
+
 	// Schema versioning
 	SchemaVersion int `json:"schemaVersion"`
 }
 
 // Status constants for ApprovalRecord
 const (
-	StatusPending  = "pending"
-	StatusApproved = "approved"
-	StatusDenied   = "denied"
-	StatusCanceled = "canceled"
+	StatusScheduled = "scheduled" // Created with a send time; not yet sent to the approver
+	StatusPending   = "pending"
+	StatusApproved  = "approved"
+	StatusDenied    = "denied"
+	StatusCanceled  = "canceled"
+)
+
+// Channel visibility options chosen in the create-request dialog
+const (
+	VisibilityDirect         = "dm"              // Approver DM only (default)
+	VisibilityChannel        = "channel"         // Also post a status card to the request channel
+	VisibilityChannelPrivate = "channel_private" // Post a status card without sensitive details
 )
 
 // Schema version constant
@@ -0,0 +1,271 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// Approver policy scope prefixes. A scope is "team:<teamID>" or "template:<templateName>".
+const (
+	PolicyScopeTeam     = "team"
+	PolicyScopeTemplate = "template"
+)
+
+// rolePattern validates team/channel role names (e.g. "team_admin", "channel_admin")
+var rolePattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+var (
+	// ErrPolicyNotFound is returned when no approver policy exists for a scope
+	ErrPolicyNotFound = errors.New("approver policy not found")
+
+	// ErrApproverNotAllowed is returned when the selected approver violates an approver policy
+	ErrApproverNotAllowed = errors.New("selected approver is not allowed by policy")
+)
+
+// PolicyViolationError reports the approver policy a selected approver does not satisfy.
+// It wraps ErrApproverNotAllowed.
+type PolicyViolationError struct {
+	Scope  string          // Scope of the violated policy, e.g. "team:<teamID>"
+	Policy *ApproverPolicy // The violated policy, to describe its rules in the user's locale
+	Rules  string          // DescribePolicyRules in the default locale
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s for %s. Allowed approvers: %s", ErrApproverNotAllowed, describeScope(e.Scope), e.Rules)
+}
+
+func (e *PolicyViolationError) Unwrap() error {
+	return ErrApproverNotAllowed
+}
+
+// ApproverPolicy restricts which users may be selected as approver for a team or a request template.
+// An approver is allowed when they match at least one rule (user, group or role).
+// When both a team policy and a template policy apply, the approver must satisfy both.
+type ApproverPolicy struct {
+	Scope     string   `json:"scope"`             // "team:<teamID>" or "template:<templateName>"
+	UserIDs   []string `json:"userIds,omitempty"` // Explicitly allowed users
+	Groups    []string `json:"groups,omitempty"`  // Mattermost group names whose members are allowed
+	Roles     []string `json:"roles,omitempty"`   // Team or channel roles, e.g. "team_admin", "channel_admin"
+	UpdatedBy string   `json:"updatedBy,omitempty"`
+	UpdatedAt int64    `json:"updatedAt,omitempty"`
+}
+
+// PolicyStore provides access to approver policies
+type PolicyStore interface {
+	GetPolicy(scope string) (*ApproverPolicy, error)
+}
+
+// TeamPolicyScope returns the policy scope for a team
+func TeamPolicyScope(teamID string) string {
+	return PolicyScopeTeam + ":" + teamID
+}
+
+// TemplatePolicyScope returns the policy scope for a request template
+func TemplatePolicyScope(templateName string) string {
+	return PolicyScopeTemplate + ":" + templateName
+}
+
+// IsValidRole reports whether a role name is well-formed (e.g. "team_admin", "channel_admin")
+func IsValidRole(role string) bool {
+	return rolePattern.MatchString(role)
+}
+
+// ValidatePolicy checks that an approver policy is well-formed
+func ValidatePolicy(policy *ApproverPolicy) error {
+	if policy == nil {
+		return fmt.Errorf("policy cannot be nil")
+	}
+
+	kind, target, found := strings.Cut(policy.Scope, ":")
+	if !found || target == "" {
+		return fmt.Errorf("invalid policy scope '%s': expected team:<teamID> or template:<name>", policy.Scope)
+	}
+
+	switch kind {
+	case PolicyScopeTeam:
+		if !model.IsValidId(target) {
+			return fmt.Errorf("invalid policy scope '%s': invalid team ID", policy.Scope)
+		}
+	case PolicyScopeTemplate:
+		if !templateNamePattern.MatchString(target) {
+			return fmt.Errorf("invalid policy scope '%s': invalid template name", policy.Scope)
+		}
+	default:
+		return fmt.Errorf("invalid policy scope '%s': expected team:<teamID> or template:<name>", policy.Scope)
+	}
+
+	if len(policy.UserIDs) == 0 && len(policy.Groups) == 0 && len(policy.Roles) == 0 {
+		return fmt.Errorf("policy must allow at least one user, group or role")
+	}
+
+	for _, userID := range policy.UserIDs {
+		if !model.IsValidId(userID) {
+			return fmt.Errorf("invalid user ID '%s'", userID)
+		}
+	}
+
+	for _, group := range policy.Groups {
+		if strings.TrimSpace(group) == "" {
+			return fmt.Errorf("group names cannot be empty")
+		}
+	}
+
+	for _, role := range policy.Roles {
+		if !rolePattern.MatchString(role) {
+			return fmt.Errorf("invalid role '%s'", role)
+		}
+	}
+
+	return nil
+}
+
+// CheckApproverPolicy enforces the approver policies that apply to a request.
+// The team policy (if teamID is set) and the template policy (if templateName is set) are
+// checked independently; scopes without a policy are unrestricted.
+// Returns a PolicyViolationError (wrapping ErrApproverNotAllowed) on violation,
+// or another error if a policy or membership lookup fails (fail closed).
+func CheckApproverPolicy(store PolicyStore, api plugin.API, approverID, teamID, channelID, templateName string) error {
+	scopes := make([]string, 0, 2)
+	if teamID != "" {
+		scopes = append(scopes, TeamPolicyScope(teamID))
+	}
+	if templateName != "" {
+		scopes = append(scopes, TemplatePolicyScope(templateName))
+	}
+
+	for _, scope := range scopes {
+		policy, err := store.GetPolicy(scope)
+		if errors.Is(err, ErrPolicyNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load approver policy %s: %w", scope, err)
+		}
+
+		allowed, err := approverMatchesPolicy(policy, api, approverID, teamID, channelID)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate approver policy %s: %w", scope, err)
+		}
+
+		if !allowed {
+			return &PolicyViolationError{Scope: scope, Policy: policy, Rules: DescribePolicyRules(policy, api, i18n.DefaultLocale)}
+		}
+	}
+
+	return nil
+}
+
+// approverMatchesPolicy reports whether the approver satisfies at least one rule of the policy
+func approverMatchesPolicy(policy *ApproverPolicy, api plugin.API, approverID, teamID, channelID string) (bool, error) {
+	if slices.Contains(policy.UserIDs, approverID) {
+		return true, nil
+	}
+
+	if len(policy.Groups) > 0 {
+		groups, appErr := api.GetGroupsForUser(approverID)
+		if appErr != nil {
+			return false, fmt.Errorf("failed to get groups for user %s: %w", approverID, appErr)
+		}
+		for _, group := range groups {
+			if group.Name != nil && slices.Contains(policy.Groups, *group.Name) {
+				return true, nil
+			}
+		}
+	}
+
+	if len(policy.Roles) > 0 {
+		roles, err := memberRoles(api, approverID, teamID, channelID)
+		if err != nil {
+			return false, err
+		}
+		for _, role := range roles {
+			if slices.Contains(policy.Roles, role) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// memberRoles returns the approver's team and channel roles, including scheme roles.
+// Missing memberships (404) contribute no roles; other API failures are returned.
+func memberRoles(api plugin.API, userID, teamID, channelID string) ([]string, error) {
+	var roles []string
+
+	if teamID != "" {
+		member, appErr := api.GetTeamMember(teamID, userID)
+		if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get team member %s: %w", userID, appErr)
+		}
+		if member != nil {
+			roles = append(roles, member.GetRoles()...)
+			if member.SchemeUser {
+				roles = append(roles, model.TeamUserRoleId)
+			}
+			if member.SchemeAdmin {
+				roles = append(roles, model.TeamAdminRoleId)
+			}
+		}
+	}
+
+	if channelID != "" {
+		member, appErr := api.GetChannelMember(channelID, userID)
+		if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get channel member %s: %w", userID, appErr)
+		}
+		if member != nil {
+			roles = append(roles, channelMemberRoles(member)...)
+		}
+	}
+
+	return roles, nil
+}
+
+// channelMemberRoles returns a channel member's explicit and scheme roles
+func channelMemberRoles(member *model.ChannelMember) []string {
+	roles := member.GetRoles()
+	if member.SchemeUser {
+		roles = append(roles, model.ChannelUserRoleId)
+	}
+	if member.SchemeAdmin {
+		roles = append(roles, model.ChannelAdminRoleId)
+	}
+	return roles
+}
+
+// DescribePolicyRules formats a policy's rules for display in a locale, e.g. "@alice, group sre, role team_admin"
+func DescribePolicyRules(policy *ApproverPolicy, api plugin.API, locale string) string {
+	rules := make([]string, 0, len(policy.UserIDs)+len(policy.Groups)+len(policy.Roles))
+	for _, userID := range policy.UserIDs {
+		if user, appErr := api.GetUser(userID); appErr == nil {
+			rules = append(rules, "@"+user.Username)
+		} else {
+			rules = append(rules, userID)
+		}
+	}
+	for _, group := range policy.Groups {
+		rules = append(rules, i18n.T(locale, "policy.rule_group", group))
+	}
+	for _, role := range policy.Roles {
+		rules = append(rules, i18n.T(locale, "policy.rule_role", role))
+	}
+	return strings.Join(rules, ", ")
+}
+
+// describeScope formats a policy scope for user-facing messages
+func describeScope(scope string) string {
+	kind, target, _ := strings.Cut(scope, ":")
+	if kind == PolicyScopeTemplate {
+		return fmt.Sprintf("'%s' requests", target)
+	}
+	return "this team"
+}
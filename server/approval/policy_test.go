@@ -0,0 +1,197 @@
+package approval
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testTeamID     = "teamaaaaaaaaaaaaaaaaaaaaaa"
+	testApproverID = "approveraaaaaaaaaaaaaaaaaa"
+)
+
+// mockPolicyStore serves policies from a map keyed by scope
+type mockPolicyStore map[string]*ApproverPolicy
+
+func (m mockPolicyStore) GetPolicy(scope string) (*ApproverPolicy, error) {
+	if policy, ok := m[scope]; ok {
+		return policy, nil
+	}
+	return nil, ErrPolicyNotFound
+}
+
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *ApproverPolicy
+		wantErr string
+	}{
+		{
+			name:   "valid team policy",
+			policy: &ApproverPolicy{Scope: TeamPolicyScope(testTeamID), UserIDs: []string{testApproverID}},
+		},
+		{
+			name:   "valid template policy",
+			policy: &ApproverPolicy{Scope: TemplatePolicyScope("prod-access"), Groups: []string{"sre"}, Roles: []string{"team_admin"}},
+		},
+		{
+			name:    "nil policy",
+			wantErr: "policy cannot be nil",
+		},
+		{
+			name:    "missing scope target",
+			policy:  &ApproverPolicy{Scope: "team:", Roles: []string{"team_admin"}},
+			wantErr: "invalid policy scope",
+		},
+		{
+			name:    "unknown scope kind",
+			policy:  &ApproverPolicy{Scope: "channel:" + testTeamID, Roles: []string{"team_admin"}},
+			wantErr: "invalid policy scope",
+		},
+		{
+			name:    "invalid team ID",
+			policy:  &ApproverPolicy{Scope: "team:short", Roles: []string{"team_admin"}},
+			wantErr: "invalid team ID",
+		},
+		{
+			name:    "invalid template name",
+			policy:  &ApproverPolicy{Scope: "template:Prod Access", Roles: []string{"team_admin"}},
+			wantErr: "invalid template name",
+		},
+		{
+			name:    "no rules",
+			policy:  &ApproverPolicy{Scope: TeamPolicyScope(testTeamID)},
+			wantErr: "at least one user, group or role",
+		},
+		{
+			name:    "invalid user ID",
+			policy:  &ApproverPolicy{Scope: TeamPolicyScope(testTeamID), UserIDs: []string{"bob"}},
+			wantErr: "invalid user ID 'bob'",
+		},
+		{
+			name:    "invalid role",
+			policy:  &ApproverPolicy{Scope: TeamPolicyScope(testTeamID), Roles: []string{"Team Admin"}},
+			wantErr: "invalid role 'Team Admin'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePolicy(tt.policy)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckApproverPolicy(t *testing.T) {
+	teamScope := TeamPolicyScope(testTeamID)
+	templateScope := TemplatePolicyScope("prod-access")
+	notFound := &model.AppError{Message: "not found", StatusCode: http.StatusNotFound}
+	sre := "sre"
+
+	t.Run("no policies allows any approver", func(t *testing.T) {
+		err := CheckApproverPolicy(mockPolicyStore{}, &plugintest.API{}, testApproverID, testTeamID, "channel1", "prod-access")
+		assert.NoError(t, err)
+	})
+
+	t.Run("explicitly listed user is allowed", func(t *testing.T) {
+		store := mockPolicyStore{teamScope: {Scope: teamScope, UserIDs: []string{testApproverID}}}
+
+		err := CheckApproverPolicy(store, &plugintest.API{}, testApproverID, testTeamID, "channel1", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("group member is allowed", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupsForUser", testApproverID).Return([]*model.Group{{Name: &sre}}, nil)
+		store := mockPolicyStore{teamScope: {Scope: teamScope, Groups: []string{"sre"}}}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("team scheme admin matches team_admin role", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetTeamMember", testTeamID, testApproverID).Return(&model.TeamMember{SchemeUser: true, SchemeAdmin: true}, nil)
+		api.On("GetChannelMember", "channel1", testApproverID).Return(nil, notFound)
+		store := mockPolicyStore{teamScope: {Scope: teamScope, Roles: []string{"team_admin"}}}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("channel role is allowed", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetTeamMember", testTeamID, testApproverID).Return(&model.TeamMember{SchemeUser: true}, nil)
+		api.On("GetChannelMember", "channel1", testApproverID).Return(&model.ChannelMember{SchemeAdmin: true}, nil)
+		store := mockPolicyStore{teamScope: {Scope: teamScope, Roles: []string{"channel_admin"}}}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("approver outside policy is rejected with allowed list", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUser", "aliceaaaaaaaaaaaaaaaaaaaaa").Return(&model.User{Username: "alice"}, nil)
+		api.On("GetTeamMember", testTeamID, testApproverID).Return(&model.TeamMember{SchemeUser: true}, nil)
+		api.On("GetChannelMember", "channel1", testApproverID).Return(nil, notFound)
+		store := mockPolicyStore{teamScope: {
+			Scope:   teamScope,
+			UserIDs: []string{"aliceaaaaaaaaaaaaaaaaaaaaa"},
+			Roles:   []string{"team_admin"},
+		}}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "")
+		assert.ErrorIs(t, err, ErrApproverNotAllowed)
+		assert.Contains(t, err.Error(), "for this team. Allowed approvers: @alice, role team_admin")
+	})
+
+	t.Run("template policy applies in addition to team policy", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupsForUser", testApproverID).Return([]*model.Group{}, nil)
+		store := mockPolicyStore{
+			teamScope:     {Scope: teamScope, UserIDs: []string{testApproverID}},
+			templateScope: {Scope: templateScope, Groups: []string{"sre"}},
+		}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "prod-access")
+		assert.ErrorIs(t, err, ErrApproverNotAllowed)
+		assert.Contains(t, err.Error(), "'prod-access' requests")
+	})
+
+	t.Run("membership lookup failure fails closed", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetGroupsForUser", testApproverID).Return(nil, &model.AppError{Message: "db down", StatusCode: http.StatusInternalServerError})
+		store := mockPolicyStore{teamScope: {Scope: teamScope, Groups: []string{"sre"}}}
+
+		err := CheckApproverPolicy(store, api, testApproverID, testTeamID, "channel1", "")
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, ErrApproverNotAllowed))
+		assert.Contains(t, err.Error(), "failed to evaluate approver policy")
+	})
+
+	t.Run("policy store failure fails closed", func(t *testing.T) {
+		store := &failingPolicyStore{}
+
+		err := CheckApproverPolicy(store, &plugintest.API{}, testApproverID, testTeamID, "", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load approver policy")
+	})
+}
+
+// failingPolicyStore returns a store error for every lookup
+type failingPolicyStore struct{}
+
+func (f *failingPolicyStore) GetPolicy(scope string) (*ApproverPolicy, error) {
+	return nil, errors.New("KV unavailable")
+}
@@ -0,0 +1,26 @@
+package approval
+
+// Recipients of an admin-triggered notification redelivery (/approve admin resend)
+const (
+	RedeliveryTargetApprover  = "approver"
+	RedeliveryTargetRequester = "requester"
+)
+
+// NotificationRedelivery records an admin resending a notification DM for a request (append-only)
+type NotificationRedelivery struct {
+	Target           string `json:"target"`       // RedeliveryTargetApprover | RedeliveryTargetRequester
+	Notification     string `json:"notification"` // DM that was resent, e.g. "request" or "outcome"
+	ResentBy         string `json:"resentBy"`     // Admin user ID
+	ResentByUsername string `json:"resentByUsername"`
+	ResentAt         int64  `json:"resentAt"`         // UTC epoch milliseconds
+	PostID           string `json:"postId,omitempty"` // Post ID of the first resent DM
+}
+
+// DefaultRedeliveryTarget returns who a resend goes to when the admin names no target:
+// the approver while the request is pending, the requester once it is finalized.
+func (r *ApprovalRecord) DefaultRedeliveryTarget() string {
+	if r.Status == StatusPending {
+		return RedeliveryTargetApprover
+	}
+	return RedeliveryTargetRequester
+}
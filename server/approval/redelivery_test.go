@@ -0,0 +1,26 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRedeliveryTarget(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: StatusPending, want: RedeliveryTargetApprover},
+		{status: StatusApproved, want: RedeliveryTargetRequester},
+		{status: StatusDenied, want: RedeliveryTargetRequester},
+		{status: StatusCanceled, want: RedeliveryTargetRequester},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			record := &ApprovalRecord{Status: tt.status}
+			assert.Equal(t, tt.want, record.DefaultRedeliveryTarget())
+		})
+	}
+}
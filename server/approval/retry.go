@@ -0,0 +1,49 @@
+package approval
+
+import (
+	"time"
+)
+
+// Notification kinds that can be queued for redelivery
+const (
+	RetryKindApproverRequest = "approver_request" // Approval request DM to the approver(s)
+	RetryKindOutcome         = "outcome"          // Decision outcome DM to the requester
+)
+
+const (
+	// RetryBaseDelay is the wait before the first retry; each further attempt doubles it
+	RetryBaseDelay = time.Minute
+
+	// RetryMaxDelay caps the wait between two attempts
+	RetryMaxDelay = time.Hour
+)
+
+// NotificationRetry is a queued redelivery of a failed notification DM
+type NotificationRetry struct {
+	Kind          string `json:"kind"` // RetryKindApproverRequest | RetryKindOutcome
+	RecordID      string `json:"recordId"`
+	Code          string `json:"code"`
+	Attempts      int    `json:"attempts"`      // Failed deliveries so far, including the original send
+	NextAttemptAt int64  `json:"nextAttemptAt"` // UTC epoch milliseconds
+	LastError     string `json:"lastError"`
+	LastErrorType string `json:"lastErrorType"` // notifications.ClassifyDMError classification
+	CreatedAt     int64  `json:"createdAt"`
+}
+
+// RetryBackoff returns the wait after the given number of failed attempts:
+// 1m, 2m, 4m, ... capped at RetryMaxDelay.
+func RetryBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := RetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= RetryMaxDelay {
+			return RetryMaxDelay
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,79 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+)
+
+const (
+	// SendAtLayout is the format of the optional send time in the create-request dialog,
+	// interpreted in the requester's timezone
+	SendAtLayout = "2006-01-02 15:04"
+
+	// MaxScheduleAhead is how far in the future a request can be scheduled
+	MaxScheduleAhead = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrRequestScheduled is returned for actions that need the approver to have been sent the request
+	ErrRequestScheduled = errors.New("approval request has not been sent yet")
+
+	// ErrSendAtInvalid is returned when the send time matches neither SendAtLayout nor RFC 3339
+	ErrSendAtInvalid = errors.New("invalid send time")
+
+	// ErrSendAtInPast is returned when the send time is not after now
+	ErrSendAtInPast = errors.New("send time is in the past")
+
+	// ErrSendAtTooFar is returned when the send time is more than MaxScheduleAhead away
+	ErrSendAtTooFar = errors.New("send time is too far ahead")
+)
+
+// ParseSendAt parses the optional send time of a new request: SendAtLayout in the requester's
+// timezone, or RFC 3339 with an explicit offset. An empty value returns the zero time (send now).
+// The time must be after now and at most MaxScheduleAhead away.
+func ParseSendAt(value, timezone string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	sendAt, err := time.ParseInLocation(SendAtLayout, value, i18n.Location(timezone))
+	if err != nil {
+		var rfcErr error
+		if sendAt, rfcErr = time.Parse(time.RFC3339, value); rfcErr != nil {
+			return time.Time{}, fmt.Errorf("%w '%s': use YYYY-MM-DD HH:MM", ErrSendAtInvalid, value)
+		}
+	}
+
+	if !sendAt.After(now) {
+		return time.Time{}, fmt.Errorf("%w: %s, leave it empty to send now", ErrSendAtInPast, value)
+	}
+	if sendAt.Sub(now) > MaxScheduleAhead {
+		return time.Time{}, fmt.Errorf("%w: %s, requests can be scheduled up to %d days ahead", ErrSendAtTooFar, value, int(MaxScheduleAhead.Hours()/24))
+	}
+
+	return sendAt, nil
+}
+
+// Schedule holds a new record until sendAt instead of sending it to the approver on creation
+func (r *ApprovalRecord) Schedule(sendAt time.Time) {
+	r.Status = StatusScheduled
+	r.ScheduledAt = sendAt.UnixMilli()
+}
+
+// RequestedAt returns when the approver was (or, for a scheduled request, will be) sent the
+// request in epoch milliseconds. Timeouts, SLAs and decision times are measured from it.
+func (r *ApprovalRecord) RequestedAt() int64 {
+	switch {
+	case r.SentAt > 0:
+		return r.SentAt
+	case r.ScheduledAt > 0:
+		return r.ScheduledAt
+	default:
+		return r.CreatedAt
+	}
+}
@@ -0,0 +1,58 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSendAt(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		timezone string
+		want     time.Time
+		wantErr  error
+	}{
+		{name: "empty sends now", value: "  "},
+		{name: "layout in UTC without a timezone", value: "2026-03-02 17:30", want: time.Date(2026, 3, 2, 17, 30, 0, 0, time.UTC)},
+		{name: "layout in the requester's timezone", value: "2026-03-03 09:00", timezone: "Europe/Berlin", want: time.Date(2026, 3, 3, 8, 0, 0, 0, time.UTC)},
+		{name: "RFC 3339 with offset", value: "2026-03-03T09:00:00-05:00", timezone: "Europe/Berlin", want: time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)},
+		{name: "unknown format", value: "tomorrow", wantErr: ErrSendAtInvalid},
+		{name: "past", value: "2026-03-02 08:59", wantErr: ErrSendAtInPast},
+		{name: "now is in the past", value: "2026-03-02 09:00", wantErr: ErrSendAtInPast},
+		{name: "at the limit", value: "2026-04-01 09:00", want: now.Add(MaxScheduleAhead)},
+		{name: "beyond the limit", value: "2026-04-01 09:01", wantErr: ErrSendAtTooFar},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSendAt(tt.value, tt.timezone, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestApprovalRecord_RequestedAt(t *testing.T) {
+	record := &ApprovalRecord{Status: StatusPending, CreatedAt: 1000}
+	assert.Equal(t, int64(1000), record.RequestedAt(), "unscheduled requests are sent on creation")
+
+	record.Schedule(time.UnixMilli(5000))
+	assert.Equal(t, StatusScheduled, record.Status)
+	assert.Equal(t, int64(5000), record.ScheduledAt)
+	assert.Equal(t, int64(5000), record.RequestedAt())
+
+	record.Status = StatusPending
+	record.SentAt = 5100
+	assert.Equal(t, int64(5100), record.RequestedAt())
+}
@@ -0,0 +1,88 @@
+package approval
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minSearchTokenRunes drops one-character tokens, which match too many requests to be useful
+	minSearchTokenRunes = 2
+
+	// maxSearchTokenRunes truncates long tokens so index keys stay within the KV key length limit
+	maxSearchTokenRunes = 32
+
+	// MaxSearchTokens caps how many distinct tokens of a description are indexed
+	MaxSearchTokens = 100
+)
+
+// SearchQuery is a parsed /approve search query. All set criteria must match.
+type SearchQuery struct {
+	Terms  []string // Description tokens (see SearchTokens); every term must appear
+	From   string   // Requester username, lowercase without "@"
+	To     string   // Approver username or group name, lowercase without "@"
+	After  int64    // Created at or after (epoch millis); 0 is unbounded
+	Before int64    // Created before (epoch millis); 0 is unbounded
+	Status string   // Record status; empty matches any
+}
+
+// IsEmpty reports whether the query has no criteria at all
+func (q SearchQuery) IsEmpty() bool {
+	return len(q.Terms) == 0 && q.From == "" && q.To == "" && q.After == 0 && q.Before == 0 && q.Status == ""
+}
+
+// Matches reports whether a record satisfies every criterion of the query
+func (q SearchQuery) Matches(r *ApprovalRecord) bool {
+	if q.From != "" && !strings.EqualFold(r.RequesterUsername, q.From) {
+		return false
+	}
+	if q.To != "" && !strings.EqualFold(r.ApproverUsername, q.To) && !strings.EqualFold(r.ApproverGroupName, q.To) {
+		return false
+	}
+	if q.After != 0 && r.CreatedAt < q.After {
+		return false
+	}
+	if q.Before != 0 && r.CreatedAt >= q.Before {
+		return false
+	}
+	if q.Status != "" && r.Status != q.Status {
+		return false
+	}
+
+	tokens := SearchTokens(r.Description)
+	for _, term := range q.Terms {
+		if !slices.Contains(tokens, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchTokens splits text into the lowercase words used by the search index: runs of letters
+// and digits of at least two characters, truncated to 32 characters, deduplicated in order of
+// appearance and capped at MaxSearchTokens
+func SearchTokens(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		runes := []rune(word)
+		if len(runes) < minSearchTokenRunes {
+			continue
+		}
+		if len(runes) > maxSearchTokenRunes {
+			word = string(runes[:maxSearchTokenRunes])
+		}
+		if slices.Contains(tokens, word) {
+			continue
+		}
+		tokens = append(tokens, word)
+		if len(tokens) == MaxSearchTokens {
+			break
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,94 @@
+package approval
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: []string{}},
+		{name: "lowercases and splits on punctuation", text: "Open firewall port 443 for db-prod.", want: []string{"open", "firewall", "port", "443", "for", "db", "prod"}},
+		{name: "drops one-character words", text: "a b c v2 x", want: []string{"v2"}},
+		{name: "deduplicates in order", text: "Deploy deploy DEPLOY hotfix", want: []string{"deploy", "hotfix"}},
+		{name: "keeps non-Latin letters", text: "本番環境 デプロイ Zugriff für Müller", want: []string{"本番環境", "デプロイ", "zugriff", "für", "müller"}},
+		{name: "truncates long words", text: strings.Repeat("x", 40), want: []string{strings.Repeat("x", 32)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SearchTokens(tt.text))
+		})
+	}
+
+	t.Run("caps the number of tokens", func(t *testing.T) {
+		var words []string
+		for i := range MaxSearchTokens + 20 {
+			words = append(words, fmt.Sprintf("w%d", i))
+		}
+		assert.Len(t, SearchTokens(strings.Join(words, " ")), MaxSearchTokens)
+	})
+}
+
+func TestSearchQuery_Matches(t *testing.T) {
+	record := &ApprovalRecord{
+		ID:                "record1",
+		RequesterID:       "user123",
+		RequesterUsername: "Alice",
+		ApproverID:        "approver456",
+		ApproverUsername:  "bob",
+		Description:       "Open firewall port 443 for db-prod",
+		Status:            StatusApproved,
+		CreatedAt:         1704931200000, // 2024-01-11 00:00:00 UTC
+	}
+
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  bool
+	}{
+		{name: "empty query", query: SearchQuery{}, want: true},
+		{name: "all terms present", query: SearchQuery{Terms: []string{"firewall", "443"}}, want: true},
+		{name: "one term missing", query: SearchQuery{Terms: []string{"firewall", "vpn"}}, want: false},
+		{name: "terms match whole words only", query: SearchQuery{Terms: []string{"fire"}}, want: false},
+		{name: "from is case-insensitive", query: SearchQuery{From: "alice"}, want: true},
+		{name: "from other user", query: SearchQuery{From: "carol"}, want: false},
+		{name: "to approver", query: SearchQuery{To: "bob"}, want: true},
+		{name: "to other user", query: SearchQuery{To: "alice"}, want: false},
+		{name: "after is inclusive", query: SearchQuery{After: 1704931200000}, want: true},
+		{name: "after excludes older", query: SearchQuery{After: 1704931200001}, want: false},
+		{name: "before is exclusive", query: SearchQuery{Before: 1704931200000}, want: false},
+		{name: "before includes older", query: SearchQuery{Before: 1704931200001}, want: true},
+		{name: "status", query: SearchQuery{Status: StatusApproved}, want: true},
+		{name: "other status", query: SearchQuery{Status: StatusPending}, want: false},
+		{name: "all criteria", query: SearchQuery{Terms: []string{"prod"}, From: "alice", To: "bob", Status: StatusApproved}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.query.Matches(record))
+		})
+	}
+
+	t.Run("to matches the approver group", func(t *testing.T) {
+		group := *record
+		group.ApproverUsername = ""
+		group.ApproverGroupName = "sre-oncall"
+		assert.True(t, SearchQuery{To: "sre-oncall"}.Matches(&group))
+		assert.False(t, SearchQuery{To: "bob"}.Matches(&group))
+	})
+}
+
+func TestSearchQuery_IsEmpty(t *testing.T) {
+	assert.True(t, SearchQuery{}.IsEmpty())
+	assert.False(t, SearchQuery{Terms: []string{"deploy"}}.IsEmpty())
+	assert.False(t, SearchQuery{From: "alice"}.IsEmpty())
+	assert.False(t, SearchQuery{Before: 1}.IsEmpty())
+}
@@ -3,8 +3,12 @@ package approval
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 )
@@ -17,6 +21,13 @@ type ApprovalStore interface {
 	GetApproval(id string) (*ApprovalRecord, error)
 	GetByCode(code string) (*ApprovalRecord, error)
 	SaveApproval(record *ApprovalRecord) error
+	KVGet(key string) ([]byte, error)
+	GetPolicy(scope string) (*ApproverPolicy, error)
+	GetSLATarget(scope string) (*SLATarget, error)
+	GetUserApprovals(userID string) ([]*ApprovalRecord, error)
+	AppendSoDViolation(violation *SoDViolation) error
+	ClaimDecision(recordID, userID string) (bool, error)
+	ReleaseDecisionClaim(recordID string) error
 }
 
 // Service provides business logic for approval operations
@@ -24,6 +35,13 @@ type Service struct {
 	store     ApprovalStore
 	api       plugin.API
 	botUserID string
+
+	// metrics counts lifecycle events; nil disables collection
+	metrics *metrics.Metrics
+
+	// sodLock guards sod, which is replaced on configuration changes
+	sodLock sync.RWMutex
+	sod     SeparationOfDuties
 }
 
 // NewService creates a new approval service
@@ -35,6 +53,25 @@ func NewService(store ApprovalStore, api plugin.API, botUserID string) *Service
 	}
 }
 
+// SetMetrics sets the collector for request lifecycle metrics (called once on activation)
+func (s *Service) SetMetrics(m *metrics.Metrics) {
+	s.metrics = m
+}
+
+// SetSeparationOfDuties replaces the separation-of-duties rules (called on configuration changes)
+func (s *Service) SetSeparationOfDuties(sod SeparationOfDuties) {
+	s.sodLock.Lock()
+	defer s.sodLock.Unlock()
+	s.sod = sod
+}
+
+// separationOfDuties returns the active separation-of-duties rules
+func (s *Service) separationOfDuties() SeparationOfDuties {
+	s.sodLock.RLock()
+	defer s.sodLock.RUnlock()
+	return s.sod
+}
+
 // CancelApproval cancels a pending approval request with a reason
 // Parameters:
 // - approvalCode: The human-friendly approval code (e.g., "A-X7K9Q2")
@@ -43,7 +80,7 @@ func NewService(store ApprovalStore, api plugin.API, botUserID string) *Service
 // - details: Additional context/explanation (optional, Story 7.3)
 // Returns:
 // - ErrRecordNotFound if approval doesn't exist
-// - ErrRecordImmutable if approval is not pending
+// - ErrRecordImmutable if approval is neither pending nor scheduled
 // - error with "permission denied" if requester doesn't match
 // - error if reason is empty
 func (s *Service) CancelApproval(approvalCode, requesterID, reason, details string) error {
@@ -81,8 +118,8 @@ func (s *Service) CancelApproval(approvalCode, requesterID, reason, details stri
 		return fmt.Errorf("permission denied: only requester can cancel approval")
 	}
 
-	// Immutability check: only pending approvals can be canceled
-	if record.Status != StatusPending {
+	// Immutability check: only pending and scheduled (not yet sent) approvals can be canceled
+	if record.Status != StatusPending && record.Status != StatusScheduled {
 		return fmt.Errorf("cannot cancel approval with status %s: %w", record.Status, ErrRecordImmutable)
 	}
 
@@ -98,6 +135,7 @@ func (s *Service) CancelApproval(approvalCode, requesterID, reason, details stri
 	if err := s.store.SaveApproval(record); err != nil {
 		return fmt.Errorf("failed to save canceled approval %s: %w", approvalCode, err)
 	}
+	s.metrics.RequestEvent(metrics.EventCanceled)
 
 	return nil
 }
@@ -159,6 +197,7 @@ func (s *Service) CancelApprovalByID(approvalID, requesterID string, isAutoCance
 	if err := s.store.SaveApproval(record); err != nil {
 		return fmt.Errorf("failed to save canceled approval %s: %w", approvalID, err)
 	}
+	s.metrics.RequestEvent(metrics.EventTimedOut)
 
 	s.api.LogInfo("Approval canceled",
 		"approval_id", approvalID,
@@ -240,6 +279,7 @@ func (s *Service) VerifyRequest(approvalCode, requesterID, comment string) error
 	if err := s.store.SaveApproval(record); err != nil {
 		return fmt.Errorf("failed to save verified approval %s: %w", approvalCode, err)
 	}
+	s.metrics.RequestEvent(metrics.EventVerified)
 
 	s.api.LogInfo("Approval verified",
 		"approval_id", record.ID,
@@ -251,9 +291,163 @@ func (s *Service) VerifyRequest(approvalCode, requesterID, comment string) error
 	return nil
 }
 
+// ResubmitApproval creates a new pending approval request cloned from a finalized one.
+// The new record keeps the original approver, description, channel and team, and the two
+// records are linked via PreviousCode/NextCode so the lineage can be displayed.
+//
+// Parameters:
+// - approvalCode: The human-friendly code of the finalized request (e.g., "A-X7K9Q2")
+// - requesterID: The user ID of the requester
+//
+// Returns:
+// - On success: (new ApprovalRecord, nil) - caller should send the approver notification
+// - On failure: (nil, error) where error is:
+//   - ErrRecordNotFound if approval doesn't exist
+//   - error with "permission denied" if requester doesn't match
+//   - error with "still pending" if the original request has not been finalized
+//   - error with "already resubmitted" if the original request was resubmitted before
+//   - error with "invalid approver" if the original approver is no longer active
+//   - ErrApproverNotAllowed if an approver policy no longer allows the original approver
+//   - error with "group approval requests" if the original request was sent to a group or role
+func (s *Service) ResubmitApproval(approvalCode, requesterID string) (*ApprovalRecord, error) {
+	// Validation: code and requester ID required (trim whitespace)
+	approvalCode = strings.TrimSpace(approvalCode)
+	if approvalCode == "" {
+		return nil, fmt.Errorf("approval code is required")
+	}
+
+	// Validate approval code format (A-X7K9Q2)
+	if !approvalCodePattern.MatchString(approvalCode) {
+		return nil, fmt.Errorf("invalid approval code format: expected format like 'A-X7K9Q2'")
+	}
+
+	requesterID = strings.TrimSpace(requesterID)
+	if requesterID == "" {
+		return nil, fmt.Errorf("requester ID is required")
+	}
+
+	// Retrieve original approval record by code
+	original, err := s.store.GetByCode(approvalCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve approval %s: %w", approvalCode, err)
+	}
+
+	// Access control: only the requester can resubmit their own request
+	if original.RequesterID != requesterID {
+		return nil, fmt.Errorf("permission denied: only requester can resubmit approval")
+	}
+
+	// Only finalized requests can be resubmitted
+	if original.Status == StatusPending || original.Status == StatusScheduled {
+		return nil, fmt.Errorf("cannot resubmit approval %s: still pending", approvalCode)
+	}
+
+	// Lineage is a simple chain: each request can be resubmitted only once
+	if original.NextCode != "" {
+		return nil, fmt.Errorf("approval %s already resubmitted as %s", approvalCode, original.NextCode)
+	}
+
+	// Group membership may have changed; group requests are re-created with /approve new --group|--role
+	if original.IsGroupApproval() {
+		return nil, fmt.Errorf("cannot resubmit approval %s: group approval requests must be created again with /approve new", approvalCode)
+	}
+
+	// Re-validate the approver (account may have been deactivated since the original request)
+	approver, err := ValidateApprover(original.ApproverID, original.RequesterID, s.api)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidApprover, err.Error())
+	}
+
+	// Policies may have changed since the original request was created
+	if err := CheckApproverPolicy(s.store, s.api, approver.Id, original.TeamID, original.RequestChannelID, original.TemplateName); err != nil {
+		return nil, err
+	}
+
+	// Create new record from the original (requester snapshot is preserved, approver refreshed)
+	record, err := NewApprovalRecord(
+		s.store,
+		original.RequesterID, original.RequesterUsername, original.RequesterDisplayName,
+		approver.Id, approver.Username, approver.GetDisplayName(model.ShowFullName),
+		original.Description,
+		original.RequestChannelID,
+		original.TeamID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resubmitted approval: %w", err)
+	}
+	record.PreviousCode = original.Code
+	record.TemplateName = original.TemplateName
+	record.CustomFields = slices.Clone(original.CustomFields)
+	record.ShareInChannel = original.ShareInChannel
+	record.Private = original.Private
+	record.RequesterLocale = original.RequesterLocale
+	record.ApproverLocale = approver.Locale
+	record.RequesterTimezone = original.RequesterTimezone
+	record.ApproverTimezone = approver.GetPreferredTimezone()
+
+	// SLA targets may have changed since the original request was created
+	slaTarget, err := ResolveSLATarget(s.store, original.TeamID, original.TemplateName)
+	if err != nil {
+		s.api.LogWarn("Failed to resolve SLA target for resubmission", "original_code", original.Code, "error", err.Error())
+	}
+	record.ApplySLATarget(slaTarget)
+
+	if err := s.store.SaveApproval(record); err != nil {
+		return nil, fmt.Errorf("failed to save resubmitted approval %s: %w", record.Code, err)
+	}
+	s.metrics.RequestEvent(metrics.EventCreated)
+
+	// Link the original record to its successor (best effort - new record already exists)
+	original.NextCode = record.Code
+	if err := s.store.SaveApproval(original); err != nil {
+		s.api.LogWarn("Failed to link original approval to resubmission",
+			"original_code", original.Code,
+			"new_code", record.Code,
+			"error", err.Error(),
+		)
+	}
+
+	s.api.LogInfo("Approval resubmitted",
+		"original_code", original.Code,
+		"new_code", record.Code,
+		"requester_id", requesterID,
+	)
+
+	return record, nil
+}
+
+// SendScheduledRequest moves a scheduled request to pending once its send time has come, so the
+// caller can send the approver DM. Timeouts and the SLA run from now.
+// Returns ErrRecordImmutable if the request is no longer scheduled (canceled or already sent).
+func (s *Service) SendScheduledRequest(approvalID string, now time.Time) (*ApprovalRecord, error) {
+	record, err := s.store.GetApproval(approvalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve approval %s: %w", approvalID, err)
+	}
+
+	if record.Status != StatusScheduled {
+		return nil, fmt.Errorf("cannot send approval with status %s: %w", record.Status, ErrRecordImmutable)
+	}
+
+	record.Status = StatusPending
+	record.SentAt = now.UnixMilli()
+	if err := s.store.SaveApproval(record); err != nil {
+		return nil, fmt.Errorf("failed to save sent approval %s: %w", record.Code, err)
+	}
+
+	s.api.LogInfo("Scheduled approval sent",
+		"approval_id", record.ID,
+		"code", record.Code,
+		"scheduled_at", record.ScheduledAt,
+	)
+
+	return record, nil
+}
+
 // RecordDecision records an approval decision (approve or deny) with immutability guarantees.
 // This method enforces:
-// - Authorization: Only the designated approver can record a decision
+// - Authorization: Only the designated approver (or a candidate member for group approvals) can record a decision
+// - Claiming: For group approvals the first member to confirm claims the decision and becomes the approver
 // - Immutability: Decisions can only be recorded on pending approvals
 // - Atomicity: All field updates happen atomically via KV store
 // - Concurrency Safety: Uses optimistic locking via KVStore to prevent race conditions
@@ -266,6 +460,9 @@ func (s *Service) VerifyRequest(approvalCode, requesterID, comment string) error
 //   - ErrRecordNotFound if approval doesn't exist
 //   - ErrRecordImmutable if approval is not pending
 //   - error with "permission denied" if approver doesn't match
+//   - ErrSelfApproval if the approver is also the requester
+//   - ErrReciprocalApproval if the requester approved one of the approver's requests within the reciprocal window
+//   - ErrDecisionClaimed if another group member already claimed the decision
 //   - error for validation failures (empty IDs, invalid decision value)
 func (s *Service) RecordDecision(approvalID, approverID, decision, comment string) (*ApprovalRecord, error) {
 	// Performance tracking (NFR-P2: must complete within 2 seconds)
@@ -302,8 +499,8 @@ func (s *Service) RecordDecision(approvalID, approverID, decision, comment strin
 		return nil, fmt.Errorf("approval record %s is nil after retrieval", approvalID)
 	}
 
-	// Authorization check: verify authenticated user is the designated approver
-	if record.ApproverID != approverID {
+	// Authorization check: verify authenticated user is the designated approver (or a group candidate)
+	if !record.CanDecide(approverID) {
 		s.api.LogError("Unauthorized decision attempt",
 			"approval_id", approvalID,
 			"authenticated_user", approverID,
@@ -322,6 +519,18 @@ func (s *Service) RecordDecision(approvalID, approverID, decision, comment strin
 		return nil, fmt.Errorf("cannot modify approval with status %s: %w", record.Status, ErrRecordImmutable)
 	}
 
+	// Separation of duties: enforced at decision time as well, since rules may have changed after creation
+	if err := s.checkSeparationOfDuties(record, approverID, decision); err != nil {
+		return nil, err
+	}
+
+	// Group approvals: the first member to confirm claims the decision and becomes the acting approver
+	if record.IsGroupApproval() {
+		if err := s.claimGroupDecision(record, approverID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Map decision string to status constant
 	var newStatus string
 	if decision == "approved" {
@@ -339,11 +548,19 @@ func (s *Service) RecordDecision(approvalID, approverID, decision, comment strin
 	// KVStore re-checks status != pending before write (kvstore.go:33-40),
 	// providing protection against race conditions via optimistic locking
 	if err := s.store.SaveApproval(record); err != nil {
+		if record.IsGroupApproval() {
+			if releaseErr := s.store.ReleaseDecisionClaim(record.ID); releaseErr != nil {
+				s.api.LogError("Failed to release decision claim", "approval_id", approvalID, "error", releaseErr.Error())
+			}
+		}
 		return nil, fmt.Errorf("failed to save decision for approval %s: %w", approvalID, err)
 	}
 
 	// Calculate operation duration for performance monitoring (NFR-P2)
 	duration := model.GetMillis() - startTime
+	s.metrics.ObserveRecordDecision(time.Duration(duration) * time.Millisecond)
+	// The approved and denied statuses double as metrics event names
+	s.metrics.RequestDecided(newStatus, time.Duration(record.DecidedAt-record.RequestedAt())*time.Millisecond)
 
 	// Log successful decision recording
 	s.api.LogInfo("Approval decision recorded",
@@ -371,3 +588,74 @@ func (s *Service) RecordDecision(approvalID, approverID, decision, comment strin
 	// Return updated record for caller to send outcome notification
 	return record, nil
 }
+
+// checkSeparationOfDuties enforces separation-of-duties rules for a decision on a pending record.
+// Self-decisions are always blocked; approvals are blocked when the requester approved one of the
+// approver's requests within the reciprocal window. Denials are never reciprocal violations.
+func (s *Service) checkSeparationOfDuties(record *ApprovalRecord, approverID, decision string) error {
+	if approverID == record.RequesterID {
+		RecordSoDViolation(s.store, s.api, &SoDViolation{
+			Rule:        SoDRuleSelfApproval,
+			UserID:      approverID,
+			RequesterID: record.RequesterID,
+			Code:        record.Code,
+		})
+		return fmt.Errorf("cannot decide approval %s: %w", record.Code, ErrSelfApproval)
+	}
+
+	window := s.separationOfDuties().ReciprocalWindow
+	if decision != "approved" || window <= 0 {
+		return nil
+	}
+
+	approverRecords, err := s.store.GetUserApprovals(approverID)
+	if err != nil {
+		return fmt.Errorf("failed to check reciprocal approvals for %s: %w", approverID, err)
+	}
+
+	since := model.GetMillis() - window.Milliseconds()
+	reciprocal := FindReciprocalApproval(approverRecords, record.RequesterID, approverID, since)
+	if reciprocal == nil {
+		return nil
+	}
+
+	RecordSoDViolation(s.store, s.api, &SoDViolation{
+		Rule:        SoDRuleReciprocalApproval,
+		UserID:      approverID,
+		RequesterID: record.RequesterID,
+		Code:        record.Code,
+		RelatedCode: reciprocal.Code,
+	})
+
+	return fmt.Errorf("%w: @%s approved your request %s within the last %s; ask another approver to review %s",
+		ErrReciprocalApproval, record.RequesterUsername, reciprocal.Code, formatWindow(window), record.Code)
+}
+
+// claimGroupDecision atomically claims a group approval for the acting member and records them as approver.
+// Returns ErrDecisionClaimed if another member confirmed first.
+func (s *Service) claimGroupDecision(record *ApprovalRecord, approverID string) error {
+	approver, appErr := s.api.GetUser(approverID)
+	if appErr != nil {
+		return fmt.Errorf("failed to get acting approver %s: %w", approverID, appErr)
+	}
+
+	claimed, err := s.store.ClaimDecision(record.ID, approverID)
+	if err != nil {
+		return fmt.Errorf("failed to claim approval %s: %w", record.Code, err)
+	}
+	if !claimed {
+		s.api.LogInfo("Group approval decision already claimed",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"user_id", approverID,
+		)
+		return fmt.Errorf("cannot decide approval %s: %w", record.Code, ErrDecisionClaimed)
+	}
+
+	record.ApproverID = approver.Id
+	record.ApproverUsername = approver.Username
+	record.ApproverDisplayName = approver.GetDisplayName(model.ShowFullName)
+	record.ApproverLocale = approver.Locale
+	record.ApproverTimezone = approver.GetPreferredTimezone()
+	return nil
+}
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockApprovalStore is a mock implementation of the ApprovalStore interface
@@ -37,6 +41,53 @@ func (m *MockApprovalStore) SaveApproval(record *ApprovalRecord) error {
 	return args.Error(0)
 }
 
+func (m *MockApprovalStore) KVGet(key string) ([]byte, error) {
+	args := m.Called(key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockApprovalStore) GetPolicy(scope string) (*ApproverPolicy, error) {
+	args := m.Called(scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ApproverPolicy), args.Error(1)
+}
+
+func (m *MockApprovalStore) GetSLATarget(scope string) (*SLATarget, error) {
+	args := m.Called(scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SLATarget), args.Error(1)
+}
+
+func (m *MockApprovalStore) GetUserApprovals(userID string) ([]*ApprovalRecord, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ApprovalRecord), args.Error(1)
+}
+
+func (m *MockApprovalStore) AppendSoDViolation(violation *SoDViolation) error {
+	args := m.Called(violation)
+	return args.Error(0)
+}
+
+func (m *MockApprovalStore) ClaimDecision(recordID, userID string) (bool, error) {
+	args := m.Called(recordID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockApprovalStore) ReleaseDecisionClaim(recordID string) error {
+	args := m.Called(recordID)
+	return args.Error(0)
+}
+
 func TestCancelApproval(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -70,6 +121,22 @@ func TestCancelApproval(t *testing.T) {
 			wantStatus:    StatusCanceled,
 			wantDecidedAt: true,
 		},
+		{
+			name:         "scheduled request can be canceled before it is sent",
+			approvalCode: "A-X7K9Q2",
+			requesterID:  "user123",
+			reason:       "No longer needed",
+			existingRecord: &ApprovalRecord{
+				ID:          "abc123",
+				Code:        "A-X7K9Q2",
+				RequesterID: "user123",
+				Status:      StatusScheduled,
+				CreatedAt:   1704931200000,
+				ScheduledAt: 1704934800000,
+			},
+			wantStatus:    StatusCanceled,
+			wantDecidedAt: true,
+		},
 		{
 			name:         "permission denied - different user",
 			approvalCode: "A-X7K9Q2",
@@ -213,7 +280,7 @@ func TestCancelApproval(t *testing.T) {
 
 					// Only expect SaveApproval if we pass access control and status checks
 					if tt.requesterID == tt.existingRecord.RequesterID &&
-						tt.existingRecord.Status == StatusPending {
+						(tt.existingRecord.Status == StatusPending || tt.existingRecord.Status == StatusScheduled) {
 						mockStore.On("SaveApproval", mock.MatchedBy(func(r *ApprovalRecord) bool {
 							// Verify the record was updated correctly
 							return r.ID == tt.existingRecord.ID &&
@@ -738,3 +805,295 @@ func TestVerifyRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestResubmitApproval(t *testing.T) {
+	finalized := func(status string) *ApprovalRecord {
+		return &ApprovalRecord{
+			ID:                   "abc123",
+			Code:                 "A-X7K9Q2",
+			RequesterID:          "user123",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Carter",
+			ApproverID:           "approver456",
+			ApproverUsername:     "bob",
+			ApproverDisplayName:  "Bob Smith",
+			Description:          "Deploy v2.5.0 to production",
+			Status:               status,
+			CreatedAt:            1704931200000,
+			DecidedAt:            1704931300000,
+			RequestChannelID:     "channel789",
+			TeamID:               "team012",
+			SchemaVersion:        1,
+		}
+	}
+
+	t.Run("creates linked pending record from denied request", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+
+		original := finalized(StatusDenied)
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(original, nil)
+		mockStore.On("GetPolicy", "team:team012").Return(nil, ErrPolicyNotFound)
+		mockStore.On("GetSLATarget", "team:team012").Return(nil, ErrSLATargetNotFound)
+		mockStore.On("GetSLATarget", SLAScopeGlobal).Return(nil, ErrSLATargetNotFound)
+		mockStore.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		mockStore.On("SaveApproval", mock.AnythingOfType("*approval.ApprovalRecord")).Return(nil)
+		mockAPI.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob", FirstName: "Bob", LastName: "Smith"}, nil)
+		mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		record, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, record)
+		assert.Zero(t, record.SLAMinutes)
+		assert.NotEqual(t, original.ID, record.ID)
+		assert.NotEqual(t, original.Code, record.Code)
+		assert.Equal(t, StatusPending, record.Status)
+		assert.Equal(t, "A-X7K9Q2", record.PreviousCode)
+		assert.Equal(t, original.Description, record.Description)
+		assert.Equal(t, "approver456", record.ApproverID)
+		assert.Equal(t, "channel789", record.RequestChannelID)
+		assert.Equal(t, "team012", record.TeamID)
+
+		// Original record is linked to its successor
+		assert.Equal(t, record.Code, original.NextCode)
+		mockStore.AssertNumberOfCalls(t, "SaveApproval", 2)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("snapshots the current SLA target", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+
+		original := finalized(StatusDenied)
+		original.SLAMinutes = 60
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(original, nil)
+		mockStore.On("GetPolicy", "team:team012").Return(nil, ErrPolicyNotFound)
+		mockStore.On("GetSLATarget", "team:team012").Return(&SLATarget{Scope: "team:team012", Minutes: 15, EscalationChannelID: "escalation123"}, nil)
+		mockStore.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		mockStore.On("SaveApproval", mock.AnythingOfType("*approval.ApprovalRecord")).Return(nil)
+		mockAPI.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		record, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 15, record.SLAMinutes)
+		assert.Equal(t, "escalation123", record.SLAEscalationChannelID)
+	})
+
+	t.Run("rejects pending request", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(finalized(StatusPending), nil)
+
+		service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+		_, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "still pending")
+		mockStore.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("rejects request that was already resubmitted", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		original := finalized(StatusCanceled)
+		original.NextCode = "A-NEWCDE"
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(original, nil)
+
+		service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+		_, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already resubmitted as A-NEWCDE")
+	})
+
+	t.Run("permission denied for different user", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(finalized(StatusDenied), nil)
+
+		service := NewService(mockStore, &plugintest.API{}, "bot-user-id")
+		_, err := service.ResubmitApproval("A-X7K9Q2", "user999")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("rejects deactivated approver", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(finalized(StatusDenied), nil)
+		mockAPI.On("GetUser", "approver456").Return(&model.User{Id: "approver456", DeleteAt: 1704931300000}, nil)
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		_, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidApprover)
+		mockStore.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("rejects approver no longer allowed by team policy", func(t *testing.T) {
+		mockStore := new(MockApprovalStore)
+		mockAPI := &plugintest.API{}
+		mockStore.On("GetByCode", "A-X7K9Q2").Return(finalized(StatusDenied), nil)
+		mockStore.On("GetPolicy", "team:team012").Return(&ApproverPolicy{Scope: "team:team012", Groups: []string{"sre"}}, nil)
+		mockAPI.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		mockAPI.On("GetGroupsForUser", "approver456").Return([]*model.Group{}, nil)
+
+		service := NewService(mockStore, mockAPI, "bot-user-id")
+		_, err := service.ResubmitApproval("A-X7K9Q2", "user123")
+
+		assert.ErrorIs(t, err, ErrApproverNotAllowed)
+		assert.Contains(t, err.Error(), "group sre")
+		mockStore.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("invalid approval code format", func(t *testing.T) {
+		service := NewService(new(MockApprovalStore), &plugintest.API{}, "bot-user-id")
+		_, err := service.ResubmitApproval("INVALID", "user123")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid approval code format")
+	})
+}
+
+func TestServiceMetrics(t *testing.T) {
+	setup := func(record *ApprovalRecord) (*MockApprovalStore, *metrics.Metrics, *Service) {
+		store := new(MockApprovalStore)
+		api := &plugintest.API{}
+		store.On("GetApproval", record.ID).Return(record, nil).Maybe()
+		store.On("GetByCode", record.Code).Return(record, nil).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		m := metrics.New()
+		service := NewService(store, api, "bot")
+		service.SetMetrics(m)
+		return store, m, service
+	}
+
+	pending := func() *ApprovalRecord {
+		return &ApprovalRecord{
+			ID:          "record1",
+			Code:        "A-PEND01",
+			RequesterID: "alice",
+			ApproverID:  "bob",
+			Status:      StatusPending,
+			CreatedAt:   model.GetMillis() - (4 * time.Minute).Milliseconds(),
+		}
+	}
+
+	render := func(m *metrics.Metrics) string {
+		var out strings.Builder
+		require.NoError(t, m.WritePrometheus(&out, 0))
+		return out.String()
+	}
+
+	t.Run("decision counts outcome and observes latency and duration", func(t *testing.T) {
+		store, m, service := setup(pending())
+		store.On("SaveApproval", mock.Anything).Return(nil)
+
+		_, err := service.RecordDecision("record1", "bob", "denied", "")
+		require.NoError(t, err)
+
+		out := render(m)
+		assert.Contains(t, out, "approval_requests_denied_total 1\n")
+		assert.Contains(t, out, "approval_requests_approved_total 0\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"120\"} 0\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"300\"} 1\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_count 1\n")
+	})
+
+	t.Run("failed save is not counted", func(t *testing.T) {
+		store, m, service := setup(pending())
+		store.On("SaveApproval", mock.Anything).Return(ErrRecordImmutable)
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+		require.Error(t, err)
+
+		out := render(m)
+		assert.Contains(t, out, "approval_requests_approved_total 0\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_count 0\n")
+	})
+
+	t.Run("cancel and timeout are counted separately", func(t *testing.T) {
+		store, m, service := setup(pending())
+		store.On("SaveApproval", mock.Anything).Return(nil)
+		require.NoError(t, service.CancelApproval("A-PEND01", "alice", "No longer needed", ""))
+
+		record := pending()
+		record.ID = "record2"
+		store.On("GetApproval", "record2").Return(record, nil)
+		require.NoError(t, service.CancelApprovalByID("record2", "alice", true))
+
+		out := render(m)
+		assert.Contains(t, out, "approval_requests_canceled_total 1\n")
+		assert.Contains(t, out, "approval_requests_timed_out_total 1\n")
+	})
+
+	t.Run("verification is counted", func(t *testing.T) {
+		record := pending()
+		record.Status = StatusApproved
+		store, m, service := setup(record)
+		store.On("SaveApproval", mock.Anything).Return(nil)
+
+		require.NoError(t, service.VerifyRequest("A-PEND01", "alice", ""))
+
+		assert.Contains(t, render(m), "approval_requests_verified_total 1\n")
+	})
+}
+
+func TestSendScheduledRequest(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 30, 0, time.UTC)
+	scheduled := func() *ApprovalRecord {
+		return &ApprovalRecord{
+			ID:          "record1",
+			Code:        "A-SCHD01",
+			RequesterID: "alice",
+			ApproverID:  "bob",
+			Status:      StatusScheduled,
+			CreatedAt:   now.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: now.Add(-30 * time.Second).UnixMilli(),
+		}
+	}
+
+	t.Run("moves the request to pending and records when it was sent", func(t *testing.T) {
+		store := new(MockApprovalStore)
+		api := &plugintest.API{}
+		store.On("GetApproval", "record1").Return(scheduled(), nil)
+		store.On("SaveApproval", mock.MatchedBy(func(r *ApprovalRecord) bool {
+			return r.Status == StatusPending && r.SentAt == now.UnixMilli()
+		})).Return(nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		record, err := NewService(store, api, "bot").SendScheduledRequest("record1", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusPending, record.Status)
+		assert.Equal(t, now.UnixMilli(), record.RequestedAt())
+		store.AssertExpectations(t)
+	})
+
+	t.Run("canceled request is not sent", func(t *testing.T) {
+		store := new(MockApprovalStore)
+		record := scheduled()
+		record.Status = StatusCanceled
+		store.On("GetApproval", "record1").Return(record, nil)
+
+		_, err := NewService(store, &plugintest.API{}, "bot").SendScheduledRequest("record1", now)
+
+		assert.ErrorIs(t, err, ErrRecordImmutable)
+		store.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("save failure is returned", func(t *testing.T) {
+		store := new(MockApprovalStore)
+		store.On("GetApproval", "record1").Return(scheduled(), nil)
+		store.On("SaveApproval", mock.Anything).Return(errors.New("KV unavailable"))
+
+		_, err := NewService(store, &plugintest.API{}, "bot").SendScheduledRequest("record1", now)
+
+		assert.ErrorContains(t, err, "failed to save sent approval A-SCHD01")
+	})
+}
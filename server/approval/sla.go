@@ -0,0 +1,196 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// SLAScopeGlobal is the scope of the SLA target that applies to every request without a more
+// specific team or template target. Team and template targets use TeamPolicyScope and
+// TemplatePolicyScope.
+const SLAScopeGlobal = "global"
+
+// PendingTimeout is how long a request stays pending before the timeout checker cancels it
+const PendingTimeout = 30 * time.Minute
+
+// SLA target bounds. The monitor checks once a minute, so targets are whole minutes. A target
+// must end before PendingTimeout, or the request is canceled before it can breach.
+const (
+	MinSLATarget = time.Minute
+	MaxSLATarget = PendingTimeout - time.Minute
+)
+
+// Per-record SLA states, derived from the record's SLA snapshot and its decision time
+const (
+	SLAStateNone     = ""         // No SLA target applied, or canceled before the deadline
+	SLAStateOnTrack  = "on_track" // Pending and still within the target
+	SLAStateMet      = "met"      // Decided within the target
+	SLAStateBreached = "breached" // Decided (or still pending, or timed out) after the target
+)
+
+// ErrSLATargetNotFound is returned when no SLA target exists for a scope
+var ErrSLATargetNotFound = errors.New("SLA target not found")
+
+// SLATarget is the time within which requests in a scope must be decided. The most specific
+// target applies: template, then team, then global. Breaches are DMed to the approver and,
+// when set, posted to the escalation channel.
+type SLATarget struct {
+	Scope               string `json:"scope"`   // "global", "team:<teamID>" or "template:<templateName>"
+	Minutes             int    `json:"minutes"` // Time to decision
+	EscalationChannelID string `json:"escalationChannelId,omitempty"`
+	UpdatedBy           string `json:"updatedBy,omitempty"`
+	UpdatedAt           int64  `json:"updatedAt,omitempty"`
+}
+
+// SLAStore provides access to SLA targets
+type SLAStore interface {
+	GetSLATarget(scope string) (*SLATarget, error)
+}
+
+// ValidateSLATarget checks that an SLA target is well-formed
+func ValidateSLATarget(target *SLATarget) error {
+	if target == nil {
+		return fmt.Errorf("SLA target cannot be nil")
+	}
+
+	if target.Scope != SLAScopeGlobal {
+		kind, name, found := strings.Cut(target.Scope, ":")
+		switch {
+		case !found || name == "":
+			return fmt.Errorf("invalid SLA scope '%s': expected global, team:<teamID> or template:<name>", target.Scope)
+		case kind == PolicyScopeTeam:
+			if !model.IsValidId(name) {
+				return fmt.Errorf("invalid SLA scope '%s': invalid team ID", target.Scope)
+			}
+		case kind == PolicyScopeTemplate:
+			if !templateNamePattern.MatchString(name) {
+				return fmt.Errorf("invalid SLA scope '%s': invalid template name", target.Scope)
+			}
+		default:
+			return fmt.Errorf("invalid SLA scope '%s': expected global, team:<teamID> or template:<name>", target.Scope)
+		}
+	}
+
+	duration := time.Duration(target.Minutes) * time.Minute
+	if duration < MinSLATarget || duration > MaxSLATarget {
+		return errSLATargetRange()
+	}
+
+	if target.EscalationChannelID != "" && !model.IsValidId(target.EscalationChannelID) {
+		return fmt.Errorf("invalid escalation channel ID '%s'", target.EscalationChannelID)
+	}
+
+	return nil
+}
+
+// ParseSLATarget parses an SLA target duration such as "10m" or "20m" into whole minutes
+func ParseSLATarget(value string) (int, error) {
+	duration, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid SLA target '%s': use a duration such as 10m or 20m", value)
+	}
+	if duration%time.Minute != 0 {
+		return 0, fmt.Errorf("invalid SLA target '%s': must be whole minutes", value)
+	}
+	if duration < MinSLATarget || duration > MaxSLATarget {
+		return 0, errSLATargetRange()
+	}
+	return int(duration / time.Minute), nil
+}
+
+// ResolveSLATarget returns the most specific SLA target for a request: the template target,
+// then the team target, then the global target. Returns nil when no target applies.
+func ResolveSLATarget(store SLAStore, teamID, templateName string) (*SLATarget, error) {
+	scopes := make([]string, 0, 3)
+	if templateName != "" {
+		scopes = append(scopes, TemplatePolicyScope(templateName))
+	}
+	if teamID != "" {
+		scopes = append(scopes, TeamPolicyScope(teamID))
+	}
+	scopes = append(scopes, SLAScopeGlobal)
+
+	for _, scope := range scopes {
+		target, err := store.GetSLATarget(scope)
+		if errors.Is(err, ErrSLATargetNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load SLA target %s: %w", scope, err)
+		}
+		return target, nil
+	}
+
+	return nil, nil
+}
+
+// ApplySLATarget snapshots an SLA target onto a new record so later target changes do not
+// move the deadline of requests already sent. A nil target leaves the record untracked.
+func (r *ApprovalRecord) ApplySLATarget(target *SLATarget) {
+	if target == nil {
+		return
+	}
+	r.SLAMinutes = target.Minutes
+	r.SLAEscalationChannelID = target.EscalationChannelID
+}
+
+// SLADeadline returns the time (epoch milliseconds) the request must be decided by, or 0 if
+// no SLA target applied when it was created. The SLA runs from when the approver is sent the request.
+func (r *ApprovalRecord) SLADeadline() int64 {
+	if r.SLAMinutes <= 0 {
+		return 0
+	}
+	return r.RequestedAt() + int64(r.SLAMinutes)*time.Minute.Milliseconds()
+}
+
+// SLAState returns the record's SLA state at now (epoch milliseconds). Requests canceled by
+// the requester before the deadline have no SLA outcome; timed-out requests closed after the
+// deadline count as breached.
+func (r *ApprovalRecord) SLAState(now int64) string {
+	deadline := r.SLADeadline()
+	if deadline == 0 {
+		return SLAStateNone
+	}
+
+	closedAt := int64(0)
+	switch r.Status {
+	case StatusApproved, StatusDenied:
+		closedAt = r.DecidedAt
+	case StatusCanceled:
+		if r.CanceledAt <= deadline {
+			return SLAStateNone
+		}
+		return SLAStateBreached
+	}
+
+	if closedAt == 0 {
+		if now > deadline {
+			return SLAStateBreached
+		}
+		return SLAStateOnTrack
+	}
+	if closedAt > deadline {
+		return SLAStateBreached
+	}
+	return SLAStateMet
+}
+
+// errSLATargetRange reports an SLA target outside MinSLATarget..MaxSLATarget
+func errSLATargetRange() error {
+	return fmt.Errorf("SLA target must be between %s and %s: pending requests time out after %s",
+		FormatSLATarget(int(MinSLATarget/time.Minute)), FormatSLATarget(int(MaxSLATarget/time.Minute)),
+		FormatSLATarget(int(PendingTimeout/time.Minute)))
+}
+
+// FormatSLATarget renders an SLA target in minutes as a duration, e.g. "15m", "2h" or "1h30m"
+func FormatSLATarget(minutes int) string {
+	formatted := strings.TrimSuffix((time.Duration(minutes) * time.Minute).String(), "0s")
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
@@ -0,0 +1,167 @@
+package approval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSLAStore serves SLA targets from a map keyed by scope
+type mockSLAStore map[string]*SLATarget
+
+func (m mockSLAStore) GetSLATarget(scope string) (*SLATarget, error) {
+	if target, ok := m[scope]; ok {
+		return target, nil
+	}
+	return nil, ErrSLATargetNotFound
+}
+
+// failingSLAStore fails every lookup
+type failingSLAStore struct{}
+
+func (failingSLAStore) GetSLATarget(string) (*SLATarget, error) {
+	return nil, errors.New("KV error")
+}
+
+func TestValidateSLATarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  *SLATarget
+		wantErr string
+	}{
+		{name: "valid global target", target: &SLATarget{Scope: SLAScopeGlobal, Minutes: 15}},
+		{name: "valid team target", target: &SLATarget{Scope: TeamPolicyScope(testTeamID), Minutes: 20, EscalationChannelID: testApproverID}},
+		{name: "valid template target", target: &SLATarget{Scope: TemplatePolicyScope("prod-access"), Minutes: 15}},
+		{name: "nil target", target: nil, wantErr: "cannot be nil"},
+		{name: "missing scope", target: &SLATarget{Minutes: 15}, wantErr: "invalid SLA scope"},
+		{name: "unknown scope kind", target: &SLATarget{Scope: "channel:abc", Minutes: 15}, wantErr: "invalid SLA scope"},
+		{name: "invalid team ID", target: &SLATarget{Scope: "team:abc", Minutes: 15}, wantErr: "invalid team ID"},
+		{name: "invalid template name", target: &SLATarget{Scope: "template:Bad Name", Minutes: 15}, wantErr: "invalid template name"},
+		{name: "zero minutes", target: &SLATarget{Scope: SLAScopeGlobal}, wantErr: "between 1m and 29m"},
+		{name: "at the pending timeout", target: &SLATarget{Scope: SLAScopeGlobal, Minutes: 30}, wantErr: "pending requests time out after 30m"},
+		{name: "over a week", target: &SLATarget{Scope: SLAScopeGlobal, Minutes: 7*24*60 + 1}, wantErr: "between 1m and 29m"},
+		{name: "invalid escalation channel", target: &SLATarget{Scope: SLAScopeGlobal, Minutes: 15, EscalationChannelID: "town-square"}, wantErr: "invalid escalation channel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSLATarget(tt.target)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseSLATarget(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr string
+	}{
+		{value: "15m", want: 15},
+		{value: "29m", want: 29},
+		{value: "15", wantErr: "use a duration such as 10m or 20m"},
+		{value: "90s", wantErr: "must be whole minutes"},
+		{value: "0m", wantErr: "between 1m and 29m"},
+		{value: "30m", wantErr: "between 1m and 29m"},
+		{value: "2h", wantErr: "pending requests time out after 30m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSLATarget(tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatSLATarget(t *testing.T) {
+	assert.Equal(t, "15m", FormatSLATarget(15))
+	assert.Equal(t, "10m", FormatSLATarget(10))
+	assert.Equal(t, "2h", FormatSLATarget(120))
+	assert.Equal(t, "1h30m", FormatSLATarget(90))
+}
+
+func TestResolveSLATarget(t *testing.T) {
+	global := &SLATarget{Scope: SLAScopeGlobal, Minutes: 60}
+	team := &SLATarget{Scope: TeamPolicyScope(testTeamID), Minutes: 30}
+	template := &SLATarget{Scope: TemplatePolicyScope("prod-access"), Minutes: 15}
+	store := mockSLAStore{global.Scope: global, team.Scope: team, template.Scope: template}
+
+	t.Run("template target is most specific", func(t *testing.T) {
+		target, err := ResolveSLATarget(store, testTeamID, "prod-access")
+		require.NoError(t, err)
+		assert.Equal(t, template, target)
+	})
+
+	t.Run("team target applies without a template target", func(t *testing.T) {
+		target, err := ResolveSLATarget(store, testTeamID, "deploy")
+		require.NoError(t, err)
+		assert.Equal(t, team, target)
+	})
+
+	t.Run("global target is the fallback", func(t *testing.T) {
+		target, err := ResolveSLATarget(store, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, global, target)
+	})
+
+	t.Run("no target applies", func(t *testing.T) {
+		target, err := ResolveSLATarget(mockSLAStore{}, testTeamID, "prod-access")
+		require.NoError(t, err)
+		assert.Nil(t, target)
+	})
+
+	t.Run("lookup failure is returned", func(t *testing.T) {
+		_, err := ResolveSLATarget(failingSLAStore{}, testTeamID, "")
+		assert.ErrorContains(t, err, "failed to load SLA target team:"+testTeamID)
+	})
+}
+
+func TestApprovalRecord_SLAState(t *testing.T) {
+	const created = int64(1704931200000)
+	const minute = int64(60_000)
+
+	tests := []struct {
+		name   string
+		record ApprovalRecord
+		now    int64
+		want   string
+	}{
+		{name: "untracked", record: ApprovalRecord{Status: StatusPending, CreatedAt: created}, now: created + 60*minute, want: SLAStateNone},
+		{name: "pending within target", record: ApprovalRecord{Status: StatusPending, CreatedAt: created, SLAMinutes: 15}, now: created + 15*minute, want: SLAStateOnTrack},
+		{name: "pending past target", record: ApprovalRecord{Status: StatusPending, CreatedAt: created, SLAMinutes: 15}, now: created + 16*minute, want: SLAStateBreached},
+		{name: "decided within target", record: ApprovalRecord{Status: StatusApproved, CreatedAt: created, DecidedAt: created + 10*minute, SLAMinutes: 15}, now: created + 60*minute, want: SLAStateMet},
+		{name: "decided after target", record: ApprovalRecord{Status: StatusDenied, CreatedAt: created, DecidedAt: created + 20*minute, SLAMinutes: 15}, now: created + 60*minute, want: SLAStateBreached},
+		{name: "canceled before deadline", record: ApprovalRecord{Status: StatusCanceled, CreatedAt: created, CanceledAt: created + 5*minute, SLAMinutes: 15}, now: created + 60*minute, want: SLAStateNone},
+		{name: "timed out after deadline", record: ApprovalRecord{Status: StatusCanceled, CreatedAt: created, CanceledAt: created + 30*minute, SLAMinutes: 15}, now: created + 60*minute, want: SLAStateBreached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.record.SLAState(tt.now))
+		})
+	}
+}
+
+func TestApprovalRecord_ApplySLATarget(t *testing.T) {
+	record := &ApprovalRecord{CreatedAt: 1704931200000}
+
+	record.ApplySLATarget(nil)
+	assert.Zero(t, record.SLADeadline())
+
+	record.ApplySLATarget(&SLATarget{Scope: SLAScopeGlobal, Minutes: 15, EscalationChannelID: testApproverID})
+	assert.Equal(t, 15, record.SLAMinutes)
+	assert.Equal(t, testApproverID, record.SLAEscalationChannelID)
+	assert.Equal(t, int64(1704931200000+15*60_000), record.SLADeadline())
+}
@@ -0,0 +1,91 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// Separation-of-duties rule identifiers recorded on violations
+const (
+	SoDRuleSelfApproval       = "self_approval"
+	SoDRuleReciprocalApproval = "reciprocal_approval"
+)
+
+var (
+	// ErrSelfApproval is returned when a requester selects themselves as approver or decides their own request
+	ErrSelfApproval = errors.New("requesters cannot approve their own requests")
+
+	// ErrReciprocalApproval is returned when two users would approve each other's requests within the configured window
+	ErrReciprocalApproval = errors.New("reciprocal approval blocked by separation of duties")
+)
+
+// SeparationOfDuties holds the configurable separation-of-duties rules.
+// Self-approval is always blocked; the reciprocal rule is disabled when ReciprocalWindow is zero.
+type SeparationOfDuties struct {
+	// ReciprocalWindow blocks approving a user's request if they approved one of yours within this window
+	ReciprocalWindow time.Duration
+}
+
+// SoDViolation records a blocked separation-of-duties violation for admin review
+type SoDViolation struct {
+	Rule        string `json:"rule"`                  // SoDRuleSelfApproval | SoDRuleReciprocalApproval
+	UserID      string `json:"userId"`                // User whose action was blocked
+	RequesterID string `json:"requesterId"`           // Requester of the affected request
+	Code        string `json:"code,omitempty"`        // Affected request (empty when blocked at creation)
+	RelatedCode string `json:"relatedCode,omitempty"` // Earlier request that made the approval reciprocal
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// SoDViolationStore persists separation-of-duties violations
+type SoDViolationStore interface {
+	AppendSoDViolation(violation *SoDViolation) error
+}
+
+// FindReciprocalApproval returns a request from the approver that the requester approved at or after since,
+// or nil if none exists. records should contain the approver's requests.
+func FindReciprocalApproval(records []*ApprovalRecord, requesterID, approverID string, since int64) *ApprovalRecord {
+	for _, record := range records {
+		if record.RequesterID == approverID &&
+			record.ApproverID == requesterID &&
+			record.Status == StatusApproved &&
+			record.DecidedAt >= since {
+			return record
+		}
+	}
+	return nil
+}
+
+// RecordSoDViolation logs a blocked violation and stores it for admin review (best effort)
+func RecordSoDViolation(store SoDViolationStore, api plugin.API, violation *SoDViolation) {
+	if violation.Timestamp == 0 {
+		violation.Timestamp = model.GetMillis()
+	}
+
+	api.LogWarn("Separation of duties violation blocked",
+		"rule", violation.Rule,
+		"user_id", violation.UserID,
+		"requester_id", violation.RequesterID,
+		"code", violation.Code,
+		"related_code", violation.RelatedCode,
+	)
+
+	if err := store.AppendSoDViolation(violation); err != nil {
+		api.LogError("Failed to store separation of duties violation",
+			"rule", violation.Rule,
+			"user_id", violation.UserID,
+			"error", err.Error(),
+		)
+	}
+}
+
+// formatWindow renders a reciprocal window for user-facing messages, e.g. "24h" or "30m"
+func formatWindow(window time.Duration) string {
+	if window%time.Hour == 0 {
+		return fmt.Sprintf("%dh", int(window/time.Hour))
+	}
+	return window.String()
+}
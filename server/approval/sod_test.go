@@ -0,0 +1,164 @@
+package approval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindReciprocalApproval(t *testing.T) {
+	const since = int64(1000)
+
+	tests := []struct {
+		name     string
+		record   *ApprovalRecord
+		wantCode string
+	}{
+		{
+			name:     "requester approved approver's request inside window",
+			record:   &ApprovalRecord{Code: "A-RECIP1", RequesterID: "bob", ApproverID: "alice", Status: StatusApproved, DecidedAt: 1500},
+			wantCode: "A-RECIP1",
+		},
+		{
+			name:   "approval outside window",
+			record: &ApprovalRecord{Code: "A-OLD001", RequesterID: "bob", ApproverID: "alice", Status: StatusApproved, DecidedAt: 999},
+		},
+		{
+			name:   "denied request is not reciprocal",
+			record: &ApprovalRecord{Code: "A-DENIED", RequesterID: "bob", ApproverID: "alice", Status: StatusDenied, DecidedAt: 1500},
+		},
+		{
+			name:   "same direction is not reciprocal",
+			record: &ApprovalRecord{Code: "A-SAMEDR", RequesterID: "alice", ApproverID: "bob", Status: StatusApproved, DecidedAt: 1500},
+		},
+		{
+			name:   "different approver is not reciprocal",
+			record: &ApprovalRecord{Code: "A-OTHER1", RequesterID: "bob", ApproverID: "carol", Status: StatusApproved, DecidedAt: 1500},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// alice is the requester of the pending request, bob is its approver
+			found := FindReciprocalApproval([]*ApprovalRecord{tt.record}, "alice", "bob", since)
+			if tt.wantCode == "" {
+				assert.Nil(t, found)
+			} else {
+				require.NotNil(t, found)
+				assert.Equal(t, tt.wantCode, found.Code)
+			}
+		})
+	}
+}
+
+func TestRecordDecision_SeparationOfDuties(t *testing.T) {
+	pending := func(requesterID string) *ApprovalRecord {
+		return &ApprovalRecord{
+			ID:                "record1",
+			Code:              "A-PEND01",
+			RequesterID:       requesterID,
+			RequesterUsername: "alice",
+			ApproverID:        "bob",
+			Description:       "Deploy",
+			Status:            StatusPending,
+			CreatedAt:         model.GetMillis(),
+			SchemaVersion:     1,
+		}
+	}
+
+	setup := func(record *ApprovalRecord) (*MockApprovalStore, *plugintest.API, *Service) {
+		store := new(MockApprovalStore)
+		api := &plugintest.API{}
+		store.On("GetApproval", "record1").Return(record, nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return store, api, NewService(store, api, "bot")
+	}
+
+	t.Run("self-decision is blocked and recorded", func(t *testing.T) {
+		store, _, service := setup(pending("bob"))
+		store.On("AppendSoDViolation", mock.MatchedBy(func(v *SoDViolation) bool {
+			return v.Rule == SoDRuleSelfApproval && v.UserID == "bob" && v.Code == "A-PEND01" && v.Timestamp > 0
+		})).Return(nil)
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		assert.ErrorIs(t, err, ErrSelfApproval)
+		store.AssertNotCalled(t, "SaveApproval", mock.Anything)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("reciprocal approval within window is blocked", func(t *testing.T) {
+		store, _, service := setup(pending("alice"))
+		service.SetSeparationOfDuties(SeparationOfDuties{ReciprocalWindow: 24 * time.Hour})
+		store.On("GetUserApprovals", "bob").Return([]*ApprovalRecord{{
+			Code:        "A-RECIP1",
+			RequesterID: "bob",
+			ApproverID:  "alice",
+			Status:      StatusApproved,
+			DecidedAt:   model.GetMillis() - time.Hour.Milliseconds(),
+		}}, nil)
+		store.On("AppendSoDViolation", mock.MatchedBy(func(v *SoDViolation) bool {
+			return v.Rule == SoDRuleReciprocalApproval && v.RelatedCode == "A-RECIP1"
+		})).Return(nil)
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		assert.ErrorIs(t, err, ErrReciprocalApproval)
+		assert.Contains(t, err.Error(), "@alice approved your request A-RECIP1 within the last 24h")
+		store.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("reciprocal rule ignores denials", func(t *testing.T) {
+		store, _, service := setup(pending("alice"))
+		service.SetSeparationOfDuties(SeparationOfDuties{ReciprocalWindow: 24 * time.Hour})
+		store.On("SaveApproval", mock.Anything).Return(nil)
+
+		record, err := service.RecordDecision("record1", "bob", "denied", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusDenied, record.Status)
+		store.AssertNotCalled(t, "GetUserApprovals", mock.Anything)
+	})
+
+	t.Run("reciprocal rule disabled by default", func(t *testing.T) {
+		store, _, service := setup(pending("alice"))
+		store.On("SaveApproval", mock.Anything).Return(nil)
+
+		record, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, StatusApproved, record.Status)
+		store.AssertNotCalled(t, "GetUserApprovals", mock.Anything)
+	})
+
+	t.Run("reciprocal lookup failure blocks decision", func(t *testing.T) {
+		store, _, service := setup(pending("alice"))
+		service.SetSeparationOfDuties(SeparationOfDuties{ReciprocalWindow: time.Hour})
+		store.On("GetUserApprovals", "bob").Return(nil, errors.New("KV unavailable"))
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to check reciprocal approvals")
+		store.AssertNotCalled(t, "SaveApproval", mock.Anything)
+	})
+
+	t.Run("violation storage failure is logged but still blocks", func(t *testing.T) {
+		store, api, service := setup(pending("bob"))
+		store.On("AppendSoDViolation", mock.Anything).Return(errors.New("KV unavailable"))
+		api.On("LogError", "Failed to store separation of duties violation", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+
+		_, err := service.RecordDecision("record1", "bob", "approved", "")
+
+		assert.ErrorIs(t, err, ErrSelfApproval)
+		api.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,67 @@
+package approval
+
+import "time"
+
+// ApprovalStats holds statistics about the approval system
+type ApprovalStats struct {
+	TotalApprovals              int
+	PendingApprovals            int
+	ApprovedApprovals           int
+	DeniedApprovals             int
+	CanceledApprovals           int
+	FailedApproverNotifications int
+	FailedOutcomeNotifications  int
+	SLATracked                  int // Requests with an SLA outcome (met, breached or on track)
+	SLAMet                      int
+	SLABreached                 int
+	SLABreachedPending          int // Breached requests still awaiting a decision
+}
+
+// CalculateStatistics computes statistics from approval records for /approve status and the admin digest
+func CalculateStatistics(records []*ApprovalRecord) ApprovalStats {
+	stats := ApprovalStats{
+		TotalApprovals: len(records),
+	}
+
+	now := time.Now().UnixMilli()
+	for _, record := range records {
+		switch record.SLAState(now) {
+		case SLAStateOnTrack:
+			stats.SLATracked++
+		case SLAStateMet:
+			stats.SLATracked++
+			stats.SLAMet++
+		case SLAStateBreached:
+			stats.SLATracked++
+			stats.SLABreached++
+			if record.Status == StatusPending {
+				stats.SLABreachedPending++
+			}
+		}
+
+		switch record.Status {
+		case StatusPending:
+			stats.PendingApprovals++
+			// Count failed approver notifications only for pending approvals
+			if !record.NotificationSent {
+				stats.FailedApproverNotifications++
+			}
+		case StatusApproved:
+			stats.ApprovedApprovals++
+			// Count failed outcome notifications for completed approvals
+			if !record.OutcomeNotified {
+				stats.FailedOutcomeNotifications++
+			}
+		case StatusDenied:
+			stats.DeniedApprovals++
+			// Count failed outcome notifications for completed approvals
+			if !record.OutcomeNotified {
+				stats.FailedOutcomeNotifications++
+			}
+		case StatusCanceled:
+			stats.CanceledApprovals++
+		}
+	}
+
+	return stats
+}
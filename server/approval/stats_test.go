@@ -0,0 +1,41 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateStatistics(t *testing.T) {
+	now := time.Now()
+	records := []*ApprovalRecord{
+		{Status: StatusPending, NotificationSent: true},
+		{Status: StatusPending, NotificationSent: false},
+		{Status: StatusApproved, OutcomeNotified: true},
+		{Status: StatusDenied, OutcomeNotified: false},
+		{Status: StatusCanceled},
+		{
+			Status:           StatusPending,
+			NotificationSent: true,
+			CreatedAt:        now.Add(-2 * time.Hour).UnixMilli(),
+			SLAMinutes:       60,
+		},
+	}
+
+	stats := CalculateStatistics(records)
+
+	assert.Equal(t, ApprovalStats{
+		TotalApprovals:              6,
+		PendingApprovals:            3,
+		ApprovedApprovals:           1,
+		DeniedApprovals:             1,
+		CanceledApprovals:           1,
+		FailedApproverNotifications: 1,
+		FailedOutcomeNotifications:  1,
+		SLATracked:                  1,
+		SLABreached:                 1,
+		SLABreachedPending:          1,
+	}, stats)
+	assert.Equal(t, ApprovalStats{}, CalculateStatistics(nil))
+}
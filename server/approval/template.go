@@ -0,0 +1,218 @@
+package approval
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Custom field types supported by request templates
+const (
+	FieldTypeText   = "text"
+	FieldTypeSelect = "select"
+	FieldTypeNumber = "number"
+	FieldTypeDate   = "date"
+)
+
+const (
+	// MaxTemplateFields is the maximum number of custom fields per template.
+	// Mattermost dialogs allow 5 elements; approver and description use two of them.
+	MaxTemplateFields = 3
+
+	// MaxCustomFieldValueLength is the maximum length of a submitted custom field value
+	MaxCustomFieldValueLength = 500
+
+	// CustomFieldElementPrefix prefixes dialog element names for custom fields
+	// to avoid collisions with the built-in "approver" and "description" elements
+	CustomFieldElementPrefix = "field_"
+
+	// CustomFieldDateLayout is the expected format for date fields
+	CustomFieldDateLayout = "2006-01-02"
+)
+
+var (
+	// templateNamePattern validates template names used in "/approve new <template>"
+	templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+	// fieldNamePattern validates custom field names (used as dialog element suffixes)
+	fieldNamePattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+)
+
+// ErrTemplateNotFound is returned when a request template does not exist
+var ErrTemplateNotFound = errors.New("request template not found")
+
+// Custom field validation failures, reported per field in a CustomFieldError
+var (
+	ErrCustomFieldRequired  = errors.New("custom field is required")
+	ErrCustomFieldTooLong   = errors.New("custom field value is too long")
+	ErrCustomFieldNotNumber = errors.New("custom field value is not a number")
+	ErrCustomFieldNotDate   = errors.New("custom field value is not a date")
+	ErrCustomFieldNotOption = errors.New("custom field value is not an option")
+)
+
+// CustomFieldError reports why a submitted custom field value was rejected
+type CustomFieldError struct {
+	Field  CustomField
+	Length int   // Length of the rejected value, for ErrCustomFieldTooLong
+	Err    error // One of the ErrCustomField* sentinels
+}
+
+func (e *CustomFieldError) Error() string {
+	switch e.Err {
+	case ErrCustomFieldRequired:
+		return fmt.Sprintf("%s is required.", e.Field.Label)
+	case ErrCustomFieldTooLong:
+		return fmt.Sprintf("%s is %d characters (max %d).", e.Field.Label, e.Length, MaxCustomFieldValueLength)
+	case ErrCustomFieldNotNumber:
+		return fmt.Sprintf("%s must be a number.", e.Field.Label)
+	case ErrCustomFieldNotDate:
+		return fmt.Sprintf("%s must be a date in YYYY-MM-DD format.", e.Field.Label)
+	case ErrCustomFieldNotOption:
+		return fmt.Sprintf("%s must be one of: %s.", e.Field.Label, strings.Join(e.Field.Options, ", "))
+	default:
+		return fmt.Sprintf("%s: %v", e.Field.Label, e.Err)
+	}
+}
+
+func (e *CustomFieldError) Unwrap() error {
+	return e.Err
+}
+
+// CustomField describes a structured field collected by a request template
+type CustomField struct {
+	Name     string   `json:"name"`               // Machine name, e.g. "system"
+	Label    string   `json:"label"`              // Display label, e.g. "Target system"
+	Type     string   `json:"type"`               // "text" | "select" | "number" | "date"
+	Required bool     `json:"required"`           // Whether a value must be submitted
+	Options  []string `json:"options,omitempty"`  // Allowed values (select only)
+	HelpText string   `json:"helpText,omitempty"` // Optional guidance shown under the field
+}
+
+// RequestTemplate defines a reusable approval request shape managed by admins
+type RequestTemplate struct {
+	Name             string        `json:"name"`                       // Used in "/approve new <name>"
+	DisplayName      string        `json:"displayName,omitempty"`      // Shown in the dialog introduction
+	Description      string        `json:"description,omitempty"`      // Guidance shown in the dialog introduction
+	DefaultApprovers []string      `json:"defaultApprovers,omitempty"` // Usernames suggested as approver
+	Fields           []CustomField `json:"fields"`
+	UpdatedBy        string        `json:"updatedBy,omitempty"`
+	UpdatedAt        int64         `json:"updatedAt,omitempty"`
+}
+
+// CustomFieldValue is a validated custom field value persisted on an ApprovalRecord.
+// The label is snapshotted so records stay readable if the template changes later.
+type CustomFieldValue struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ValidateTemplate checks that a request template definition is well-formed
+func ValidateTemplate(tmpl *RequestTemplate) error {
+	if tmpl == nil {
+		return fmt.Errorf("template cannot be nil")
+	}
+
+	if !templateNamePattern.MatchString(tmpl.Name) {
+		return fmt.Errorf("invalid template name '%s': use 1-32 lowercase letters, digits, '-' or '_'", tmpl.Name)
+	}
+
+	if len(tmpl.Fields) > MaxTemplateFields {
+		return fmt.Errorf("template has %d fields (max %d)", len(tmpl.Fields), MaxTemplateFields)
+	}
+
+	seen := make(map[string]bool)
+	for i, field := range tmpl.Fields {
+		if !fieldNamePattern.MatchString(field.Name) {
+			return fmt.Errorf("field %d: invalid name '%s': use 1-32 lowercase letters, digits or '_'", i+1, field.Name)
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("field %d: duplicate name '%s'", i+1, field.Name)
+		}
+		seen[field.Name] = true
+
+		if strings.TrimSpace(field.Label) == "" {
+			return fmt.Errorf("field '%s': label is required", field.Name)
+		}
+
+		switch field.Type {
+		case FieldTypeText, FieldTypeNumber, FieldTypeDate:
+		case FieldTypeSelect:
+			if len(field.Options) == 0 {
+				return fmt.Errorf("field '%s': select fields require at least one option", field.Name)
+			}
+		default:
+			return fmt.Errorf("field '%s': invalid type '%s', must be text|select|number|date", field.Name, field.Type)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCustomFieldValues validates a dialog submission against a template's custom fields.
+// Returns the submitted values in template order (empty optional fields are omitted) and a map
+// of dialog element name to CustomFieldError for every invalid field. Field errors keep the modal open.
+func ValidateCustomFieldValues(tmpl *RequestTemplate, submission map[string]any) ([]CustomFieldValue, map[string]*CustomFieldError) {
+	values := make([]CustomFieldValue, 0, len(tmpl.Fields))
+	fieldErrors := make(map[string]*CustomFieldError)
+
+	for _, field := range tmpl.Fields {
+		elementName := CustomFieldElementPrefix + field.Name
+		value := customFieldString(submission[elementName])
+
+		if value == "" {
+			if field.Required {
+				fieldErrors[elementName] = &CustomFieldError{Field: field, Err: ErrCustomFieldRequired}
+			}
+			continue
+		}
+
+		if len(value) > MaxCustomFieldValueLength {
+			fieldErrors[elementName] = &CustomFieldError{Field: field, Length: len(value), Err: ErrCustomFieldTooLong}
+			continue
+		}
+
+		switch field.Type {
+		case FieldTypeNumber:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				fieldErrors[elementName] = &CustomFieldError{Field: field, Err: ErrCustomFieldNotNumber}
+				continue
+			}
+		case FieldTypeDate:
+			if _, err := time.Parse(CustomFieldDateLayout, value); err != nil {
+				fieldErrors[elementName] = &CustomFieldError{Field: field, Err: ErrCustomFieldNotDate}
+				continue
+			}
+		case FieldTypeSelect:
+			if !slices.Contains(field.Options, value) {
+				fieldErrors[elementName] = &CustomFieldError{Field: field, Err: ErrCustomFieldNotOption}
+				continue
+			}
+		}
+
+		values = append(values, CustomFieldValue{
+			Name:  field.Name,
+			Label: field.Label,
+			Value: value,
+		})
+	}
+
+	return values, fieldErrors
+}
+
+// customFieldString converts a submitted dialog value to a trimmed string.
+// Number inputs may arrive as JSON numbers depending on the client.
+func customFieldString(raw any) string {
+	switch v := raw.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
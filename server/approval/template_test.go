@@ -0,0 +1,193 @@
+package approval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTemplate() *RequestTemplate {
+	return &RequestTemplate{
+		Name:        "prod-access",
+		DisplayName: "Production Access",
+		Fields: []CustomField{
+			{Name: "system", Label: "System", Type: FieldTypeSelect, Required: true, Options: []string{"database", "kubernetes"}},
+			{Name: "hours", Label: "Duration (hours)", Type: FieldTypeNumber, Required: true},
+			{Name: "start", Label: "Start date", Type: FieldTypeDate},
+		},
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(tmpl *RequestTemplate)
+		wantErr string
+	}{
+		{
+			name:   "valid template",
+			modify: func(tmpl *RequestTemplate) {},
+		},
+		{
+			name:   "valid template without fields",
+			modify: func(tmpl *RequestTemplate) { tmpl.Fields = nil },
+		},
+		{
+			name:    "invalid name with spaces",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Name = "prod access" },
+			wantErr: "invalid template name",
+		},
+		{
+			name:    "empty name",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Name = "" },
+			wantErr: "invalid template name",
+		},
+		{
+			name: "too many fields",
+			modify: func(tmpl *RequestTemplate) {
+				tmpl.Fields = append(tmpl.Fields, CustomField{Name: "extra", Label: "Extra", Type: FieldTypeText})
+			},
+			wantErr: "template has 4 fields (max 3)",
+		},
+		{
+			name:    "duplicate field name",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Fields[1].Name = "system" },
+			wantErr: "duplicate name 'system'",
+		},
+		{
+			name:    "invalid field name",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Fields[0].Name = "System-Name" },
+			wantErr: "invalid name 'System-Name'",
+		},
+		{
+			name:    "missing label",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Fields[1].Label = "  " },
+			wantErr: "label is required",
+		},
+		{
+			name:    "unknown field type",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Fields[1].Type = "checkbox" },
+			wantErr: "invalid type 'checkbox'",
+		},
+		{
+			name:    "select without options",
+			modify:  func(tmpl *RequestTemplate) { tmpl.Fields[0].Options = nil },
+			wantErr: "select fields require at least one option",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := newTestTemplate()
+			tt.modify(tmpl)
+
+			err := ValidateTemplate(tmpl)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("nil template", func(t *testing.T) {
+		assert.Error(t, ValidateTemplate(nil))
+	})
+}
+
+func TestValidateCustomFieldValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		submission  map[string]any
+		wantValues  []CustomFieldValue
+		wantErrKeys []string
+	}{
+		{
+			name: "all fields valid",
+			submission: map[string]any{
+				"field_system": "database",
+				"field_hours":  " 4 ",
+				"field_start":  "2026-03-01",
+			},
+			wantValues: []CustomFieldValue{
+				{Name: "system", Label: "System", Value: "database"},
+				{Name: "hours", Label: "Duration (hours)", Value: "4"},
+				{Name: "start", Label: "Start date", Value: "2026-03-01"},
+			},
+		},
+		{
+			name: "optional field omitted",
+			submission: map[string]any{
+				"field_system": "kubernetes",
+				"field_hours":  float64(2.5),
+			},
+			wantValues: []CustomFieldValue{
+				{Name: "system", Label: "System", Value: "kubernetes"},
+				{Name: "hours", Label: "Duration (hours)", Value: "2.5"},
+			},
+		},
+		{
+			name:        "required fields missing",
+			submission:  map[string]any{"field_hours": ""},
+			wantErrKeys: []string{"field_system", "field_hours"},
+		},
+		{
+			name: "select value not in options",
+			submission: map[string]any{
+				"field_system": "mainframe",
+				"field_hours":  "1",
+			},
+			wantErrKeys: []string{"field_system"},
+		},
+		{
+			name: "number field not numeric",
+			submission: map[string]any{
+				"field_system": "database",
+				"field_hours":  "four",
+			},
+			wantErrKeys: []string{"field_hours"},
+		},
+		{
+			name: "date field wrong format",
+			submission: map[string]any{
+				"field_system": "database",
+				"field_hours":  "1",
+				"field_start":  "03/01/2026",
+			},
+			wantErrKeys: []string{"field_start"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, fieldErrors := ValidateCustomFieldValues(newTestTemplate(), tt.submission)
+
+			if len(tt.wantErrKeys) == 0 {
+				assert.Empty(t, fieldErrors)
+				assert.Equal(t, tt.wantValues, values)
+				return
+			}
+
+			assert.Len(t, fieldErrors, len(tt.wantErrKeys))
+			for _, key := range tt.wantErrKeys {
+				assert.Contains(t, fieldErrors, key)
+			}
+		})
+	}
+
+	t.Run("value exceeding max length rejected", func(t *testing.T) {
+		tmpl := &RequestTemplate{
+			Name:   "notes",
+			Fields: []CustomField{{Name: "notes", Label: "Notes", Type: FieldTypeText}},
+		}
+
+		_, fieldErrors := ValidateCustomFieldValues(tmpl, map[string]any{
+			"field_notes": strings.Repeat("a", MaxCustomFieldValueLength+1),
+		})
+
+		assert.ErrorIs(t, fieldErrors["field_notes"], ErrCustomFieldTooLong)
+		assert.EqualError(t, fieldErrors["field_notes"], "Notes is 501 characters (max 500).")
+	})
+}
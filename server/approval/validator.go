@@ -3,6 +3,7 @@ package approval
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -27,7 +28,8 @@ func ValidateApprovalRecord(record *ApprovalRecord) error {
 		return fmt.Errorf("requester ID is required")
 	}
 
-	if record.ApproverID == "" {
+	// Group approvals have no individual approver until a member claims the decision
+	if record.ApproverID == "" && len(record.CandidateApproverIDs) == 0 {
 		return fmt.Errorf("approver ID is required")
 	}
 
@@ -36,7 +38,7 @@ func ValidateApprovalRecord(record *ApprovalRecord) error {
 	}
 
 	if !IsValidStatus(record.Status) {
-		return fmt.Errorf("invalid status: %s, must be pending|approved|denied|canceled", record.Status)
+		return fmt.Errorf("invalid status: %s, must be scheduled|pending|approved|denied|canceled", record.Status)
 	}
 
 	if record.CreatedAt <= 0 {
@@ -51,10 +53,10 @@ func ValidateApprovalRecord(record *ApprovalRecord) error {
 }
 
 // IsValidStatus checks if a status string is one of the valid values.
-// Valid statuses are: pending, approved, denied, canceled.
+// Valid statuses are: scheduled, pending, approved, denied, canceled.
 func IsValidStatus(status string) bool {
 	switch status {
-	case StatusPending, StatusApproved, StatusDenied, StatusCanceled:
+	case StatusScheduled, StatusPending, StatusApproved, StatusDenied, StatusCanceled:
 		return true
 	default:
 		return false
@@ -67,6 +69,7 @@ var (
 	ErrDescriptionTooLong  = errors.New("description exceeds maximum length")
 	ErrApproverRequired    = errors.New("approver is required")
 	ErrInvalidApprover     = errors.New("invalid approver")
+	ErrInvalidVisibility   = errors.New("invalid visibility")
 )
 
 const (
@@ -75,35 +78,56 @@ const (
 
 // ValidateDescription validates the description field for approval requests.
 // Returns an error if:
-// - Description is empty or whitespace-only
-// - Description exceeds 1000 characters
+// - Description is empty or whitespace-only (ErrDescriptionRequired)
+// - Description exceeds 1000 characters (ErrDescriptionTooLong)
 //
 // Error messages include character count for length violations to help users fix the issue.
 func ValidateDescription(description string) error {
 	// Trim whitespace for empty check
 	trimmed := strings.TrimSpace(description)
 	if trimmed == "" {
-		return fmt.Errorf("description field is required: please describe what needs approval")
+		return fmt.Errorf("%w: please describe what needs approval", ErrDescriptionRequired)
 	}
 
 	// Check length against max
 	length := len(description)
 	if length > MaxDescriptionLength {
-		return fmt.Errorf("description is %d characters (max %d): please shorten your request", length, MaxDescriptionLength)
+		return fmt.Errorf("%w: %d characters (max %d), please shorten your request", ErrDescriptionTooLong, length, MaxDescriptionLength)
 	}
 
 	return nil
 }
 
+// ParseVisibility converts the dialog visibility option into the record's channel card flags.
+// An empty value means VisibilityDirect (dialogs opened before the option existed).
+func ParseVisibility(visibility string) (shareInChannel, private bool, err error) {
+	switch visibility {
+	case "", VisibilityDirect:
+		return false, false, nil
+	case VisibilityChannel:
+		return true, false, nil
+	case VisibilityChannelPrivate:
+		return true, true, nil
+	default:
+		return false, false, fmt.Errorf("%w '%s': choose approver DM only or a channel status card", ErrInvalidVisibility, visibility)
+	}
+}
+
 // ValidateApprover validates the approver user ID for approval requests.
 // Returns the user object and an error if:
+// - Approver is the requester (ErrSelfApproval, separation of duties)
 // - User does not exist in Mattermost
-// - User is deleted/deactivated (DeleteAt > 0)
+// - User is deleted/deactivated (ErrInvalidApprover, DeleteAt > 0)
 //
 // Returns the validated user object to avoid redundant API calls.
 // API errors are propagated with proper error wrapping (%w) to preserve error chain.
 // Note: Empty string validation is handled by HandleDialogSubmission (Layer 1).
-func ValidateApprover(approverID string, api plugin.API) (*model.User, error) {
+func ValidateApprover(approverID, requesterID string, api plugin.API) (*model.User, error) {
+	// Separation of duties: a requester can never approve their own request
+	if approverID == requesterID {
+		return nil, fmt.Errorf("%w: please select a different approver", ErrSelfApproval)
+	}
+
 	// Get user from Mattermost API
 	user, appErr := api.GetUser(approverID)
 	if appErr != nil {
@@ -112,8 +136,17 @@ func ValidateApprover(approverID string, api plugin.API) (*model.User, error) {
 
 	// Check if user is deleted/deactivated
 	if user.DeleteAt > 0 {
-		return nil, fmt.Errorf("selected approver is not a valid user: please select an active user")
+		return nil, fmt.Errorf("%w: selected approver is not a valid user, please select an active user", ErrInvalidApprover)
 	}
 
 	return user, nil
 }
+
+// IsSystemAdminUser reports whether an already loaded user has the system_admin role
+func IsSystemAdminUser(user *model.User) bool {
+	// Check if user has system admin role (exact match to prevent bypass)
+	// Security: Split roles by space and check for exact "system_admin" match
+	// to prevent bypass attacks like "fake_system_admin" or "not_system_admin"
+	roles := strings.Fields(user.Roles)
+	return slices.Contains(roles, "system_admin")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestValidateApprovalRecord(t *testing.T) {
@@ -91,6 +92,21 @@ func TestValidateApprovalRecord(t *testing.T) {
 			wantErr: true,
 			errMsg:  "approver ID is required",
 		},
+		{
+			name: "group approval without approver ID",
+			record: &ApprovalRecord{
+				ID:                   "abcdefghijklmnopqrstuvwxyz",
+				Code:                 "A-X7K9Q2",
+				RequesterID:          "r1234567890123456789012345",
+				ApproverGroupName:    "sre-oncall",
+				CandidateApproverIDs: []string{"a1234567890123456789012345"},
+				Description:          "Test",
+				Status:               StatusPending,
+				CreatedAt:            1704931200000,
+				SchemaVersion:        1,
+			},
+			wantErr: false,
+		},
 		{
 			name: "missing description",
 			record: &ApprovalRecord{
@@ -249,6 +265,35 @@ func TestValidateDescription(t *testing.T) {
 	}
 }
 
+func TestParseVisibility(t *testing.T) {
+	tests := []struct {
+		name        string
+		visibility  string
+		wantShare   bool
+		wantPrivate bool
+		wantErr     bool
+	}{
+		{name: "empty defaults to DM only", visibility: ""},
+		{name: "DM only", visibility: VisibilityDirect},
+		{name: "channel card", visibility: VisibilityChannel, wantShare: true},
+		{name: "private channel card", visibility: VisibilityChannelPrivate, wantShare: true, wantPrivate: true},
+		{name: "unknown option", visibility: "everyone", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			share, private, err := ParseVisibility(tt.visibility)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantShare, share)
+			assert.Equal(t, tt.wantPrivate, private)
+		})
+	}
+}
+
 func TestValidateApprover(t *testing.T) {
 	t.Run("valid user passes validation", func(t *testing.T) {
 		api := &plugintest.API{}
@@ -259,7 +304,7 @@ func TestValidateApprover(t *testing.T) {
 		}
 		api.On("GetUser", "user123").Return(expectedUser, nil)
 
-		user, err := ValidateApprover("user123", api)
+		user, err := ValidateApprover("user123", "requester789", api)
 		assert.NoError(t, err)
 		assert.NotNil(t, user)
 		assert.Equal(t, expectedUser, user)
@@ -271,7 +316,7 @@ func TestValidateApprover(t *testing.T) {
 		appErr := model.NewAppError("GetUser", "api.user.get.app_error", nil, "", 404)
 		api.On("GetUser", "invalid").Return(nil, appErr)
 
-		user, err := ValidateApprover("invalid", api)
+		user, err := ValidateApprover("invalid", "requester789", api)
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "failed to validate approver")
@@ -286,7 +331,7 @@ func TestValidateApprover(t *testing.T) {
 			DeleteAt: 1234567890000, // Deleted user (non-zero DeleteAt)
 		}, nil)
 
-		user, err := ValidateApprover("deleted", api)
+		user, err := ValidateApprover("deleted", "requester789", api)
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "not a valid user")
@@ -298,10 +343,26 @@ func TestValidateApprover(t *testing.T) {
 		appErr := model.NewAppError("GetUser", "api.user.get.app_error", nil, "database connection failed", 500)
 		api.On("GetUser", "user456").Return(nil, appErr)
 
-		user, err := ValidateApprover("user456", api)
+		user, err := ValidateApprover("user456", "requester789", api)
 		assert.Error(t, err)
 		assert.Nil(t, user)
 		assert.Contains(t, err.Error(), "failed to validate approver")
 		assert.Contains(t, err.Error(), "user456")
 	})
+
+	t.Run("self-approval is rejected without API call", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		user, err := ValidateApprover("user123", "user123", api)
+		assert.ErrorIs(t, err, ErrSelfApproval)
+		assert.Nil(t, user)
+		assert.Contains(t, err.Error(), "please select a different approver")
+		api.AssertNotCalled(t, "GetUser", mock.Anything)
+	})
+}
+
+func TestIsSystemAdminUser(t *testing.T) {
+	assert.True(t, IsSystemAdminUser(&model.User{Roles: "system_user system_admin"}))
+	assert.False(t, IsSystemAdminUser(&model.User{Roles: "system_user"}))
+	assert.False(t, IsSystemAdminUser(&model.User{Roles: "system_user fake_system_admin"}), "roles must match exactly")
 }
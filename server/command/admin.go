@@ -0,0 +1,197 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// isSystemAdmin reports whether the user has the system_admin role
+func (r *Router) isSystemAdmin(userID string) (bool, error) {
+	user, appErr := r.api.GetUser(userID)
+	if appErr != nil {
+		return false, fmt.Errorf("failed to get user %s: %w", userID, appErr)
+	}
+
+	return approval.IsSystemAdminUser(user), nil
+}
+
+// executeAdmin routes /approve admin subcommands (system admin only)
+func (r *Router) executeAdmin(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	isAdmin, err := r.isSystemAdmin(args.UserId)
+	if err != nil {
+		r.api.LogError("Failed to get user for admin command", "user_id", args.UserId, "error", err.Error())
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "command.permission_check_failed"),
+		}, nil
+	}
+
+	if !isAdmin {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "admin.permission_denied"),
+		}, nil
+	}
+
+	if len(subargs) > 0 {
+		switch subargs[0] {
+		case "template":
+			return r.executeAdminTemplate(args, subargs[1:])
+		case "policy":
+			return r.executeAdminPolicy(args, subargs[1:])
+		case "sla":
+			return r.executeAdminSLA(args, subargs[1:])
+		}
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         i18n.T(r.locale, "admin.usage"),
+	}, nil
+}
+
+// executeAdminTemplate handles /approve admin template list|set|delete
+func (r *Router) executeAdminTemplate(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	action := ""
+	if len(subargs) > 0 {
+		action = subargs[0]
+	}
+
+	var text string
+	switch action {
+	case "list":
+		text = r.listTemplates()
+	case "set":
+		// Preserve the JSON exactly as typed: "/approve admin template set {...}"
+		text = r.setTemplate(args.UserId, rawArgsAfter(args.Command, 4))
+	case "delete":
+		if len(subargs) != 2 {
+			text = i18n.T(r.locale, "template.delete_usage")
+		} else {
+			text = r.deleteTemplate(strings.ToLower(subargs[1]))
+		}
+	default:
+		text = i18n.T(r.locale, "admin.usage")
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         text,
+	}, nil
+}
+
+// listTemplates formats all request templates for display
+func (r *Router) listTemplates() string {
+	templates, err := r.store.ListTemplates()
+	if err != nil {
+		r.api.LogError("Failed to list request templates", "error", err.Error())
+		return i18n.T(r.locale, "template.list_failed")
+	}
+
+	if len(templates) == 0 {
+		return i18n.T(r.locale, "template.none")
+	}
+
+	var output strings.Builder
+	output.WriteString(i18n.T(r.locale, "template.list_header", len(templates)))
+	for _, tmpl := range templates {
+		approvers := "-"
+		if len(tmpl.DefaultApprovers) > 0 {
+			approvers = "@" + strings.Join(tmpl.DefaultApprovers, ", @")
+		}
+
+		fields := make([]string, 0, len(tmpl.Fields))
+		for _, field := range tmpl.Fields {
+			entry := fmt.Sprintf("%s (%s)", field.Name, field.Type)
+			if field.Required {
+				entry += "*"
+			}
+			fields = append(fields, entry)
+		}
+		fieldList := "-"
+		if len(fields) > 0 {
+			fieldList = strings.Join(fields, ", ")
+		}
+
+		output.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s |\n", tmpl.Name, tmpl.DisplayName, approvers, fieldList))
+	}
+	output.WriteString(i18n.T(r.locale, "template.list_footer"))
+
+	return output.String()
+}
+
+// setTemplate parses, validates and saves a request template definition
+func (r *Router) setTemplate(userID, rawJSON string) string {
+	if rawJSON == "" {
+		return i18n.T(r.locale, "template.set_usage") + "\n\n" + i18n.T(r.locale, "admin.usage")
+	}
+
+	var tmpl approval.RequestTemplate
+	if err := json.Unmarshal([]byte(rawJSON), &tmpl); err != nil {
+		return i18n.T(r.locale, "template.invalid_json", err.Error())
+	}
+
+	tmpl.Name = strings.ToLower(strings.TrimSpace(tmpl.Name))
+	if err := approval.ValidateTemplate(&tmpl); err != nil {
+		return i18n.T(r.locale, "template.invalid", err.Error())
+	}
+
+	// Default approvers must be existing users (stored without the @ prefix)
+	for i, username := range tmpl.DefaultApprovers {
+		username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+		if _, appErr := r.api.GetUserByUsername(username); appErr != nil {
+			return i18n.T(r.locale, "template.approver_not_found", username)
+		}
+		tmpl.DefaultApprovers[i] = username
+	}
+
+	tmpl.UpdatedBy = userID
+	tmpl.UpdatedAt = model.GetMillis()
+
+	if err := r.store.SaveTemplate(&tmpl); err != nil {
+		r.api.LogError("Failed to save request template", "template", tmpl.Name, "error", err.Error())
+		return i18n.T(r.locale, "template.save_failed")
+	}
+
+	r.api.LogInfo("Request template saved", "template", tmpl.Name, "user_id", userID, "field_count", len(tmpl.Fields))
+
+	return i18n.T(r.locale, "template.saved", tmpl.Name, tmpl.Name)
+}
+
+// deleteTemplate removes a request template by name
+func (r *Router) deleteTemplate(name string) string {
+	if _, err := r.store.GetTemplate(name); err != nil {
+		if errors.Is(err, approval.ErrTemplateNotFound) {
+			return i18n.T(r.locale, "template.not_found", name)
+		}
+		r.api.LogError("Failed to load request template for deletion", "template", name, "error", err.Error())
+		return i18n.T(r.locale, "template.delete_failed")
+	}
+
+	if err := r.store.DeleteTemplate(name); err != nil {
+		r.api.LogError("Failed to delete request template", "template", name, "error", err.Error())
+		return i18n.T(r.locale, "template.delete_failed")
+	}
+
+	return i18n.T(r.locale, "template.deleted", name)
+}
+
+// rawArgsAfter returns the command text following the first n whitespace-separated fields,
+// preserving the original spacing of the remainder (needed for JSON and free-text arguments)
+func rawArgsAfter(command string, n int) string {
+	rest := strings.TrimSpace(command)
+	for i := 0; i < n && rest != ""; i++ {
+		idx := strings.IndexFunc(rest, func(c rune) bool { return c == ' ' || c == '\t' || c == '\n' })
+		if idx < 0 {
+			return ""
+		}
+		rest = strings.TrimSpace(rest[idx:])
+	}
+	return rest
+}
@@ -0,0 +1,138 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteAdmin(t *testing.T) {
+	setup := func(roles string) (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: roles}, nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api, store, NewRouter(api, store)
+	}
+
+	route := func(router *Router, command string) string {
+		resp, err := router.Route(&model.CommandArgs{Command: command, UserId: "admin123"})
+		assert.NoError(t, err)
+		return resp.Text
+	}
+
+	t.Run("non-admin is rejected", func(t *testing.T) {
+		_, store, router := setup("system_user")
+
+		text := route(router, "/approve admin template list")
+
+		assert.Contains(t, text, "❌ Permission denied")
+		store.AssertNotCalled(t, "ListTemplates")
+	})
+
+	t.Run("missing subcommand shows usage", func(t *testing.T) {
+		_, _, router := setup("system_user system_admin")
+
+		assert.Contains(t, route(router, "/approve admin"), "/approve admin template set <JSON>")
+	})
+
+	t.Run("list shows templates as table", func(t *testing.T) {
+		_, store, router := setup("system_admin")
+		store.On("ListTemplates").Return([]*approval.RequestTemplate{{
+			Name:             "prod-access",
+			DisplayName:      "Production Access",
+			DefaultApprovers: []string{"bob", "carol"},
+			Fields:           []approval.CustomField{{Name: "system", Type: approval.FieldTypeSelect, Required: true}},
+		}}, nil)
+
+		text := route(router, "/approve admin template list")
+
+		assert.Contains(t, text, "Request Templates (1)")
+		assert.Contains(t, text, "| `prod-access` | Production Access | @bob, @carol | system (select)* |")
+	})
+
+	t.Run("replies are rendered in the admin's locale", func(t *testing.T) {
+		_, store, router := setup("system_admin")
+		router.SetLocale("de")
+		store.On("ListTemplates").Return([]*approval.RequestTemplate{}, nil)
+
+		assert.Contains(t, route(router, "/approve admin template list"), "Keine Anfragevorlagen definiert.")
+		assert.Contains(t, route(router, "/approve admin"), "**Administratorbefehle:**")
+	})
+
+	t.Run("set saves template preserving JSON spacing", func(t *testing.T) {
+		api, store, router := setup("system_admin")
+		api.On("GetUserByUsername", "bob").Return(&model.User{Id: "bob-id"}, nil)
+		store.On("SaveTemplate", mock.MatchedBy(func(tmpl *approval.RequestTemplate) bool {
+			return tmpl.Name == "prod-access" &&
+				tmpl.DisplayName == "Production  Access" &&
+				tmpl.DefaultApprovers[0] == "bob" &&
+				tmpl.UpdatedBy == "admin123" &&
+				tmpl.UpdatedAt > 0
+		})).Return(nil)
+
+		text := route(router, `/approve admin template set {"name":"Prod-Access","displayName":"Production  Access","defaultApprovers":["@bob"],"fields":[]}`)
+
+		assert.Contains(t, text, "✅ Request template `prod-access` saved")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("set rejects invalid JSON", func(t *testing.T) {
+		_, store, router := setup("system_admin")
+
+		text := route(router, `/approve admin template set {"name":`)
+
+		assert.Contains(t, text, "❌ Invalid template JSON")
+		store.AssertNotCalled(t, "SaveTemplate", mock.Anything)
+	})
+
+	t.Run("set rejects unknown default approver", func(t *testing.T) {
+		api, store, router := setup("system_admin")
+		api.On("GetUserByUsername", "ghost").Return(nil, &model.AppError{Message: "not found"})
+
+		text := route(router, `/approve admin template set {"name":"deploy","defaultApprovers":["ghost"]}`)
+
+		assert.Contains(t, text, "default approver @ghost not found")
+		store.AssertNotCalled(t, "SaveTemplate", mock.Anything)
+	})
+
+	t.Run("delete removes existing template", func(t *testing.T) {
+		_, store, router := setup("system_admin")
+		store.On("GetTemplate", "deploy").Return(&approval.RequestTemplate{Name: "deploy"}, nil)
+		store.On("DeleteTemplate", "deploy").Return(nil)
+
+		assert.Contains(t, route(router, "/approve admin template delete deploy"), "✅ Request template `deploy` deleted")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("delete reports missing template", func(t *testing.T) {
+		_, store, router := setup("system_admin")
+		store.On("GetTemplate", "deploy").Return(nil, fmt.Errorf("wrapped: %w", approval.ErrTemplateNotFound))
+
+		assert.Contains(t, route(router, "/approve admin template delete deploy"), "not found")
+		store.AssertNotCalled(t, "DeleteTemplate", mock.Anything)
+	})
+}
+
+func TestRawArgsAfter(t *testing.T) {
+	tests := []struct {
+		command string
+		n       int
+		want    string
+	}{
+		{"/approve admin template set {\"a\": \"b  c\"}", 4, "{\"a\": \"b  c\"}"},
+		{"/approve comment A-X7K9Q2   hello   world", 3, "hello   world"},
+		{"/approve admin template set", 4, ""},
+		{"/approve", 3, ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rawArgsAfter(tt.command, tt.n), tt.command)
+	}
+}
@@ -3,18 +3,60 @@ package command
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
 	"github.com/mattermost/mattermost/server/public/model"
 )
 
+// DialogState is carried in the create-request dialog State so the submission handler knows
+// which template and approver group the dialog was opened for, and which locale to answer in
+type DialogState struct {
+	Template      string `json:"template,omitempty"`
+	ApproverGroup string `json:"approverGroup,omitempty"` // Mattermost group name (without @)
+	ApproverRole  string `json:"approverRole,omitempty"`  // Channel role, e.g. "channel_admin"
+	Locale        string `json:"locale,omitempty"`        // Requester's Mattermost locale
+}
+
+// IsGroupApproval reports whether the dialog sends the request to a group or role instead of a selected approver
+func (s DialogState) IsGroupApproval() bool {
+	return s.ApproverGroup != "" || s.ApproverRole != ""
+}
+
+// Encode serializes the state for a dialog. Template-only state stays a plain template name
+// so dialogs opened before group approvals existed keep working.
+func (s DialogState) Encode() string {
+	if !s.IsGroupApproval() && s.Locale == "" {
+		return s.Template
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return s.Template
+	}
+	return string(data)
+}
+
+// ParseDialogState decodes a dialog State, accepting both JSON and a plain template name
+func ParseDialogState(state string) DialogState {
+	if !strings.HasPrefix(state, "{") {
+		return DialogState{Template: state}
+	}
+
+	var parsed DialogState
+	if err := json.Unmarshal([]byte(state), &parsed); err != nil {
+		return DialogState{}
+	}
+	return parsed
+}
+
 // HandleDialogSubmission validates a dialog submission and returns validation errors if any.
 // Performs basic presence validation for required fields:
 // - approver: Must be present and non-empty
 // - description: Must be present and non-empty
 //
 // Returns field-specific errors that keep the modal open, preserving user input.
-// Error messages follow UX guidelines: specific, actionable, helpful tone.
-func HandleDialogSubmission(submission map[string]any) *model.SubmitDialogResponse {
+// Error messages follow UX guidelines: specific, actionable, helpful tone, in the requester's locale.
+func HandleDialogSubmission(submission map[string]any, locale string) *model.SubmitDialogResponse {
 	response := &model.SubmitDialogResponse{
 		Errors: make(map[string]string),
 	}
@@ -22,13 +64,13 @@ func HandleDialogSubmission(submission map[string]any) *model.SubmitDialogRespon
 	// Validate approver field (AC1: Validate Missing Approver)
 	approver, ok := submission["approver"].(string)
 	if !ok || approver == "" {
-		response.Errors["approver"] = "Approver field is required. Please select a user."
+		response.Errors["approver"] = i18n.T(locale, "new.approver_required")
 	}
 
 	// Validate description field (AC2: Validate Missing Description)
 	description, ok := submission["description"].(string)
 	if !ok || description == "" {
-		response.Errors["description"] = "Description field is required. Please describe what needs approval."
+		response.Errors["description"] = i18n.T(locale, "new.description_required")
 	}
 
 	return response
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +16,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": "Test approval request description",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.Empty(t, response.Error)
 		assert.Empty(t, response.Errors)
@@ -26,7 +27,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": "Test approval request description",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "approver")
@@ -40,7 +41,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": "Test approval request description",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "approver")
@@ -52,7 +53,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"approver": "user-id-abcdefghijklmnopqrstuvwxyz",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "description")
@@ -66,7 +67,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": "",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "description")
@@ -76,7 +77,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 	t.Run("returns errors for both fields missing", func(t *testing.T) {
 		submission := map[string]any{}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "approver")
@@ -89,7 +90,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": "Test approval request description",
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "approver")
@@ -101,7 +102,7 @@ func TestHandleDialogSubmission(t *testing.T) {
 			"description": []string{"invalid", "type"}, // Invalid type
 		}
 
-		response := HandleDialogSubmission(submission)
+		response := HandleDialogSubmission(submission, i18n.DefaultLocale)
 		assert.NotNil(t, response)
 		assert.NotEmpty(t, response.Errors)
 		assert.Contains(t, response.Errors, "description")
@@ -140,3 +141,35 @@ func TestParseDialogSubmissionPayload(t *testing.T) {
 		assert.Nil(t, parsed)
 	})
 }
+
+func TestDialogState(t *testing.T) {
+	t.Run("template-only state stays a plain name", func(t *testing.T) {
+		state := DialogState{Template: "prod-access"}
+		assert.Equal(t, "prod-access", state.Encode())
+		assert.Equal(t, state, ParseDialogState(state.Encode()))
+	})
+
+	t.Run("group state round-trips", func(t *testing.T) {
+		state := DialogState{Template: "prod-access", ApproverGroup: "sre-oncall"}
+		assert.True(t, state.IsGroupApproval())
+		assert.Equal(t, state, ParseDialogState(state.Encode()))
+	})
+
+	t.Run("role state round-trips", func(t *testing.T) {
+		state := DialogState{ApproverRole: "channel_admin"}
+		assert.True(t, state.IsGroupApproval())
+		assert.Equal(t, state, ParseDialogState(state.Encode()))
+	})
+
+	t.Run("locale state round-trips", func(t *testing.T) {
+		state := DialogState{Template: "prod-access", Locale: "de"}
+		assert.False(t, state.IsGroupApproval())
+		assert.Equal(t, state, ParseDialogState(state.Encode()))
+	})
+
+	t.Run("empty and malformed state", func(t *testing.T) {
+		assert.Equal(t, DialogState{}, ParseDialogState(""))
+		assert.Equal(t, DialogState{}, ParseDialogState("{not json"))
+		assert.False(t, ParseDialogState("").IsGroupApproval())
+	})
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// executeDigest handles /approve digest on|off|status for the calling user
+func (r *Router) executeDigest(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	action := "status"
+	if len(subargs) > 0 {
+		action = strings.ToLower(subargs[0])
+	}
+
+	var text string
+	switch action {
+	case "on", "off":
+		enabled := action == "on"
+		if err := r.store.SetDigestSubscription(args.UserId, enabled); err != nil {
+			r.api.LogError("Failed to update digest subscription", "user_id", args.UserId, "error", err.Error())
+			text = i18n.T(r.locale, "digest.update_failed")
+			break
+		}
+		if enabled {
+			text = i18n.T(r.locale, "digest.subscribed")
+		} else {
+			text = i18n.T(r.locale, "digest.unsubscribed")
+		}
+	case "status":
+		subscribed, err := r.store.GetDigestSubscription(args.UserId)
+		if err != nil {
+			r.api.LogError("Failed to get digest subscription", "user_id", args.UserId, "error", err.Error())
+			text = i18n.T(r.locale, "digest.status_failed")
+			break
+		}
+		if subscribed {
+			text = i18n.T(r.locale, "digest.status_on")
+		} else {
+			text = i18n.T(r.locale, "digest.status_off")
+		}
+	default:
+		text = i18n.T(r.locale, "digest.usage")
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         text,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteDigest(t *testing.T) {
+	setup := func() (*mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return store, NewRouter(api, store)
+	}
+
+	route := func(router *Router, command string) string {
+		resp, err := router.Route(&model.CommandArgs{Command: command, UserId: "user123"})
+		assert.NoError(t, err)
+		return resp.Text
+	}
+
+	t.Run("on subscribes the caller", func(t *testing.T) {
+		store, router := setup()
+		store.On("SetDigestSubscription", "user123", true).Return(nil)
+
+		assert.Contains(t, route(router, "/approve digest on"), "✅ You will receive the approval digest")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("off unsubscribes the caller", func(t *testing.T) {
+		store, router := setup()
+		store.On("SetDigestSubscription", "user123", false).Return(nil)
+
+		assert.Contains(t, route(router, "/approve digest OFF"), "✅ You will no longer receive the approval digest.")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("store failure is reported", func(t *testing.T) {
+		store, router := setup()
+		store.On("SetDigestSubscription", "user123", true).Return(errors.New("kv down"))
+
+		assert.Contains(t, route(router, "/approve digest on"), "❌ Failed to update your digest subscription")
+	})
+
+	t.Run("status is the default", func(t *testing.T) {
+		store, router := setup()
+		store.On("GetDigestSubscription", "user123").Return(true, nil)
+
+		assert.Contains(t, route(router, "/approve digest"), "📬 You receive the approval digest.")
+	})
+
+	t.Run("status when not subscribed", func(t *testing.T) {
+		store, router := setup()
+		store.On("GetDigestSubscription", "user123").Return(false, nil)
+
+		assert.Contains(t, route(router, "/approve digest status"), "📭 You do not receive the approval digest.")
+	})
+
+	t.Run("unknown action shows usage", func(t *testing.T) {
+		store, router := setup()
+
+		assert.Contains(t, route(router, "/approve digest weekly"), "**Approval Digest:**")
+		store.AssertNotCalled(t, "SetDigestSubscription", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,403 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// defaultListLimit is the number of records per /approve list page
+	defaultListLimit = 20
+
+	// maxListLimit caps --limit so a page stays well within the post size limit
+	maxListLimit = 50
+
+	// listPageURL is the action endpoint of the Next and Previous buttons
+	listPageURL = "/plugins/com.mattermost.plugin-approver2/list/page"
+
+	// actionURL is the action endpoint of the inbox Approve and Deny buttons, shared with the approver DM
+	actionURL = "/plugins/com.mattermost.plugin-approver2/action"
+
+	// bulkURL is the action endpoint of the inbox button that opens the bulk decision dialog
+	bulkURL = "/plugins/com.mattermost.plugin-approver2/bulk"
+
+	// listScopeInbox lists requests sent to the user, oldest first, with Approve and Deny buttons
+	listScopeInbox = "inbox"
+
+	// listScopeOutbox lists the user's own requests
+	listScopeOutbox = "outbox"
+)
+
+// listFilters are the valid /approve list filters; all but "all" are record statuses
+var listFilters = []string{"pending", "scheduled", "approved", "denied", "canceled", "all"}
+
+// listScopeRoles maps the /approve list scopes to the index role they read
+var listScopeRoles = map[string]string{
+	listScopeInbox:  approval.IndexRoleApprover,
+	listScopeOutbox: approval.IndexRoleRequester,
+}
+
+// ListQuery selects one page of /approve list. Pages follow the user's requester/approver
+// index, newest created first (oldest first in the inbox). A cursor (After or Before) continues
+// from the page the buttons were shown on; without one, Page is counted from the first record.
+type ListQuery struct {
+	Scope  string // inbox, outbox, or empty for both
+	Filter string // pending, scheduled, approved, denied, canceled or all
+	Page   int    // 1-based page number
+	Limit  int    // Records per page, 1 to maxListLimit
+	After  string // Next button: start after this index position
+	Before string // Previous button: end before this index position
+}
+
+// listPage is one page of a user's records with what is needed to navigate from it
+type listPage struct {
+	Query       ListQuery
+	Records     []*approval.ApprovalRecord
+	HasNext     bool
+	HasPrevious bool
+	First       string // Index position of the first record shown; empty when there are none
+	Last        string // Index position of the last record shown; empty when there are none
+}
+
+// parseListArgs parses /approve list [inbox|outbox] [filter] [--page N] [--limit N]. Flags take
+// their value as the next argument or after "=". Errors are localized for display.
+func parseListArgs(fields []string, locale string) (ListQuery, error) {
+	query := ListQuery{Filter: "pending", Page: 1, Limit: defaultListLimit}
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if !strings.HasPrefix(field, "--") {
+			filter := strings.ToLower(field)
+			if _, ok := listScopeRoles[filter]; ok {
+				query.Scope = filter
+				continue
+			}
+			if !slices.Contains(listFilters, filter) {
+				return ListQuery{}, errors.New(i18n.T(locale, "list.invalid_filter", field))
+			}
+			query.Filter = filter
+			continue
+		}
+
+		flag, value, hasValue := strings.Cut(strings.ToLower(field), "=")
+		if !hasValue && i+1 < len(fields) {
+			i++
+			value = fields[i]
+		}
+
+		switch flag {
+		case "--page":
+			page, err := strconv.Atoi(value)
+			if err != nil || page < 1 {
+				return ListQuery{}, errors.New(i18n.T(locale, "list.invalid_page", value))
+			}
+			query.Page = page
+		case "--limit":
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > maxListLimit {
+				return ListQuery{}, errors.New(i18n.T(locale, "list.invalid_limit", value, maxListLimit))
+			}
+			query.Limit = limit
+		default:
+			return ListQuery{}, errors.New(i18n.T(locale, "list.invalid_filter", field))
+		}
+	}
+
+	return query, nil
+}
+
+// ListQueryFromContext reads the query of a Next or Previous button. The cursors only position
+// the page within the clicking user's own index, so the context needs no signature.
+func ListQueryFromContext(context map[string]any) (ListQuery, error) {
+	filter, _ := context["filter"].(string)
+	if !slices.Contains(listFilters, filter) {
+		return ListQuery{}, fmt.Errorf("invalid filter %q", filter)
+	}
+
+	scope, _ := context["scope"].(string)
+	if _, ok := listScopeRoles[scope]; scope != "" && !ok {
+		return ListQuery{}, fmt.Errorf("invalid scope %q", scope)
+	}
+
+	query := ListQuery{Scope: scope, Filter: filter}
+	query.After, _ = context["after"].(string)
+	query.Before, _ = context["before"].(string)
+
+	// JSON numbers decode as float64
+	page, _ := context["page"].(float64)
+	limit, _ := context["limit"].(float64)
+	query.Page = int(page)
+	query.Limit = int(limit)
+	if query.Page < 1 || query.Limit < 1 || query.Limit > maxListLimit {
+		return ListQuery{}, fmt.Errorf("invalid page %v or limit %v", context["page"], context["limit"])
+	}
+
+	return query, nil
+}
+
+// loadListPage loads one page of the user's records. Only records on the page, and records
+// skipped by the status filter or --page, are loaded; the rest of the history stays in the KV
+// store. Access control: the index only holds records where the user is requester or approver.
+func (r *Router) loadListPage(userID string, query ListQuery) (*listPage, error) {
+	entries, err := r.store.GetUserApprovalIndex(userID, listScopeRoles[query.Scope])
+	if err != nil {
+		return nil, err
+	}
+
+	// precedes reports whether position a is listed before position b
+	precedes := func(a, b string) bool { return a < b }
+	if query.Scope == listScopeInbox {
+		// The inbox is a queue: the longest-waiting request comes first
+		entries = slices.Clone(entries)
+		slices.Reverse(entries)
+		precedes = func(a, b string) bool { return a > b }
+	}
+
+	page := &listPage{Query: query}
+	var shown []approval.IndexEntry
+
+	if query.Before != "" {
+		// Walk back from the cursor, then restore list order
+		end := sort.Search(len(entries), func(i int) bool { return !precedes(entries[i].Position, query.Before) })
+		i := end - 1
+		for ; i >= 0 && len(page.Records) < query.Limit; i-- {
+			if record := r.loadListRecord(userID, entries[i], query.Filter); record != nil {
+				page.Records = append(page.Records, record)
+				shown = append(shown, entries[i])
+			}
+		}
+		slices.Reverse(page.Records)
+		slices.Reverse(shown)
+
+		page.HasNext = end < len(entries)
+		page.HasPrevious = i >= 0
+		if !page.HasPrevious {
+			page.Query.Page = 1
+		} else if page.Query.Page < 2 {
+			page.Query.Page = 2
+		}
+	} else {
+		start := 0
+		skip := 0
+		if query.After != "" {
+			start = sort.Search(len(entries), func(i int) bool { return precedes(query.After, entries[i].Position) })
+		} else {
+			skip = (query.Page - 1) * query.Limit
+		}
+
+		i := start
+		for ; i < len(entries) && len(page.Records) < query.Limit; i++ {
+			// Without a status filter, skipped pages need no loading
+			if skip > 0 && query.Filter == "all" {
+				skip--
+				continue
+			}
+			record := r.loadListRecord(userID, entries[i], query.Filter)
+			if record == nil {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			page.Records = append(page.Records, record)
+			shown = append(shown, entries[i])
+		}
+
+		// Later entries may not match the filter; a Next page without matches says so
+		page.HasNext = i < len(entries)
+		page.HasPrevious = start > 0 || query.Page > 1
+	}
+
+	if len(shown) > 0 {
+		page.First = shown[0].Position
+		page.Last = shown[len(shown)-1].Position
+	}
+
+	return page, nil
+}
+
+// loadListRecord loads an index entry's record, returning nil when it does not match the
+// filter or cannot be loaded
+func (r *Router) loadListRecord(userID string, entry approval.IndexEntry, filter string) *approval.ApprovalRecord {
+	record, err := r.store.GetApproval(entry.RecordID)
+	if err != nil {
+		r.api.LogWarn("Failed to retrieve approval record for list page",
+			"record_id", entry.RecordID,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return nil
+	}
+	if filter != "all" && record.Status != filter {
+		return nil
+	}
+	return record
+}
+
+// ListPagePost renders a page of /approve list as an ephemeral post with Next and Previous
+// buttons. It is used by the list command and, to replace the post in place, by the buttons.
+func (r *Router) ListPagePost(userID, channelID string, query ListQuery) (*model.Post, error) {
+	page, err := r.loadListPage(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	post := &model.Post{
+		UserId:    userID,
+		ChannelId: channelID,
+	}
+
+	now := time.Now()
+	filterLabel := i18n.T(r.locale, "filter."+query.Filter)
+	var attachments []any
+	switch {
+	case len(page.Records) == 0 && page.Query.Page == 1:
+		// Story 5.2: Filter-specific empty state
+		post.Message = i18n.T(r.locale, listMessageKey(query.Scope, "empty"), filterLabel)
+	case len(page.Records) == 0:
+		post.Message = i18n.T(r.locale, "list.page_empty", filterLabel)
+	case query.Scope == listScopeInbox:
+		// Inbox records stay in queue order, each decidable one with its own buttons
+		post.Message = formatListResponse(page, r.locale, r.timezone, now)
+		attachments = r.inboxAttachments(userID, page.Records, now)
+	default:
+		// Apply chronological sorting for specific status filters (Story 5.1, Subtask 2.5)
+		sortRecordsByTimestamp(page.Records, query.Filter)
+		post.Message = formatListResponse(page, r.locale, r.timezone, now)
+	}
+
+	if actions := listNavigationActions(page, r.locale); len(actions) > 0 {
+		attachments = append(attachments, map[string]any{"actions": actions})
+	}
+	if len(attachments) > 0 {
+		post.Props = model.StringInterface{"attachments": attachments}
+	}
+
+	return post, nil
+}
+
+// inboxAttachments builds an attachment with Approve and Deny buttons for each pending record
+// the user may decide. The buttons open the confirmation dialog, like those of the approver DM.
+// With several such records, a last button opens the bulk decision dialog.
+func (r *Router) inboxAttachments(userID string, records []*approval.ApprovalRecord, now time.Time) []any {
+	if r.signer == nil {
+		return nil
+	}
+
+	var attachments []any
+	for _, record := range records {
+		if record.Status != approval.StatusPending || !record.CanDecide(userID) {
+			continue
+		}
+		attachments = append(attachments, map[string]any{
+			"text": i18n.T(r.locale, "list.inbox_row", record.Code, record.RequesterUsername, record.DescriptionSummary()),
+			"actions": []any{
+				map[string]any{
+					"name": i18n.T(r.locale, "dm.request.approve"),
+					"integration": map[string]any{
+						"url":     actionURL,
+						"context": r.signer.SignedContext(record.ID, "approve", now),
+					},
+					"style": "primary",
+				},
+				map[string]any{
+					"name": i18n.T(r.locale, "dm.request.deny"),
+					"integration": map[string]any{
+						"url":     actionURL,
+						"context": r.signer.SignedContext(record.ID, "deny", now),
+					},
+					"style": "danger",
+				},
+			},
+		})
+	}
+
+	if len(attachments) > 1 {
+		attachments = append(attachments, map[string]any{
+			"actions": []any{
+				map[string]any{
+					"name": i18n.T(r.locale, "list.bulk"),
+					"integration": map[string]any{
+						"url":     bulkURL,
+						"context": map[string]any{},
+					},
+				},
+			},
+		})
+	}
+	return attachments
+}
+
+// listMessageKey returns the catalog key of a list message, e.g. "list.empty", or
+// "list.inbox_empty" for the inbox
+func listMessageKey(scope, name string) string {
+	if scope == "" {
+		return "list." + name
+	}
+	return "list." + scope + "_" + name
+}
+
+// listNavigationActions builds the Previous and Next buttons of a page. Buttons continue from
+// the page's first or last record; pages without records fall back to the page number.
+func listNavigationActions(page *listPage, locale string) []any {
+	var actions []any
+
+	button := func(name string, context map[string]any) map[string]any {
+		return map[string]any{
+			"name": name,
+			"integration": map[string]any{
+				"url":     listPageURL,
+				"context": context,
+			},
+		}
+	}
+
+	pageContext := func(number int) map[string]any {
+		context := map[string]any{"filter": page.Query.Filter, "limit": page.Query.Limit, "page": number}
+		if page.Query.Scope != "" {
+			context["scope"] = page.Query.Scope
+		}
+		return context
+	}
+
+	if page.HasPrevious {
+		context := pageContext(page.Query.Page - 1)
+		if page.First != "" {
+			context["before"] = page.First
+		}
+		actions = append(actions, button(i18n.T(locale, "list.previous"), context))
+	}
+
+	if page.HasNext {
+		context := pageContext(page.Query.Page + 1)
+		if page.Last != "" {
+			context["after"] = page.Last
+		}
+		actions = append(actions, button(i18n.T(locale, "list.next"), context))
+	}
+
+	return actions
+}
+
+// listPageCommand is the slash command that shows the given page, for the page footer
+func listPageCommand(query ListQuery, page int) string {
+	command := "/approve list "
+	if query.Scope != "" {
+		command += query.Scope + " "
+	}
+	command += fmt.Sprintf("%s --page %d", query.Filter, page)
+	if query.Limit != defaultListLimit {
+		command += fmt.Sprintf(" --limit %d", query.Limit)
+	}
+	return command
+}
@@ -0,0 +1,436 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// listRecords creates count records for user123, newest first, alternating pending and approved
+func listRecords(count int) []*approval.ApprovalRecord {
+	records := make([]*approval.ApprovalRecord, 0, count)
+	for i := range count {
+		status := approval.StatusPending
+		if i%2 == 1 {
+			status = approval.StatusApproved
+		}
+		records = append(records, &approval.ApprovalRecord{
+			ID:                fmt.Sprintf("record%02d", i),
+			Code:              fmt.Sprintf("A-REC%02d", i),
+			Status:            status,
+			RequesterID:       "user123",
+			RequesterUsername: "alice",
+			ApproverUsername:  "bob",
+			CreatedAt:         int64(1704897000000 - i*1000),
+			DecidedAt:         int64(1704898000000 - i*1000),
+		})
+	}
+	return records
+}
+
+// fakeSigner signs button contexts with their approval ID and action only
+type fakeSigner struct{}
+
+func (fakeSigner) SignedContext(approvalID, action string, _ time.Time) map[string]any {
+	return map[string]any{"approval_id": approvalID, "action": action}
+}
+
+// inboxRecords creates count pending records sent to user123 by alice, newest first
+func inboxRecords(count int) []*approval.ApprovalRecord {
+	records := listRecords(count)
+	for _, record := range records {
+		record.Status = approval.StatusPending
+		record.RequesterID = "alice123"
+		record.ApproverID = "user123"
+		record.Description = "Deploy release " + record.ID
+	}
+	return records
+}
+
+func recordIDs(records []*approval.ApprovalRecord) []string {
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+	return ids
+}
+
+func TestParseListArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+		want   ListQuery
+	}{
+		{name: "defaults", fields: nil, want: ListQuery{Filter: "pending", Page: 1, Limit: 20}},
+		{name: "filter", fields: []string{"ALL"}, want: ListQuery{Filter: "all", Page: 1, Limit: 20}},
+		{name: "flags with separate values", fields: []string{"approved", "--page", "3", "--limit", "50"}, want: ListQuery{Filter: "approved", Page: 3, Limit: 50}},
+		{name: "flags with equals and filter last", fields: []string{"--page=2", "--limit=5", "denied"}, want: ListQuery{Filter: "denied", Page: 2, Limit: 5}},
+		{name: "inbox", fields: []string{"inbox"}, want: ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 20}},
+		{name: "outbox with filter and page", fields: []string{"approved", "OUTBOX", "--page", "2"}, want: ListQuery{Scope: "outbox", Filter: "approved", Page: 2, Limit: 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := parseListArgs(tt.fields, i18n.DefaultLocale)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query)
+		})
+	}
+
+	errorTests := []struct {
+		name    string
+		fields  []string
+		wantErr string
+	}{
+		{name: "invalid filter", fields: []string{"bogus"}, wantErr: "Invalid filter 'bogus'"},
+		{name: "unknown flag", fields: []string{"--sort", "asc"}, wantErr: "Invalid filter '--sort'"},
+		{name: "page zero", fields: []string{"--page", "0"}, wantErr: "Invalid page '0'"},
+		{name: "missing page", fields: []string{"--page"}, wantErr: "Invalid page ''"},
+		{name: "limit too large", fields: []string{"--limit", "51"}, wantErr: "Invalid limit '51'. Use a number from 1 to 50."},
+		{name: "limit not a number", fields: []string{"--limit=ten"}, wantErr: "Invalid limit 'ten'"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseListArgs(tt.fields, i18n.DefaultLocale)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestListQueryFromContext(t *testing.T) {
+	query, err := ListQueryFromContext(map[string]any{
+		"filter": "all", "page": float64(2), "limit": float64(20), "after": "8295103018999:record19",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ListQuery{Filter: "all", Page: 2, Limit: 20, After: "8295103018999:record19"}, query)
+
+	query, err = ListQueryFromContext(map[string]any{"scope": "inbox", "filter": "pending", "page": float64(1), "limit": float64(20)})
+	require.NoError(t, err)
+	assert.Equal(t, ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 20}, query)
+
+	_, err = ListQueryFromContext(map[string]any{"filter": "mine", "page": float64(1), "limit": float64(20)})
+	assert.Error(t, err)
+	_, err = ListQueryFromContext(map[string]any{"scope": "everyone", "filter": "all", "page": float64(1), "limit": float64(20)})
+	assert.Error(t, err)
+	_, err = ListQueryFromContext(map[string]any{"filter": "all", "page": float64(0), "limit": float64(20)})
+	assert.Error(t, err)
+	_, err = ListQueryFromContext(map[string]any{"filter": "all", "page": float64(1), "limit": float64(500)})
+	assert.Error(t, err)
+}
+
+func TestLoadListPage(t *testing.T) {
+	setup := func(records []*approval.ApprovalRecord) (*mockStore, *Router) {
+		store := &mockStore{}
+		mockUserIndex(store, "user123", records)
+		return store, NewRouter(&plugintest.API{}, store)
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		store, router := setup(listRecords(25))
+
+		page, err := router.loadListPage("user123", ListQuery{Filter: "all", Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Len(t, page.Records, 10)
+		assert.Equal(t, "record00", page.Records[0].ID)
+		assert.True(t, page.HasNext)
+		assert.False(t, page.HasPrevious)
+		assert.Equal(t, "8295102999999:record00", page.First)
+		assert.Equal(t, "8295103008999:record09", page.Last)
+		store.AssertNotCalled(t, "GetApproval", "record10")
+	})
+
+	t.Run("page number skips without loading when unfiltered", func(t *testing.T) {
+		store, router := setup(listRecords(25))
+
+		page, err := router.loadListPage("user123", ListQuery{Filter: "all", Page: 3, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record20", "record21", "record22", "record23", "record24"}, recordIDs(page.Records))
+		assert.False(t, page.HasNext)
+		assert.True(t, page.HasPrevious)
+		store.AssertNotCalled(t, "GetApproval", "record05")
+	})
+
+	t.Run("page number counts matching records when filtered", func(t *testing.T) {
+		_, router := setup(listRecords(25))
+
+		page, err := router.loadListPage("user123", ListQuery{Filter: "approved", Page: 2, Limit: 3})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record07", "record09", "record11"}, recordIDs(page.Records))
+		assert.True(t, page.HasNext)
+		assert.True(t, page.HasPrevious)
+	})
+
+	t.Run("next cursor continues after the last record", func(t *testing.T) {
+		_, router := setup(listRecords(25))
+
+		page, err := router.loadListPage("user123", ListQuery{Filter: "pending", Page: 2, Limit: 3, After: "8295103003999:record04"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record06", "record08", "record10"}, recordIDs(page.Records))
+		assert.True(t, page.HasPrevious)
+	})
+
+	t.Run("previous cursor ends before the first record", func(t *testing.T) {
+		_, router := setup(listRecords(25))
+
+		page, err := router.loadListPage("user123", ListQuery{Filter: "pending", Page: 2, Limit: 3, Before: "8295103005999:record06"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record00", "record02", "record04"}, recordIDs(page.Records))
+		assert.True(t, page.HasNext)
+		assert.False(t, page.HasPrevious)
+		assert.Equal(t, 1, page.Query.Page, "reaching the newest record is page 1")
+	})
+
+	t.Run("inbox is oldest first", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleApprover, inboxRecords(5))
+		router := NewRouter(&plugintest.API{}, store)
+
+		page, err := router.loadListPage("user123", ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record04", "record03"}, recordIDs(page.Records))
+		assert.True(t, page.HasNext)
+
+		page, err = router.loadListPage("user123", ListQuery{Scope: "inbox", Filter: "pending", Page: 2, Limit: 2, After: page.Last})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record02", "record01"}, recordIDs(page.Records))
+
+		page, err = router.loadListPage("user123", ListQuery{Scope: "inbox", Filter: "pending", Page: 2, Limit: 2, Before: page.First})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record04", "record03"}, recordIDs(page.Records))
+		assert.False(t, page.HasPrevious)
+	})
+
+	t.Run("outbox reads the requester index", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleRequester, listRecords(3))
+		router := NewRouter(&plugintest.API{}, store)
+
+		page, err := router.loadListPage("user123", ListQuery{Scope: "outbox", Filter: "all", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record00", "record01", "record02"}, recordIDs(page.Records))
+		store.AssertNotCalled(t, "GetUserApprovalIndex", "user123", "")
+	})
+
+	t.Run("skips records that fail to load", func(t *testing.T) {
+		store := &mockStore{}
+		api := &plugintest.API{}
+		records := listRecords(2)
+		store.On("GetUserApprovalIndex", "user123", "").Return([]approval.IndexEntry{
+			{Position: "8295102999999:record00", RecordID: "record00"},
+			{Position: "8295103000999:record01", RecordID: "record01"},
+		}, nil)
+		store.On("GetApproval", "record00").Return(nil, approval.ErrRecordNotFound)
+		store.On("GetApproval", "record01").Return(records[1], nil)
+		api.On("LogWarn", "Failed to retrieve approval record for list page",
+			"record_id", "record00", "user_id", "user123", "error", mock.Anything).Once()
+
+		page, err := NewRouter(api, store).loadListPage("user123", ListQuery{Filter: "all", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"record01"}, recordIDs(page.Records))
+		api.AssertExpectations(t)
+	})
+}
+
+func TestListPagePost(t *testing.T) {
+	t.Run("buttons carry the page cursors", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserIndex(store, "user123", listRecords(25))
+		router := NewRouter(&plugintest.API{}, store)
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Filter: "all", Page: 2, Limit: 10, After: "8295103008999:record09"})
+		require.NoError(t, err)
+		assert.Equal(t, "channel123", post.ChannelId)
+		assert.Contains(t, post.Message, "## Your Approval Requests (all, page 2)")
+		assert.Contains(t, post.Message, "A-REC10")
+		assert.Contains(t, post.Message, "A-REC19")
+
+		attachments := post.Props["attachments"].([]any)
+		actions := attachments[0].(map[string]any)["actions"].([]any)
+		require.Len(t, actions, 2)
+
+		previous := actions[0].(map[string]any)
+		assert.Equal(t, "◀ Previous", previous["name"])
+		assert.Equal(t, map[string]any{
+			"url": listPageURL,
+			"context": map[string]any{
+				"filter": "all", "limit": 10, "page": 1, "before": "8295103009999:record10",
+			},
+		}, previous["integration"])
+
+		next := actions[1].(map[string]any)
+		assert.Equal(t, "Next ▶", next["name"])
+		assert.Equal(t, map[string]any{
+			"filter": "all", "limit": 10, "page": 3, "after": "8295103018999:record19",
+		}, next["integration"].(map[string]any)["context"])
+	})
+
+	t.Run("single page has no buttons", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserIndex(store, "user123", listRecords(3))
+		router := NewRouter(&plugintest.API{}, store)
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Filter: "all", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Contains(t, post.Message, "## Your Approval Requests (3 all)")
+		assert.Nil(t, post.Props)
+	})
+
+	t.Run("page past the last match offers Previous", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserIndex(store, "user123", listRecords(4))
+		router := NewRouter(&plugintest.API{}, store)
+		router.SetLocale("de")
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Filter: "pending", Page: 3, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, "Keine weiteren Genehmigungsanfragen (ausstehend).", post.Message)
+
+		attachments := post.Props["attachments"].([]any)
+		actions := attachments[0].(map[string]any)["actions"].([]any)
+		require.Len(t, actions, 1)
+		previous := actions[0].(map[string]any)
+		assert.Equal(t, "◀ Zurück", previous["name"])
+		assert.Equal(t, map[string]any{"filter": "pending", "limit": 2, "page": 2},
+			previous["integration"].(map[string]any)["context"], "no cursor without records")
+	})
+}
+
+func TestListPagePost_Inbox(t *testing.T) {
+	t.Run("pending rows the user may decide get buttons", func(t *testing.T) {
+		records := inboxRecords(3)
+		records[1].Status = approval.StatusApproved
+		records[2].ApproverID = "someone-else"
+
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleApprover, records)
+		router := NewRouter(&plugintest.API{}, store)
+		router.SetActionSigner(fakeSigner{})
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Scope: "inbox", Filter: "all", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Contains(t, post.Message, "## Requests Sent to You (3 all)")
+		assert.Less(t, strings.Index(post.Message, "A-REC02"), strings.Index(post.Message, "A-REC00"), "oldest first")
+		assert.NotContains(t, post.Message, "**Pending Approvals:**")
+
+		attachments := post.Props["attachments"].([]any)
+		require.Len(t, attachments, 1)
+		row := attachments[0].(map[string]any)
+		assert.Equal(t, "**A-REC00** from @alice: Deploy release record00", row["text"])
+		actions := row["actions"].([]any)
+		require.Len(t, actions, 2)
+		approve := actions[0].(map[string]any)
+		assert.Equal(t, "Approve", approve["name"])
+		assert.Equal(t, map[string]any{
+			"url":     actionURL,
+			"context": map[string]any{"approval_id": "record00", "action": "approve"},
+		}, approve["integration"])
+		deny := actions[1].(map[string]any)
+		assert.Equal(t, "Deny", deny["name"])
+		assert.Equal(t, "danger", deny["style"])
+	})
+
+	t.Run("several decidable rows add the bulk button", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleApprover, inboxRecords(2))
+		router := NewRouter(&plugintest.API{}, store)
+		router.SetActionSigner(fakeSigner{})
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 20})
+		require.NoError(t, err)
+
+		attachments := post.Props["attachments"].([]any)
+		require.Len(t, attachments, 3)
+		bulk := attachments[2].(map[string]any)["actions"].([]any)[0].(map[string]any)
+		assert.Equal(t, "Decide several...", bulk["name"])
+		assert.Equal(t, bulkURL, bulk["integration"].(map[string]any)["url"])
+	})
+
+	t.Run("navigation keeps the scope", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleApprover, inboxRecords(3))
+		router := NewRouter(&plugintest.API{}, store)
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 2})
+		require.NoError(t, err)
+		assert.Contains(t, post.Message, "## Requests Sent to You (pending, page 1)")
+		assert.Contains(t, post.Message, "Newer requests: **Next ▶** or `/approve list inbox pending --page 2 --limit 2`")
+
+		attachments := post.Props["attachments"].([]any)
+		require.Len(t, attachments, 1, "no row buttons without a signer")
+		actions := attachments[0].(map[string]any)["actions"].([]any)
+		assert.Equal(t, map[string]any{
+			"scope": "inbox", "filter": "pending", "limit": 2, "page": 2, "after": "8295103000999:record01",
+		}, actions[0].(map[string]any)["integration"].(map[string]any)["context"])
+	})
+
+	t.Run("empty inbox and outbox", func(t *testing.T) {
+		store := &mockStore{}
+		mockUserRoleIndex(store, "user123", approval.IndexRoleApprover, nil)
+		mockUserRoleIndex(store, "user123", approval.IndexRoleRequester, nil)
+		router := NewRouter(&plugintest.API{}, store)
+
+		post, err := router.ListPagePost("user123", "channel123", ListQuery{Scope: "inbox", Filter: "pending", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Equal(t, "No pending requests were sent to you. Use `/approve list outbox` to see the requests you sent.", post.Message)
+
+		post, err = router.ListPagePost("user123", "channel123", ListQuery{Scope: "outbox", Filter: "denied", Page: 1, Limit: 20})
+		require.NoError(t, err)
+		assert.Equal(t, "You sent no denied approval requests. Use `/approve list outbox all` to see all of them.", post.Message)
+	})
+}
+
+func TestExecuteList_Paging(t *testing.T) {
+	api := &plugintest.API{}
+	store := &mockStore{}
+	router := NewRouter(api, store)
+	mockUserIndex(store, "user123", listRecords(25))
+
+	var capturedPost *model.Post
+	api.On("SendEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+		capturedPost = post
+		return true
+	})).Return(&model.Post{})
+
+	resp, err := router.Route(&model.CommandArgs{Command: "/approve list all --page 2 --limit 5", UserId: "user123", ChannelId: "channel123"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Text)
+	require.NotNil(t, capturedPost)
+	assert.Contains(t, capturedPost.Message, "## Your Approval Requests (all, page 2)")
+	assert.Contains(t, capturedPost.Message, "A-REC05")
+	assert.Contains(t, capturedPost.Message, "A-REC09")
+	assert.NotContains(t, capturedPost.Message, "A-REC10")
+	assert.Contains(t, capturedPost.Message, "`/approve list all --page 3 --limit 5`")
+	assert.NotNil(t, capturedPost.Props["attachments"])
+
+	resp, err = router.Route(&model.CommandArgs{Command: "/approve list --limit 100", UserId: "user123", ChannelId: "channel123"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Text)
+	assert.Equal(t, "Invalid limit '100'. Use a number from 1 to 50.", capturedPost.Message)
+}
+
+func TestExecuteList_Scopes(t *testing.T) {
+	api := &plugintest.API{}
+	store := &mockStore{}
+	router := NewRouter(api, store)
+	mockUserRoleIndex(store, "user123", approval.IndexRoleRequester, listRecords(2))
+
+	var capturedPost *model.Post
+	api.On("SendEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+		capturedPost = post
+		return true
+	})).Return(&model.Post{})
+
+	_, err := router.Route(&model.CommandArgs{Command: "/approve list outbox all", UserId: "user123", ChannelId: "channel123"})
+	require.NoError(t, err)
+	require.NotNil(t, capturedPost)
+	assert.Contains(t, capturedPost.Message, "## Requests You Sent (2 all)")
+	store.AssertCalled(t, "GetUserApprovalIndex", "user123", approval.IndexRoleRequester)
+}
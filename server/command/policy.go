@@ -0,0 +1,191 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// executeAdminPolicy handles /approve admin policy list|set|delete
+func (r *Router) executeAdminPolicy(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	action := ""
+	if len(subargs) > 0 {
+		action = subargs[0]
+	}
+
+	var text string
+	switch action {
+	case "list":
+		text = r.listPolicies()
+	case "set":
+		scope, rules, err := parsePolicyScope(args.TeamId, subargs[1:], r.locale)
+		if err != nil {
+			text = fmt.Sprintf("❌ %s\n\n%s", err.Error(), i18n.T(r.locale, "policy.usage"))
+		} else {
+			text = r.setPolicy(args.UserId, scope, rules)
+		}
+	case "delete":
+		scope, rules, err := parsePolicyScope(args.TeamId, subargs[1:], r.locale)
+		if err != nil || len(rules) > 0 {
+			text = i18n.T(r.locale, "policy.delete_usage")
+		} else {
+			text = r.deletePolicy(scope)
+		}
+	default:
+		text = i18n.T(r.locale, "policy.usage")
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         text,
+	}, nil
+}
+
+// parsePolicyScope extracts the policy scope ("team" or "template <name>") and the remaining rule arguments
+func parsePolicyScope(teamID string, subargs []string, locale string) (string, []string, error) {
+	if len(subargs) == 0 {
+		return "", nil, errors.New(i18n.T(locale, "policy.scope_required"))
+	}
+
+	switch subargs[0] {
+	case approval.PolicyScopeTeam:
+		if teamID == "" {
+			return "", nil, errors.New(i18n.T(locale, "policy.team_channel_required"))
+		}
+		return approval.TeamPolicyScope(teamID), subargs[1:], nil
+	case approval.PolicyScopeTemplate:
+		if len(subargs) < 2 {
+			return "", nil, errors.New(i18n.T(locale, "policy.template_name_required"))
+		}
+		return approval.TemplatePolicyScope(strings.ToLower(subargs[1])), subargs[2:], nil
+	default:
+		return "", nil, errors.New(i18n.T(locale, "policy.invalid_scope", subargs[0]))
+	}
+}
+
+// setPolicy parses users=/groups=/roles= rules, resolves usernames and saves the policy
+func (r *Router) setPolicy(userID, scope string, rules []string) string {
+	if len(rules) == 0 {
+		return i18n.T(r.locale, "policy.rule_required") + "\n\n" + i18n.T(r.locale, "policy.usage")
+	}
+
+	policy := &approval.ApproverPolicy{Scope: scope}
+	for _, rule := range rules {
+		key, value, found := strings.Cut(rule, "=")
+		if !found || value == "" {
+			return i18n.T(r.locale, "policy.invalid_rule", rule)
+		}
+
+		values := splitPolicyValues(value)
+		switch strings.ToLower(key) {
+		case "users":
+			for _, username := range values {
+				username = strings.TrimPrefix(username, "@")
+				user, appErr := r.api.GetUserByUsername(username)
+				if appErr != nil {
+					return i18n.T(r.locale, "policy.user_not_found", username)
+				}
+				if !slices.Contains(policy.UserIDs, user.Id) {
+					policy.UserIDs = append(policy.UserIDs, user.Id)
+				}
+			}
+		case "groups":
+			for _, name := range values {
+				if _, appErr := r.api.GetGroupByName(name); appErr != nil {
+					return i18n.T(r.locale, "policy.group_not_found", name)
+				}
+				policy.Groups = append(policy.Groups, name)
+			}
+		case "roles":
+			policy.Roles = append(policy.Roles, values...)
+		default:
+			return i18n.T(r.locale, "policy.invalid_rule", rule)
+		}
+	}
+
+	if err := approval.ValidatePolicy(policy); err != nil {
+		return i18n.T(r.locale, "policy.invalid", err.Error())
+	}
+
+	policy.UpdatedBy = userID
+	policy.UpdatedAt = model.GetMillis()
+
+	if err := r.store.SavePolicy(policy); err != nil {
+		r.api.LogError("Failed to save approver policy", "scope", scope, "error", err.Error())
+		return i18n.T(r.locale, "policy.save_failed")
+	}
+
+	r.api.LogInfo("Approver policy saved", "scope", scope, "user_id", userID)
+
+	return i18n.T(r.locale, "policy.saved", r.formatPolicyScope(scope), approval.DescribePolicyRules(policy, r.api, r.locale))
+}
+
+// deletePolicy removes the approver policy for a scope
+func (r *Router) deletePolicy(scope string) string {
+	if _, err := r.store.GetPolicy(scope); err != nil {
+		if errors.Is(err, approval.ErrPolicyNotFound) {
+			return i18n.T(r.locale, "policy.not_found", r.formatPolicyScope(scope))
+		}
+		r.api.LogError("Failed to load approver policy for deletion", "scope", scope, "error", err.Error())
+		return i18n.T(r.locale, "policy.delete_failed")
+	}
+
+	if err := r.store.DeletePolicy(scope); err != nil {
+		r.api.LogError("Failed to delete approver policy", "scope", scope, "error", err.Error())
+		return i18n.T(r.locale, "policy.delete_failed")
+	}
+
+	r.api.LogInfo("Approver policy deleted", "scope", scope)
+
+	return i18n.T(r.locale, "policy.deleted", r.formatPolicyScope(scope))
+}
+
+// listPolicies formats all approver policies for display
+func (r *Router) listPolicies() string {
+	policies, err := r.store.ListPolicies()
+	if err != nil {
+		r.api.LogError("Failed to list approver policies", "error", err.Error())
+		return i18n.T(r.locale, "policy.list_failed")
+	}
+
+	if len(policies) == 0 {
+		return i18n.T(r.locale, "policy.none")
+	}
+
+	var output strings.Builder
+	output.WriteString(i18n.T(r.locale, "policy.list_header", len(policies)))
+	for _, policy := range policies {
+		output.WriteString(fmt.Sprintf("| %s | %s |\n", r.formatPolicyScope(policy.Scope), approval.DescribePolicyRules(policy, r.api, r.locale)))
+	}
+
+	return output.String()
+}
+
+// formatPolicyScope renders a policy scope for display, resolving team names where possible
+func (r *Router) formatPolicyScope(scope string) string {
+	kind, target, _ := strings.Cut(scope, ":")
+	if kind == approval.PolicyScopeTemplate {
+		return i18n.T(r.locale, "policy.scope_template", target)
+	}
+
+	if team, appErr := r.api.GetTeam(target); appErr == nil {
+		return i18n.T(r.locale, "policy.scope_team", team.DisplayName)
+	}
+	return i18n.T(r.locale, "policy.scope_team_id", target)
+}
+
+// splitPolicyValues splits a comma-separated rule value, dropping empty entries
+func splitPolicyValues(value string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
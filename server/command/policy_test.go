@@ -0,0 +1,132 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteAdminPolicy(t *testing.T) {
+	const teamID = "teamaaaaaaaaaaaaaaaaaaaaaa"
+	const aliceID = "aliceaaaaaaaaaaaaaaaaaaaaa"
+	teamScope := approval.TeamPolicyScope(teamID)
+
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		api.On("GetUser", aliceID).Return(&model.User{Id: aliceID, Username: "alice"}, nil).Maybe()
+		api.On("GetTeam", teamID).Return(&model.Team{Id: teamID, DisplayName: "Engineering"}, nil).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api, store, NewRouter(api, store)
+	}
+
+	route := func(router *Router, command string) string {
+		resp, err := router.Route(&model.CommandArgs{Command: command, UserId: "admin123", TeamId: teamID})
+		assert.NoError(t, err)
+		return resp.Text
+	}
+
+	t.Run("missing subcommand shows usage", func(t *testing.T) {
+		_, _, router := setup()
+
+		assert.Contains(t, route(router, "/approve admin policy"), "/approve admin policy set team <rules>")
+	})
+
+	t.Run("set team policy resolves users and validates groups", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUserByUsername", "alice").Return(&model.User{Id: aliceID, Username: "alice"}, nil)
+		api.On("GetGroupByName", "sre").Return(&model.Group{Id: "group1"}, nil)
+		store.On("SavePolicy", mock.MatchedBy(func(policy *approval.ApproverPolicy) bool {
+			return policy.Scope == teamScope &&
+				assert.Equal(t, []string{aliceID}, policy.UserIDs) &&
+				assert.Equal(t, []string{"sre"}, policy.Groups) &&
+				assert.Equal(t, []string{"team_admin", "channel_admin"}, policy.Roles) &&
+				policy.UpdatedBy == "admin123"
+		})).Return(nil)
+
+		text := route(router, "/approve admin policy set team users=@alice groups=sre roles=team_admin,channel_admin")
+
+		assert.Contains(t, text, "✅ Approver policy for team Engineering saved")
+		assert.Contains(t, text, "@alice, group sre, role team_admin, role channel_admin")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("set template policy uses template scope", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("SavePolicy", mock.MatchedBy(func(policy *approval.ApproverPolicy) bool {
+			return policy.Scope == "template:prod-access"
+		})).Return(nil)
+
+		text := route(router, "/approve admin policy set template Prod-Access roles=team_admin")
+
+		assert.Contains(t, text, "✅ Approver policy for template `prod-access` saved")
+	})
+
+	t.Run("unknown user is rejected", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUserByUsername", "ghost").Return(nil, &model.AppError{Message: "not found"})
+
+		text := route(router, "/approve admin policy set team users=@ghost")
+
+		assert.Contains(t, text, "❌ Invalid rule: user @ghost not found.")
+		store.AssertNotCalled(t, "SavePolicy", mock.Anything)
+	})
+
+	t.Run("malformed rule is rejected", func(t *testing.T) {
+		_, store, router := setup()
+
+		text := route(router, "/approve admin policy set team admins=bob")
+
+		assert.Contains(t, text, "❌ Invalid rule 'admins=bob'")
+		store.AssertNotCalled(t, "SavePolicy", mock.Anything)
+	})
+
+	t.Run("set without rules is rejected", func(t *testing.T) {
+		_, store, router := setup()
+
+		assert.Contains(t, route(router, "/approve admin policy set team"), "❌ At least one rule is required.")
+		store.AssertNotCalled(t, "SavePolicy", mock.Anything)
+	})
+
+	t.Run("delete removes existing policy", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetPolicy", teamScope).Return(&approval.ApproverPolicy{Scope: teamScope}, nil)
+		store.On("DeletePolicy", teamScope).Return(nil)
+
+		text := route(router, "/approve admin policy delete team")
+
+		assert.Contains(t, text, "✅ Approver policy for team Engineering deleted.")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("delete reports missing policy", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetPolicy", "template:deploy").Return(nil, fmt.Errorf("approver policy template:deploy: %w", approval.ErrPolicyNotFound))
+
+		text := route(router, "/approve admin policy delete template deploy")
+
+		assert.Contains(t, text, "❌ No approver policy defined for template `deploy`.")
+		store.AssertNotCalled(t, "DeletePolicy", mock.Anything)
+	})
+
+	t.Run("list shows policies as table", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("ListPolicies").Return([]*approval.ApproverPolicy{
+			{Scope: teamScope, UserIDs: []string{aliceID}},
+			{Scope: "template:deploy", Groups: []string{"sre"}},
+		}, nil)
+
+		text := route(router, "/approve admin policy list")
+
+		assert.Contains(t, text, "Approver Policies (2)")
+		assert.Contains(t, text, "| team Engineering | @alice |")
+		assert.Contains(t, text, "| template `deploy` | group sre |")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 )
@@ -17,13 +18,39 @@ import (
 type Storer interface {
 	GetAllApprovals() ([]*approval.ApprovalRecord, error)
 	GetUserApprovals(userID string) ([]*approval.ApprovalRecord, error)
+	GetUserApprovalIndex(userID, role string) ([]approval.IndexEntry, error)
+	GetApproval(id string) (*approval.ApprovalRecord, error)
 	GetApprovalByCode(code string) (*approval.ApprovalRecord, error)
+	SearchApprovals(userID string, query approval.SearchQuery) ([]*approval.ApprovalRecord, error)
+	GetTemplate(name string) (*approval.RequestTemplate, error)
+	ListTemplates() ([]*approval.RequestTemplate, error)
+	SaveTemplate(tmpl *approval.RequestTemplate) error
+	DeleteTemplate(name string) error
+	GetPolicy(scope string) (*approval.ApproverPolicy, error)
+	ListPolicies() ([]*approval.ApproverPolicy, error)
+	SavePolicy(policy *approval.ApproverPolicy) error
+	DeletePolicy(scope string) error
+	GetSLATarget(scope string) (*approval.SLATarget, error)
+	ListSLATargets() ([]*approval.SLATarget, error)
+	SaveSLATarget(target *approval.SLATarget) error
+	DeleteSLATarget(scope string) error
+	GetSoDViolations() ([]*approval.SoDViolation, error)
+	GetDigestSubscription(userID string) (bool, error)
+	SetDigestSubscription(userID string, enabled bool) error
+}
+
+// ActionSigner signs the contexts of Approve/Deny buttons for the action endpoint
+type ActionSigner interface {
+	SignedContext(approvalID, action string, now time.Time) map[string]any
 }
 
 // Router routes slash command invocations to appropriate handlers
 type Router struct {
-	api   plugin.API
-	store Storer
+	api      plugin.API
+	store    Storer
+	signer   ActionSigner // Signs the inbox Approve/Deny buttons; nil lists the inbox without buttons
+	locale   string       // Mattermost locale of the invoking user; empty uses the default locale
+	timezone string       // Mattermost timezone of the invoking user; empty uses UTC
 }
 
 // NewRouter creates a new command router
@@ -34,13 +61,28 @@ func NewRouter(api plugin.API, store Storer) *Router {
 	}
 }
 
+// SetLocale sets the locale responses are rendered in (the invoking user's Mattermost locale)
+func (r *Router) SetLocale(locale string) {
+	r.locale = locale
+}
+
+// SetTimezone sets the timezone timestamps are rendered in (the invoking user's Mattermost timezone)
+func (r *Router) SetTimezone(timezone string) {
+	r.timezone = timezone
+}
+
+// SetActionSigner sets the signer of the Approve/Deny buttons shown in /approve list inbox
+func (r *Router) SetActionSigner(signer ActionSigner) {
+	r.signer = signer
+}
+
 // Route determines which handler should process the command
 func (r *Router) Route(args *model.CommandArgs) (*model.CommandResponse, error) {
 	split := strings.Fields(args.Command)
 	if len(split) < 1 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Invalid command format.",
+			Text:         i18n.T(r.locale, "command.invalid_format"),
 		}, nil
 	}
 
@@ -53,68 +95,43 @@ func (r *Router) Route(args *model.CommandArgs) (*model.CommandResponse, error)
 	// Route to appropriate handler
 	switch subcommand {
 	case "":
-		return executeHelp(), nil
+		return executeHelp(r.locale), nil
 	case "help":
-		return executeHelp(), nil
+		return executeHelp(r.locale), nil
 	case "new":
 		return r.executeNew(args)
 	case "list":
 		return r.executeList(args)
+	case "search":
+		return r.executeSearch(args, split[2:])
 	case "get":
 		return r.executeGet(args)
 	case "status":
 		return r.executeStatus(args, split[2:])
+	case "stats":
+		return r.executeStats(args, split[2:])
+	case "admin":
+		return r.executeAdmin(args, split[2:])
+	case "digest":
+		return r.executeDigest(args, split[2:])
 	default:
-		return executeUnknown(subcommand), nil
+		return executeUnknown(subcommand, r.locale), nil
 	}
 }
 
 // executeHelp returns help text
-func executeHelp() *model.CommandResponse {
-	helpText := `### Mattermost Approval Workflow
-
-**Available Commands:**
-
-* **/approve new** - Create a new approval request
-* **/approve list [filter]** - View your approval requests and decisions
-  * No filter: shows pending requests (default)
-  * **pending** - pending approval requests
-  * **approved** - approved requests
-  * **denied** - denied requests
-  * **canceled** - canceled requests
-  * **all** - all requests (pending, approved, denied, canceled)
-* **/approve get [ID]** - View a specific approval by ID
-* **/approve cancel <APPROVAL_ID>** - Cancel a pending approval request
-* **/approve verify <APPROVAL_CODE> [comment]** - Mark an approved request as verified/complete
-* **/approve status** - View approval system statistics (admin only)
-* **/approve help** - Display this help text
-
-**Admin Commands:**
-
-* **/approve status** - View overall approval statistics and notification health
-* **/approve status --failed-notifications** - List specific approvals with failed notifications
-
-**Examples:**
-` + "`/approve new`" + ` - Opens a modal to create an approval request
-` + "`/approve list`" + ` - Shows pending approval requests
-` + "`/approve list approved`" + ` - Shows approved requests
-` + "`/approve list all`" + ` - Shows all requests
-
-For more information, visit the plugin documentation.`
-
+func executeHelp(locale string) *model.CommandResponse {
 	return &model.CommandResponse{
 		ResponseType: model.CommandResponseTypeEphemeral,
-		Text:         helpText,
+		Text:         i18n.T(locale, "command.help"),
 	}
 }
 
 // executeUnknown returns error for unrecognized commands
-func executeUnknown(subcommand string) *model.CommandResponse {
-	errorText := fmt.Sprintf("Unknown command: **%s**\n\nValid commands: `new`, `list`, `get`, `cancel`, `verify`, `status`, `help`\n\nType `/approve help` for more information.", subcommand)
-
+func executeUnknown(subcommand, locale string) *model.CommandResponse {
 	return &model.CommandResponse{
 		ResponseType: model.CommandResponseTypeEphemeral,
-		Text:         errorText,
+		Text:         i18n.T(locale, "command.unknown", subcommand),
 	}
 }
 
@@ -124,7 +141,7 @@ func (r *Router) executeNew(args *model.CommandArgs) (*model.CommandResponse, er
 	if args.TriggerId == "" {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Missing trigger ID. Please try the command again.",
+			Text:         i18n.T(r.locale, "new.missing_trigger"),
 		}, nil
 	}
 
@@ -134,36 +151,59 @@ func (r *Router) executeNew(args *model.CommandArgs) (*model.CommandResponse, er
 		r.api.LogError("Site URL not configured", "site_url", "empty or nil", "command", "approve new")
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Plugin configuration error. Please contact your system administrator.",
+			Text:         i18n.T(r.locale, "new.config_error"),
 		}, nil
 	}
 
 	callbackURL := fmt.Sprintf("%s/plugins/com.mattermost.plugin-approver2/dialog/submit", *siteURL)
 
+	// Optional request template and approver group: /approve new [template] [--group <name> | --role <role>]
+	state, err := parseNewArgs(strings.Fields(args.Command))
+	if err != nil {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "new.invalid_args", err.Error()),
+		}, nil
+	}
+
+	var tmpl *approval.RequestTemplate
+	if state.Template != "" {
+		found, err := r.store.GetTemplate(state.Template)
+		if err != nil {
+			if errors.Is(err, approval.ErrTemplateNotFound) {
+				return &model.CommandResponse{
+					ResponseType: model.CommandResponseTypeEphemeral,
+					Text:         r.formatTemplateNotFound(state.Template),
+				}, nil
+			}
+
+			r.api.LogError("Failed to load request template", "template", state.Template, "error", err.Error())
+			return &model.CommandResponse{
+				ResponseType: model.CommandResponseTypeEphemeral,
+				Text:         i18n.T(r.locale, "new.template_failed"),
+			}, nil
+		}
+		tmpl = found
+	}
+
+	// Check the group exists before opening the dialog; members are resolved on submission
+	if state.ApproverGroup != "" {
+		if _, appErr := r.api.GetGroupByName(state.ApproverGroup); appErr != nil {
+			return &model.CommandResponse{
+				ResponseType: model.CommandResponseTypeEphemeral,
+				Text:         i18n.T(r.locale, "new.group_not_found", state.ApproverGroup),
+			}, nil
+		}
+	}
+
+	// The submission handler renders its responses in the requester's locale
+	state.Locale = r.locale
+
 	// Define the dialog structure
 	dialog := model.OpenDialogRequest{
 		TriggerId: args.TriggerId,
 		URL:       callbackURL,
-		Dialog: model.Dialog{
-			Title:       "Create Approval Request",
-			SubmitLabel: "Submit Request",
-			CallbackId:  "approve_new",
-			Elements: []model.DialogElement{
-				{
-					DisplayName: "Select approver *",
-					Name:        "approver",
-					Type:        "select",
-					DataSource:  "users",
-				},
-				{
-					DisplayName: "What needs approval? *",
-					Name:        "description",
-					Type:        "textarea",
-					Placeholder: "Describe the action requiring approval",
-					MaxLength:   1000,
-				},
-			},
-		},
+		Dialog:    r.buildNewRequestDialog(tmpl, state),
 	}
 
 	// Open the interactive dialog
@@ -171,7 +211,7 @@ func (r *Router) executeNew(args *model.CommandArgs) (*model.CommandResponse, er
 		r.api.LogError("Failed to open interactive dialog", "error", appErr.Error())
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Failed to open approval request form. Please try again.",
+			Text:         i18n.T(r.locale, "new.dialog_failed"),
 		}, nil
 	}
 
@@ -181,26 +221,229 @@ func (r *Router) executeNew(args *model.CommandArgs) (*model.CommandResponse, er
 	}, nil
 }
 
+// buildNewRequestDialog builds the create-request dialog, adding template fields when provided.
+// The template name and approver group are carried in the dialog State so the submission can be
+// validated against them. Group and role requests have no approver element.
+func (r *Router) buildNewRequestDialog(tmpl *approval.RequestTemplate, state DialogState) model.Dialog {
+	dialog := model.Dialog{
+		Title:       i18n.T(r.locale, "new.dialog.title"),
+		SubmitLabel: i18n.T(r.locale, "new.dialog.submit"),
+		CallbackId:  "approve_new",
+		State:       state.Encode(),
+		Elements: []model.DialogElement{
+			{
+				DisplayName: i18n.T(r.locale, "new.dialog.description"),
+				Name:        "description",
+				Type:        "textarea",
+				Placeholder: i18n.T(r.locale, "new.dialog.description_placeholder"),
+				MaxLength:   1000,
+			},
+		},
+	}
+
+	var intro []string
+	switch {
+	case state.ApproverGroup != "":
+		intro = append(intro, i18n.T(r.locale, "new.dialog.group_intro", state.ApproverGroup))
+	case state.ApproverRole != "":
+		intro = append(intro, i18n.T(r.locale, "new.dialog.role_intro", state.ApproverRole))
+	default:
+		dialog.Elements = append([]model.DialogElement{{
+			DisplayName: i18n.T(r.locale, "new.dialog.approver"),
+			Name:        "approver",
+			Type:        "select",
+			DataSource:  "users",
+		}}, dialog.Elements...)
+	}
+
+	if tmpl == nil {
+		dialog.IntroductionText = strings.Join(intro, "\n\n")
+		dialog.Elements = append(dialog.Elements, visibilityElement(r.locale), sendAtElement(r.locale, r.timezone))
+		return dialog
+	}
+
+	displayName := tmpl.DisplayName
+	if displayName == "" {
+		displayName = tmpl.Name
+	}
+	intro = append([]string{i18n.T(r.locale, "new.dialog.template_intro", displayName)}, intro...)
+	if tmpl.Description != "" {
+		intro = append(intro, tmpl.Description)
+	}
+	dialog.IntroductionText = strings.Join(intro, "\n\n")
+
+	// Suggest default approvers: first resolvable user becomes the dialog default
+	if len(tmpl.DefaultApprovers) > 0 && !state.IsGroupApproval() {
+		approverElement := &dialog.Elements[0]
+		approverElement.HelpText = i18n.T(r.locale, "new.dialog.suggested", strings.Join(tmpl.DefaultApprovers, ", @"))
+		for _, username := range tmpl.DefaultApprovers {
+			user, appErr := r.api.GetUserByUsername(username)
+			if appErr != nil {
+				r.api.LogWarn("Template default approver not found", "template", tmpl.Name, "username", username)
+				continue
+			}
+			approverElement.Default = user.Id
+			break
+		}
+	}
+
+	for _, field := range tmpl.Fields {
+		dialog.Elements = append(dialog.Elements, customFieldElement(field))
+	}
+	dialog.Elements = append(dialog.Elements, visibilityElement(r.locale), sendAtElement(r.locale, r.timezone))
+
+	return dialog
+}
+
+// visibilityElement lets the requester also post a status card to the originating channel
+func visibilityElement(locale string) model.DialogElement {
+	return model.DialogElement{
+		DisplayName: i18n.T(locale, "new.dialog.visibility"),
+		Name:        "visibility",
+		Type:        "select",
+		Optional:    true,
+		Default:     approval.VisibilityDirect,
+		HelpText:    i18n.T(locale, "new.dialog.visibility_help"),
+		Options: []*model.PostActionOptions{
+			{Text: i18n.T(locale, "new.dialog.visibility_dm"), Value: approval.VisibilityDirect},
+			{Text: i18n.T(locale, "new.dialog.visibility_channel"), Value: approval.VisibilityChannel},
+			{Text: i18n.T(locale, "new.dialog.visibility_private"), Value: approval.VisibilityChannelPrivate},
+		},
+	}
+}
+
+// sendAtElement lets the requester schedule the request for later, in their own timezone
+func sendAtElement(locale, timezone string) model.DialogElement {
+	return model.DialogElement{
+		DisplayName: i18n.T(locale, "new.dialog.send_at"),
+		Name:        "send_at",
+		Type:        "text",
+		Optional:    true,
+		Placeholder: "YYYY-MM-DD HH:MM",
+		MaxLength:   len(approval.SendAtLayout),
+		HelpText:    i18n.T(locale, "new.dialog.send_at_help", i18n.Location(timezone).String()),
+	}
+}
+
+// parseNewArgs parses "/approve new [template] [--group <name> | --role <role>]"
+func parseNewArgs(fields []string) (DialogState, error) {
+	var state DialogState
+	if len(fields) <= 2 {
+		return state, nil
+	}
+
+	args := fields[2:]
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--group", "--role":
+			if i+1 >= len(args) {
+				return DialogState{}, fmt.Errorf("%s requires a value", arg)
+			}
+			if state.IsGroupApproval() {
+				return DialogState{}, fmt.Errorf("use either --group or --role, not both")
+			}
+			i++
+			if arg == "--group" {
+				state.ApproverGroup = strings.TrimPrefix(args[i], "@")
+			} else {
+				state.ApproverRole = strings.ToLower(args[i])
+				if !approval.IsValidRole(state.ApproverRole) {
+					return DialogState{}, fmt.Errorf("invalid role '%s'", args[i])
+				}
+			}
+		default:
+			if strings.HasPrefix(arg, "--") {
+				return DialogState{}, fmt.Errorf("unknown option %s", arg)
+			}
+			if state.Template != "" {
+				return DialogState{}, fmt.Errorf("unexpected argument '%s'", arg)
+			}
+			state.Template = strings.ToLower(arg)
+		}
+	}
+
+	return state, nil
+}
+
+// customFieldElement converts a template custom field into a dialog element
+func customFieldElement(field approval.CustomField) model.DialogElement {
+	element := model.DialogElement{
+		DisplayName: field.Label,
+		Name:        approval.CustomFieldElementPrefix + field.Name,
+		Optional:    !field.Required,
+		HelpText:    field.HelpText,
+	}
+	if field.Required {
+		element.DisplayName += " *"
+	}
+
+	switch field.Type {
+	case approval.FieldTypeSelect:
+		element.Type = "select"
+		for _, option := range field.Options {
+			element.Options = append(element.Options, &model.PostActionOptions{Text: option, Value: option})
+		}
+	case approval.FieldTypeNumber:
+		element.Type = "text"
+		element.SubType = "number"
+	case approval.FieldTypeDate:
+		element.Type = "text"
+		element.Placeholder = "YYYY-MM-DD"
+		element.MaxLength = len(approval.CustomFieldDateLayout)
+	default:
+		element.Type = "text"
+		element.MaxLength = approval.MaxCustomFieldValueLength
+	}
+
+	return element
+}
+
+// formatTemplateNotFound builds the error shown for an unknown template, listing available ones
+func (r *Router) formatTemplateNotFound(name string) string {
+	message := i18n.T(r.locale, "new.template_not_found", name)
+
+	templates, err := r.store.ListTemplates()
+	if err != nil {
+		r.api.LogWarn("Failed to list request templates", "error", err.Error())
+		return message
+	}
+
+	if len(templates) == 0 {
+		return message + i18n.T(r.locale, "new.no_templates")
+	}
+
+	names := make([]string, 0, len(templates))
+	for _, tmpl := range templates {
+		names = append(names, "`"+tmpl.Name+"`")
+	}
+	return message + i18n.T(r.locale, "new.available_templates", strings.Join(names, ", "))
+}
+
 // executeStatus displays approval system statistics (admin only)
 func (r *Router) executeStatus(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
 	// Check if user is system admin
-	user, appErr := r.api.GetUser(args.UserId)
-	if appErr != nil {
-		r.api.LogError("Failed to get user for status command", "user_id", args.UserId, "error", appErr.Error())
+	isAdmin, err := r.isSystemAdmin(args.UserId)
+	if err != nil {
+		r.api.LogError("Failed to get user for status command", "user_id", args.UserId, "error", err.Error())
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Failed to verify permissions. Please try again.",
+			Text:         i18n.T(r.locale, "command.permission_check_failed"),
 		}, nil
 	}
 
-	// Check if user has system admin role (exact match to prevent bypass)
-	// Security: Split roles by space and check for exact "system_admin" match
-	// to prevent bypass attacks like "fake_system_admin" or "not_system_admin"
-	roles := strings.Fields(user.Roles)
-	if !slices.Contains(roles, "system_admin") {
+	if !isAdmin {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Permission denied. Only system administrators can view approval statistics.",
+			Text:         i18n.T(r.locale, "status.permission_denied"),
+		}, nil
+	}
+
+	// Separation-of-duties violations are reviewed separately with --sod
+	if slices.Contains(subargs, "--sod") {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         r.formatSoDViolations(),
 		}, nil
 	}
 
@@ -210,7 +453,7 @@ func (r *Router) executeStatus(args *model.CommandArgs, subargs []string) (*mode
 		r.api.LogError("Failed to retrieve approval records for status command", "error", err.Error())
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Failed to retrieve approval statistics. Please try again.",
+			Text:         i18n.T(r.locale, "status.failed"),
 		}, nil
 	}
 
@@ -218,14 +461,14 @@ func (r *Router) executeStatus(args *model.CommandArgs, subargs []string) (*mode
 	showFailedOnly := slices.Contains(subargs, "--failed-notifications")
 
 	// Calculate statistics
-	stats := calculateStatistics(records)
+	stats := approval.CalculateStatistics(records)
 
 	// Format response based on flag
 	var responseText string
 	if showFailedOnly {
 		responseText = formatFailedNotifications(records, stats)
 	} else {
-		responseText = formatStatusResponse(stats)
+		responseText = formatStatusResponse(stats) + formatSLASummary(stats) + r.formatSoDSummary()
 	}
 
 	return &model.CommandResponse{
@@ -234,53 +477,8 @@ func (r *Router) executeStatus(args *model.CommandArgs, subargs []string) (*mode
 	}, nil
 }
 
-// ApprovalStats holds statistics about the approval system
-type ApprovalStats struct {
-	TotalApprovals              int
-	PendingApprovals            int
-	ApprovedApprovals           int
-	DeniedApprovals             int
-	CanceledApprovals           int
-	FailedApproverNotifications int
-	FailedOutcomeNotifications  int
-}
-
-// calculateStatistics computes statistics from approval records
-func calculateStatistics(records []*approval.ApprovalRecord) ApprovalStats {
-	stats := ApprovalStats{
-		TotalApprovals: len(records),
-	}
-
-	for _, record := range records {
-		switch record.Status {
-		case approval.StatusPending:
-			stats.PendingApprovals++
-			// Count failed approver notifications only for pending approvals
-			if !record.NotificationSent {
-				stats.FailedApproverNotifications++
-			}
-		case approval.StatusApproved:
-			stats.ApprovedApprovals++
-			// Count failed outcome notifications for completed approvals
-			if !record.OutcomeNotified {
-				stats.FailedOutcomeNotifications++
-			}
-		case approval.StatusDenied:
-			stats.DeniedApprovals++
-			// Count failed outcome notifications for completed approvals
-			if !record.OutcomeNotified {
-				stats.FailedOutcomeNotifications++
-			}
-		case approval.StatusCanceled:
-			stats.CanceledApprovals++
-		}
-	}
-
-	return stats
-}
-
 // formatStatusResponse formats the statistics into a user-friendly message
-func formatStatusResponse(stats ApprovalStats) string {
+func formatStatusResponse(stats approval.ApprovalStats) string {
 	if stats.TotalApprovals == 0 {
 		return "📊 **Approval System Status**\n\nNo approvals in the system yet."
 	}
@@ -334,8 +532,105 @@ func formatStatusResponse(stats ApprovalStats) string {
 	)
 }
 
+// formatSLASummary returns a status section with SLA breach counts, or an empty string if no
+// request has an SLA outcome
+func formatSLASummary(stats approval.ApprovalStats) string {
+	if stats.SLATracked == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\n**SLA Compliance:**\n"+
+		"- Tracked Requests: %d\n"+
+		"- ✅ Met: %d\n"+
+		"- 🚨 Breached: %d (%d still pending)\n"+
+		"- Use `/approve admin sla list` to review SLA targets",
+		stats.SLATracked, stats.SLAMet, stats.SLABreached, stats.SLABreachedPending)
+}
+
+// formatSoDSummary returns a status section summarizing blocked separation-of-duties violations,
+// or an empty string if there are none (or they cannot be loaded)
+func (r *Router) formatSoDSummary() string {
+	violations, err := r.store.GetSoDViolations()
+	if err != nil {
+		r.api.LogError("Failed to retrieve separation of duties violations for status command", "error", err.Error())
+		return ""
+	}
+
+	if len(violations) == 0 {
+		return ""
+	}
+
+	selfApprovals := 0
+	for _, violation := range violations {
+		if violation.Rule == approval.SoDRuleSelfApproval {
+			selfApprovals++
+		}
+	}
+
+	return fmt.Sprintf("\n\n**Separation of Duties:**\n"+
+		"- 🛡️ Blocked Violations: %d (Self-approval: %d, Reciprocal: %d)\n"+
+		"- Use `/approve status --sod` to review recent violations",
+		len(violations), selfApprovals, len(violations)-selfApprovals)
+}
+
+// formatSoDViolations lists recent separation-of-duties violations for admin review
+func (r *Router) formatSoDViolations() string {
+	violations, err := r.store.GetSoDViolations()
+	if err != nil {
+		r.api.LogError("Failed to retrieve separation of duties violations", "error", err.Error())
+		return "❌ Failed to retrieve separation of duties violations. Please try again."
+	}
+
+	if len(violations) == 0 {
+		return "**🛡️ Separation of Duties Violations**\n\n✅ No violations recorded."
+	}
+
+	var message strings.Builder
+	message.WriteString("**🛡️ Separation of Duties Violations**\n\n")
+	message.WriteString("| Time (UTC) | Rule | User | Requester | Request | Related |\n")
+	message.WriteString("|------------|------|------|-----------|---------|---------|\n")
+	for i, violation := range violations {
+		if i >= 20 {
+			message.WriteString(fmt.Sprintf("\n... and %d more", len(violations)-20))
+			break
+		}
+
+		rule := "Reciprocal approval"
+		if violation.Rule == approval.SoDRuleSelfApproval {
+			rule = "Self-approval"
+		}
+
+		message.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n",
+			time.UnixMilli(violation.Timestamp).UTC().Format("2006-01-02 15:04"),
+			rule,
+			r.formatUserMention(violation.UserID),
+			r.formatUserMention(violation.RequesterID),
+			codeOrDash(violation.Code),
+			codeOrDash(violation.RelatedCode),
+		))
+	}
+
+	return message.String()
+}
+
+// formatUserMention renders a user ID as @username, falling back to the ID if the user cannot be loaded
+func (r *Router) formatUserMention(userID string) string {
+	if user, appErr := r.api.GetUser(userID); appErr == nil {
+		return "@" + user.Username
+	}
+	return userID
+}
+
+// codeOrDash renders an approval code in backticks, or "-" if empty
+func codeOrDash(code string) string {
+	if code == "" {
+		return "-"
+	}
+	return "`" + code + "`"
+}
+
 // formatFailedNotifications formats records with failed notifications
-func formatFailedNotifications(records []*approval.ApprovalRecord, stats ApprovalStats) string {
+func formatFailedNotifications(records []*approval.ApprovalRecord, stats approval.ApprovalStats) string {
 	if stats.FailedApproverNotifications == 0 && stats.FailedOutcomeNotifications == 0 {
 		return "**📋 Approvals with Failed Notifications**\n\n✅ No failed notifications found. All notifications delivered successfully!"
 	}
@@ -388,164 +683,51 @@ func formatFailedNotifications(records []*approval.ApprovalRecord, stats Approva
 	return message.String()
 }
 
-// executeList displays all approval records for the authenticated user
+// executeList displays one page of the authenticated user's approval records
 func (r *Router) executeList(args *model.CommandArgs) (*model.CommandResponse, error) {
-	// Parse filter parameter from command (Story 5.1)
-	// Example: "/approve list pending" -> filter = "pending"
+	// Parse filter and paging flags from command (Story 5.1)
+	// Example: "/approve list pending --page 2" -> filter = "pending", page 2
 	// Example: "/approve list" -> filter = "pending" (default, Story 5.2: changed to focus on actionable items)
 	parts := strings.Fields(args.Command)
-	filter := "pending" // Story 5.2: Changed default to focus on actionable items (was "all" in Story 5.1)
-
-	if len(parts) > 2 {
-		// Extract filter argument (parts[2] is the filter after "/approve list")
-		filter = strings.ToLower(strings.TrimSpace(parts[2]))
-
-		// Validate filter
-		validFilters := map[string]bool{
-			"pending":  true,
-			"approved": true,
-			"denied":   true,
-			"canceled": true,
-			"all":      true,
-		}
-
-		if !validFilters[filter] {
-			errorMsg := fmt.Sprintf("Invalid filter '%s'. Valid filters: pending, approved, denied, canceled, all", parts[2])
-			post := &model.Post{
-				UserId:    args.UserId,
-				ChannelId: args.ChannelId,
-				Message:   errorMsg,
-			}
-			ephemeralPost := r.api.SendEphemeralPost(args.UserId, post)
-			if ephemeralPost == nil {
-				// Fallback to CommandResponse if ephemeral post fails
-				return &model.CommandResponse{
-					ResponseType: model.CommandResponseTypeEphemeral,
-					Text:         errorMsg,
-				}, nil
-			}
-			return &model.CommandResponse{}, nil
-		}
+	query, err := parseListArgs(parts[2:], r.locale)
+	if err != nil {
+		return r.sendListMessage(args, &model.Post{Message: err.Error()}), nil
 	}
 
 	// Security: args.UserId is authenticated by Mattermost Plugin API
 	// The Mattermost server guarantees this ID matches the authenticated user session (NFR-S1)
-	// Access control: GetUserApprovals only returns records where this user is requester or approver (NFR-S2, FR37)
-	records, err := r.store.GetUserApprovals(args.UserId)
+	// Access control: the user's index only holds records where this user is requester or approver (NFR-S2, FR37)
+	post, err := r.ListPagePost(args.UserId, args.ChannelId, query)
 	if err != nil {
 		r.api.LogError("Failed to retrieve approval records for list command",
 			"user_id", args.UserId,
 			"error", err.Error(),
 		)
-		errorMsg := "❌ Failed to retrieve approval records. Please try again."
-		post := &model.Post{
-			UserId:    args.UserId,
-			ChannelId: args.ChannelId,
-			Message:   errorMsg,
-		}
-		ephemeralPost := r.api.SendEphemeralPost(args.UserId, post)
-		if ephemeralPost == nil {
-			// Fallback to CommandResponse if ephemeral post fails
-			return &model.CommandResponse{
-				ResponseType: model.CommandResponseTypeEphemeral,
-				Text:         errorMsg,
-			}, nil
-		}
-		return &model.CommandResponse{}, nil
-	}
-
-	// Apply status filter (Story 5.1)
-	filteredRecords := filterRecordsByStatus(records, filter)
-
-	// Handle empty state (Story 5.2: Filter-specific message)
-	if len(filteredRecords) == 0 {
-		emptyMessage := fmt.Sprintf("No %s approval requests. Use `/approve list all` to see all requests.", filter)
-		post := &model.Post{
-			UserId:    args.UserId,
-			ChannelId: args.ChannelId,
-			Message:   emptyMessage,
-		}
-		ephemeralPost := r.api.SendEphemeralPost(args.UserId, post)
-		if ephemeralPost == nil {
-			// Fallback to CommandResponse if ephemeral post fails
-			return &model.CommandResponse{
-				ResponseType: model.CommandResponseTypeEphemeral,
-				Text:         emptyMessage,
-			}, nil
-		}
-		return &model.CommandResponse{}, nil
+		return r.sendListMessage(args, &model.Post{Message: i18n.T(r.locale, "list.failed")}), nil
 	}
 
-	// Apply chronological sorting for specific status filters (Story 5.1, Subtask 2.5)
-	// For "all" filter, groupAndSortRecords will handle sorting in formatListResponse
-	sortRecordsByTimestamp(filteredRecords, filter)
-
-	// Apply pagination (limit to 20 records)
-	total := len(filteredRecords)
-	displayRecords := filteredRecords
-	if total > 20 {
-		displayRecords = filteredRecords[:20]
-	}
+	return r.sendListMessage(args, post), nil
+}
 
-	// Format response (Story 5.2: Pass filter for dynamic header)
-	responseText := formatListResponse(displayRecords, total, filter)
-
-	// Story 7.5: Send as ephemeral post instead of CommandResponse to enable markdown table rendering
-	// CommandResponse.Text doesn't properly render markdown tables in Mattermost
-	post := &model.Post{
-		UserId:    args.UserId,
-		ChannelId: args.ChannelId,
-		Message:   responseText,
-	}
+// sendListMessage sends a list response as an ephemeral post.
+// Story 7.5: Send as ephemeral post instead of CommandResponse to enable markdown table rendering;
+// CommandResponse.Text doesn't properly render markdown tables in Mattermost
+func (r *Router) sendListMessage(args *model.CommandArgs, post *model.Post) *model.CommandResponse {
+	post.UserId = args.UserId
+	post.ChannelId = args.ChannelId
 
 	ephemeralPost := r.api.SendEphemeralPost(args.UserId, post)
 	if ephemeralPost == nil {
 		r.api.LogError("Failed to send ephemeral list response", "user_id", args.UserId)
-		// Fallback to CommandResponse if ephemeral post fails
+		// Fallback to CommandResponse if ephemeral post fails (without the page buttons)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         responseText,
-		}, nil
-	}
-
-	// Return empty response since we already sent the ephemeral post
-	return &model.CommandResponse{}, nil
-}
-
-// filterRecordsByStatus filters approval records by status type (Story 5.1)
-// Supported filters: "pending", "approved", "denied", "canceled", "all"
-// Returns filtered slice or original slice if filter is "all"
-func filterRecordsByStatus(records []*approval.ApprovalRecord, filter string) []*approval.ApprovalRecord {
-	// "all" filter returns all records unchanged
-	if filter == "all" {
-		return records
-	}
-
-	// Filter records by matching status
-	// Initialize as empty slice (not nil) for consistent behavior
-	filtered := make([]*approval.ApprovalRecord, 0)
-	for _, record := range records {
-		switch filter {
-		case "pending":
-			if record.Status == approval.StatusPending {
-				filtered = append(filtered, record)
-			}
-		case "approved":
-			if record.Status == approval.StatusApproved {
-				filtered = append(filtered, record)
-			}
-		case "denied":
-			if record.Status == approval.StatusDenied {
-				filtered = append(filtered, record)
-			}
-		case "canceled":
-			if record.Status == approval.StatusCanceled {
-				filtered = append(filtered, record)
-			}
+			Text:         post.Message,
 		}
 	}
 
-	return filtered
+	// Return empty response since we already sent the ephemeral post
+	return &model.CommandResponse{}
 }
 
 // sortRecordsByTimestamp sorts approval records chronologically by appropriate timestamp
@@ -563,8 +745,8 @@ func sortRecordsByTimestamp(records []*approval.ApprovalRecord, filter string) {
 		var iTime, jTime int64
 
 		switch filter {
-		case "pending":
-			// Pending records: sort by CreatedAt
+		case "pending", "scheduled":
+			// Pending and scheduled records: sort by CreatedAt
 			iTime = records[i].CreatedAt
 			jTime = records[j].CreatedAt
 
@@ -595,12 +777,13 @@ func sortRecordsByTimestamp(records []*approval.ApprovalRecord, filter string) {
 }
 
 // groupAndSortRecords separates records into three groups (pending, decided, canceled)
-// and sorts each group by appropriate timestamp descending (newest first)
+// and sorts each group by appropriate timestamp descending (newest first).
+// Scheduled requests are listed with the pending ones; their status column tells them apart.
 func groupAndSortRecords(records []*approval.ApprovalRecord) (pending, decided, canceled []*approval.ApprovalRecord) {
 	// Separate into groups
 	for _, record := range records {
 		switch record.Status {
-		case approval.StatusPending:
+		case approval.StatusPending, approval.StatusScheduled:
 			pending = append(pending, record)
 		case approval.StatusApproved, approval.StatusDenied:
 			decided = append(decided, record)
@@ -635,112 +818,88 @@ func groupAndSortRecords(records []*approval.ApprovalRecord) (pending, decided,
 	return pending, decided, canceled
 }
 
-// formatListResponse formats approval records into a readable list with grouped sections
-// Story 5.2: Added filter parameter for dynamic header count
-func formatListResponse(records []*approval.ApprovalRecord, total int, filter string) string {
+// formatListResponse formats a page of approval records into a readable list with grouped sections
+// Story 5.2: Header count for the filter; pages after the first, or followed by more, show the page number instead
+// Creation times are shown in the viewer's timezone with their age relative to now.
+func formatListResponse(page *listPage, locale, timezone string, now time.Time) string {
 	var output strings.Builder
 
-	// Story 5.2: Dynamic header with count (AC2, AC3)
-	header := fmt.Sprintf("## Your Approval Requests (%d %s)\n\n", total, filter)
-	output.WriteString(header)
-
-	// Group and sort records
-	pending, decided, canceled := groupAndSortRecords(records)
-
-	// Limit to 20 total records across all groups
-	displayed := 0
-	limit := 20
-
-	// Render Pending section
-	if len(pending) > 0 {
-		output.WriteString("**Pending Approvals:**\n\n")
-		output.WriteString("| Code | Status | Requestor | Approver | Created |\n")
-		output.WriteString("|------|--------|-----------|----------|----------|\n")
-		for _, record := range pending {
-			if displayed >= limit {
-				break
-			}
-			statusIcon := getStatusIcon(record.Status)
-			createdTime := time.Unix(0, record.CreatedAt*int64(time.Millisecond))
-			formattedDate := createdTime.UTC().Format("2006-01-02 15:04")
-			output.WriteString(fmt.Sprintf("| %s | %s | @%s | @%s | %s |\n",
-				record.Code, statusIcon, record.RequesterUsername, record.ApproverUsername, formattedDate))
-			displayed++
-		}
-		output.WriteString("\n")
+	filterLabel := i18n.T(locale, "filter."+page.Query.Filter)
+	headerKey := listMessageKey(page.Query.Scope, "header")
+	if page.Query.Page == 1 && !page.HasNext {
+		output.WriteString(i18n.T(locale, headerKey, len(page.Records), filterLabel))
+	} else {
+		output.WriteString(i18n.T(locale, headerKey+"_page", filterLabel, page.Query.Page))
 	}
+	tableHeader := i18n.T(locale, "list.table_header")
 
-	// Render Decided section
-	if len(decided) > 0 && displayed < limit {
-		output.WriteString("**Decided Approvals:**\n\n")
-		output.WriteString("| Code | Status | Requestor | Approver | Created |\n")
-		output.WriteString("|------|--------|-----------|----------|----------|\n")
-		for _, record := range decided {
-			if displayed >= limit {
-				break
-			}
-			statusIcon := getStatusIcon(record.Status)
-			createdTime := time.Unix(0, record.CreatedAt*int64(time.Millisecond))
-			formattedDate := createdTime.UTC().Format("2006-01-02 15:04")
-			output.WriteString(fmt.Sprintf("| %s | %s | @%s | @%s | %s |\n",
-				record.Code, statusIcon, record.RequesterUsername, record.ApproverUsername, formattedDate))
-			displayed++
+	if page.Query.Scope == listScopeInbox {
+		// The inbox is one table in queue order, oldest first
+		output.WriteString(tableHeader)
+		for _, record := range page.Records {
+			output.WriteString(formatListRow(record, locale, timezone, now))
 		}
 		output.WriteString("\n")
-	}
-
-	// Render Canceled section (with reason)
-	if len(canceled) > 0 && displayed < limit {
-		output.WriteString("**Canceled Requests:**\n\n")
-		output.WriteString("| Code | Status | Requestor | Approver | Created |\n")
-		output.WriteString("|------|--------|-----------|----------|----------|\n")
-		for _, record := range canceled {
-			if displayed >= limit {
-				break
+	} else {
+		// Group and sort records
+		pending, decided, canceled := groupAndSortRecords(page.Records)
+
+		for _, section := range []struct {
+			key     string
+			records []*approval.ApprovalRecord
+		}{
+			{"list.pending_section", pending},
+			{"list.decided_section", decided},
+			{"list.canceled_section", canceled}, // Rows show the cancellation reason
+		} {
+			if len(section.records) == 0 {
+				continue
 			}
-
-			// Format canceled status with reason
-			var statusText string
-			if record.CanceledReason != "" {
-				reason := record.CanceledReason
-				// Truncate if longer than 40 characters (use rune count for proper UTF-8 handling)
-				runes := []rune(reason)
-				if len(runes) > 40 {
-					reason = string(runes[:37]) + "..."
-				}
-				statusText = fmt.Sprintf("🚫 Canceled (%s)", reason)
-			} else {
-				statusText = "🚫 Canceled"
+			output.WriteString(i18n.T(locale, section.key))
+			output.WriteString(tableHeader)
+			for _, record := range section.records {
+				output.WriteString(formatListRow(record, locale, timezone, now))
 			}
-
-			createdTime := time.Unix(0, record.CreatedAt*int64(time.Millisecond))
-			formattedDate := createdTime.UTC().Format("2006-01-02 15:04")
-			output.WriteString(fmt.Sprintf("| %s | %s | @%s | @%s | %s |\n",
-				record.Code, statusText, record.RequesterUsername, record.ApproverUsername, formattedDate))
-			displayed++
+			output.WriteString("\n")
 		}
-		output.WriteString("\n")
 	}
 
-	// Pagination footer (if total > displayed)
-	if total > displayed {
-		output.WriteString(fmt.Sprintf("*Showing %d of %d total records.* Use `/approve get <ID>` to view specific requests.", displayed, total))
+	// Pagination footer when more records follow (newer ones in the inbox)
+	if page.HasNext {
+		footerKey := "list.page_footer"
+		if page.Query.Scope == listScopeInbox {
+			footerKey = "list.inbox_page_footer"
+		}
+		output.WriteString(i18n.T(locale, footerKey, page.Query.Page, listPageCommand(page.Query, page.Query.Page+1)))
 	}
 
 	return output.String()
 }
 
+// formatListRow renders one record as a row of the list table. Canceled records show their
+// reason, truncated to 40 characters.
+func formatListRow(record *approval.ApprovalRecord, locale, timezone string, now time.Time) string {
+	statusText := getStatusIcon(record.Status, locale)
+	if record.Status == approval.StatusCanceled && record.CanceledReason != "" {
+		reason := record.CanceledReason
+		// Truncate if longer than 40 characters (use rune count for proper UTF-8 handling)
+		runes := []rune(reason)
+		if len(runes) > 40 {
+			reason = string(runes[:37]) + "..."
+		}
+		statusText = i18n.T(locale, "status.canceled_reason", reason)
+	}
+
+	formattedDate := i18n.FormatTimeWithAge(locale, record.CreatedAt, "2006-01-02 15:04", timezone, now)
+	return fmt.Sprintf("| %s | %s | @%s | @%s | %s |\n",
+		record.Code, statusText, record.RequesterUsername, record.ApproverUsername, formattedDate)
+}
+
 // getStatusIcon returns the icon and label for an approval status
-func getStatusIcon(status string) string {
+func getStatusIcon(status, locale string) string {
 	switch status {
-	case "approved":
-		return "✅ Approved"
-	case "denied":
-		return "❌ Denied"
-	case "pending":
-		return "⏳ Pending"
-	case "canceled":
-		return "🚫 Canceled"
+	case approval.StatusApproved, approval.StatusDenied, approval.StatusPending, approval.StatusCanceled, approval.StatusScheduled:
+		return i18n.T(locale, "status."+status)
 	default:
 		return status // Fallback
 	}
@@ -755,7 +914,7 @@ func (r *Router) executeGet(args *model.CommandArgs) (*model.CommandResponse, er
 	if len(split) < 3 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Usage: /approve get <APPROVAL_ID>\n\nExample: /approve get A-X7K9Q2",
+			Text:         i18n.T(r.locale, "get.usage"),
 		}, nil
 	}
 
@@ -768,7 +927,7 @@ func (r *Router) executeGet(args *model.CommandArgs) (*model.CommandResponse, er
 		if errors.Is(err, approval.ErrRecordNotFound) {
 			return &model.CommandResponse{
 				ResponseType: model.CommandResponseTypeEphemeral,
-				Text:         fmt.Sprintf("❌ Approval record '%s' not found.\n\nUse `/approve list` to see your approval records.", code),
+				Text:         i18n.T(r.locale, "get.not_found", code),
 			}, nil
 		}
 
@@ -780,25 +939,26 @@ func (r *Router) executeGet(args *model.CommandArgs) (*model.CommandResponse, er
 		)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Failed to retrieve approval record. Please try again.",
+			Text:         i18n.T(r.locale, "get.failed"),
 		}, nil
 	}
 
 	// Access control check (AC4, AC7, AC8: verify user is requester or approver)
-	// Security: Only show records where authenticated user (args.UserId) is requester or approver (NFR-S2, FR37)
-	if record.RequesterID != args.UserId && record.ApproverID != args.UserId {
+	// Security: Only show records where authenticated user (args.UserId) is requester or approver (NFR-S2, FR37).
+	// Every member of a group approval counts as an approver.
+	if record.RequesterID != args.UserId && record.ApproverID != args.UserId && !record.CanDecide(args.UserId) {
 		r.api.LogWarn("Unauthorized approval access attempt",
 			"user_id", args.UserId,
 			"record_id", record.ID,
 		)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Permission denied. You can only view approval records where you are the requester or approver.",
+			Text:         i18n.T(r.locale, "get.permission_denied"),
 		}, nil
 	}
 
 	// Format and return complete record details (AC3: display complete record)
-	responseText := formatRecordDetail(record)
+	responseText := formatRecordDetail(record, r.locale, r.timezone, time.Now())
 
 	return &model.CommandResponse{
 		ResponseType: model.CommandResponseTypeEphemeral,
@@ -806,114 +966,167 @@ func (r *Router) executeGet(args *model.CommandArgs) (*model.CommandResponse, er
 	}, nil
 }
 
-// formatRecordDetail formats a complete approval record for display
-func formatRecordDetail(record *approval.ApprovalRecord) string {
+// detailTimestampLayout is the timestamp format of /approve get, rendered in the viewer's timezone
+const detailTimestampLayout = "2006-01-02 15:04:05 MST"
+
+// formatRecordDetail formats a complete approval record for display in the given locale and timezone.
+// Request, decision, cancellation and verification times include their age relative to now.
+func formatRecordDetail(record *approval.ApprovalRecord, locale, timezone string, now time.Time) string {
 	var output strings.Builder
 
 	// Header with emoji and code (AC3)
-	output.WriteString(fmt.Sprintf("**📋 Approval Record: %s**\n\n", record.Code))
+	output.WriteString(i18n.T(locale, "get.header", record.Code))
 
 	// Status with icon (AC3)
-	statusIcon := getStatusIcon(record.Status)
-	output.WriteString(fmt.Sprintf("**Status:** %s\n\n", statusIcon))
+	statusIcon := getStatusIcon(record.Status, locale)
+	output.WriteString(i18n.T(locale, "get.status", statusIcon))
 
 	// Requester and Approver information (AC3)
-	output.WriteString(fmt.Sprintf("**Requester:** @%s (%s)\n", record.RequesterUsername, record.RequesterDisplayName))
-	output.WriteString(fmt.Sprintf("**Approver:** @%s (%s)\n\n", record.ApproverUsername, record.ApproverDisplayName))
+	output.WriteString(i18n.T(locale, "get.requester", record.RequesterUsername, record.RequesterDisplayName))
+	switch {
+	case !record.IsGroupApproval():
+		output.WriteString(i18n.T(locale, "get.approver", record.ApproverUsername, record.ApproverDisplayName) + "\n")
+	case record.ApproverID == "":
+		output.WriteString(i18n.T(locale, "get.approver_group_pending",
+			record.ApproverGroupLabelIn(locale), len(record.CandidateApproverIDs)))
+	default:
+		output.WriteString(i18n.T(locale, "get.approver", record.ApproverUsername, record.ApproverDisplayName))
+		output.WriteString(i18n.T(locale, "get.approver_group", record.ApproverGroupLabelIn(locale)))
+	}
 
 	// Description (AC3)
-	output.WriteString(fmt.Sprintf("**Description:**\n%s\n\n", record.Description))
+	output.WriteString(i18n.T(locale, "get.description", record.Description))
+
+	// Template custom fields (structured values snapshotted at creation)
+	if record.TemplateName != "" {
+		output.WriteString(i18n.T(locale, "get.template", record.TemplateName))
+		for _, field := range record.CustomFields {
+			output.WriteString(fmt.Sprintf("**%s:** %s\n", field.Label, field.Value))
+		}
+		output.WriteString("\n")
+	}
 
 	// Cancellation details (Story 7.3: display reason, details, and timestamp)
 	if record.Status == approval.StatusCanceled {
 		output.WriteString("---\n\n")
-		output.WriteString("**Cancellation:**\n")
+		output.WriteString(i18n.T(locale, "get.cancellation"))
 
 		// Cancellation reason (handle empty for old records)
 		reason := record.CanceledReason
 		if reason == "" {
-			reason = "No reason recorded (canceled before v0.2.0)"
+			reason = i18n.T(locale, "get.no_reason")
 		}
-		output.WriteString(fmt.Sprintf("**Reason:** %s\n", reason))
+		output.WriteString(i18n.T(locale, "get.reason", reason))
 
 		// Additional details (Story 7.3: display if present)
 		if record.CanceledDetails != "" {
-			output.WriteString(fmt.Sprintf("**Details:** %s\n", record.CanceledDetails))
+			output.WriteString(i18n.T(locale, "get.details", record.CanceledDetails))
 		}
 
 		// Who canceled (only requester can cancel in v0.2.0)
-		output.WriteString(fmt.Sprintf("**Canceled by:** @%s\n", record.RequesterUsername))
+		output.WriteString(i18n.T(locale, "get.canceled_by", record.RequesterUsername))
 
 		// Cancellation timestamp (handle zero value for old records)
 		if record.CanceledAt > 0 {
-			canceledTime := time.Unix(0, record.CanceledAt*int64(time.Millisecond))
-			formattedCanceled := canceledTime.UTC().Format("2006-01-02 15:04:05 MST")
-			output.WriteString(fmt.Sprintf("**Canceled:** %s\n", formattedCanceled))
+			formattedCanceled := i18n.FormatTimeWithAge(locale, record.CanceledAt, detailTimestampLayout, timezone, now)
+			output.WriteString(i18n.T(locale, "get.canceled", formattedCanceled))
 		} else {
-			output.WriteString("**Canceled:** Unknown\n")
+			output.WriteString(i18n.T(locale, "get.canceled", i18n.T(locale, "get.unknown")))
 		}
 
 		output.WriteString("\n") // Spacing before next section
 	}
 
 	// Timestamps (AC3)
-	// Format: YYYY-MM-DD HH:MM:SS UTC
-	createdTime := time.Unix(0, record.CreatedAt*int64(time.Millisecond))
-	formattedCreated := createdTime.UTC().Format("2006-01-02 15:04:05 MST")
-	output.WriteString(fmt.Sprintf("**Requested:** %s\n", formattedCreated))
+	// Format: YYYY-MM-DD HH:MM:SS in the viewer's timezone, followed by the age
+	formattedCreated := i18n.FormatTimeWithAge(locale, record.CreatedAt, detailTimestampLayout, timezone, now)
+	output.WriteString(i18n.T(locale, "get.requested", formattedCreated))
+
+	// Send time of a scheduled request: upcoming while scheduled, then when it went to the approver
+	if record.ScheduledAt > 0 {
+		if record.SentAt > 0 {
+			output.WriteString(i18n.T(locale, "get.sent", i18n.FormatTime(record.SentAt, detailTimestampLayout, timezone)))
+		} else {
+			output.WriteString(i18n.T(locale, "get.scheduled", i18n.FormatTime(record.ScheduledAt, detailTimestampLayout, timezone)))
+		}
+	}
 
 	// Decided timestamp (only if decided and not canceled)
 	if record.Status != approval.StatusCanceled {
 		if record.DecidedAt > 0 {
-			decidedTime := time.Unix(0, record.DecidedAt*int64(time.Millisecond))
-			formattedDecided := decidedTime.UTC().Format("2006-01-02 15:04:05 MST")
-			output.WriteString(fmt.Sprintf("**Decided:** %s\n", formattedDecided))
+			formattedDecided := i18n.FormatTimeWithAge(locale, record.DecidedAt, detailTimestampLayout, timezone, now)
+			output.WriteString(i18n.T(locale, "get.decided", formattedDecided))
 		} else {
-			output.WriteString("**Decided:** Not yet decided\n")
+			output.WriteString(i18n.T(locale, "get.decided", i18n.T(locale, "get.not_decided")))
 		}
 	}
 
+	// SLA target snapshotted at creation and whether it was met
+	if state := record.SLAState(now.UnixMilli()); state != approval.SLAStateNone {
+		output.WriteString(i18n.T(locale, "get.sla", approval.FormatSLATarget(record.SLAMinutes), i18n.T(locale, "get.sla."+state)))
+	}
+
 	// Decision comment (only if present) (AC3)
 	if record.DecisionComment != "" {
-		output.WriteString(fmt.Sprintf("\n**Decision Comment:**\n%s\n", record.DecisionComment))
+		output.WriteString(i18n.T(locale, "get.decision_comment", record.DecisionComment))
 	}
 
 	// Verification details (Story 6.2: display verification info for verified approved requests)
 	if record.Status == approval.StatusApproved && record.Verified {
 		output.WriteString("\n---\n\n")
-		output.WriteString("**✅ Verification:**\n")
+		output.WriteString(i18n.T(locale, "get.verification"))
 
 		// Verification timestamp
 		if record.VerifiedAt > 0 {
-			verifiedTime := time.Unix(0, record.VerifiedAt*int64(time.Millisecond))
-			formattedVerified := verifiedTime.UTC().Format("2006-01-02 15:04:05 MST")
-			output.WriteString(fmt.Sprintf("**Verified:** %s\n", formattedVerified))
+			formattedVerified := i18n.FormatTimeWithAge(locale, record.VerifiedAt, detailTimestampLayout, timezone, now)
+			output.WriteString(i18n.T(locale, "get.verified", formattedVerified))
 		}
 
 		// Verified by (always the requester in Story 6.2)
-		output.WriteString(fmt.Sprintf("**Verified by:** @%s\n", record.RequesterUsername))
+		output.WriteString(i18n.T(locale, "get.verified_by", record.RequesterUsername))
 
 		// Verification comment (optional)
 		if record.VerificationComment != "" {
-			output.WriteString(fmt.Sprintf("**Note:** %s\n", record.VerificationComment))
+			output.WriteString(i18n.T(locale, "get.verification_note", record.VerificationComment))
 		}
 
 		output.WriteString("\n") // Spacing before next section
 	}
 
+	// Comment history relayed with /approve comment
+	if len(record.Comments) > 0 {
+		output.WriteString("\n---\n\n")
+		output.WriteString(i18n.T(locale, "get.comments", len(record.Comments)))
+		for _, comment := range record.Comments {
+			commentTime := i18n.FormatTime(comment.CreatedAt, detailTimestampLayout, timezone)
+			output.WriteString(fmt.Sprintf("- %s @%s: %s\n", commentTime, comment.Username, comment.Message))
+		}
+	}
+
 	// Context section (AC3)
-	output.WriteString("\n**Context:**\n")
-	output.WriteString(fmt.Sprintf("- Request ID: %s\n", record.Code))
-	output.WriteString(fmt.Sprintf("- Full ID: %s\n", record.ID))
+	output.WriteString(i18n.T(locale, "get.context"))
+	output.WriteString(i18n.T(locale, "get.context.request_id", record.Code))
+	output.WriteString(i18n.T(locale, "get.context.full_id", record.ID))
 	if record.RequestChannelID != "" {
-		output.WriteString(fmt.Sprintf("- Channel ID: %s\n", record.RequestChannelID))
+		output.WriteString(i18n.T(locale, "get.context.channel_id", record.RequestChannelID))
 	}
 	if record.TeamID != "" {
-		output.WriteString(fmt.Sprintf("- Team ID: %s\n", record.TeamID))
+		output.WriteString(i18n.T(locale, "get.context.team_id", record.TeamID))
+	}
+
+	// Lineage (resubmitted requests link to their predecessor/successor)
+	if record.PreviousCode != "" || record.NextCode != "" {
+		output.WriteString(i18n.T(locale, "get.lineage"))
+		if record.PreviousCode != "" {
+			output.WriteString(i18n.T(locale, "get.lineage.from", record.PreviousCode))
+		}
+		if record.NextCode != "" {
+			output.WriteString(i18n.T(locale, "get.lineage.as", record.NextCode))
+		}
 	}
 
 	// Footer: immutability statement (AC3)
-	output.WriteString("\n**This record is immutable and cannot be edited.**\n")
+	output.WriteString(i18n.T(locale, "get.immutable"))
 
 	return output.String()
 }
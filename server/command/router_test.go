@@ -2,14 +2,18 @@ package command
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // mockStore is a mock implementation of the Storer interface
@@ -33,6 +37,22 @@ func (m *mockStore) GetUserApprovals(userID string) ([]*approval.ApprovalRecord,
 	return args.Get(0).([]*approval.ApprovalRecord), args.Error(1)
 }
 
+func (m *mockStore) GetUserApprovalIndex(userID, role string) ([]approval.IndexEntry, error) {
+	args := m.Called(userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]approval.IndexEntry), args.Error(1)
+}
+
+func (m *mockStore) GetApproval(id string) (*approval.ApprovalRecord, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*approval.ApprovalRecord), args.Error(1)
+}
+
 func (m *mockStore) GetApprovalByCode(code string) (*approval.ApprovalRecord, error) {
 	args := m.Called(code)
 	if args.Get(0) == nil {
@@ -41,6 +61,110 @@ func (m *mockStore) GetApprovalByCode(code string) (*approval.ApprovalRecord, er
 	return args.Get(0).(*approval.ApprovalRecord), args.Error(1)
 }
 
+func (m *mockStore) SearchApprovals(userID string, query approval.SearchQuery) ([]*approval.ApprovalRecord, error) {
+	args := m.Called(userID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*approval.ApprovalRecord), args.Error(1)
+}
+
+func (m *mockStore) GetTemplate(name string) (*approval.RequestTemplate, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*approval.RequestTemplate), args.Error(1)
+}
+
+func (m *mockStore) ListTemplates() ([]*approval.RequestTemplate, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*approval.RequestTemplate), args.Error(1)
+}
+
+func (m *mockStore) SaveTemplate(tmpl *approval.RequestTemplate) error {
+	args := m.Called(tmpl)
+	return args.Error(0)
+}
+
+func (m *mockStore) DeleteTemplate(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *mockStore) GetPolicy(scope string) (*approval.ApproverPolicy, error) {
+	args := m.Called(scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*approval.ApproverPolicy), args.Error(1)
+}
+
+func (m *mockStore) ListPolicies() ([]*approval.ApproverPolicy, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*approval.ApproverPolicy), args.Error(1)
+}
+
+func (m *mockStore) SavePolicy(policy *approval.ApproverPolicy) error {
+	args := m.Called(policy)
+	return args.Error(0)
+}
+
+func (m *mockStore) DeletePolicy(scope string) error {
+	args := m.Called(scope)
+	return args.Error(0)
+}
+
+func (m *mockStore) GetSLATarget(scope string) (*approval.SLATarget, error) {
+	args := m.Called(scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*approval.SLATarget), args.Error(1)
+}
+
+func (m *mockStore) ListSLATargets() ([]*approval.SLATarget, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*approval.SLATarget), args.Error(1)
+}
+
+func (m *mockStore) SaveSLATarget(target *approval.SLATarget) error {
+	args := m.Called(target)
+	return args.Error(0)
+}
+
+func (m *mockStore) DeleteSLATarget(scope string) error {
+	args := m.Called(scope)
+	return args.Error(0)
+}
+
+func (m *mockStore) GetSoDViolations() ([]*approval.SoDViolation, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*approval.SoDViolation), args.Error(1)
+}
+
+func (m *mockStore) GetDigestSubscription(userID string) (bool, error) {
+	args := m.Called(userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockStore) SetDigestSubscription(userID string, enabled bool) error {
+	args := m.Called(userID, enabled)
+	return args.Error(0)
+}
+
 func TestRoute(t *testing.T) {
 	api := &plugintest.API{}
 	store := &mockStore{}
@@ -82,6 +206,181 @@ func TestRoute(t *testing.T) {
 	}
 }
 
+func TestRoute_Locale(t *testing.T) {
+	t.Run("help and unknown command use the caller's locale", func(t *testing.T) {
+		router := NewRouter(&plugintest.API{}, &mockStore{})
+		router.SetLocale("de")
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve help"})
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "**Verfügbare Befehle:**")
+
+		router.SetLocale("ja")
+		resp, err = router.Route(&model.CommandArgs{Command: "/approve bogus"})
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "不明なコマンド: **bogus**")
+	})
+
+	t.Run("empty list names the filter in the caller's locale", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		router := NewRouter(api, store)
+		router.SetLocale("ja")
+
+		mockUserIndex(store, "user123", []*approval.ApprovalRecord{})
+		var capturedPost *model.Post
+		api.On("SendEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+			capturedPost = post
+			return true
+		})).Return(&model.Post{})
+
+		_, err := router.Route(&model.CommandArgs{Command: "/approve list", UserId: "user123", ChannelId: "channel123"})
+		assert.NoError(t, err)
+		assert.Contains(t, capturedPost.Message, "保留中 の承認リクエストはありません。")
+	})
+
+	t.Run("record detail and list are rendered in the given locale", func(t *testing.T) {
+		record := &approval.ApprovalRecord{
+			ID:                   "record1",
+			Code:                 "A-ABC123",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice",
+			ApproverUsername:     "bob",
+			ApproverDisplayName:  "Bob",
+			Description:          "Deploy hotfix",
+			Status:               approval.StatusApproved,
+			CreatedAt:            1704931200000,
+			DecidedAt:            1704931300000,
+		}
+
+		detail := formatRecordDetail(record, "de", "", time.Now())
+		assert.Contains(t, detail, "**📋 Genehmigungsdatensatz: A-ABC123**")
+		assert.Contains(t, detail, "**Status:** ✅ Genehmigt")
+		assert.Contains(t, detail, "**Antragsteller:** @alice (Alice)")
+
+		list := formatListResponse(singleListPage("all", []*approval.ApprovalRecord{record}), "ja", "", time.Now())
+		assert.Contains(t, list, "## 自分の承認リクエスト (すべて: 1 件)")
+		assert.Contains(t, list, "✅ 承認")
+	})
+}
+
+func TestFormatRecordDetail_SLA(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		ID:                "record1",
+		Code:              "A-ABC123",
+		RequesterUsername: "alice",
+		ApproverUsername:  "bob",
+		Status:            approval.StatusPending,
+		CreatedAt:         1704974400000, // 2024-01-11 12:00:00 UTC
+		SLAMinutes:        15,
+	}
+	requested := time.UnixMilli(record.CreatedAt)
+
+	assert.Contains(t, formatRecordDetail(record, "en", "", requested.Add(10*time.Minute)), "**SLA:** 15m (on track)")
+	assert.Contains(t, formatRecordDetail(record, "en", "", requested.Add(20*time.Minute)), "**SLA:** 15m (🚨 breached)")
+
+	record.Status = approval.StatusApproved
+	record.DecidedAt = requested.Add(5 * time.Minute).UnixMilli()
+	assert.Contains(t, formatRecordDetail(record, "en", "", requested.Add(time.Hour)), "**SLA:** 15m (met)")
+
+	record.SLAMinutes = 0
+	assert.NotContains(t, formatRecordDetail(record, "en", "", requested.Add(time.Hour)), "**SLA:**")
+}
+
+func TestFormatRecordDetail_Scheduled(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		ID:                "record1",
+		Code:              "A-ABC123",
+		RequesterUsername: "alice",
+		ApproverUsername:  "bob",
+		Status:            approval.StatusScheduled,
+		CreatedAt:         1704974400000, // 2024-01-11 12:00:00 UTC
+		ScheduledAt:       1705046400000, // 2024-01-12 08:00:00 UTC
+	}
+	now := time.UnixMilli(record.CreatedAt).Add(time.Hour)
+
+	detail := formatRecordDetail(record, "en", "Europe/Berlin", now)
+	assert.Contains(t, detail, "**Status:** 🕒 Scheduled")
+	assert.Contains(t, detail, "**Scheduled for:** 2024-01-12 09:00:00 CET")
+	assert.NotContains(t, detail, "**Sent:**")
+
+	record.Status = approval.StatusPending
+	record.SentAt = 1705046430000 // 2024-01-12 08:00:30 UTC
+	detail = formatRecordDetail(record, "en", "Europe/Berlin", now)
+	assert.Contains(t, detail, "**Sent:** 2024-01-12 09:00:30 CET")
+	assert.NotContains(t, detail, "**Scheduled for:**")
+}
+
+func TestFormatTimestamps_Timezones(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		ID:                "record1",
+		Code:              "A-ABC123",
+		RequesterUsername: "alice",
+		ApproverUsername:  "bob",
+		Status:            approval.StatusApproved,
+		CreatedAt:         1704974400000, // 2024-01-11 12:00:00 UTC
+		DecidedAt:         1704975120000, // 2024-01-11 12:12:00 UTC
+		Comments: []approval.ApprovalComment{
+			{Username: "bob", Message: "Which cluster?", CreatedAt: 1704974460000},
+		},
+	}
+	now := time.UnixMilli(record.DecidedAt).Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		timezone      string
+		wantRequested string
+		wantDecided   string
+		wantListed    string
+		wantComment   string
+	}{
+		{
+			name:          "UTC default",
+			timezone:      "",
+			wantRequested: "**Requested:** 2024-01-11 12:00:00 UTC (1 h ago)",
+			wantDecided:   "**Decided:** 2024-01-11 12:12:00 UTC (1 h ago)",
+			wantListed:    "| 2024-01-11 12:00 (1 h ago) |",
+			wantComment:   "- 2024-01-11 12:01:00 UTC @bob: Which cluster?",
+		},
+		{
+			name:          "Europe/Berlin",
+			timezone:      "Europe/Berlin",
+			wantRequested: "**Requested:** 2024-01-11 13:00:00 CET (1 h ago)",
+			wantDecided:   "**Decided:** 2024-01-11 13:12:00 CET (1 h ago)",
+			wantListed:    "| 2024-01-11 13:00 (1 h ago) |",
+			wantComment:   "- 2024-01-11 13:01:00 CET @bob: Which cluster?",
+		},
+		{
+			name:          "America/Los_Angeles",
+			timezone:      "America/Los_Angeles",
+			wantRequested: "**Requested:** 2024-01-11 04:00:00 PST (1 h ago)",
+			wantDecided:   "**Decided:** 2024-01-11 04:12:00 PST (1 h ago)",
+			wantListed:    "| 2024-01-11 04:00 (1 h ago) |",
+			wantComment:   "- 2024-01-11 04:01:00 PST @bob: Which cluster?",
+		},
+		{
+			name:          "Pacific/Auckland next day",
+			timezone:      "Pacific/Auckland",
+			wantRequested: "**Requested:** 2024-01-12 01:00:00 NZDT (1 h ago)",
+			wantDecided:   "**Decided:** 2024-01-12 01:12:00 NZDT (1 h ago)",
+			wantListed:    "| 2024-01-12 01:00 (1 h ago) |",
+			wantComment:   "- 2024-01-12 01:01:00 NZDT @bob: Which cluster?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := formatRecordDetail(record, i18n.DefaultLocale, tt.timezone, now)
+			assert.Contains(t, detail, tt.wantRequested)
+			assert.Contains(t, detail, tt.wantDecided)
+			assert.Contains(t, detail, tt.wantComment)
+
+			list := formatListResponse(singleListPage("all", []*approval.ApprovalRecord{record}), i18n.DefaultLocale, tt.timezone, now)
+			assert.Contains(t, list, tt.wantListed)
+		})
+	}
+}
+
 func TestRouteNew(t *testing.T) {
 	t.Run("new command opens modal dialog with correct structure", func(t *testing.T) {
 		api := &plugintest.API{}
@@ -113,8 +412,8 @@ func TestRouteNew(t *testing.T) {
 				return false
 			}
 
-			// Verify exactly 2 fields (AC1)
-			if len(dialog.Elements) != 2 {
+			// Verify approver, description, channel visibility and send time fields (AC1)
+			if len(dialog.Elements) != 4 {
 				return false
 			}
 
@@ -137,6 +436,25 @@ func TestRouteNew(t *testing.T) {
 				return false
 			}
 
+			// Verify optional channel visibility selector defaults to DM only
+			visibilityField := dialog.Elements[2]
+			if visibilityField.Name != "visibility" ||
+				visibilityField.Type != "select" ||
+				!visibilityField.Optional ||
+				visibilityField.Default != approval.VisibilityDirect ||
+				len(visibilityField.Options) != 3 {
+				return false
+			}
+
+			// Verify optional send time for scheduling the request
+			sendAtField := dialog.Elements[3]
+			if sendAtField.Name != "send_at" ||
+				sendAtField.Type != "text" ||
+				!sendAtField.Optional ||
+				sendAtField.Placeholder != "YYYY-MM-DD HH:MM" {
+				return false
+			}
+
 			// Verify trigger ID is passed
 			if req.TriggerId != "test-trigger-id-12345678901234567890" {
 				return false
@@ -315,6 +633,7 @@ func TestExecuteStatus(t *testing.T) {
 
 		// Mock GetAllApprovals to return empty slice
 		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{}, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
 
 		args := &model.CommandArgs{
 			Command: "/approve status",
@@ -380,6 +699,7 @@ func TestExecuteStatus(t *testing.T) {
 			},
 		}
 		store.On("GetAllApprovals").Return(records, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
 
 		args := &model.CommandArgs{
 			Command: "/approve status",
@@ -439,6 +759,7 @@ func TestExecuteStatus(t *testing.T) {
 			},
 		}
 		store.On("GetAllApprovals").Return(records, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
 
 		args := &model.CommandArgs{
 			Command: "/approve status --failed-notifications",
@@ -495,6 +816,7 @@ func TestExecuteStatus(t *testing.T) {
 			},
 		}
 		store.On("GetAllApprovals").Return(records, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
 
 		args := &model.CommandArgs{
 			Command: "/approve status --failed-notifications",
@@ -527,6 +849,7 @@ func TestExecuteStatus(t *testing.T) {
 
 		// Mock GetAllApprovals to return error
 		store.On("GetAllApprovals").Return(nil, assert.AnError)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
 
 		// Mock LogError call
 		api.On("LogError", mock.Anything, mock.Anything, mock.Anything)
@@ -544,6 +867,82 @@ func TestExecuteStatus(t *testing.T) {
 		api.AssertExpectations(t)
 		store.AssertExpectations(t)
 	})
+
+	t.Run("status command reports SLA compliance", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		router := NewRouter(api, store)
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+
+		now := time.Now()
+		records := []*approval.ApprovalRecord{
+			// Decided within its SLA
+			{ID: "id1", Status: approval.StatusApproved, SLAMinutes: 15,
+				CreatedAt: now.Add(-time.Hour).UnixMilli(), DecidedAt: now.Add(-50 * time.Minute).UnixMilli()},
+			// Decided after its SLA
+			{ID: "id2", Status: approval.StatusDenied, SLAMinutes: 15,
+				CreatedAt: now.Add(-time.Hour).UnixMilli(), DecidedAt: now.Add(-30 * time.Minute).UnixMilli()},
+			// Pending past its SLA
+			{ID: "id3", Status: approval.StatusPending, SLAMinutes: 15, NotificationSent: true,
+				CreatedAt: now.Add(-20 * time.Minute).UnixMilli()},
+			// Pending within its SLA
+			{ID: "id4", Status: approval.StatusPending, SLAMinutes: 15, NotificationSent: true,
+				CreatedAt: now.Add(-5 * time.Minute).UnixMilli()},
+			// No SLA
+			{ID: "id5", Status: approval.StatusApproved, CreatedAt: now.Add(-time.Hour).UnixMilli()},
+		}
+		store.On("GetAllApprovals").Return(records, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status", UserId: "admin123"})
+		assert.NoError(t, err)
+
+		assert.Contains(t, resp.Text, "**SLA Compliance:**")
+		assert.Contains(t, resp.Text, "Tracked Requests: 4")
+		assert.Contains(t, resp.Text, "✅ Met: 1")
+		assert.Contains(t, resp.Text, "🚨 Breached: 2 (1 still pending)")
+	})
+
+	t.Run("status command omits SLA section without tracked requests", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		router := NewRouter(api, store)
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{{ID: "id1", Status: approval.StatusApproved}}, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil).Maybe()
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status", UserId: "admin123"})
+		assert.NoError(t, err)
+
+		assert.NotContains(t, resp.Text, "SLA Compliance")
+	})
+}
+
+// mockUserIndex mocks the user's approval index over records, ordered by creation time like the
+// store's index keys, and the record lookups of the list pages
+func mockUserIndex(store *mockStore, userID string, records []*approval.ApprovalRecord) {
+	mockUserRoleIndex(store, userID, "", records)
+}
+
+// mockUserRoleIndex is mockUserIndex for one index role
+func mockUserRoleIndex(store *mockStore, userID, role string, records []*approval.ApprovalRecord) {
+	entries := make([]approval.IndexEntry, 0, len(records))
+	for _, record := range records {
+		entries = append(entries, approval.IndexEntry{
+			Position: fmt.Sprintf("%013d:%s", 9999999999999-record.CreatedAt, record.ID),
+			RecordID: record.ID,
+		})
+		store.On("GetApproval", record.ID).Return(record, nil).Maybe()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Position < entries[j].Position
+	})
+	store.On("GetUserApprovalIndex", userID, role).Return(entries, nil)
+}
+
+// singleListPage is a first page of /approve list with no further pages
+func singleListPage(filter string, records []*approval.ApprovalRecord) *listPage {
+	return &listPage{Query: ListQuery{Filter: filter, Page: 1, Limit: defaultListLimit}, Records: records}
 }
 
 func TestExecuteList(t *testing.T) {
@@ -553,7 +952,7 @@ func TestExecuteList(t *testing.T) {
 		router := NewRouter(api, store)
 
 		// Mock store to return empty slice
-		store.On("GetUserApprovals", "user123").Return([]*approval.ApprovalRecord{}, nil)
+		mockUserIndex(store, "user123", []*approval.ApprovalRecord{})
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -599,7 +998,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1705000000000, // 2024-01-11 ~20:26 UTC
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -667,7 +1066,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000, // Oldest
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -719,7 +1118,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         int64(25-i) * 1000, // Descending timestamps
 			}
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -747,10 +1146,15 @@ func TestExecuteList(t *testing.T) {
 		assert.NotContains(t, capturedPost.Message, "A-REC20")
 		assert.NotContains(t, capturedPost.Message, "A-REC24")
 
-		// Should show pagination footer with new format (Story 4.6: updated message)
-		assert.Contains(t, capturedPost.Message, "Showing 20 of 25 total records")
+		// Should show the page number and how to reach the next page
+		assert.Contains(t, capturedPost.Message, "## Your Approval Requests (pending, page 1)")
+		assert.Contains(t, capturedPost.Message, "*Page 1.* Older requests: **Next ▶** or `/approve list pending --page 2`")
 		assert.Contains(t, capturedPost.Message, "/approve get")
 
+		// Only the records on the page are loaded
+		store.AssertNotCalled(t, "GetApproval", "record21")
+		store.AssertNotCalled(t, "GetApproval", "record24")
+
 		api.AssertExpectations(t)
 		store.AssertExpectations(t)
 	})
@@ -773,7 +1177,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -797,7 +1201,7 @@ func TestExecuteList(t *testing.T) {
 		assert.Contains(t, capturedPost.Message, "A-USER1")
 
 		// Verify store was called with correct user ID
-		store.AssertCalled(t, "GetUserApprovals", "user123")
+		store.AssertCalled(t, "GetUserApprovalIndex", "user123", "")
 		api.AssertExpectations(t)
 		store.AssertExpectations(t)
 	})
@@ -819,7 +1223,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -864,7 +1268,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -919,7 +1323,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -954,7 +1358,7 @@ func TestExecuteList(t *testing.T) {
 
 		// Mock store to return error
 		storeErr := fmt.Errorf("KV store connection failed")
-		store.On("GetUserApprovals", "user123").Return(nil, storeErr)
+		store.On("GetUserApprovalIndex", "user123", "").Return(nil, storeErr)
 
 		// Mock LogError call
 		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
@@ -1024,7 +1428,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         1000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -1073,7 +1477,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         timestamp,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -1126,7 +1530,7 @@ func TestExecuteList(t *testing.T) {
 				CreatedAt:         2000,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -1170,7 +1574,7 @@ func TestExecuteList(t *testing.T) {
 				DecidedAt:         1500,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -1231,7 +1635,7 @@ func TestExecuteList(t *testing.T) {
 				DecidedAt:         3500,
 			},
 		}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -1265,7 +1669,7 @@ func TestExecuteList(t *testing.T) {
 		router := NewRouter(api, store)
 
 		records := []*approval.ApprovalRecord{}
-		store.On("GetUserApprovals", "user123").Return(records, nil)
+		mockUserIndex(store, "user123", records)
 
 		// Story 7.5: Mock SendEphemeralPost to capture the message
 		var capturedPost *model.Post
@@ -2066,7 +2470,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 3, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		// Verify section headers appear in correct order
 		assert.Contains(t, result, "**Pending Approvals:**")
@@ -2103,7 +2507,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 1, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		assert.Contains(t, result, "**Pending Approvals:**")
 		assert.NotContains(t, result, "**Decided Approvals:**")
@@ -2123,7 +2527,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 1, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		assert.Contains(t, result, "🚫 Canceled (No longer needed)")
 	})
@@ -2143,7 +2547,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 1, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		// Should truncate to 37 chars + "..." (exact first 37 characters)
 		assert.Contains(t, result, "🚫 Canceled (No longer needed - project was cancel...)")
@@ -2163,14 +2567,14 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 1, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		// Should show without reason text or parentheses
 		assert.Contains(t, result, "🚫 Canceled")
 		assert.NotContains(t, result, "🚫 Canceled ()")
 	})
 
-	t.Run("respects 20-record limit across all groups", func(t *testing.T) {
+	t.Run("renders every record of the page across all groups", func(t *testing.T) {
 		var records []*approval.ApprovalRecord
 
 		// Create 10 pending, 10 decided, 10 canceled (30 total)
@@ -2205,7 +2609,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			})
 		}
 
-		result := formatListResponse(records, 30, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		// Count record codes in output (each appears once)
 		recordCount := 0
@@ -2221,11 +2625,10 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			}
 		}
 
-		assert.LessOrEqual(t, recordCount, 20, "Should display at most 20 records")
+		assert.Equal(t, 30, recordCount, "Should display every record of the page")
 
-		// Should show pagination footer
-		assert.Contains(t, result, "Showing")
-		assert.Contains(t, result, "of 30 total records")
+		// A single page has no pagination footer
+		assert.NotContains(t, result, "*Page")
 	})
 
 	t.Run("updates pagination footer correctly", func(t *testing.T) {
@@ -2240,10 +2643,12 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			})
 		}
 
-		result := formatListResponse(records, 25, "all")
+		page := &listPage{Query: ListQuery{Filter: "all", Page: 2, Limit: 15}, Records: records, HasNext: true, HasPrevious: true}
+		result := formatListResponse(page, i18n.DefaultLocale, "", time.Now())
 
-		// Should show "Showing 15 of 25"
-		assert.Contains(t, result, "Showing 15 of 25 total records")
+		// Should show the page number instead of a count, and the next page's command
+		assert.Contains(t, result, "## Your Approval Requests (all, page 2)")
+		assert.Contains(t, result, "*Page 2.* Older requests: **Next ▶** or `/approve list all --page 3 --limit 15`")
 	})
 
 	t.Run("omits footer when all records shown", func(t *testing.T) {
@@ -2257,11 +2662,11 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 			},
 		}
 
-		result := formatListResponse(records, 1, "all")
+		result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 		// Should NOT show pagination footer
-		assert.NotContains(t, result, "Showing")
-		assert.NotContains(t, result, "total records")
+		assert.Contains(t, result, "## Your Approval Requests (1 all)")
+		assert.NotContains(t, result, "*Page")
 	})
 
 	t.Run("displays all four cancellation reasons correctly", func(t *testing.T) {
@@ -2285,7 +2690,7 @@ func TestFormatListResponse_GroupedSections(t *testing.T) {
 				},
 			}
 
-			result := formatListResponse(records, 1, "all")
+			result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 
 			assert.Contains(t, result, fmt.Sprintf("🚫 Canceled (%s)", reason),
 				"Should display reason: %s", reason)
@@ -2345,7 +2750,7 @@ func TestFormatListResponse_UTF8Handling(t *testing.T) {
 				},
 			}
 
-			result := formatListResponse(records, 1, "all")
+			result := formatListResponse(singleListPage("all", records), i18n.DefaultLocale, "", time.Now())
 			assert.Contains(t, result, tc.expectedOutput,
 				"Should handle UTF-8 characters correctly")
 		})
@@ -2373,7 +2778,7 @@ func TestFormatRecordDetail_Verification(t *testing.T) {
 			VerificationComment:  "",
 		}
 
-		result := formatRecordDetail(record)
+		result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
 
 		// Verify section header
 		assert.Contains(t, result, "**✅ Verification:**")
@@ -2407,7 +2812,7 @@ func TestFormatRecordDetail_Verification(t *testing.T) {
 			VerificationComment:  "Deployment completed successfully",
 		}
 
-		result := formatRecordDetail(record)
+		result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
 
 		// Verify section header
 		assert.Contains(t, result, "**✅ Verification:**")
@@ -2439,7 +2844,7 @@ func TestFormatRecordDetail_Verification(t *testing.T) {
 			Verified:             false,
 		}
 
-		result := formatRecordDetail(record)
+		result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
 
 		// Verification section should NOT be shown
 		assert.NotContains(t, result, "**✅ Verification:**")
@@ -2470,7 +2875,7 @@ func TestFormatRecordDetail_Verification(t *testing.T) {
 				VerifiedAt:           1704931400000,
 			}
 
-			result := formatRecordDetail(record)
+			result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
 
 			// Verification section should NOT be shown for non-approved status
 			assert.NotContains(t, result, "**✅ Verification:**",
@@ -2478,3 +2883,360 @@ func TestFormatRecordDetail_Verification(t *testing.T) {
 		}
 	})
 }
+
+func TestFormatRecordDetail_Lineage(t *testing.T) {
+	baseRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			Status:               approval.StatusPending,
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Smith",
+			ApproverUsername:     "bob",
+			ApproverDisplayName:  "Bob Jones",
+			Description:          "Deploy to production",
+			CreatedAt:            1704931200000,
+		}
+	}
+
+	t.Run("shows predecessor for resubmitted request", func(t *testing.T) {
+		record := baseRecord()
+		record.PreviousCode = "A-OLD234"
+
+		result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
+
+		assert.Contains(t, result, "**Lineage:**")
+		assert.Contains(t, result, "- Resubmitted from: `A-OLD234`")
+		assert.NotContains(t, result, "Resubmitted as:")
+	})
+
+	t.Run("shows successor for request that was resubmitted", func(t *testing.T) {
+		record := baseRecord()
+		record.Status = approval.StatusDenied
+		record.DecidedAt = 1704931300000
+		record.NextCode = "A-NEW234"
+
+		result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
+
+		assert.Contains(t, result, "**Lineage:**")
+		assert.Contains(t, result, "- Resubmitted as: `A-NEW234`")
+		assert.NotContains(t, result, "Resubmitted from:")
+	})
+
+	t.Run("omits lineage section when record has no links", func(t *testing.T) {
+		result := formatRecordDetail(baseRecord(), i18n.DefaultLocale, "", time.Now())
+
+		assert.NotContains(t, result, "**Lineage:**")
+	})
+}
+
+func TestRouteNew_Template(t *testing.T) {
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		siteURL := "https://mattermost.example.com"
+		config := &model.Config{}
+		config.ServiceSettings.SiteURL = &siteURL
+		api.On("GetConfig").Return(config)
+		return api, store, NewRouter(api, store)
+	}
+
+	t.Run("renders template fields and default approver", func(t *testing.T) {
+		api, store, router := setup()
+
+		store.On("GetTemplate", "prod-access").Return(&approval.RequestTemplate{
+			Name:             "prod-access",
+			DisplayName:      "Production Access",
+			DefaultApprovers: []string{"bob"},
+			Fields: []approval.CustomField{
+				{Name: "system", Label: "System", Type: approval.FieldTypeSelect, Required: true, Options: []string{"database", "kubernetes"}},
+				{Name: "hours", Label: "Duration (hours)", Type: approval.FieldTypeNumber, Required: true},
+				{Name: "start", Label: "Start date", Type: approval.FieldTypeDate},
+			},
+		}, nil)
+		api.On("GetUserByUsername", "bob").Return(&model.User{Id: "bob-id", Username: "bob"}, nil)
+
+		var opened model.OpenDialogRequest
+		api.On("OpenInteractiveDialog", mock.Anything).Run(func(args mock.Arguments) {
+			opened = args.Get(0).(model.OpenDialogRequest)
+		}).Return(nil)
+
+		resp, err := router.Route(&model.CommandArgs{
+			Command:   "/approve new Prod-Access",
+			TriggerId: "trigger123",
+			UserId:    "user123",
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Text)
+
+		dialog := opened.Dialog
+		assert.Equal(t, "approve_new", dialog.CallbackId)
+		assert.Equal(t, "prod-access", dialog.State)
+		assert.Contains(t, dialog.IntroductionText, "Production Access")
+		assert.Len(t, dialog.Elements, 7)
+		assert.Equal(t, "bob-id", dialog.Elements[0].Default)
+		assert.Equal(t, "visibility", dialog.Elements[5].Name, "visibility follows the template fields")
+		assert.Equal(t, "send_at", dialog.Elements[6].Name)
+
+		system := dialog.Elements[2]
+		assert.Equal(t, "field_system", system.Name)
+		assert.Equal(t, "select", system.Type)
+		assert.Equal(t, "System *", system.DisplayName)
+		assert.False(t, system.Optional)
+		assert.Len(t, system.Options, 2)
+
+		hours := dialog.Elements[3]
+		assert.Equal(t, "text", hours.Type)
+		assert.Equal(t, "number", hours.SubType)
+
+		start := dialog.Elements[4]
+		assert.Equal(t, "YYYY-MM-DD", start.Placeholder)
+		assert.True(t, start.Optional)
+	})
+
+	t.Run("unknown template lists available templates", func(t *testing.T) {
+		api, store, router := setup()
+
+		store.On("GetTemplate", "nope").Return(nil, fmt.Errorf("request template nope: %w", approval.ErrTemplateNotFound))
+		store.On("ListTemplates").Return([]*approval.RequestTemplate{{Name: "deploy"}, {Name: "expense"}}, nil)
+
+		resp, err := router.Route(&model.CommandArgs{
+			Command:   "/approve new nope",
+			TriggerId: "trigger123",
+			UserId:    "user123",
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "Request template 'nope' not found")
+		assert.Contains(t, resp.Text, "Available templates: `deploy`, `expense`")
+		api.AssertNotCalled(t, "OpenInteractiveDialog", mock.Anything)
+	})
+}
+
+func TestRouteNew_GroupApprover(t *testing.T) {
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		siteURL := "https://mattermost.example.com"
+		config := &model.Config{}
+		config.ServiceSettings.SiteURL = &siteURL
+		api.On("GetConfig").Return(config)
+		return api, store, NewRouter(api, store)
+	}
+
+	captureDialog := func(api *plugintest.API) *model.OpenDialogRequest {
+		opened := &model.OpenDialogRequest{}
+		api.On("OpenInteractiveDialog", mock.Anything).Run(func(args mock.Arguments) {
+			*opened = args.Get(0).(model.OpenDialogRequest)
+		}).Return(nil)
+		return opened
+	}
+
+	t.Run("group dialog omits approver and carries group in state", func(t *testing.T) {
+		api, _, router := setup()
+		api.On("GetGroupByName", "sre-oncall").Return(&model.Group{Id: "group1"}, nil)
+		opened := captureDialog(api)
+
+		resp, err := router.Route(&model.CommandArgs{
+			Command:   "/approve new --group @sre-oncall",
+			TriggerId: "trigger123",
+			UserId:    "user123",
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Text)
+		require.Len(t, opened.Dialog.Elements, 3)
+		assert.Equal(t, "description", opened.Dialog.Elements[0].Name)
+		assert.Equal(t, "visibility", opened.Dialog.Elements[1].Name)
+		assert.Equal(t, "send_at", opened.Dialog.Elements[2].Name)
+		assert.Contains(t, opened.Dialog.IntroductionText, "**Approver group:** @sre-oncall")
+		assert.Equal(t, DialogState{ApproverGroup: "sre-oncall"}, ParseDialogState(opened.Dialog.State))
+	})
+
+	t.Run("role with template keeps template fields", func(t *testing.T) {
+		api, store, router := setup()
+		store.On("GetTemplate", "deploy").Return(&approval.RequestTemplate{
+			Name:             "deploy",
+			DefaultApprovers: []string{"bob"},
+			Fields:           []approval.CustomField{{Name: "env", Label: "Environment", Type: approval.FieldTypeText}},
+		}, nil)
+		opened := captureDialog(api)
+
+		_, err := router.Route(&model.CommandArgs{
+			Command:   "/approve new deploy --role channel_admin",
+			TriggerId: "trigger123",
+			UserId:    "user123",
+		})
+
+		assert.NoError(t, err)
+		require.Len(t, opened.Dialog.Elements, 4)
+		assert.Equal(t, "field_env", opened.Dialog.Elements[1].Name)
+		assert.Contains(t, opened.Dialog.IntroductionText, "**Template:** deploy")
+		assert.Contains(t, opened.Dialog.IntroductionText, "role `channel_admin`")
+		assert.Equal(t, DialogState{Template: "deploy", ApproverRole: "channel_admin"}, ParseDialogState(opened.Dialog.State))
+		api.AssertNotCalled(t, "GetUserByUsername", mock.Anything)
+	})
+
+	t.Run("unknown group is rejected before opening the dialog", func(t *testing.T) {
+		api, _, router := setup()
+		api.On("GetGroupByName", "nope").Return(nil, model.NewAppError("GetGroupByName", "not_found", nil, "", 404))
+
+		resp, err := router.Route(&model.CommandArgs{
+			Command:   "/approve new --group nope",
+			TriggerId: "trigger123",
+			UserId:    "user123",
+		})
+
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "Group @nope not found")
+		api.AssertNotCalled(t, "OpenInteractiveDialog", mock.Anything)
+	})
+
+	t.Run("invalid arguments show usage", func(t *testing.T) {
+		for _, command := range []string{
+			"/approve new --group",
+			"/approve new --group sre --role channel_admin",
+			"/approve new --urgent",
+			"/approve new --role Not-A-Role!",
+			"/approve new deploy extra",
+		} {
+			_, _, router := setup()
+
+			resp, err := router.Route(&model.CommandArgs{Command: command, TriggerId: "trigger123", UserId: "user123"})
+
+			assert.NoError(t, err, command)
+			assert.Contains(t, resp.Text, "Usage: `/approve new [template] [--group <name> | --role <role>]`", command)
+		}
+	})
+}
+
+func TestFormatRecordDetail_GroupApproval(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		Code:                 "A-GROUP1",
+		Status:               approval.StatusPending,
+		RequesterUsername:    "alice",
+		ApproverUsername:     "sre-oncall",
+		ApproverGroupName:    "sre-oncall",
+		CandidateApproverIDs: []string{"bob", "carol"},
+		Description:          "Deploy",
+		CreatedAt:            1704931200000,
+	}
+
+	assert.Contains(t, formatRecordDetail(record, i18n.DefaultLocale, "", time.Now()), "**Approver group:** @sre-oncall (2 members, first to confirm decides)")
+
+	record.Status = approval.StatusApproved
+	record.ApproverID = "bob"
+	record.ApproverUsername = "bob"
+	record.ApproverDisplayName = "Bob Smith"
+	record.DecidedAt = 1704931300000
+
+	result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
+	assert.Contains(t, result, "**Approver:** @bob (Bob Smith)\n**Approver group:** @sre-oncall")
+}
+
+func TestFormatRecordDetail_CustomFields(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		ID:           "record123",
+		Code:         "A-X7K9Q2",
+		Status:       approval.StatusPending,
+		Description:  "Need database access",
+		CreatedAt:    1704931200000,
+		TemplateName: "prod-access",
+		CustomFields: []approval.CustomFieldValue{
+			{Name: "system", Label: "System", Value: "database"},
+			{Name: "hours", Label: "Duration (hours)", Value: "4"},
+		},
+	}
+
+	result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
+
+	assert.Contains(t, result, "**Template:** prod-access")
+	assert.Contains(t, result, "**System:** database")
+	assert.Contains(t, result, "**Duration (hours):** 4")
+}
+
+func TestFormatRecordDetail_Comments(t *testing.T) {
+	record := &approval.ApprovalRecord{
+		ID:          "record123",
+		Code:        "A-X7K9Q2",
+		Status:      approval.StatusPending,
+		Description: "Need database access",
+		CreatedAt:   1704931200000,
+		Comments: []approval.ApprovalComment{
+			{UserID: "approver456", Username: "bob", Message: "Which cluster?", CreatedAt: 1704931260000},
+			{UserID: "requester123", Username: "alice", Message: "The EU one", CreatedAt: 1704931320000},
+		},
+	}
+
+	result := formatRecordDetail(record, i18n.DefaultLocale, "", time.Now())
+
+	assert.Contains(t, result, "**💬 Comments (2):**")
+	assert.Contains(t, result, "- 2024-01-11 00:01:00 UTC @bob: Which cluster?")
+	assert.Contains(t, result, "- 2024-01-11 00:02:00 UTC @alice: The EU one")
+	assert.Less(t, strings.Index(result, "Which cluster?"), strings.Index(result, "The EU one"))
+
+	record.Comments = nil
+	assert.NotContains(t, formatRecordDetail(record, i18n.DefaultLocale, "", time.Now()), "Comments")
+}
+
+func TestExecuteStatus_SeparationOfDuties(t *testing.T) {
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		api.On("GetUser", "alice123").Return(&model.User{Id: "alice123", Username: "alice"}, nil)
+		api.On("GetUser", "bob123").Return(&model.User{Id: "bob123", Username: "bob"}, nil)
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api, store, NewRouter(api, store)
+	}
+
+	violations := []*approval.SoDViolation{
+		{Rule: approval.SoDRuleReciprocalApproval, UserID: "bob123", RequesterID: "alice123", Code: "A-PEND01", RelatedCode: "A-EARLY1", Timestamp: 1704931200000},
+		{Rule: approval.SoDRuleSelfApproval, UserID: "alice123", RequesterID: "alice123", Timestamp: 1704931100000},
+	}
+
+	t.Run("status summarizes blocked violations", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{{Status: approval.StatusPending, NotificationSent: true}}, nil)
+		store.On("GetSoDViolations").Return(violations, nil)
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status", UserId: "admin123"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "**Separation of Duties:**")
+		assert.Contains(t, resp.Text, "Blocked Violations: 2 (Self-approval: 1, Reciprocal: 1)")
+	})
+
+	t.Run("status omits section when no violations", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{{Status: approval.StatusPending, NotificationSent: true}}, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil)
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status", UserId: "admin123"})
+
+		assert.NoError(t, err)
+		assert.NotContains(t, resp.Text, "Separation of Duties")
+	})
+
+	t.Run("--sod lists recent violations", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{}, nil)
+		store.On("GetSoDViolations").Return(violations, nil)
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status --sod", UserId: "admin123"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "| 2024-01-11 00:00 | Reciprocal approval | @bob | @alice | `A-PEND01` | `A-EARLY1` |")
+		assert.Contains(t, resp.Text, "| Self-approval | @alice | @alice | - | - |")
+	})
+
+	t.Run("--sod with no violations", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{}, nil)
+		store.On("GetSoDViolations").Return([]*approval.SoDViolation{}, nil)
+
+		resp, err := router.Route(&model.CommandArgs{Command: "/approve status --sod", UserId: "admin123"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, resp.Text, "No violations recorded")
+	})
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// searchLimit caps the rows shown by /approve search, like /approve list
+const searchLimit = 20
+
+// searchDateLayout is the format of the after: and before: filters
+const searchDateLayout = "2006-01-02"
+
+// executeSearch handles /approve search <words> [from:@user] [to:@user] [after:DATE] [before:DATE] [status:STATUS]
+func (r *Router) executeSearch(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	if len(subargs) == 0 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "search.usage"),
+		}, nil
+	}
+
+	query, err := parseSearchQuery(subargs, r.timezone)
+	if err != nil {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "search.invalid_args", err.Error()) + "\n\n" + i18n.T(r.locale, "search.usage"),
+		}, nil
+	}
+
+	// Access control: SearchApprovals only returns records where this user is requester or approver,
+	// like GetUserApprovals for /approve list
+	records, err := r.store.SearchApprovals(args.UserId, query)
+	if err != nil {
+		r.api.LogError("Failed to search approval records", "user_id", args.UserId, "error", err.Error())
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "search.failed"),
+		}, nil
+	}
+
+	queryText := strings.Join(subargs, " ")
+	if len(records) == 0 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "search.empty", queryText),
+		}, nil
+	}
+
+	responseText := formatSearchResponse(records, queryText, r.locale, r.timezone, time.Now())
+
+	// Send as ephemeral post so the markdown table renders, as /approve list does
+	post := &model.Post{
+		UserId:    args.UserId,
+		ChannelId: args.ChannelId,
+		Message:   responseText,
+	}
+	if ephemeralPost := r.api.SendEphemeralPost(args.UserId, post); ephemeralPost == nil {
+		r.api.LogError("Failed to send ephemeral search response", "user_id", args.UserId)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         responseText,
+		}, nil
+	}
+
+	return &model.CommandResponse{}, nil
+}
+
+// parseSearchQuery parses the search arguments. Filters take the form key:value; every other
+// argument contributes description terms. Dates are midnight in the caller's timezone, and
+// like Mattermost message search, after: and before: exclude the given day.
+func parseSearchQuery(fields []string, timezone string) (approval.SearchQuery, error) {
+	var query approval.SearchQuery
+	var words []string
+
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, ":")
+		if !found {
+			words = append(words, field)
+			continue
+		}
+
+		switch strings.ToLower(key) {
+		case "from":
+			query.From = strings.ToLower(strings.TrimPrefix(value, "@"))
+			if query.From == "" {
+				return approval.SearchQuery{}, fmt.Errorf("from: requires a username")
+			}
+		case "to":
+			query.To = strings.ToLower(strings.TrimPrefix(value, "@"))
+			if query.To == "" {
+				return approval.SearchQuery{}, fmt.Errorf("to: requires a username")
+			}
+		case "after":
+			day, err := time.ParseInLocation(searchDateLayout, value, i18n.Location(timezone))
+			if err != nil {
+				return approval.SearchQuery{}, fmt.Errorf("invalid date '%s', use YYYY-MM-DD", value)
+			}
+			query.After = day.AddDate(0, 0, 1).UnixMilli()
+		case "before":
+			day, err := time.ParseInLocation(searchDateLayout, value, i18n.Location(timezone))
+			if err != nil {
+				return approval.SearchQuery{}, fmt.Errorf("invalid date '%s', use YYYY-MM-DD", value)
+			}
+			query.Before = day.UnixMilli()
+		case "status":
+			query.Status = strings.ToLower(value)
+			if !approval.IsValidStatus(query.Status) {
+				return approval.SearchQuery{}, fmt.Errorf("invalid status '%s'", value)
+			}
+		default:
+			// Not a filter (e.g. "db:prod"); search for its words
+			words = append(words, field)
+		}
+	}
+
+	query.Terms = approval.SearchTokens(strings.Join(words, " "))
+
+	if query.After != 0 && query.Before != 0 && query.After >= query.Before {
+		return approval.SearchQuery{}, fmt.Errorf("after: and before: leave no days to search")
+	}
+	if query.IsEmpty() {
+		return approval.SearchQuery{}, fmt.Errorf("search words need at least two letters or digits")
+	}
+
+	return query, nil
+}
+
+// formatSearchResponse renders search results, newest first, as a single table capped at searchLimit rows
+func formatSearchResponse(records []*approval.ApprovalRecord, queryText, locale, timezone string, now time.Time) string {
+	var output strings.Builder
+
+	output.WriteString(i18n.T(locale, "search.header", queryText, len(records)))
+	output.WriteString(i18n.T(locale, "list.table_header"))
+
+	displayed := min(len(records), searchLimit)
+	for _, record := range records[:displayed] {
+		output.WriteString(formatListRow(record, locale, timezone, now))
+	}
+
+	if len(records) > displayed {
+		output.WriteString("\n")
+		output.WriteString(i18n.T(locale, "search.footer", displayed, len(records)))
+	}
+
+	return output.String()
+}
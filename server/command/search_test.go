@@ -0,0 +1,176 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	t.Run("words and filters", func(t *testing.T) {
+		query, err := parseSearchQuery([]string{"Firewall", "from:@Alice", "to:bob", "status:Approved", "port", "443"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, approval.SearchQuery{
+			Terms:  []string{"firewall", "port", "443"},
+			From:   "alice",
+			To:     "bob",
+			Status: approval.StatusApproved,
+		}, query)
+	})
+
+	t.Run("dates exclude the given day in UTC", func(t *testing.T) {
+		query, err := parseSearchQuery([]string{"after:2024-01-10", "before:2024-01-15"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC).UnixMilli(), query.After)
+		assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC).UnixMilli(), query.Before)
+	})
+
+	t.Run("dates are midnight in the caller's timezone", func(t *testing.T) {
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		query, err := parseSearchQuery([]string{"before:2024-01-15"}, "Asia/Tokyo")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, tokyo).UnixMilli(), query.Before)
+	})
+
+	t.Run("unknown filters are searched as words", func(t *testing.T) {
+		query, err := parseSearchQuery([]string{"db:prod"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db", "prod"}, query.Terms)
+	})
+
+	errorTests := []struct {
+		name    string
+		fields  []string
+		wantErr string
+	}{
+		{name: "empty from", fields: []string{"from:@"}, wantErr: "from: requires a username"},
+		{name: "empty to", fields: []string{"to:"}, wantErr: "to: requires a username"},
+		{name: "invalid date", fields: []string{"after:01/10/2024"}, wantErr: "invalid date '01/10/2024', use YYYY-MM-DD"},
+		{name: "invalid status", fields: []string{"status:done"}, wantErr: "invalid status 'done'"},
+		{name: "empty date range", fields: []string{"after:2024-01-10", "before:2024-01-11"}, wantErr: "after: and before: leave no days to search"},
+		{name: "only short words", fields: []string{"a", "-"}, wantErr: "search words need at least two letters or digits"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSearchQuery(tt.fields, "")
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExecuteSearch(t *testing.T) {
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		return api, store, NewRouter(api, store)
+	}
+
+	args := func(command string) *model.CommandArgs {
+		return &model.CommandArgs{Command: command, UserId: "user123", ChannelId: "channel123"}
+	}
+
+	t.Run("no arguments shows usage", func(t *testing.T) {
+		_, store, router := setup()
+
+		resp, err := router.Route(args("/approve search"))
+		require.NoError(t, err)
+		assert.Contains(t, resp.Text, "Usage: `/approve search <words>")
+		store.AssertNotCalled(t, "SearchApprovals", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid query shows the error and usage", func(t *testing.T) {
+		_, store, router := setup()
+
+		resp, err := router.Route(args("/approve search status:done"))
+		require.NoError(t, err)
+		assert.Contains(t, resp.Text, "❌ Invalid search: invalid status 'done'")
+		assert.Contains(t, resp.Text, "Usage: `/approve search <words>")
+		store.AssertNotCalled(t, "SearchApprovals", mock.Anything, mock.Anything)
+	})
+
+	t.Run("searches as the calling user", func(t *testing.T) {
+		api, store, router := setup()
+
+		records := []*approval.ApprovalRecord{
+			{
+				ID:                "record1",
+				Code:              "A-ABC123",
+				Status:            approval.StatusApproved,
+				RequesterID:       "user123",
+				RequesterUsername: "alice",
+				ApproverUsername:  "bob",
+				Description:       "Open firewall port",
+				CreatedAt:         1705000000000,
+			},
+		}
+		store.On("SearchApprovals", "user123", approval.SearchQuery{Terms: []string{"firewall"}, To: "bob"}).Return(records, nil)
+
+		var capturedPost *model.Post
+		api.On("SendEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+			capturedPost = post
+			return true
+		})).Return(&model.Post{})
+
+		resp, err := router.Route(args("/approve search firewall to:@bob"))
+		require.NoError(t, err)
+		assert.Empty(t, resp.Text)
+		require.NotNil(t, capturedPost)
+		assert.Contains(t, capturedPost.Message, "## Search Results for `firewall to:@bob` (1)")
+		assert.Contains(t, capturedPost.Message, "| A-ABC123 |")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("SearchApprovals", "user123", mock.Anything).Return([]*approval.ApprovalRecord{}, nil)
+
+		resp, err := router.Route(args("/approve search vpn"))
+		require.NoError(t, err)
+		assert.Equal(t, "No approval requests match `vpn`.", resp.Text)
+	})
+
+	t.Run("store failure is reported", func(t *testing.T) {
+		api, store, router := setup()
+		store.On("SearchApprovals", "user123", mock.Anything).Return(nil, errors.New("kv down"))
+		api.On("LogError", "Failed to search approval records", "user_id", "user123", "error", "kv down").Once()
+
+		resp, err := router.Route(args("/approve search vpn"))
+		require.NoError(t, err)
+		assert.Contains(t, resp.Text, "❌ Failed to search approval records")
+		api.AssertExpectations(t)
+	})
+}
+
+func TestFormatSearchResponse(t *testing.T) {
+	records := make([]*approval.ApprovalRecord, 0, searchLimit+5)
+	for i := range searchLimit + 5 {
+		records = append(records, &approval.ApprovalRecord{
+			ID:                fmt.Sprintf("record%d", i),
+			Code:              fmt.Sprintf("A-%06d", i),
+			Status:            approval.StatusPending,
+			RequesterUsername: "alice",
+			ApproverUsername:  "bob",
+			CreatedAt:         1705000000000,
+		})
+	}
+
+	output := formatSearchResponse(records, "deploy", "en", "", time.Now())
+	assert.Contains(t, output, "## Search Results for `deploy` (25)")
+	assert.Contains(t, output, "| A-000019 |")
+	assert.NotContains(t, output, "| A-000020 |")
+	assert.Contains(t, output, "*Showing 20 of 25 matches.*")
+
+	output = formatSearchResponse(records[:3], "deploy", "en", "", time.Now())
+	assert.Contains(t, output, "| A-000002 |")
+	assert.NotContains(t, output, "*Showing")
+}
@@ -0,0 +1,195 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// slaUsage is shown for missing or unknown /approve admin sla subcommands
+const slaUsage = `**SLA Commands:**
+
+* **/approve admin sla list** - List SLA targets
+* **/approve admin sla set global <duration> [channel=~name]** - Set the SLA for every request
+* **/approve admin sla set team <duration> [channel=~name]** - Set the SLA for the current team
+* **/approve admin sla set template <name> <duration> [channel=~name]** - Set the SLA for a request template
+* **/approve admin sla delete global|team|template <name>** - Remove an SLA target
+
+**Duration:** whole minutes, e.g. ` + "`10m`, `20m`" + ` (up to 29m).
+**channel=~name:** a channel in the current team that is alerted when a request breaches its SLA.
+
+The most specific target applies when a request is created: template, then team, then global. When a request is still pending after its SLA, the approver is sent a DM and the escalation channel is alerted once. Requests time out after 30 minutes, so targets must be shorter.`
+
+// executeAdminSLA handles /approve admin sla list|set|delete
+func (r *Router) executeAdminSLA(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	action := ""
+	if len(subargs) > 0 {
+		action = subargs[0]
+	}
+
+	var text string
+	switch action {
+	case "list":
+		text = r.listSLATargets()
+	case "set":
+		scope, rest, err := parseSLAScope(args.TeamId, subargs[1:])
+		if err != nil {
+			text = fmt.Sprintf("❌ %s\n\n%s", err.Error(), slaUsage)
+		} else {
+			text = r.setSLATarget(args.UserId, args.TeamId, scope, rest)
+		}
+	case "delete":
+		scope, rest, err := parseSLAScope(args.TeamId, subargs[1:])
+		if err != nil || len(rest) > 0 {
+			text = "Usage: /approve admin sla delete global|team|template <name>"
+		} else {
+			text = r.deleteSLATarget(scope)
+		}
+	default:
+		text = slaUsage
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         text,
+	}, nil
+}
+
+// parseSLAScope extracts the SLA scope ("global", "team" or "template <name>") and the remaining arguments
+func parseSLAScope(teamID string, subargs []string) (string, []string, error) {
+	if len(subargs) == 0 {
+		return "", nil, fmt.Errorf("SLA scope is required: use 'global', 'team' or 'template <name>'")
+	}
+
+	switch subargs[0] {
+	case approval.SLAScopeGlobal:
+		return approval.SLAScopeGlobal, subargs[1:], nil
+	case approval.PolicyScopeTeam:
+		if teamID == "" {
+			return "", nil, fmt.Errorf("team SLA targets must be managed from a team channel")
+		}
+		return approval.TeamPolicyScope(teamID), subargs[1:], nil
+	case approval.PolicyScopeTemplate:
+		if len(subargs) < 2 {
+			return "", nil, fmt.Errorf("template name is required")
+		}
+		return approval.TemplatePolicyScope(strings.ToLower(subargs[1])), subargs[2:], nil
+	default:
+		return "", nil, fmt.Errorf("invalid SLA scope '%s': use 'global', 'team' or 'template <name>'", subargs[0])
+	}
+}
+
+// setSLATarget parses the duration and optional escalation channel, then saves the SLA target
+func (r *Router) setSLATarget(userID, teamID, scope string, rest []string) string {
+	if len(rest) == 0 {
+		return "❌ SLA duration is required.\n\n" + slaUsage
+	}
+
+	minutes, err := approval.ParseSLATarget(rest[0])
+	if err != nil {
+		return fmt.Sprintf("❌ %s", err.Error())
+	}
+
+	target := &approval.SLATarget{Scope: scope, Minutes: minutes}
+	for _, option := range rest[1:] {
+		key, value, found := strings.Cut(option, "=")
+		if !found || strings.ToLower(key) != "channel" || value == "" {
+			return fmt.Sprintf("❌ Invalid option '%s'. Expected channel=~name.", option)
+		}
+
+		if teamID == "" {
+			return "❌ Escalation channels must be set from a team channel."
+		}
+
+		name := strings.TrimPrefix(value, "~")
+		channel, appErr := r.api.GetChannelByName(teamID, name, false)
+		if appErr != nil {
+			return fmt.Sprintf("❌ Channel ~%s not found in this team.", name)
+		}
+		target.EscalationChannelID = channel.Id
+	}
+
+	if err := approval.ValidateSLATarget(target); err != nil {
+		return fmt.Sprintf("❌ Invalid SLA target: %s", err.Error())
+	}
+
+	target.UpdatedBy = userID
+	target.UpdatedAt = model.GetMillis()
+
+	if err := r.store.SaveSLATarget(target); err != nil {
+		r.api.LogError("Failed to save SLA target", "scope", scope, "error", err.Error())
+		return "❌ Failed to save SLA target. Please try again."
+	}
+
+	r.api.LogInfo("SLA target saved", "scope", scope, "minutes", minutes, "user_id", userID)
+
+	return fmt.Sprintf("✅ SLA for %s set to %s. Escalation: %s. Applies to requests created from now on.",
+		r.formatSLAScope(scope), approval.FormatSLATarget(minutes), r.formatEscalationChannel(target.EscalationChannelID))
+}
+
+// deleteSLATarget removes the SLA target for a scope
+func (r *Router) deleteSLATarget(scope string) string {
+	if _, err := r.store.GetSLATarget(scope); err != nil {
+		if errors.Is(err, approval.ErrSLATargetNotFound) {
+			return fmt.Sprintf("❌ No SLA target defined for %s.", r.formatSLAScope(scope))
+		}
+		r.api.LogError("Failed to load SLA target for deletion", "scope", scope, "error", err.Error())
+		return "❌ Failed to delete SLA target. Please try again."
+	}
+
+	if err := r.store.DeleteSLATarget(scope); err != nil {
+		r.api.LogError("Failed to delete SLA target", "scope", scope, "error", err.Error())
+		return "❌ Failed to delete SLA target. Please try again."
+	}
+
+	r.api.LogInfo("SLA target deleted", "scope", scope)
+
+	return fmt.Sprintf("✅ SLA target for %s deleted. Requests already created keep their SLA.", r.formatSLAScope(scope))
+}
+
+// listSLATargets formats all SLA targets for display
+func (r *Router) listSLATargets() string {
+	targets, err := r.store.ListSLATargets()
+	if err != nil {
+		r.api.LogError("Failed to list SLA targets", "error", err.Error())
+		return "❌ Failed to retrieve SLA targets. Please try again."
+	}
+
+	if len(targets) == 0 {
+		return "No SLA targets defined. Use `/approve admin sla set global 15m` to track time to decision."
+	}
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("**⏱️ SLA Targets (%d)**\n\n", len(targets)))
+	output.WriteString("| Scope | Decide Within | Escalation Channel |\n")
+	output.WriteString("|-------|---------------|--------------------|\n")
+	for _, target := range targets {
+		output.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
+			r.formatSLAScope(target.Scope), approval.FormatSLATarget(target.Minutes), r.formatEscalationChannel(target.EscalationChannelID)))
+	}
+	output.WriteString("\n*The most specific target applies: template, then team, then global.*")
+
+	return output.String()
+}
+
+// formatSLAScope renders an SLA scope for display
+func (r *Router) formatSLAScope(scope string) string {
+	if scope == approval.SLAScopeGlobal {
+		return "all requests"
+	}
+	return r.formatPolicyScope(scope)
+}
+
+// formatEscalationChannel renders an escalation channel as ~name, or "approver only" when unset
+func (r *Router) formatEscalationChannel(channelID string) string {
+	if channelID == "" {
+		return "approver only"
+	}
+	if channel, appErr := r.api.GetChannel(channelID); appErr == nil {
+		return "~" + channel.Name
+	}
+	return "`" + channelID + "`"
+}
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteAdminSLA(t *testing.T) {
+	const teamID = "teamaaaaaaaaaaaaaaaaaaaaaa"
+	const channelID = "channelaaaaaaaaaaaaaaaaaaa"
+	teamScope := approval.TeamPolicyScope(teamID)
+
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		api.On("GetTeam", teamID).Return(&model.Team{Id: teamID, DisplayName: "Engineering"}, nil).Maybe()
+		api.On("GetChannel", channelID).Return(&model.Channel{Id: channelID, Name: "approval-alerts"}, nil).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		return api, store, NewRouter(api, store)
+	}
+
+	route := func(router *Router, command string) string {
+		resp, err := router.Route(&model.CommandArgs{Command: command, UserId: "admin123", TeamId: teamID})
+		assert.NoError(t, err)
+		return resp.Text
+	}
+
+	t.Run("missing subcommand shows usage", func(t *testing.T) {
+		_, _, router := setup()
+
+		assert.Contains(t, route(router, "/approve admin sla"), "/approve admin sla set global <duration> [channel=~name]")
+	})
+
+	t.Run("set global target", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("SaveSLATarget", mock.MatchedBy(func(target *approval.SLATarget) bool {
+			return target.Scope == approval.SLAScopeGlobal &&
+				target.Minutes == 20 &&
+				target.EscalationChannelID == "" &&
+				target.UpdatedBy == "admin123"
+		})).Return(nil)
+
+		text := route(router, "/approve admin sla set global 20m")
+
+		assert.Contains(t, text, "✅ SLA for all requests set to 20m. Escalation: approver only.")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("set team target with escalation channel", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetChannelByName", teamID, "approval-alerts", false).Return(&model.Channel{Id: channelID, Name: "approval-alerts"}, nil)
+		store.On("SaveSLATarget", mock.MatchedBy(func(target *approval.SLATarget) bool {
+			return target.Scope == teamScope && target.Minutes == 15 && target.EscalationChannelID == channelID
+		})).Return(nil)
+
+		text := route(router, "/approve admin sla set team 15m channel=~approval-alerts")
+
+		assert.Contains(t, text, "✅ SLA for team Engineering set to 15m. Escalation: ~approval-alerts.")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("set template target", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("SaveSLATarget", mock.MatchedBy(func(target *approval.SLATarget) bool {
+			return target.Scope == "template:prod-access" && target.Minutes == 25
+		})).Return(nil)
+
+		text := route(router, "/approve admin sla set template Prod-Access 25m")
+
+		assert.Contains(t, text, "✅ SLA for template `prod-access` set to 25m.")
+	})
+
+	t.Run("target that cannot end before the timeout is rejected", func(t *testing.T) {
+		_, store, router := setup()
+
+		text := route(router, "/approve admin sla set global 2h")
+
+		assert.Contains(t, text, "❌ SLA target must be between 1m and 29m: pending requests time out after 30m")
+		store.AssertNotCalled(t, "SaveSLATarget", mock.Anything)
+	})
+
+	t.Run("unknown escalation channel is rejected", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetChannelByName", teamID, "ghost", false).Return(nil, &model.AppError{Message: "not found"})
+
+		text := route(router, "/approve admin sla set global 15m channel=~ghost")
+
+		assert.Contains(t, text, "❌ Channel ~ghost not found in this team.")
+		store.AssertNotCalled(t, "SaveSLATarget", mock.Anything)
+	})
+
+	t.Run("invalid duration is rejected", func(t *testing.T) {
+		_, store, router := setup()
+
+		assert.Contains(t, route(router, "/approve admin sla set global soon"), "❌")
+		assert.Contains(t, route(router, "/approve admin sla set global 30s"), "❌")
+		assert.Contains(t, route(router, "/approve admin sla set global 200h"), "❌")
+		store.AssertNotCalled(t, "SaveSLATarget", mock.Anything)
+	})
+
+	t.Run("invalid scope is rejected", func(t *testing.T) {
+		_, store, router := setup()
+
+		assert.Contains(t, route(router, "/approve admin sla set channel 15m"), "❌ invalid SLA scope 'channel'")
+		store.AssertNotCalled(t, "SaveSLATarget", mock.Anything)
+	})
+
+	t.Run("delete removes existing target", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetSLATarget", teamScope).Return(&approval.SLATarget{Scope: teamScope, Minutes: 15}, nil)
+		store.On("DeleteSLATarget", teamScope).Return(nil)
+
+		text := route(router, "/approve admin sla delete team")
+
+		assert.Contains(t, text, "✅ SLA target for team Engineering deleted.")
+		store.AssertExpectations(t)
+	})
+
+	t.Run("delete reports missing target", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("GetSLATarget", approval.SLAScopeGlobal).Return(nil, fmt.Errorf("SLA target global: %w", approval.ErrSLATargetNotFound))
+
+		text := route(router, "/approve admin sla delete global")
+
+		assert.Contains(t, text, "❌ No SLA target defined for all requests.")
+		store.AssertNotCalled(t, "DeleteSLATarget", mock.Anything)
+	})
+
+	t.Run("list shows targets as table", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("ListSLATargets").Return([]*approval.SLATarget{
+			{Scope: approval.SLAScopeGlobal, Minutes: 20},
+			{Scope: teamScope, Minutes: 15, EscalationChannelID: channelID},
+		}, nil)
+
+		text := route(router, "/approve admin sla list")
+
+		assert.Contains(t, text, "SLA Targets (2)")
+		assert.Contains(t, text, "| all requests | 20m | approver only |")
+		assert.Contains(t, text, "| team Engineering | 15m | ~approval-alerts |")
+	})
+
+	t.Run("list with no targets", func(t *testing.T) {
+		_, store, router := setup()
+		store.On("ListSLATargets").Return([]*approval.SLATarget{}, nil)
+
+		assert.Contains(t, route(router, "/approve admin sla list"), "No SLA targets defined.")
+	})
+}
@@ -0,0 +1,397 @@
+package command
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// statsDefaultSince is the --since window used when none is given
+	statsDefaultSince = "30d"
+
+	// statsMaxWindow bounds --since so the window start stays a valid timestamp
+	statsMaxWindow = 3650 * 24 * time.Hour
+
+	// statsTopApprovers caps the busiest approvers table
+	statsTopApprovers = 5
+
+	// statsDateLayout renders the start of the window
+	statsDateLayout = "2006-01-02"
+)
+
+// StatsQuery is a parsed /approve stats invocation
+type StatsQuery struct {
+	Username  string        // Target user without "@"; empty for the caller
+	TeamName  string        // Target team name without "~"; empty for a user
+	Since     time.Duration // Only requests created within this window are counted
+	SinceText string        // The window as given, e.g. "30d"
+}
+
+// ApprovalAnalytics holds decision-time and outcome statistics for a set of approval records
+type ApprovalAnalytics struct {
+	Total          int
+	Pending        int // Includes scheduled requests not yet sent
+	Approved       int
+	Denied         int
+	Canceled       int // Includes timed-out requests
+	TimedOut       int
+	Verified       int
+	MedianDecision time.Duration // Zero when nothing was decided
+	P90Decision    time.Duration
+	Approvers      []ApproverActivity // Busiest first, capped at statsTopApprovers
+}
+
+// ApproverActivity summarizes the decisions made by one approver
+type ApproverActivity struct {
+	UserID         string
+	Username       string
+	Decisions      int
+	MedianDecision time.Duration
+}
+
+// executeStats handles /approve stats [@user|~team] [--since 30d]. Users see their own
+// statistics; system admins may also query any user or team.
+func (r *Router) executeStats(args *model.CommandArgs, subargs []string) (*model.CommandResponse, error) {
+	query, err := parseStatsArgs(subargs)
+	if err != nil {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "stats.invalid_args", err.Error()) + "\n\n" + i18n.T(r.locale, "stats.usage"),
+		}, nil
+	}
+
+	caller, appErr := r.api.GetUser(args.UserId)
+	if appErr != nil {
+		r.api.LogError("Failed to get user for stats command", "user_id", args.UserId, "error", appErr.Error())
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(r.locale, "command.permission_check_failed"),
+		}, nil
+	}
+
+	since := time.Now().Add(-query.Since).UnixMilli()
+
+	var responseText string
+	if query.TeamName != "" {
+		responseText = r.teamStats(caller, query, since)
+	} else {
+		responseText = r.userStats(caller, query, since)
+	}
+
+	// Send as ephemeral post so the approvers table renders, as /approve search does
+	post := &model.Post{
+		UserId:    args.UserId,
+		ChannelId: args.ChannelId,
+		Message:   responseText,
+	}
+	if ephemeralPost := r.api.SendEphemeralPost(args.UserId, post); ephemeralPost == nil {
+		r.api.LogError("Failed to send ephemeral stats response", "user_id", args.UserId)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         responseText,
+		}, nil
+	}
+
+	return &model.CommandResponse{}, nil
+}
+
+// userStats renders the received and sent statistics of the caller, or of another user for admins
+func (r *Router) userStats(caller *model.User, query StatsQuery, since int64) string {
+	target := caller
+	if query.Username != "" && !strings.EqualFold(query.Username, caller.Username) {
+		if !approval.IsSystemAdminUser(caller) {
+			return i18n.T(r.locale, "stats.permission_denied")
+		}
+
+		user, appErr := r.api.GetUserByUsername(query.Username)
+		if appErr != nil {
+			return i18n.T(r.locale, "stats.user_not_found", query.Username)
+		}
+		target = user
+	}
+
+	records, err := r.store.GetUserApprovals(target.Id)
+	if err != nil {
+		r.api.LogError("Failed to retrieve approval records for stats command", "user_id", target.Id, "error", err.Error())
+		return i18n.T(r.locale, "stats.failed")
+	}
+
+	var received, sent []*approval.ApprovalRecord
+	for _, record := range records {
+		if record.RequesterID == target.Id {
+			sent = append(sent, record)
+		}
+		if receivedBy(record, target.Id) {
+			received = append(received, record)
+		}
+	}
+
+	var output strings.Builder
+	output.WriteString(i18n.T(r.locale, "stats.header", "@"+target.Username))
+	output.WriteString(r.formatStatsPeriod(query, since))
+	output.WriteString(r.formatAnalytics("stats.section.received", CalculateAnalytics(received, since), false))
+	output.WriteString(r.formatAnalytics("stats.section.sent", CalculateAnalytics(sent, since), true))
+	return output.String()
+}
+
+// teamStats renders the statistics of every request created in a team (system admins only)
+func (r *Router) teamStats(caller *model.User, query StatsQuery, since int64) string {
+	if !approval.IsSystemAdminUser(caller) {
+		return i18n.T(r.locale, "stats.permission_denied")
+	}
+
+	team, appErr := r.api.GetTeamByName(query.TeamName)
+	if appErr != nil {
+		return i18n.T(r.locale, "stats.team_not_found", query.TeamName)
+	}
+
+	records, err := r.store.GetAllApprovals()
+	if err != nil {
+		r.api.LogError("Failed to retrieve approval records for stats command", "team_id", team.Id, "error", err.Error())
+		return i18n.T(r.locale, "stats.failed")
+	}
+
+	teamRecords := make([]*approval.ApprovalRecord, 0, len(records))
+	for _, record := range records {
+		if record.TeamID == team.Id {
+			teamRecords = append(teamRecords, record)
+		}
+	}
+
+	var output strings.Builder
+	output.WriteString(i18n.T(r.locale, "stats.header", "~"+team.Name))
+	output.WriteString(r.formatStatsPeriod(query, since))
+	output.WriteString(r.formatAnalytics("stats.section.team", CalculateAnalytics(teamRecords, since), true))
+	return output.String()
+}
+
+// receivedBy reports whether the request counts towards the user's statistics as an approver:
+// requests they decided or were assigned, and undecided group requests they could have decided.
+// Group requests decided by another member count for that member only.
+func receivedBy(record *approval.ApprovalRecord, userID string) bool {
+	if record.ApproverID == userID {
+		return true
+	}
+	if !record.IsGroupApproval() || !record.CanDecide(userID) {
+		return false
+	}
+	return record.Status != approval.StatusApproved && record.Status != approval.StatusDenied
+}
+
+// parseStatsArgs parses [@user|~team] [--since <window>]
+func parseStatsArgs(fields []string) (StatsQuery, error) {
+	query := StatsQuery{SinceText: statsDefaultSince}
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		switch {
+		case field == "--since":
+			if i+1 >= len(fields) {
+				return StatsQuery{}, fmt.Errorf("--since requires a window such as 30d")
+			}
+			i++
+			query.SinceText = strings.ToLower(fields[i])
+		case strings.HasPrefix(field, "@") && len(field) > 1:
+			if query.Username != "" || query.TeamName != "" {
+				return StatsQuery{}, fmt.Errorf("only one @user or ~team can be given")
+			}
+			query.Username = strings.ToLower(field[1:])
+		case strings.HasPrefix(field, "~") && len(field) > 1:
+			if query.Username != "" || query.TeamName != "" {
+				return StatsQuery{}, fmt.Errorf("only one @user or ~team can be given")
+			}
+			query.TeamName = strings.ToLower(field[1:])
+		default:
+			return StatsQuery{}, fmt.Errorf("unexpected argument '%s'", field)
+		}
+	}
+
+	since, err := parseStatsWindow(query.SinceText)
+	if err != nil {
+		return StatsQuery{}, err
+	}
+	query.Since = since
+
+	return query, nil
+}
+
+// parseStatsWindow parses a window of hours, days or weeks such as "12h", "30d" or "4w"
+func parseStatsWindow(value string) (time.Duration, error) {
+	if len(value) < 2 {
+		return 0, fmt.Errorf("invalid window '%s', use e.g. 12h, 30d or 4w", value)
+	}
+
+	var unit time.Duration
+	switch value[len(value)-1] {
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("invalid window '%s', use e.g. 12h, 30d or 4w", value)
+	}
+
+	count, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || count < 1 {
+		return 0, fmt.Errorf("invalid window '%s', use e.g. 12h, 30d or 4w", value)
+	}
+	if int64(count) > int64(statsMaxWindow/unit) {
+		return 0, fmt.Errorf("window '%s' is longer than 3650 days", value)
+	}
+
+	return time.Duration(count) * unit, nil
+}
+
+// CalculateAnalytics computes outcome counts and decision times for the records created at or after
+// since (epoch milliseconds). Time to decision runs from when the request was sent (RequestedAt) to
+// DecidedAt of approved and denied requests; timed-out requests are the auto-canceled ones.
+func CalculateAnalytics(records []*approval.ApprovalRecord, since int64) ApprovalAnalytics {
+	var analytics ApprovalAnalytics
+	var decisionTimes []time.Duration
+	approvers := make(map[string]*ApproverActivity)
+	approverTimes := make(map[string][]time.Duration)
+
+	for _, record := range records {
+		if record.CreatedAt < since {
+			continue
+		}
+
+		analytics.Total++
+		switch record.Status {
+		case approval.StatusPending, approval.StatusScheduled:
+			analytics.Pending++
+		case approval.StatusApproved:
+			analytics.Approved++
+			if record.Verified && record.VerifiedAt != 0 {
+				analytics.Verified++
+			}
+		case approval.StatusDenied:
+			analytics.Denied++
+		case approval.StatusCanceled:
+			analytics.Canceled++
+			if notifications.IsAutoCanceled(record) {
+				analytics.TimedOut++
+			}
+		}
+
+		if record.Status != approval.StatusApproved && record.Status != approval.StatusDenied {
+			continue
+		}
+		if record.DecidedAt < record.RequestedAt() {
+			continue // Clock skew between nodes; the duration is meaningless
+		}
+
+		decisionTime := time.Duration(record.DecidedAt-record.RequestedAt()) * time.Millisecond
+		decisionTimes = append(decisionTimes, decisionTime)
+
+		activity, ok := approvers[record.ApproverID]
+		if !ok {
+			activity = &ApproverActivity{UserID: record.ApproverID, Username: record.ApproverUsername}
+			approvers[record.ApproverID] = activity
+		}
+		activity.Decisions++
+		approverTimes[record.ApproverID] = append(approverTimes[record.ApproverID], decisionTime)
+	}
+
+	analytics.MedianDecision = percentile(decisionTimes, 50)
+	analytics.P90Decision = percentile(decisionTimes, 90)
+
+	for approverID, activity := range approvers {
+		activity.MedianDecision = percentile(approverTimes[approverID], 50)
+		analytics.Approvers = append(analytics.Approvers, *activity)
+	}
+	sort.Slice(analytics.Approvers, func(i, j int) bool {
+		if analytics.Approvers[i].Decisions != analytics.Approvers[j].Decisions {
+			return analytics.Approvers[i].Decisions > analytics.Approvers[j].Decisions
+		}
+		return analytics.Approvers[i].Username < analytics.Approvers[j].Username
+	})
+	if len(analytics.Approvers) > statsTopApprovers {
+		analytics.Approvers = analytics.Approvers[:statsTopApprovers]
+	}
+
+	return analytics
+}
+
+// percentile returns the nearest-rank p-th percentile of durations, or zero if there are none.
+// The slice is sorted in place.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	rank := int(math.Ceil(p / 100 * float64(len(durations))))
+	rank = max(rank, 1)
+	return durations[rank-1]
+}
+
+// formatStatsPeriod renders the window the statistics cover, starting in the caller's timezone
+func (r *Router) formatStatsPeriod(query StatsQuery, since int64) string {
+	return i18n.T(r.locale, "stats.period", query.SinceText, i18n.FormatTime(since, statsDateLayout, r.timezone))
+}
+
+// formatAnalytics renders one statistics section, optionally followed by the busiest approvers
+func (r *Router) formatAnalytics(titleKey string, analytics ApprovalAnalytics, showApprovers bool) string {
+	var output strings.Builder
+	output.WriteString(i18n.T(r.locale, titleKey, analytics.Total))
+
+	if analytics.Total == 0 {
+		output.WriteString(i18n.T(r.locale, "stats.empty"))
+		return output.String()
+	}
+
+	decided := analytics.Approved + analytics.Denied
+	output.WriteString(i18n.T(r.locale, "stats.counts",
+		analytics.Pending, analytics.Approved, analytics.Denied, analytics.Canceled))
+	if decided > 0 {
+		output.WriteString(i18n.T(r.locale, "stats.decision_time",
+			r.formatStatsDuration(analytics.MedianDecision), r.formatStatsDuration(analytics.P90Decision)))
+	}
+	output.WriteString(i18n.T(r.locale, "stats.rates",
+		formatRate(analytics.Approved, decided),
+		formatRate(analytics.Denied, decided),
+		formatRate(analytics.TimedOut, analytics.Total-analytics.Pending),
+		formatRate(analytics.Verified, analytics.Approved)))
+
+	if showApprovers && len(analytics.Approvers) > 0 {
+		output.WriteString(i18n.T(r.locale, "stats.approvers_header"))
+		for _, activity := range analytics.Approvers {
+			output.WriteString(fmt.Sprintf("| @%s | %d | %s |\n",
+				activity.Username, activity.Decisions, r.formatStatsDuration(activity.MedianDecision)))
+		}
+	}
+
+	return output.String()
+}
+
+// formatStatsDuration renders a decision time in minutes, hours and minutes, or days and hours
+func (r *Router) formatStatsDuration(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return i18n.T(r.locale, "stats.duration.minutes", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return i18n.T(r.locale, "stats.duration.hours", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return i18n.T(r.locale, "stats.duration.days", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
+
+// formatRate renders part/total as a percentage with one decimal, or "-" when total is zero
+func formatRate(part, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)/float64(total)*100)
+}
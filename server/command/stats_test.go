@@ -0,0 +1,371 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatsArgs(t *testing.T) {
+	t.Run("defaults to the caller and 30 days", func(t *testing.T) {
+		query, err := parseStatsArgs(nil)
+		require.NoError(t, err)
+		assert.Equal(t, StatsQuery{Since: 30 * 24 * time.Hour, SinceText: "30d"}, query)
+	})
+
+	t.Run("user and window", func(t *testing.T) {
+		query, err := parseStatsArgs([]string{"--since", "2W", "@Alice"})
+		require.NoError(t, err)
+		assert.Equal(t, StatsQuery{Username: "alice", Since: 14 * 24 * time.Hour, SinceText: "2w"}, query)
+	})
+
+	t.Run("team and hours", func(t *testing.T) {
+		query, err := parseStatsArgs([]string{"~platform", "--since", "12h"})
+		require.NoError(t, err)
+		assert.Equal(t, StatsQuery{TeamName: "platform", Since: 12 * time.Hour, SinceText: "12h"}, query)
+	})
+
+	errorTests := []struct {
+		name    string
+		fields  []string
+		wantErr string
+	}{
+		{name: "missing window", fields: []string{"--since"}, wantErr: "--since requires a window such as 30d"},
+		{name: "invalid unit", fields: []string{"--since", "30m"}, wantErr: "invalid window '30m', use e.g. 12h, 30d or 4w"},
+		{name: "zero window", fields: []string{"--since", "0d"}, wantErr: "invalid window '0d', use e.g. 12h, 30d or 4w"},
+		{name: "window too long", fields: []string{"--since", "600w"}, wantErr: "window '600w' is longer than 3650 days"},
+		{name: "user and team", fields: []string{"@alice", "~platform"}, wantErr: "only one @user or ~team can be given"},
+		{name: "unexpected argument", fields: []string{"alice"}, wantErr: "unexpected argument 'alice'"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseStatsArgs(tt.fields)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCalculateAnalytics(t *testing.T) {
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC).UnixMilli()
+
+	verified := &approval.ApprovalRecord{
+		ID:               "record1",
+		Status:           approval.StatusApproved,
+		RequesterID:      "requester1",
+		ApproverID:       "bob",
+		ApproverUsername: "bob",
+		CreatedAt:        created,
+		DecidedAt:        created + (10 * time.Minute).Milliseconds(),
+		Verified:         true,
+		VerifiedAt:       created + (70 * time.Minute).Milliseconds(),
+	}
+
+	timedOut := &approval.ApprovalRecord{
+		ID:               "record2",
+		Status:           approval.StatusCanceled,
+		RequesterID:      "requester1",
+		ApproverID:       "bob",
+		ApproverUsername: "bob",
+		CreatedAt:        created,
+		DecidedAt:        created + (30 * time.Minute).Milliseconds(),
+		CanceledReason:   "Auto-canceled: No response within 30 minutes",
+	}
+	canceled := &approval.ApprovalRecord{
+		ID:               "record3",
+		Status:           approval.StatusCanceled,
+		RequesterID:      "requester1",
+		ApproverID:       "carol",
+		ApproverUsername: "carol",
+		CreatedAt:        created,
+		DecidedAt:        created + time.Minute.Milliseconds(),
+		CanceledReason:   "No longer needed",
+	}
+
+	records := []*approval.ApprovalRecord{
+		verified,
+		{
+			ID:               "record4",
+			Status:           approval.StatusApproved,
+			RequesterID:      "requester1",
+			ApproverID:       "bob",
+			ApproverUsername: "bob",
+			CreatedAt:        created,
+			DecidedAt:        created + (20 * time.Minute).Milliseconds(),
+		},
+		{
+			ID:               "record5",
+			Status:           approval.StatusDenied,
+			RequesterID:      "requester1",
+			ApproverID:       "bob",
+			ApproverUsername: "bob",
+			CreatedAt:        created,
+			DecidedAt:        created + (2 * time.Hour).Milliseconds(),
+		},
+		{
+			ID:               "record6",
+			Status:           approval.StatusApproved,
+			RequesterID:      "requester1",
+			ApproverID:       "carol",
+			ApproverUsername: "carol",
+			CreatedAt:        created,
+			DecidedAt:        created + (3 * time.Hour).Milliseconds(),
+		},
+		timedOut,
+		canceled,
+		{
+			ID:               "record7",
+			Status:           approval.StatusPending,
+			RequesterID:      "requester1",
+			ApproverID:       "carol",
+			ApproverUsername: "carol",
+			CreatedAt:        created,
+		},
+		// Created before the window: ignored
+		{
+			ID:               "record8",
+			Status:           approval.StatusApproved,
+			RequesterID:      "requester1",
+			ApproverID:       "dave",
+			ApproverUsername: "dave",
+			CreatedAt:        created - (48 * time.Hour).Milliseconds(),
+			DecidedAt:        created - (48*time.Hour - time.Minute).Milliseconds(),
+		},
+	}
+
+	analytics := CalculateAnalytics(records, created-time.Hour.Milliseconds())
+
+	assert.Equal(t, 7, analytics.Total)
+	assert.Equal(t, 1, analytics.Pending)
+	assert.Equal(t, 3, analytics.Approved)
+	assert.Equal(t, 1, analytics.Denied)
+	assert.Equal(t, 2, analytics.Canceled)
+	assert.Equal(t, 1, analytics.TimedOut)
+	assert.Equal(t, 1, analytics.Verified)
+
+	// Decision times: 10m, 20m, 2h, 3h (nearest rank)
+	assert.Equal(t, 20*time.Minute, analytics.MedianDecision)
+	assert.Equal(t, 3*time.Hour, analytics.P90Decision)
+
+	assert.Equal(t, []ApproverActivity{
+		{UserID: "bob", Username: "bob", Decisions: 3, MedianDecision: 20 * time.Minute},
+		{UserID: "carol", Username: "carol", Decisions: 1, MedianDecision: 3 * time.Hour},
+	}, analytics.Approvers)
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Zero(t, percentile(nil, 50))
+	assert.Equal(t, time.Minute, percentile([]time.Duration{time.Minute}, 90))
+
+	durations := []time.Duration{5, 1, 4, 2, 3, 10, 9, 8, 7, 6}
+	assert.Equal(t, time.Duration(5), percentile(durations, 50))
+	assert.Equal(t, time.Duration(9), percentile(durations, 90))
+	assert.Equal(t, time.Duration(10), percentile(durations, 100))
+}
+
+func TestExecuteStats(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-24 * time.Hour).UnixMilli()
+
+	setup := func() (*plugintest.API, *mockStore, *Router) {
+		api := &plugintest.API{}
+		store := &mockStore{}
+		return api, store, NewRouter(api, store)
+	}
+
+	args := func(command string) *model.CommandArgs {
+		return &model.CommandArgs{Command: command, UserId: "user123", ChannelId: "channel123"}
+	}
+
+	// captureMessage records the message of the ephemeral stats post
+	captureMessage := func(api *plugintest.API) *string {
+		message := new(string)
+		api.On("SendEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+			*message = post.Message
+			return true
+		})).Return(&model.Post{})
+		return message
+	}
+
+	t.Run("invalid arguments show the error and usage", func(t *testing.T) {
+		_, store, router := setup()
+
+		resp, err := router.Route(args("/approve stats --since forever"))
+		require.NoError(t, err)
+		assert.Contains(t, resp.Text, "❌ Invalid stats query: invalid window 'forever'")
+		assert.Contains(t, resp.Text, "Usage: `/approve stats [@user|~team] [--since 30d]`")
+		store.AssertNotCalled(t, "GetUserApprovals", mock.Anything)
+	})
+
+	t.Run("own statistics split received and sent requests", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice", Roles: "system_user"}, nil)
+
+		sent := &approval.ApprovalRecord{
+			ID:               "sent",
+			Status:           approval.StatusApproved,
+			RequesterID:      "user123",
+			ApproverID:       "bob",
+			ApproverUsername: "bob",
+			CreatedAt:        recent,
+			DecidedAt:        recent + (90 * time.Minute).Milliseconds(),
+		}
+		received := &approval.ApprovalRecord{
+			ID:               "received",
+			Status:           approval.StatusDenied,
+			RequesterID:      "requester1",
+			ApproverID:       "user123",
+			ApproverUsername: "alice",
+			CreatedAt:        recent,
+			DecidedAt:        recent + (5 * time.Minute).Milliseconds(),
+		}
+		// Decided by another group member: not counted as received
+		groupDecided := &approval.ApprovalRecord{
+			ID:                   "groupDecided",
+			Status:               approval.StatusApproved,
+			RequesterID:          "requester1",
+			ApproverID:           "carol",
+			ApproverUsername:     "carol",
+			ApproverGroupName:    "sre",
+			CandidateApproverIDs: []string{"user123", "carol"},
+			CreatedAt:            recent,
+			DecidedAt:            recent + time.Minute.Milliseconds(),
+		}
+		store.On("GetUserApprovals", "user123").Return([]*approval.ApprovalRecord{sent, received, groupDecided}, nil)
+		message := captureMessage(api)
+
+		resp, err := router.Route(args("/approve stats"))
+		require.NoError(t, err)
+		assert.Empty(t, resp.Text)
+		assert.Contains(t, *message, "## 📈 Approval Statistics for @alice")
+		assert.Contains(t, *message, "*Requests created in the last 30d (since ")
+		assert.Contains(t, *message, "### Requests Received (1)\n\n- Pending: 0 · Approved: 0 · Denied: 1 · Canceled: 0\n- Time to decision: median 5 min, p90 5 min\n- Approval rate: 0.0% · Denial rate: 100.0% · Timeout rate: 0.0% · Verification rate: -\n")
+		assert.Contains(t, *message, "### Requests Sent (1)")
+		assert.Contains(t, *message, "| @bob | 1 | 1 h 30 min |")
+		assert.NotContains(t, *message, "@carol")
+	})
+
+	t.Run("users cannot query other users", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice", Roles: "system_user"}, nil)
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats @bob"))
+		require.NoError(t, err)
+		assert.Equal(t, "❌ Permission denied. Only system administrators can view statistics of other users or teams.", *message)
+		store.AssertNotCalled(t, "GetUserApprovals", mock.Anything)
+		api.AssertNotCalled(t, "GetUserByUsername", mock.Anything)
+	})
+
+	t.Run("users can name themselves", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice", Roles: "system_user"}, nil)
+		store.On("GetUserApprovals", "user123").Return([]*approval.ApprovalRecord{}, nil)
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats @Alice --since 7d"))
+		require.NoError(t, err)
+		assert.Contains(t, *message, "*Requests created in the last 7d (since ")
+		assert.Contains(t, *message, "### Requests Received (0)\n\nNo requests in this period.\n")
+	})
+
+	t.Run("users cannot query teams", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice", Roles: "system_user"}, nil)
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats ~platform"))
+		require.NoError(t, err)
+		assert.Contains(t, *message, "Permission denied")
+		store.AssertNotCalled(t, "GetAllApprovals")
+	})
+
+	t.Run("admins query another user", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "admin", Roles: "system_user system_admin"}, nil)
+		api.On("GetUserByUsername", "bob").Return(&model.User{Id: "bob", Username: "bob"}, nil)
+		store.On("GetUserApprovals", "bob").Return([]*approval.ApprovalRecord{
+			{
+				ID:               "bobApproved",
+				Status:           approval.StatusApproved,
+				RequesterID:      "requester1",
+				ApproverID:       "bob",
+				ApproverUsername: "bob",
+				CreatedAt:        recent,
+				DecidedAt:        recent + (2 * time.Hour).Milliseconds(),
+			},
+		}, nil)
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats @bob"))
+		require.NoError(t, err)
+		assert.Contains(t, *message, "## 📈 Approval Statistics for @bob")
+		assert.Contains(t, *message, "- Time to decision: median 2 h 0 min, p90 2 h 0 min")
+	})
+
+	t.Run("admins query a team", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "admin", Roles: "system_admin"}, nil)
+		api.On("GetTeamByName", "platform").Return(&model.Team{Id: "team1", Name: "platform"}, nil)
+
+		inTeam := &approval.ApprovalRecord{
+			ID:               "inTeam",
+			Status:           approval.StatusApproved,
+			RequesterID:      "requester1",
+			ApproverID:       "bob",
+			ApproverUsername: "bob",
+			TeamID:           "team1",
+			CreatedAt:        recent,
+			DecidedAt:        recent + (26 * time.Hour).Milliseconds(),
+		}
+		otherTeam := &approval.ApprovalRecord{
+			ID:               "otherTeam",
+			Status:           approval.StatusDenied,
+			RequesterID:      "requester1",
+			ApproverID:       "carol",
+			ApproverUsername: "carol",
+			TeamID:           "team2",
+			CreatedAt:        recent,
+			DecidedAt:        recent + time.Minute.Milliseconds(),
+		}
+		store.On("GetAllApprovals").Return([]*approval.ApprovalRecord{inTeam, otherTeam}, nil)
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats ~platform"))
+		require.NoError(t, err)
+		assert.Contains(t, *message, "## 📈 Approval Statistics for ~platform")
+		assert.Contains(t, *message, "### Team Requests (1)")
+		assert.Contains(t, *message, "| @bob | 1 | 1 d 2 h |")
+		assert.NotContains(t, *message, "@carol")
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		api, _, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "admin", Roles: "system_admin"}, nil)
+		api.On("GetTeamByName", "nope").Return(nil, &model.AppError{Message: "not found"})
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats ~nope"))
+		require.NoError(t, err)
+		assert.Equal(t, "❌ Team ~nope not found.", *message)
+	})
+
+	t.Run("store failure is reported", func(t *testing.T) {
+		api, store, router := setup()
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice"}, nil)
+		store.On("GetUserApprovals", "user123").Return(nil, errors.New("kv down"))
+		api.On("LogError", "Failed to retrieve approval records for stats command", "user_id", "user123", "error", "kv down").Once()
+		message := captureMessage(api)
+
+		_, err := router.Route(args("/approve stats"))
+		require.NoError(t, err)
+		assert.Equal(t, "❌ Failed to retrieve approval statistics. Please try again.", *message)
+		api.AssertExpectations(t)
+	})
+}
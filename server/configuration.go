@@ -2,10 +2,17 @@ package main
 
 import (
 	"reflect"
+	"time"
 
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/digest"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
 	"github.com/pkg/errors"
 )
 
+// maxNotificationRetries caps NotificationMaxRetries; at the one hour backoff cap this is most of a day
+const maxNotificationRetries = 20
+
 // configuration captures the plugin's external configuration as exposed in the Mattermost server
 // configuration, as well as values computed from the configuration. Any public fields will be
 // deserialized from the Mattermost server configuration in OnConfigurationChange.
@@ -17,7 +24,30 @@ import (
 //
 // If you add non-reference types to your configuration struct, be sure to rewrite Clone as a deep
 // copy appropriate for your types.
-type configuration struct{}
+type configuration struct {
+	// ReciprocalApprovalWindowHours blocks a user from approving a request from someone who approved
+	// one of their requests within this many hours (separation of duties). 0 disables the rule.
+	ReciprocalApprovalWindowHours int
+
+	// DigestCadence controls the scheduled approval digest for opted-in users:
+	// "off" (or empty), "daily", or "weekly" (sent on Mondays).
+	DigestCadence string
+
+	// DigestHour is the UTC hour (0-23) the digest is sent at.
+	DigestHour int
+
+	// NotificationMaxRetries is how many times a failed approver or outcome DM is redelivered
+	// before system admins are alerted. 0 disables the retry queue.
+	NotificationMaxRetries int
+
+	// Message templates override the text of each notification DM kind with a Go text/template.
+	// Empty uses the built-in message; see notifications.TemplateData for the available data.
+	RequestMessageTemplate      string
+	OutcomeMessageTemplate      string
+	CanceledMessageTemplate     string
+	TimeoutMessageTemplate      string
+	VerificationMessageTemplate string
+}
 
 // Clone shallow copies the configuration. Your implementation may require a deep copy if
 // your configuration has reference types.
@@ -28,10 +58,53 @@ func (c *configuration) Clone() *configuration {
 
 // IsValid checks if the configuration is valid
 func (c *configuration) IsValid() error {
-	// No validation needed for MVP (no config fields)
+	if c.ReciprocalApprovalWindowHours < 0 {
+		return errors.New("ReciprocalApprovalWindowHours must be zero or positive")
+	}
+
+	switch c.DigestCadence {
+	case "", digest.CadenceOff, digest.CadenceDaily, digest.CadenceWeekly:
+	default:
+		return errors.Errorf("DigestCadence must be %q, %q or %q", digest.CadenceOff, digest.CadenceDaily, digest.CadenceWeekly)
+	}
+
+	if c.DigestHour < 0 || c.DigestHour > 23 {
+		return errors.New("DigestHour must be between 0 and 23")
+	}
+
+	if c.NotificationMaxRetries < 0 || c.NotificationMaxRetries > maxNotificationRetries {
+		return errors.Errorf("NotificationMaxRetries must be between 0 and %d", maxNotificationRetries)
+	}
+
 	return nil
 }
 
+// separationOfDuties converts the configuration into separation-of-duties rules
+func (c *configuration) separationOfDuties() approval.SeparationOfDuties {
+	return approval.SeparationOfDuties{
+		ReciprocalWindow: time.Duration(c.ReciprocalApprovalWindowHours) * time.Hour,
+	}
+}
+
+// digestSchedule converts the configuration into the digest schedule
+func (c *configuration) digestSchedule() digest.Schedule {
+	return digest.Schedule{
+		Cadence: c.DigestCadence,
+		Hour:    c.DigestHour,
+	}
+}
+
+// messageTemplateSources maps each notification kind to its configured message template
+func (c *configuration) messageTemplateSources() map[string]string {
+	return map[string]string{
+		notifications.TemplateRequest:      c.RequestMessageTemplate,
+		notifications.TemplateOutcome:      c.OutcomeMessageTemplate,
+		notifications.TemplateCanceled:     c.CanceledMessageTemplate,
+		notifications.TemplateTimeout:      c.TimeoutMessageTemplate,
+		notifications.TemplateVerification: c.VerificationMessageTemplate,
+	}
+}
+
 // getConfiguration retrieves the active configuration under lock, making it safe to use
 // concurrently. The active configuration may change underneath the client of this method, but
 // the struct returned by this API call is considered immutable.
@@ -82,7 +155,24 @@ func (p *Plugin) OnConfigurationChange() error {
 		return errors.Wrap(err, "failed to load plugin configuration")
 	}
 
+	if err := configuration.IsValid(); err != nil {
+		return errors.Wrap(err, "invalid plugin configuration")
+	}
+
 	p.setConfiguration(configuration)
 
+	// Invalid message templates do not block the rest of the configuration; their notification
+	// kinds keep the built-in message
+	templates, err := notifications.NewMessageTemplates(configuration.messageTemplateSources())
+	if err != nil {
+		p.API.LogError("Invalid notification message template; using the built-in message", "error", err.Error())
+	}
+	notifications.SetMessageTemplates(templates)
+
+	// The service is created in OnActivate, which runs after the initial configuration load
+	if p.service != nil {
+		p.service.SetSeparationOfDuties(configuration.separationOfDuties())
+	}
+
 	return nil
 }
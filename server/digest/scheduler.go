@@ -0,0 +1,346 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// Digest cadences accepted by the DigestCadence setting
+const (
+	CadenceOff    = "off"
+	CadenceDaily  = "daily"
+	CadenceWeekly = "weekly"
+)
+
+const (
+	// CheckInterval is how often the scheduler checks whether a digest is due
+	CheckInterval = 5 * time.Minute
+
+	// maxListedRequests caps the pending requests listed in one approver digest
+	maxListedRequests = 20
+
+	// maxListedFailures caps the failed notifications listed in one admin digest
+	maxListedFailures = 10
+
+	// descriptionPreviewLength is the maximum description length shown per request
+	descriptionPreviewLength = 60
+)
+
+// Schedule is the digest cadence and the UTC hour it is sent at.
+// Weekly digests are sent on Mondays.
+type Schedule struct {
+	Cadence string
+	Hour    int
+}
+
+// Period returns the digest period that is due at now, or false if no digest is due.
+// Each period is sent at most once; the period key is claimed in the KV store before sending.
+func (s Schedule) Period(now time.Time) (string, bool) {
+	now = now.UTC()
+	if now.Hour() < s.Hour {
+		return "", false
+	}
+
+	switch s.Cadence {
+	case CadenceDaily:
+		return CadenceDaily + ":" + now.Format("2006-01-02"), true
+	case CadenceWeekly:
+		if now.Weekday() != time.Monday {
+			return "", false
+		}
+		year, week := now.ISOWeek()
+		return fmt.Sprintf("%s:%d-W%02d", CadenceWeekly, year, week), true
+	default:
+		return "", false
+	}
+}
+
+// Scheduler periodically sends opted-in users a digest of the requests awaiting their decision,
+// and opted-in system admins a summary of approval statistics and failed notifications.
+type Scheduler struct {
+	store     *store.KVStore
+	api       plugin.API
+	botUserID string
+	schedule  func() Schedule
+	now       func() time.Time
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewScheduler creates a new digest Scheduler. The schedule is read on every check so
+// configuration changes apply without restarting the plugin.
+func NewScheduler(store *store.KVStore, api plugin.API, botUserID string, schedule func() Schedule) *Scheduler {
+	return &Scheduler{
+		store:     store,
+		api:       api,
+		botUserID: botUserID,
+		schedule:  schedule,
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the background goroutine that sends due digests.
+func (s *Scheduler) Start() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	go s.run()
+
+	s.api.LogInfo("Digest scheduler started", "check_interval", CheckInterval.String())
+}
+
+// Stop gracefully shuts down the digest scheduler goroutine.
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	// Wait for goroutine to exit
+	<-s.done
+
+	s.api.LogInfo("Digest scheduler stopped")
+}
+
+// run is the main loop that periodically checks whether a digest is due.
+func (s *Scheduler) run() {
+	defer close(s.done)
+	defer func() {
+		if r := recover(); r != nil {
+			s.api.LogError("Digest scheduler panic recovered", "panic", r)
+		}
+	}()
+
+	ticker := time.NewTicker(CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.checkDigest(); err != nil {
+				s.api.LogError("Digest check failed", "error", err.Error())
+			}
+		}
+	}
+}
+
+// checkDigest sends the digest for the current period if it is due and this node wins the claim.
+func (s *Scheduler) checkDigest() error {
+	schedule := s.schedule()
+	now := s.now()
+
+	period, due := schedule.Period(now)
+	if !due {
+		return nil
+	}
+
+	// Only one cluster node (and one run per period) sends the digest
+	claimed, err := s.store.ClaimDigestRun(period)
+	if err != nil {
+		return fmt.Errorf("failed to claim digest period %s: %w", period, err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	return s.sendDigests(schedule.Cadence, now)
+}
+
+// sendDigests DMs every subscriber their digest. Delivery failures are logged per user.
+func (s *Scheduler) sendDigests(cadence string, now time.Time) error {
+	subscribers, err := s.store.ListDigestSubscribers()
+	if err != nil {
+		return fmt.Errorf("failed to list digest subscribers: %w", err)
+	}
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	records, err := s.store.GetAllApprovals()
+	if err != nil {
+		return fmt.Errorf("failed to load approvals for digest: %w", err)
+	}
+
+	pendingByApprover := groupPendingByApprover(records)
+	sentCount := 0
+	failedCount := 0
+
+	for _, userID := range subscribers {
+		user, appErr := s.api.GetUser(userID)
+		if appErr != nil {
+			s.api.LogWarn("Failed to get digest subscriber", "user_id", userID, "error", appErr.Error())
+			failedCount++
+			continue
+		}
+		if user.DeleteAt != 0 {
+			continue
+		}
+
+		isAdmin := approval.IsSystemAdminUser(user)
+		message := FormatDigest(cadence, pendingByApprover[userID], records, isAdmin, user.Locale, now)
+		if message == "" {
+			continue
+		}
+
+		if _, err := notifications.SendDigestDM(s.api, s.botUserID, userID, message); err != nil {
+			s.api.LogWarn("Failed to send approval digest", "user_id", userID, "error", err.Error())
+			failedCount++
+			continue
+		}
+		sentCount++
+	}
+
+	s.api.LogInfo("Approval digest sent",
+		"cadence", cadence,
+		"subscriber_count", len(subscribers),
+		"sent_count", sentCount,
+		"failed_count", failedCount)
+
+	return nil
+}
+
+// groupPendingByApprover maps each approver (every candidate of a group request) to their
+// pending requests, oldest first
+func groupPendingByApprover(records []*approval.ApprovalRecord) map[string][]*approval.ApprovalRecord {
+	pending := make(map[string][]*approval.ApprovalRecord)
+	for _, record := range records {
+		if record.Status != approval.StatusPending {
+			continue
+		}
+		for _, approverID := range record.ApproverRecipientIDs() {
+			pending[approverID] = append(pending[approverID], record)
+		}
+	}
+
+	for _, list := range pending {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].RequestedAt() < list[j].RequestedAt()
+		})
+	}
+
+	return pending
+}
+
+// FormatDigest renders a user's digest in their locale: their pending requests and, for system
+// admins, approval statistics and failed notifications. Returns "" when there is nothing to report.
+func FormatDigest(cadence string, pending []*approval.ApprovalRecord, records []*approval.ApprovalRecord, isAdmin bool, locale string, now time.Time) string {
+	if len(pending) == 0 && !isAdmin {
+		return ""
+	}
+
+	var digest strings.Builder
+	if cadence == CadenceWeekly {
+		digest.WriteString(i18n.T(locale, "digest.title_weekly"))
+	} else {
+		digest.WriteString(i18n.T(locale, "digest.title_daily"))
+	}
+
+	if len(pending) > 0 {
+		digest.WriteString(formatPendingSection(pending, locale, now))
+	} else {
+		digest.WriteString(i18n.T(locale, "digest.none_pending"))
+	}
+
+	if isAdmin {
+		digest.WriteString("\n")
+		digest.WriteString(formatAdminSection(records, locale))
+	}
+
+	digest.WriteString(i18n.T(locale, "digest.footer"))
+	return digest.String()
+}
+
+// formatPendingSection lists pending requests with their age, oldest first
+func formatPendingSection(pending []*approval.ApprovalRecord, locale string, now time.Time) string {
+	var section strings.Builder
+	section.WriteString(i18n.T(locale, "digest.pending_header", len(pending)))
+
+	for i, record := range pending {
+		if i >= maxListedRequests {
+			section.WriteString(i18n.T(locale, "digest.pending_more", len(pending)-maxListedRequests))
+			break
+		}
+		age := now.Sub(time.UnixMilli(record.RequestedAt()))
+		section.WriteString(i18n.T(locale, "digest.pending_row",
+			record.Code,
+			record.RequesterUsername,
+			FormatAge(locale, age),
+			previewDescription(record.Description)))
+	}
+
+	return section.String()
+}
+
+// formatAdminSection summarizes approval statistics and lists failed notifications
+func formatAdminSection(records []*approval.ApprovalRecord, locale string) string {
+	stats := approval.CalculateStatistics(records)
+
+	var section strings.Builder
+	section.WriteString(i18n.T(locale, "digest.admin_header"))
+	section.WriteString(i18n.T(locale, "digest.admin_counts",
+		stats.TotalApprovals,
+		stats.PendingApprovals,
+		stats.ApprovedApprovals,
+		stats.DeniedApprovals,
+		stats.CanceledApprovals))
+	section.WriteString(i18n.T(locale, "digest.admin_failed_approver", stats.FailedApproverNotifications))
+	section.WriteString(i18n.T(locale, "digest.admin_failed_outcome", stats.FailedOutcomeNotifications))
+
+	var failed []string
+	for _, record := range records {
+		pendingUnsent := record.Status == approval.StatusPending && !record.NotificationSent
+		decidedUnnotified := (record.Status == approval.StatusApproved || record.Status == approval.StatusDenied) && !record.OutcomeNotified
+		if pendingUnsent || decidedUnnotified {
+			failed = append(failed, "`"+record.Code+"`")
+		}
+	}
+	if len(failed) > 0 {
+		if len(failed) > maxListedFailures {
+			failed = append(failed[:maxListedFailures], i18n.T(locale, "digest.admin_affected_more", len(failed)-maxListedFailures))
+		}
+		section.WriteString(i18n.T(locale, "digest.admin_affected", strings.Join(failed, ", ")))
+		section.WriteString(i18n.T(locale, "digest.admin_details"))
+	}
+
+	return section.String()
+}
+
+// FormatAge renders how long a request has been waiting in a locale, e.g. "45m", "3h 12m" or "2d 4h"
+func FormatAge(locale string, age time.Duration) string {
+	if age < 0 {
+		age = 0
+	}
+
+	days := int(age.Hours()) / 24
+	hours := int(age.Hours()) % 24
+	minutes := int(age.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return i18n.T(locale, "digest.age_days", days, hours)
+	case hours > 0:
+		return i18n.T(locale, "digest.age_hours", hours, minutes)
+	default:
+		return i18n.T(locale, "digest.age_minutes", minutes)
+	}
+}
+
+// previewDescription shortens a description to a single line for the digest list
+func previewDescription(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	runes := []rune(description)
+	if len(runes) <= descriptionPreviewLength {
+		return description
+	}
+	return string(runes[:descriptionPreviewLength-1]) + "…"
+}
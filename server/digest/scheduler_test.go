@@ -0,0 +1,352 @@
+package digest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// monday is 2026-10-19 10:30 UTC (ISO week 43)
+var monday = time.Date(2026, time.October, 19, 10, 30, 0, 0, time.UTC)
+
+func TestSchedulePeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedule   Schedule
+		now        time.Time
+		wantPeriod string
+		wantDue    bool
+	}{
+		{name: "off is never due", schedule: Schedule{Cadence: CadenceOff, Hour: 9}, now: monday},
+		{name: "empty cadence is off", schedule: Schedule{Hour: 0}, now: monday},
+		{name: "daily before the hour", schedule: Schedule{Cadence: CadenceDaily, Hour: 11}, now: monday},
+		{name: "daily at the hour", schedule: Schedule{Cadence: CadenceDaily, Hour: 10}, now: monday, wantPeriod: "daily:2026-10-19", wantDue: true},
+		{name: "daily after the hour", schedule: Schedule{Cadence: CadenceDaily, Hour: 9}, now: monday.Add(30 * time.Hour), wantPeriod: "daily:2026-10-20", wantDue: true},
+		{name: "daily uses UTC", schedule: Schedule{Cadence: CadenceDaily, Hour: 10}, now: monday.In(time.FixedZone("PST", -8*3600)), wantPeriod: "daily:2026-10-19", wantDue: true},
+		{name: "weekly on Monday", schedule: Schedule{Cadence: CadenceWeekly, Hour: 9}, now: monday, wantPeriod: "weekly:2026-W43", wantDue: true},
+		{name: "weekly on Tuesday", schedule: Schedule{Cadence: CadenceWeekly, Hour: 9}, now: monday.Add(24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, due := tt.schedule.Period(tt.now)
+
+			assert.Equal(t, tt.wantDue, due)
+			assert.Equal(t, tt.wantPeriod, period)
+		})
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		locale string
+		age    time.Duration
+		want   string
+	}{
+		{age: -time.Minute, want: "0m"},
+		{age: 45 * time.Minute, want: "45m"},
+		{age: 3*time.Hour + 12*time.Minute, want: "3h 12m"},
+		{age: 52 * time.Hour, want: "2d 4h"},
+		{locale: "de", age: 52 * time.Hour, want: "2 T. 4 Std."},
+		{locale: "ja", age: 3*time.Hour + 12*time.Minute, want: "3時間12分"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatAge(tt.locale, tt.age))
+		})
+	}
+}
+
+func TestFormatDigest(t *testing.T) {
+	older := &approval.ApprovalRecord{
+		ID:                "older",
+		Code:              "A-OLDER1",
+		RequesterUsername: "alice",
+		ApproverID:        "bob123",
+		Description:       "Deploy v2.5.0 to production",
+		Status:            approval.StatusPending,
+		CreatedAt:         monday.Add(-52 * time.Hour).UnixMilli(),
+		NotificationSent:  true,
+	}
+	newer := &approval.ApprovalRecord{
+		ID:                "newer",
+		Code:              "A-NEWER1",
+		RequesterUsername: "carol",
+		ApproverID:        "bob123",
+		Description:       "Rotate staging credentials",
+		Status:            approval.StatusPending,
+		CreatedAt:         monday.Add(-45 * time.Minute).UnixMilli(),
+		NotificationSent:  true,
+	}
+
+	t.Run("approver digest lists pending requests with age", func(t *testing.T) {
+		digest := FormatDigest(CadenceDaily, []*approval.ApprovalRecord{older, newer}, nil, false, "", monday)
+
+		assert.Contains(t, digest, "📬 **Daily Approval Digest**")
+		assert.Contains(t, digest, "**⏳ Awaiting your decision (2):**")
+		assert.Contains(t, digest, "- `A-OLDER1` from @alice · waiting 2d 4h · Deploy v2.5.0 to production")
+		assert.Contains(t, digest, "- `A-NEWER1` from @carol · waiting 45m")
+		assert.Contains(t, digest, "`/approve digest off`")
+		assert.NotContains(t, digest, "Approval System (admin)")
+	})
+
+	t.Run("digest is rendered in the subscriber's locale", func(t *testing.T) {
+		digest := FormatDigest(CadenceWeekly, []*approval.ApprovalRecord{older}, nil, false, "de", monday)
+
+		assert.Contains(t, digest, "📬 **Wöchentliche Genehmigungsübersicht**")
+		assert.Contains(t, digest, "**⏳ Warten auf Ihre Entscheidung (1):**")
+		assert.Contains(t, digest, "- `A-OLDER1` von @alice · wartet seit 2 T. 4 Std.")
+	})
+
+	t.Run("nothing to report for non-admin without pending requests", func(t *testing.T) {
+		assert.Empty(t, FormatDigest(CadenceDaily, nil, nil, false, "", monday))
+	})
+
+	t.Run("admin digest includes statistics and failed notifications", func(t *testing.T) {
+		failedDM := &approval.ApprovalRecord{
+			ID:               "nodm",
+			Code:             "A-NODM01",
+			Status:           approval.StatusPending,
+			CreatedAt:        monday.Add(-time.Hour).UnixMilli(),
+			NotificationSent: false,
+		}
+		approved := &approval.ApprovalRecord{
+			ID:               "done",
+			Code:             "A-DONE01",
+			Status:           approval.StatusApproved,
+			CreatedAt:        monday.Add(-time.Hour).UnixMilli(),
+			NotificationSent: true,
+			OutcomeNotified:  true,
+		}
+
+		digest := FormatDigest(CadenceWeekly, nil, []*approval.ApprovalRecord{older, failedDM, approved}, true, "", monday)
+
+		assert.Contains(t, digest, "📬 **Weekly Approval Digest**")
+		assert.Contains(t, digest, "✅ No requests are awaiting your decision.")
+		assert.Contains(t, digest, "- Total: 3 · Pending: 2 · Approved: 1 · Denied: 0 · Canceled: 0")
+		assert.Contains(t, digest, "- ❌ Failed approver notifications: 1")
+		assert.Contains(t, digest, "- Affected requests: `A-NODM01`")
+	})
+
+	t.Run("long lists are truncated", func(t *testing.T) {
+		var pending []*approval.ApprovalRecord
+		for i := 0; i < maxListedRequests+3; i++ {
+			pending = append(pending, &approval.ApprovalRecord{
+				Code:              "A-LIST01",
+				RequesterUsername: "alice",
+				Status:            approval.StatusPending,
+				CreatedAt:         monday.Add(-time.Hour).UnixMilli(),
+			})
+		}
+
+		digest := FormatDigest(CadenceDaily, pending, nil, false, "", monday)
+
+		assert.Equal(t, maxListedRequests, strings.Count(digest, "- `A-LIST01`"))
+		assert.Contains(t, digest, "... and 3 more.")
+	})
+
+	t.Run("long descriptions are shortened to one line", func(t *testing.T) {
+		record := &approval.ApprovalRecord{
+			Code:              "A-LONG01",
+			RequesterUsername: "alice",
+			Description:       "Line one\n" + strings.Repeat("x", 100),
+			Status:            approval.StatusPending,
+			CreatedAt:         monday.Add(-time.Hour).UnixMilli(),
+		}
+
+		digest := FormatDigest(CadenceDaily, []*approval.ApprovalRecord{record}, nil, false, "", monday)
+
+		assert.Contains(t, digest, "Line one xxx")
+		assert.Contains(t, digest, "…\n")
+	})
+}
+
+func TestGroupPendingByApprover(t *testing.T) {
+	older := &approval.ApprovalRecord{
+		Code:       "A-OLDER1",
+		ApproverID: "bob123",
+		Status:     approval.StatusPending,
+		CreatedAt:  monday.Add(-2 * time.Hour).UnixMilli(),
+	}
+	newer := &approval.ApprovalRecord{
+		Code:       "A-NEWER1",
+		ApproverID: "bob123",
+		Status:     approval.StatusPending,
+		CreatedAt:  monday.Add(-time.Hour).UnixMilli(),
+	}
+	group := &approval.ApprovalRecord{
+		Code:                 "A-GROUP1",
+		ApproverGroupName:    "sre-oncall",
+		CandidateApproverIDs: []string{"bob123", "erin123"},
+		Status:               approval.StatusPending,
+		CreatedAt:            monday.Add(-time.Hour).UnixMilli(),
+	}
+	decided := &approval.ApprovalRecord{
+		Code:       "A-DONE01",
+		ApproverID: "bob123",
+		Status:     approval.StatusDenied,
+		CreatedAt:  monday.Add(-time.Hour).UnixMilli(),
+	}
+
+	pending := groupPendingByApprover([]*approval.ApprovalRecord{newer, decided, group, older})
+
+	require.Len(t, pending["bob123"], 3)
+	assert.Equal(t, "A-OLDER1", pending["bob123"][0].Code, "oldest first")
+	assert.Equal(t, []*approval.ApprovalRecord{group}, pending["erin123"])
+}
+
+// digestAPI mocks the KV calls made by a digest run for the given subscribers and records
+func digestAPI(t *testing.T, subscribers []string, records []*approval.ApprovalRecord) *plugintest.API {
+	api := &plugintest.API{}
+
+	keys := make([]string, 0)
+	for _, userID := range subscribers {
+		keys = append(keys, "approval:digest:subscriber:"+userID)
+	}
+	for _, record := range records {
+		keys = append(keys, "approval:record:"+record.ID)
+		data, err := json.Marshal(record)
+		require.NoError(t, err)
+		api.On("KVGet", "approval:record:"+record.ID).Return(data, nil)
+	}
+	api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(keys, nil)
+	api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	return api
+}
+
+func newTestScheduler(api *plugintest.API, schedule Schedule) *Scheduler {
+	scheduler := NewScheduler(store.NewKVStore(api), api, "bot123", func() Schedule { return schedule })
+	scheduler.now = func() time.Time { return monday }
+	return scheduler
+}
+
+func TestCheckDigest(t *testing.T) {
+	daily := Schedule{Cadence: CadenceDaily, Hour: 9}
+
+	t.Run("sends approver and admin digests once the period is claimed", func(t *testing.T) {
+		records := []*approval.ApprovalRecord{{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			Status:            approval.StatusPending,
+			CreatedAt:         monday.Add(-3 * time.Hour).UnixMilli(),
+			NotificationSent:  true,
+		}}
+		api := digestAPI(t, []string{"admin123", "bob123", "idle123"}, records)
+		api.On("KVSetWithOptions", "approval:digest:run:daily:2026-10-19", mock.Anything, mock.Anything).Return(true, nil)
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		api.On("GetUser", "bob123").Return(&model.User{Id: "bob123", Roles: "system_user"}, nil)
+		api.On("GetUser", "idle123").Return(&model.User{Id: "idle123", Roles: "system_user"}, nil)
+
+		messages := map[string]string{}
+		api.On("GetDirectChannel", "bot123", mock.AnythingOfType("string")).Return(func(_, userID string) *model.Channel {
+			return &model.Channel{Id: "dm_" + userID}
+		}, nil)
+		api.On("CreatePost", mock.Anything).Return(func(post *model.Post) *model.Post {
+			messages[post.ChannelId] = post.Message
+			return &model.Post{Id: "post_" + post.ChannelId}
+		}, nil)
+
+		require.NoError(t, newTestScheduler(api, daily).checkDigest())
+
+		assert.Len(t, messages, 2, "subscribers with nothing to report get no DM")
+		assert.Contains(t, messages["dm_bob123"], "- `A-X7K9Q2` from @alice · waiting 3h 0m")
+		assert.NotContains(t, messages["dm_bob123"], "Approval System (admin)")
+		assert.Contains(t, messages["dm_admin123"], "**📊 Approval System (admin):**")
+		assert.Contains(t, messages["dm_admin123"], "- Total: 1 · Pending: 1")
+	})
+
+	t.Run("another node already sent the period", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVSetWithOptions", "approval:digest:run:daily:2026-10-19", mock.Anything, mock.Anything).Return(false, nil)
+
+		require.NoError(t, newTestScheduler(api, daily).checkDigest())
+
+		api.AssertNotCalled(t, "KVList", mock.Anything, mock.Anything)
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("not due does not claim", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		require.NoError(t, newTestScheduler(api, Schedule{Cadence: CadenceOff}).checkDigest())
+
+		api.AssertNotCalled(t, "KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("claim error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(false, &model.AppError{Message: "KV error"})
+
+		err := newTestScheduler(api, daily).checkDigest()
+
+		assert.ErrorContains(t, err, "failed to claim digest period daily:2026-10-19")
+	})
+
+	t.Run("delivery failure for one user does not stop the others", func(t *testing.T) {
+		records := []*approval.ApprovalRecord{{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			Status:            approval.StatusPending,
+			CreatedAt:         monday.Add(-time.Hour).UnixMilli(),
+			NotificationSent:  true,
+		}}
+		api := digestAPI(t, []string{"admin123", "bob123"}, records)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_admin"}, nil)
+		api.On("GetUser", "bob123").Return(&model.User{Id: "bob123"}, nil)
+		api.On("GetDirectChannel", "bot123", "admin123").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post"}, nil)
+
+		require.NoError(t, newTestScheduler(api, daily).checkDigest())
+
+		api.AssertNumberOfCalls(t, "CreatePost", 1)
+	})
+
+	t.Run("deactivated subscribers are skipped", func(t *testing.T) {
+		api := digestAPI(t, []string{"admin123"}, nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_admin", DeleteAt: 1}, nil)
+
+		require.NoError(t, newTestScheduler(api, daily).checkDigest())
+
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+}
+
+func TestSchedulerStartStop(t *testing.T) {
+	api := &plugintest.API{}
+	api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything).Return()
+	api.On("LogInfo", mock.Anything).Return()
+
+	scheduler := newTestScheduler(api, Schedule{})
+	scheduler.Start()
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop() did not complete within 2 seconds")
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost-plugin-approver2/server/timeout"
+)
+
+// Health check statuses
+const (
+	healthOK       = "ok"
+	healthDegraded = "degraded"
+)
+
+// maxMissedScans is how many timeout check intervals may pass without a successful scan before
+// the timeout checker is reported as degraded
+const maxMissedScans = 2
+
+// healthCheck is the result of one subsystem check
+type healthCheck struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// timeoutCheckerHealth reports the periodic timeout scan
+type timeoutCheckerHealth struct {
+	healthCheck
+	LastSuccessAt     int64 `json:"lastSuccessAt,omitempty"` // epoch millis; omitted until the first successful scan
+	ErrorCount        int   `json:"errorCount"`
+	ConsecutiveErrors int   `json:"consecutiveErrors"`
+}
+
+// retryQueueHealth reports the notification retry queue
+type retryQueueHealth struct {
+	healthCheck
+	Depth int `json:"depth"`
+}
+
+// healthReport is the /api/v1/health response body
+type healthReport struct {
+	Status         string               `json:"status"`
+	KVStore        healthCheck          `json:"kvStore"`
+	BotUser        healthCheck          `json:"botUser"`
+	TimeoutChecker timeoutCheckerHealth `json:"timeoutChecker"`
+	RetryQueue     retryQueueHealth     `json:"retryQueue"`
+	Configuration  healthCheck          `json:"configuration"`
+}
+
+// checkResult converts an error into a check result
+func checkResult(err error) healthCheck {
+	if err != nil {
+		return healthCheck{Status: healthDegraded, Error: err.Error()}
+	}
+	return healthCheck{Status: healthOK}
+}
+
+// handleHealth reports the status of the plugin's dependencies and background workers. It
+// responds 200 when every check is ok and 503 when any check is degraded.
+func (p *Plugin) handleHealth(w http.ResponseWriter, r *http.Request) {
+	report := p.checkHealth(time.Now())
+
+	status := http.StatusOK
+	if report.Status != healthOK {
+		status = http.StatusServiceUnavailable
+		p.API.LogWarn("Health check degraded", "kv_store", report.KVStore.Status, "bot_user", report.BotUser.Status,
+			"timeout_checker", report.TimeoutChecker.Status, "retry_queue", report.RetryQueue.Status,
+			"configuration", report.Configuration.Status)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		p.API.LogWarn("Failed to write health response", "error", err.Error())
+	}
+}
+
+// checkHealth runs every health check
+func (p *Plugin) checkHealth(now time.Time) *healthReport {
+	kvStore := store.NewKVStore(p.API)
+
+	report := &healthReport{
+		KVStore:        checkResult(kvStore.Ping()),
+		BotUser:        checkResult(p.checkBotUser()),
+		TimeoutChecker: p.checkTimeoutChecker(now),
+		RetryQueue:     retryQueueHealth{healthCheck: healthCheck{Status: healthOK}},
+		Configuration:  checkResult(p.checkConfiguration()),
+	}
+
+	retries, err := kvStore.ListNotificationRetries()
+	if err != nil {
+		report.RetryQueue.healthCheck = checkResult(err)
+	} else {
+		report.RetryQueue.Depth = len(retries)
+	}
+
+	report.Status = healthOK
+	for _, check := range []string{
+		report.KVStore.Status,
+		report.BotUser.Status,
+		report.TimeoutChecker.Status,
+		report.RetryQueue.Status,
+		report.Configuration.Status,
+	} {
+		if check != healthOK {
+			report.Status = healthDegraded
+		}
+	}
+
+	return report
+}
+
+// checkBotUser verifies the notification bot still exists and is active
+func (p *Plugin) checkBotUser() error {
+	if p.botUserID == "" {
+		return errors.New("bot user not initialized")
+	}
+	if _, appErr := p.API.GetBot(p.botUserID, false); appErr != nil {
+		return fmt.Errorf("failed to get bot user: %w", appErr)
+	}
+	return nil
+}
+
+// checkTimeoutChecker reports the timeout checker as degraded when its latest scan failed or no
+// scan has succeeded for maxMissedScans intervals
+func (p *Plugin) checkTimeoutChecker(now time.Time) timeoutCheckerHealth {
+	if p.timeoutChecker == nil {
+		return timeoutCheckerHealth{healthCheck: healthCheck{Status: healthDegraded, Error: "timeout checker not running"}}
+	}
+
+	status := p.timeoutChecker.Status()
+	result := timeoutCheckerHealth{
+		healthCheck:       healthCheck{Status: healthOK},
+		ErrorCount:        status.ErrorCount,
+		ConsecutiveErrors: status.ConsecutiveErrors,
+	}
+	if !status.LastSuccessAt.IsZero() {
+		result.LastSuccessAt = status.LastSuccessAt.UnixMilli()
+	}
+
+	// Before the first scan, measure from when the checker started
+	lastSuccess := status.LastSuccessAt
+	if lastSuccess.IsZero() {
+		lastSuccess = status.StartedAt
+	}
+
+	switch {
+	case status.ConsecutiveErrors > 0:
+		result.Status = healthDegraded
+		result.Error = status.LastError
+	case now.Sub(lastSuccess) > maxMissedScans*timeout.CheckInterval:
+		result.Status = healthDegraded
+		result.Error = "no successful scan since " + lastSuccess.UTC().Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// checkConfiguration reloads the plugin configuration and validates it, including the message
+// templates, which OnConfigurationChange only logs when invalid
+func (p *Plugin) checkConfiguration() error {
+	configuration := new(configuration)
+	if err := p.API.LoadPluginConfiguration(configuration); err != nil {
+		return fmt.Errorf("failed to load plugin configuration: %w", err)
+	}
+	if err := configuration.IsValid(); err != nil {
+		return err
+	}
+	if _, err := notifications.NewMessageTemplates(configuration.messageTemplateSources()); err != nil {
+		return err
+	}
+	return nil
+}
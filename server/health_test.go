@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost-plugin-approver2/server/timeout"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleHealth(t *testing.T) {
+	// setup returns a plugin with a running timeout checker whose checks pass unless mockOverrides
+	// registers expectations, which take precedence over the defaults
+	setup := func(t *testing.T, mockOverrides func(api *plugintest.API)) (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		if mockOverrides != nil {
+			mockOverrides(api)
+		}
+		retry, _ := json.Marshal(&approval.NotificationRetry{Kind: approval.RetryKindApproverRequest, RecordID: "record1"})
+		api.On("GetUser", "admin123").Return(&model.User{Id: "admin123", Roles: "system_user system_admin"}, nil)
+		api.On("KVList", 0, 1).Return([]string{"approval:secret:action_signing"}, nil).Maybe()
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return([]string{"approval:retry:approver_request:record1", "approval:record:record1"}, nil).Maybe()
+		api.On("KVGet", "approval:retry:approver_request:record1").Return(retry, nil).Maybe()
+		api.On("GetBot", "bot123", false).Return(&model.Bot{UserId: "bot123"}, nil).Maybe()
+		api.On("LoadPluginConfiguration", mock.AnythingOfType("*main.configuration")).Return(nil).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything).Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		kvStore := store.NewKVStore(api)
+		checker := timeout.NewChecker(kvStore, approval.NewService(kvStore, api, "bot123"), api, "bot123", nil)
+		checker.Start()
+		t.Cleanup(checker.Stop)
+
+		p := &Plugin{botUserID: "bot123", timeoutChecker: checker}
+		p.SetAPI(api)
+		return api, p
+	}
+
+	get := func(t *testing.T, p *Plugin, userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+		req.Header.Set("Mattermost-User-ID", userID)
+		w := httptest.NewRecorder()
+		p.ServeHTTP(nil, w, req)
+		return w
+	}
+
+	report := func(t *testing.T, w *httptest.ResponseRecorder) map[string]map[string]any {
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var body map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		checks := make(map[string]map[string]any)
+		for name, raw := range body {
+			var check map[string]any
+			if json.Unmarshal(raw, &check) == nil {
+				checks[name] = check
+			}
+		}
+		return checks
+	}
+
+	t.Run("all checks ok", func(t *testing.T) {
+		_, p := setup(t, nil)
+
+		w := get(t, p, "admin123")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"ok"`)
+		checks := report(t, w)
+		for _, name := range []string{"kvStore", "botUser", "timeoutChecker", "retryQueue", "configuration"} {
+			assert.Equal(t, "ok", checks[name]["status"], name)
+		}
+		assert.Equal(t, float64(1), checks["retryQueue"]["depth"])
+		assert.Equal(t, float64(0), checks["timeoutChecker"]["errorCount"])
+		assert.NotContains(t, checks["timeoutChecker"], "lastSuccessAt")
+	})
+
+	t.Run("KV store failure is degraded", func(t *testing.T) {
+		_, p := setup(t, func(api *plugintest.API) {
+			api.On("KVList", 0, 1).Return(nil, &model.AppError{Message: "database unavailable"})
+			api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(nil, &model.AppError{Message: "database unavailable"})
+		})
+
+		w := get(t, p, "admin123")
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"degraded"`)
+		checks := report(t, w)
+		assert.Equal(t, "degraded", checks["kvStore"]["status"])
+		assert.Contains(t, checks["kvStore"]["error"], "database unavailable")
+		assert.Equal(t, "degraded", checks["retryQueue"]["status"])
+		assert.Equal(t, "ok", checks["botUser"]["status"])
+	})
+
+	t.Run("deleted bot user is degraded", func(t *testing.T) {
+		_, p := setup(t, func(api *plugintest.API) {
+			api.On("GetBot", "bot123", false).Return(nil, &model.AppError{Message: "bot not found"})
+		})
+
+		w := get(t, p, "admin123")
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, report(t, w)["botUser"]["error"], "bot not found")
+	})
+
+	t.Run("invalid message template is degraded", func(t *testing.T) {
+		_, p := setup(t, func(api *plugintest.API) {
+			api.On("LoadPluginConfiguration", mock.AnythingOfType("*main.configuration")).Run(func(args mock.Arguments) {
+				args.Get(0).(*configuration).OutcomeMessageTemplate = "{{.Record.Nope}}"
+			}).Return(nil)
+		})
+
+		w := get(t, p, "admin123")
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Contains(t, report(t, w)["configuration"]["error"], "outcome template:")
+	})
+
+	t.Run("stopped timeout checker is degraded", func(t *testing.T) {
+		_, p := setup(t, nil)
+		p.timeoutChecker = nil
+
+		w := get(t, p, "admin123")
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, "timeout checker not running", report(t, w)["timeoutChecker"]["error"])
+	})
+
+	t.Run("non-admin is forbidden", func(t *testing.T) {
+		api, p := setup(t, func(api *plugintest.API) {
+			api.On("GetUser", "user123").Return(&model.User{Id: "user123", Roles: "system_user"}, nil)
+		})
+
+		w := get(t, p, "user123")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		api.AssertNotCalled(t, "GetBot", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCheckTimeoutChecker(t *testing.T) {
+	api := &plugintest.API{}
+	api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("LogInfo", mock.Anything).Maybe()
+	kvStore := store.NewKVStore(api)
+	checker := timeout.NewChecker(kvStore, approval.NewService(kvStore, api, "bot123"), api, "bot123", nil)
+	checker.Start()
+	t.Cleanup(checker.Stop)
+	p := &Plugin{timeoutChecker: checker}
+	p.SetAPI(api)
+	startedAt := checker.Status().StartedAt
+
+	t.Run("ok before the first scan is due", func(t *testing.T) {
+		result := p.checkTimeoutChecker(startedAt.Add(timeout.CheckInterval))
+
+		assert.Equal(t, healthOK, result.Status)
+		assert.Zero(t, result.LastSuccessAt)
+	})
+
+	t.Run("degraded when scans are missed", func(t *testing.T) {
+		result := p.checkTimeoutChecker(startedAt.Add(3 * timeout.CheckInterval))
+
+		assert.Equal(t, healthDegraded, result.Status)
+		assert.Contains(t, result.Error, "no successful scan since")
+	})
+}
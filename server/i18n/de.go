@@ -0,0 +1,594 @@
+package i18n
+
+// de is the German catalog
+var de = map[string]string{
+	// Shared labels
+	"approval.role_label": "Kanalrolle `%s`",
+
+	// Approver request DM
+	"dm.request": "📋 **Genehmigungsanfrage**\n\n" +
+		"**Von:** @%s (%s)\n" +
+		"**Angefragt:** %s\n" +
+		"**Beschreibung:**\n%s\n\n" +
+		"**Anfrage-ID:** `%s`",
+	"dm.request.resubmission": "\n**Erneute Einreichung von:** `%s`",
+	"dm.request.group": "\n**Genehmigergruppe:** %s\n\n" +
+		"_An %d Mitglieder gesendet. Das erste Mitglied, das eine Entscheidung bestätigt, übernimmt diese Anfrage._",
+	"dm.request.approve":        "Genehmigen",
+	"dm.request.deny":           "Ablehnen",
+	"dm.custom_fields.template": "\n\n**Vorlage:** %s",
+
+	// Requester confirmation DM (thread root)
+	"dm.confirmation": "📨 **Genehmigungsanfrage gesendet**\n\n" +
+		"**Anfrage-ID:** `%s`\n" +
+		"**Genehmiger:** %s\n" +
+		"**Beschreibung:**\n%s",
+	"dm.confirmation.footer": "\n\n_Neuigkeiten zu dieser Anfrage werden in diesem Thread gepostet. " +
+		"Mit `/approve comment %s <text>` können Sie dem Genehmiger schreiben._",
+
+	// Relayed comment DM
+	"dm.comment": "💬 **Kommentar von @%s** zu `%s`\n\n%s\n\n" +
+		"_Antworten Sie mit `/approve comment %s <text>`._",
+
+	// Outcome DM
+	"dm.outcome": "%s\n\n" +
+		"**Genehmiger:** @%s (%s)\n" +
+		"**Entscheidungszeit:** %s\n" +
+		"**Anfrage-ID:** `%s`\n\n" +
+		"**Ursprüngliche Anfrage:**\n> %s",
+	"dm.outcome.approved":        "✅ **Genehmigungsanfrage genehmigt**",
+	"dm.outcome.approved_status": "**Status:** Sie können mit dieser Aktion fortfahren.",
+	"dm.outcome.denied":          "❌ **Genehmigungsanfrage abgelehnt**",
+	"dm.outcome.denied_status":   "**Status:** Diese Anfrage wurde abgelehnt.",
+	"dm.outcome.comment":         "\n\n**Kommentar:**\n%s",
+
+	// Approver post replaced on cancellation
+	"dm.canceled_post": "🚫 **Genehmigungsanfrage (storniert)**\n\n" +
+		"**Von:** @%s\n" +
+		"**Anfrage-ID:** `%s`\n" +
+		"**Beschreibung:**\n%s\n\n" +
+		"---\n" +
+		"_Storniert von @%s am %s_",
+
+	// Approver cancellation DM
+	"dm.cancellation": "🚫 **Genehmigungsanfrage storniert**\n\n" +
+		"**Referenz:** `%s`\n" +
+		"**Antragsteller:** @%s\n" +
+		"**Grund:** %s",
+	"dm.cancellation.no_reason": "Nicht angegeben",
+	"dm.cancellation.details":   "\n**Details:** %s",
+	"dm.cancellation.footer": "\n**Storniert:** %s\n\n" +
+		"Die Genehmigungsanfrage, die Sie erhalten haben, wurde vom Antragsteller storniert.",
+
+	// Requester cancellation DM
+	"dm.requester_cancellation": "🚫 **Ihre Genehmigungsanfrage wurde storniert**\n\n" +
+		"**Anfrage-ID:** `%s`\n" +
+		"**Ursprüngliche Anfrage:** %s\n" +
+		"**Genehmiger:** @%s\n" +
+		"**Grund:** %s",
+	"dm.requester_cancellation.footer": "\n**Storniert:** %s\n\n" +
+		"---\n\n" +
+		"Der Genehmiger hat diese Genehmigungsanfrage storniert. Bei Bedarf können Sie eine neue Anfrage stellen.",
+
+	// Timeout DM
+	"dm.timeout": "⏱️ **Zeitüberschreitung der Genehmigungsanfrage**\n\n" +
+		"**Anfrage-ID:** `%s`\n\n" +
+		"**Ursprüngliche Anfrage:**\n> %s\n\n" +
+		"**Genehmiger:** @%s (%s)\n\n" +
+		"**Grund:** Keine Antwort innerhalb von 30 Minuten\n\n" +
+		"**Status:** Diese Anfrage wurde automatisch storniert. Bei Bedarf können Sie eine neue Anfrage stellen.",
+
+	// Verification DM
+	"dm.verification": "✅ **Genehmigungsanfrage verifiziert**\n\n" +
+		"**Anfrage-ID:** `%s`\n\n" +
+		"**Ursprüngliche Anfrage:**\n> %s\n\n" +
+		"**Antragsteller:** @%s (%s)\n" +
+		"**Verifiziert:** %s",
+	"dm.verification.note": "\n\n**Verifizierungsnotiz:**\n> %s",
+
+	// SLA breach DM
+	"dm.sla_breach": "🚨 **Genehmigungs-SLA überschritten**\n\n" +
+		"**Anfrage-ID:** `%s`\n" +
+		"**Von:** @%s (%s)\n" +
+		"**Angefragt:** %s\n" +
+		"**SLA:** Entscheidung innerhalb von %s\n\n" +
+		"**Beschreibung:**\n> %s\n\n" +
+		"Diese Anfrage hat ihr SLA überschritten. Bitte genehmigen oder lehnen Sie sie jetzt ab.",
+
+	// Slash commands
+	"command.invalid_format":          "Ungültiges Befehlsformat.",
+	"command.error":                   "Bei der Verarbeitung Ihres Befehls ist ein Fehler aufgetreten. Bitte versuchen Sie es erneut.",
+	"command.permission_check_failed": "Berechtigungen konnten nicht geprüft werden. Bitte versuchen Sie es erneut.",
+	"command.help": "### Mattermost-Genehmigungsworkflow\n\n" +
+		"**Verfügbare Befehle:**\n\n" +
+		"* **/approve new [template]** - Neue Genehmigungsanfrage erstellen, optional aus einer Anfragevorlage\n" +
+		"  * **--group <name>** - an jedes Mitglied einer Benutzergruppe senden; wer zuerst bestätigt, entscheidet\n" +
+		"  * **--role <role>** - an Kanalmitglieder mit einer Rolle senden (z. B. channel_admin); wer zuerst bestätigt, entscheidet\n" +
+		"* **/approve list [inbox|outbox] [filter] [--page N] [--limit N]** - Eigene Genehmigungsanfragen und Entscheidungen anzeigen, 20 pro Seite\n" +
+		"  * **inbox** - nur an Sie gerichtete Anfragen, älteste zuerst, mit Schaltflächen zum Genehmigen und Ablehnen\n" +
+		"  * **outbox** - nur von Ihnen gesendete Anfragen\n" +
+		"  * Ohne Filter: zeigt ausstehende Anfragen (Standard)\n" +
+		"  * **pending** - ausstehende Genehmigungsanfragen\n" +
+		"  * **approved** - genehmigte Anfragen\n" +
+		"  * **denied** - abgelehnte Anfragen\n" +
+		"  * **canceled** - stornierte Anfragen\n" +
+		"  * **all** - alle Anfragen (ausstehend, genehmigt, abgelehnt, storniert)\n" +
+		"* **/approve get [ID]** - Eine bestimmte Genehmigung anhand der ID anzeigen\n" +
+		"* **/approve bulk** - Mehrere ausstehende Anfragen auf einmal genehmigen oder ablehnen\n" +
+		"* **/approve yes|no <APPROVAL_ID> [comment]** - Eine ausstehende Anfrage ohne die DM-Schaltflächen genehmigen oder ablehnen\n" +
+		"* **/approve stats [@user|~team] [--since 30d]** - Statistiken zu Entscheidungszeiten und Ergebnissen anzeigen (eigene; Administratoren auch für beliebige Benutzer oder Teams)\n" +
+		"* **/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:STATUS]** - Eigene Anfragen nach Wörtern der Beschreibung und Filtern durchsuchen\n" +
+		"* **/approve cancel <APPROVAL_ID>** - Eine ausstehende Genehmigungsanfrage stornieren\n" +
+		"* **/approve verify <APPROVAL_CODE> [comment]** - Eine genehmigte Anfrage als verifiziert/abgeschlossen markieren\n" +
+		"* **/approve resubmit <APPROVAL_CODE>** - Aus einer abgelehnten, stornierten oder genehmigten Anfrage eine neue erstellen\n" +
+		"* **/approve comment <APPROVAL_CODE> <text>** - Der anderen Partei im Thread der Genehmigung einen Kommentar senden\n" +
+		"* **/approve digest [on|off|status]** - Eine geplante Übersicht der Anfragen abonnieren, die auf Ihre Entscheidung warten\n" +
+		"* **/approve status** - Statistiken des Genehmigungssystems anzeigen (nur Administratoren)\n" +
+		"* **/approve help** - Diese Hilfe anzeigen\n\n" +
+		"**Administratorbefehle:**\n\n" +
+		"* **/approve status** - Gesamtstatistiken und Zustand der Benachrichtigungen anzeigen\n" +
+		"* **/approve status --failed-notifications** - Genehmigungen mit fehlgeschlagenen Benachrichtigungen auflisten\n" +
+		"* **/approve status --sod** - Blockierte Verstöße gegen die Funktionstrennung auflisten\n" +
+		"* **/approve admin template list|set|delete** - Anfragevorlagen mit benutzerdefinierten Feldern verwalten\n" +
+		"* **/approve admin policy list|set|delete** - Genehmigerrichtlinien pro Team oder Vorlage verwalten\n" +
+		"* **/approve admin sla list|set|delete** - SLA-Ziele und Eskalationskanäle für Überschreitungen verwalten\n" +
+		"* **/approve admin resend <code> [approver|requester]** - Die Benachrichtigungs-DM für den aktuellen Stand einer Anfrage erneut senden\n\n" +
+		"**Beispiele:**\n" +
+		"`/approve new` - Öffnet ein Formular zum Erstellen einer Genehmigungsanfrage\n" +
+		"`/approve list` - Zeigt ausstehende Genehmigungsanfragen\n" +
+		"`/approve list approved` - Zeigt genehmigte Anfragen\n" +
+		"`/approve list all` - Zeigt alle Anfragen\n" +
+		"`/approve list all --page 2` - Zeigt die nächsten 20 Anfragen\n" +
+		"`/approve list inbox` - Zeigt ausstehende Anfragen, über die Sie entscheiden\n" +
+		"`/approve search firewall from:@alice` - Findet Anfragen von @alice, die „firewall“ erwähnen\n\n" +
+		"Weitere Informationen finden Sie in der Plugin-Dokumentation.",
+	"command.unknown": "Unbekannter Befehl: **%s**\n\n" +
+		"Gültige Befehle: `new`, `list`, `get`, `cancel`, `comment`, `search`, `verify`, `resubmit`, `bulk`, `yes`, `no`, `stats`, `status`, `digest`, `admin`, `help`\n\n" +
+		"Geben Sie `/approve help` ein, um weitere Informationen zu erhalten.",
+	"admin.permission_denied":  "❌ Zugriff verweigert. Nur Systemadministratoren können Administratorbefehle verwenden.",
+	"status.permission_denied": "❌ Zugriff verweigert. Nur Systemadministratoren können Genehmigungsstatistiken einsehen.",
+	"status.failed":            "❌ Genehmigungsstatistiken konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+
+	// Timestamps and relative ages
+	"time.with_age":    "%s (%s)",
+	"time.just_now":    "gerade eben",
+	"time.minutes_ago": "vor %d Min.",
+	"time.hours_ago":   "vor %d Std.",
+	"time.day_ago":     "vor 1 Tag",
+	"time.days_ago":    "vor %d Tagen",
+
+	// Status labels and list filters
+	"status.pending":         "⏳ Ausstehend",
+	"status.approved":        "✅ Genehmigt",
+	"status.denied":          "❌ Abgelehnt",
+	"status.canceled":        "🚫 Storniert",
+	"status.canceled_reason": "🚫 Storniert (%s)",
+	"status.scheduled":       "🕒 Geplant",
+	"filter.pending":         "ausstehend",
+	"filter.approved":        "genehmigt",
+	"filter.denied":          "abgelehnt",
+	"filter.canceled":        "storniert",
+	"filter.scheduled":       "geplant",
+	"filter.all":             "alle",
+
+	// /approve new and the create-request dialog
+	"new.missing_trigger":     "Trigger-ID fehlt. Bitte führen Sie den Befehl erneut aus.",
+	"new.config_error":        "Fehler in der Plugin-Konfiguration. Bitte wenden Sie sich an Ihren Systemadministrator.",
+	"new.invalid_args":        "❌ %s\n\nVerwendung: `/approve new [template] [--group <name> | --role <role>]`",
+	"new.template_failed":     "Anfragevorlage konnte nicht geladen werden. Bitte versuchen Sie es erneut.",
+	"new.template_not_found":  "❌ Anfragevorlage '%s' nicht gefunden.",
+	"new.no_templates":        "\n\nEs sind keine Vorlagen definiert. Verwenden Sie `/approve new`, um eine freie Anfrage zu erstellen.",
+	"new.available_templates": "\n\nVerfügbare Vorlagen: %s",
+	"new.group_not_found":     "❌ Gruppe @%s nicht gefunden.",
+	"new.dialog_failed":       "Das Anfrageformular konnte nicht geöffnet werden. Bitte versuchen Sie es erneut.",
+
+	"new.dialog.title":                   "Genehmigungsanfrage erstellen",
+	"new.dialog.submit":                  "Anfrage senden",
+	"new.dialog.description":             "Was muss genehmigt werden? *",
+	"new.dialog.description_placeholder": "Beschreiben Sie die Aktion, die eine Genehmigung erfordert",
+	"new.dialog.approver":                "Genehmiger auswählen *",
+	"new.dialog.group_intro":             "**Genehmigergruppe:** @%s - alle Mitglieder werden benachrichtigt, wer zuerst bestätigt, entscheidet.",
+	"new.dialog.role_intro":              "**Genehmiger:** Kanalmitglieder mit der Rolle `%s` - alle Rolleninhaber werden benachrichtigt, wer zuerst bestätigt, entscheidet.",
+	"new.dialog.template_intro":          "**Vorlage:** %s",
+	"new.dialog.suggested":               "Vorgeschlagen: @%s",
+	"new.dialog.visibility":              "Sichtbarkeit im Kanal",
+	"new.dialog.visibility_help":         "Eine Statuskarte in diesem Kanal wird aktualisiert, sobald über die Anfrage entschieden ist. Private Karten verbergen die Beschreibung.",
+	"new.dialog.visibility_dm":           "Nur DM an den Genehmiger",
+	"new.dialog.visibility_channel":      "Statuskarte in diesem Kanal posten",
+	"new.dialog.visibility_private":      "Statuskarte ohne Details posten (privat)",
+	"new.dialog.send_at":                 "Senden am",
+	"new.dialog.send_at_help":            "Leer lassen, um sofort zu senden. Um die Anfrage später zu senden, geben Sie einen Zeitpunkt in Ihrer Zeitzone (%s) innerhalb der nächsten 30 Tage ein.",
+
+	// /approve list
+	"list.invalid_filter":   "Ungültiger Filter '%s'. Gültige Filter: pending, scheduled, approved, denied, canceled, all, optional mit inbox oder outbox",
+	"list.failed":           "❌ Genehmigungsdatensätze konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"list.empty":            "Keine Genehmigungsanfragen (%s). Mit `/approve list all` sehen Sie alle Anfragen.",
+	"list.header":           "## Ihre Genehmigungsanfragen (%d, %s)\n\n",
+	"list.pending_section":  "**Ausstehende Genehmigungen:**\n\n",
+	"list.decided_section":  "**Entschiedene Genehmigungen:**\n\n",
+	"list.canceled_section": "**Stornierte Anfragen:**\n\n",
+	"list.table_header": "| Code | Status | Antragsteller | Genehmiger | Erstellt |\n" +
+		"|------|--------|---------------|------------|----------|\n",
+	"list.header_page":        "## Ihre Genehmigungsanfragen (%s, Seite %d)\n\n",
+	"list.page_footer":        "*Seite %d.* Ältere Anfragen: **Weiter ▶** oder `%s`. Mit `/approve get <ID>` sehen Sie einzelne Anfragen.",
+	"list.page_empty":         "Keine weiteren Genehmigungsanfragen (%s).",
+	"list.invalid_page":       "Ungültige Seite '%s'. Verwenden Sie eine Seitenzahl ab 1, z. B. `/approve list all --page 2`.",
+	"list.invalid_limit":      "Ungültiges Limit '%s'. Verwenden Sie eine Zahl von 1 bis %d.",
+	"list.previous":           "◀ Zurück",
+	"list.next":               "Weiter ▶",
+	"list.bulk":               "Mehrere entscheiden...",
+	"list.inbox_header":       "## An Sie gerichtete Anfragen (%d, %s)\n\n",
+	"list.inbox_header_page":  "## An Sie gerichtete Anfragen (%s, Seite %d)\n\n",
+	"list.inbox_page_footer":  "*Seite %d.* Neuere Anfragen: **Weiter ▶** oder `%s`. Mit `/approve get <ID>` sehen Sie einzelne Anfragen.",
+	"list.inbox_empty":        "Keine an Sie gerichteten Anfragen (%s). Mit `/approve list outbox` sehen Sie Ihre gesendeten Anfragen.",
+	"list.inbox_row":          "**%s** von @%s: %s",
+	"list.outbox_header":      "## Von Ihnen gesendete Anfragen (%d, %s)\n\n",
+	"list.outbox_header_page": "## Von Ihnen gesendete Anfragen (%s, Seite %d)\n\n",
+	"list.outbox_empty":       "Keine von Ihnen gesendeten Genehmigungsanfragen (%s). Mit `/approve list outbox all` sehen Sie alle.",
+
+	// /approve bulk
+	"bulk.usage":             "Verwendung: `/approve bulk`\n\nÖffnet einen Dialog, um mehrere Anfragen, über die Sie entscheiden, auf einmal zu genehmigen oder abzulehnen.",
+	"bulk.none":              "Keine ausstehenden Anfragen warten auf Ihre Entscheidung.",
+	"bulk.dialog_failed":     "Der Dialog für die Sammelentscheidung konnte nicht geöffnet werden. Bitte versuchen Sie es erneut.",
+	"bulk.dialog.title":      "Anfragen entscheiden",
+	"bulk.dialog.intro":      "Markieren Sie die Anfragen, über die Sie entscheiden möchten. Entscheidung und Kommentar gelten für jede markierte Anfrage, und jeder Antragsteller erhält die übliche Ergebnisnachricht.\n\n**Entscheidungen werden protokolliert und können nicht bearbeitet werden.**",
+	"bulk.dialog.more":       "\n\nAngezeigt werden Ihre %d ältesten ausstehenden Anfragen. Führen Sie `/approve bulk` für die übrigen erneut aus.",
+	"bulk.dialog.decision":   "Entscheidung",
+	"bulk.dialog.record":     "@%s: %s",
+	"bulk.decision_required": "Wählen Sie Genehmigen oder Ablehnen.",
+	"bulk.none_selected":     "Markieren Sie mindestens eine Anfrage.",
+	"bulk.result_header":     "### Sammelentscheidung: %d von %d protokolliert\n\n",
+	"bulk.result_ok":         "- ✅ **%s** %s\n",
+	"bulk.result_failed":     "- ❌ **%s**: %s\n",
+
+	// /approve yes|no
+	"decide.usage":            "Verwendung: `/approve yes <APPROVAL_CODE> [comment]` oder `/approve no <APPROVAL_CODE> [comment]`\n\nBeispiel: `/approve yes A-X7K9Q2 Sieht gut aus`",
+	"decide.approved":         "✅ **%s** genehmigt. Der Antragsteller wird benachrichtigt.",
+	"decide.denied":           "❌ **%s** abgelehnt. Der Antragsteller wird benachrichtigt.",
+	"decide.comment_too_long": "Der Kommentar ist zu lang (%d Zeichen, maximal %d).",
+
+	// /approve search
+	"search.usage": "Verwendung: `/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:scheduled|pending|approved|denied|canceled]`\n\n" +
+		"Alle Wörter und Filter müssen zutreffen. Datumsangaben gelten in Ihrer Zeitzone; `after` und `before` schließen den angegebenen Tag aus.",
+	"search.invalid_args": "❌ Ungültige Suche: %s",
+	"search.failed":       "❌ Genehmigungsdatensätze konnten nicht durchsucht werden. Bitte versuchen Sie es erneut.",
+	"search.empty":        "Keine Genehmigungsanfragen entsprechen `%s`.",
+	"search.header":       "## Suchergebnisse für `%s` (%d)\n\n",
+	"search.footer":       "*%d von %d Treffern werden angezeigt.* Grenzen Sie die Suche mit weiteren Wörtern oder Filtern ein, oder sehen Sie eine Anfrage mit `/approve get <ID>` an.",
+
+	// /approve stats
+	"stats.usage":             "Verwendung: `/approve stats [@user|~team] [--since 30d]`\n\nZeigt Statistiken für Anfragen, die im Zeitraum erstellt wurden (Standard 30d; h, d oder w verwenden). Nur Systemadministratoren können andere Benutzer oder Teams abfragen.",
+	"stats.invalid_args":      "❌ Ungültige Statistikabfrage: %s",
+	"stats.permission_denied": "❌ Zugriff verweigert. Nur Systemadministratoren können Statistiken anderer Benutzer oder Teams anzeigen.",
+	"stats.user_not_found":    "❌ Benutzer @%s nicht gefunden.",
+	"stats.team_not_found":    "❌ Team ~%s nicht gefunden.",
+	"stats.failed":            "❌ Genehmigungsstatistiken konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"stats.header":            "## 📈 Genehmigungsstatistiken für %s\n\n",
+	"stats.period":            "*Anfragen der letzten %s (seit %s)*\n",
+	"stats.section.received":  "\n### Erhaltene Anfragen (%d)\n\n",
+	"stats.section.sent":      "\n### Gesendete Anfragen (%d)\n\n",
+	"stats.section.team":      "\n### Anfragen im Team (%d)\n\n",
+	"stats.empty":             "Keine Anfragen in diesem Zeitraum.\n",
+	"stats.counts":            "- Ausstehend: %d · Genehmigt: %d · Abgelehnt: %d · Abgebrochen: %d\n",
+	"stats.decision_time":     "- Zeit bis zur Entscheidung: Median %s, p90 %s\n",
+	"stats.rates":             "- Genehmigungsquote: %s · Ablehnungsquote: %s · Zeitüberschreitungsquote: %s · Verifizierungsquote: %s\n",
+	"stats.approvers_header":  "\n**Aktivste Genehmiger:**\n\n| Genehmiger | Entscheidungen | Median bis zur Entscheidung |\n|------------|----------------|-----------------------------|\n",
+	"stats.duration.minutes":  "%d Min.",
+	"stats.duration.hours":    "%d Std. %d Min.",
+	"stats.duration.days":     "%d T. %d Std.",
+
+	// /approve get
+	"get.usage":             "Verwendung: /approve get <APPROVAL_ID>\n\nBeispiel: /approve get A-X7K9Q2",
+	"get.not_found":         "❌ Genehmigungsdatensatz '%s' nicht gefunden.\n\nMit `/approve list` sehen Sie Ihre Genehmigungsdatensätze.",
+	"get.failed":            "❌ Genehmigungsdatensatz konnte nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"get.permission_denied": "❌ Zugriff verweigert. Sie können nur Genehmigungsdatensätze einsehen, bei denen Sie Antragsteller oder Genehmiger sind.",
+
+	"get.header":                 "**📋 Genehmigungsdatensatz: %s**\n\n",
+	"get.status":                 "**Status:** %s\n\n",
+	"get.requester":              "**Antragsteller:** @%s (%s)\n",
+	"get.approver":               "**Genehmiger:** @%s (%s)\n",
+	"get.approver_group":         "**Genehmigergruppe:** %s\n\n",
+	"get.approver_group_pending": "**Genehmigergruppe:** %s (%d Mitglieder, wer zuerst bestätigt, entscheidet)\n\n",
+	"get.description":            "**Beschreibung:**\n%s\n\n",
+	"get.template":               "**Vorlage:** %s\n",
+	"get.cancellation":           "**Stornierung:**\n",
+	"get.no_reason":              "Kein Grund erfasst (vor v0.2.0 storniert)",
+	"get.reason":                 "**Grund:** %s\n",
+	"get.details":                "**Details:** %s\n",
+	"get.canceled_by":            "**Storniert von:** @%s\n",
+	"get.canceled":               "**Storniert:** %s\n",
+	"get.unknown":                "Unbekannt",
+	"get.requested":              "**Angefragt:** %s\n",
+	"get.scheduled":              "**Geplant für:** %s\n",
+	"get.sent":                   "**Gesendet:** %s\n",
+	"get.decided":                "**Entschieden:** %s\n",
+	"get.not_decided":            "Noch nicht entschieden",
+	"get.sla":                    "**SLA:** %s (%s)\n",
+	"get.sla.on_track":           "im Zeitplan",
+	"get.sla.met":                "eingehalten",
+	"get.sla.breached":           "🚨 überschritten",
+	"get.decision_comment":       "\n**Kommentar zur Entscheidung:**\n%s\n",
+	"get.verification":           "**✅ Verifizierung:**\n",
+	"get.verified":               "**Verifiziert:** %s\n",
+	"get.verified_by":            "**Verifiziert von:** @%s\n",
+	"get.verification_note":      "**Notiz:** %s\n",
+	"get.comments":               "**💬 Kommentare (%d):**\n",
+	"get.context":                "\n**Kontext:**\n",
+	"get.context.request_id":     "- Anfrage-ID: %s\n",
+	"get.context.full_id":        "- Vollständige ID: %s\n",
+	"get.context.channel_id":     "- Kanal-ID: %s\n",
+	"get.context.team_id":        "- Team-ID: %s\n",
+	"get.lineage":                "\n**Herkunft:**\n",
+	"get.lineage.from":           "- Erneut eingereicht aus: `%s`\n",
+	"get.lineage.as":             "- Erneut eingereicht als: `%s`\n",
+	"get.immutable":              "\n**Dieser Datensatz ist unveränderlich und kann nicht bearbeitet werden.**\n",
+
+	// Create-request dialog submission
+	"new.approver_required":      "Das Feld Genehmiger ist erforderlich. Bitte wählen Sie einen Benutzer aus.",
+	"new.description_required":   "Das Feld Beschreibung ist erforderlich. Bitte beschreiben Sie, was genehmigt werden muss.",
+	"new.invalid_submission":     "Ungültiges Übermittlungsformat. Bitte versuchen Sie es erneut.",
+	"new.template_unavailable":   "Die Anfragevorlage ist nicht mehr verfügbar. Bitte beginnen Sie erneut mit `/approve new`.",
+	"new.group_rejected":         "Gruppen-Genehmigungsanfrage kann nicht erstellt werden: %s.",
+	"new.group_failed":           "Gruppengenehmiger konnten nicht ermittelt werden. Bitte versuchen Sie es erneut.",
+	"new.group_display_name":     "Gruppe %s",
+	"new.role_display_name":      "Kanalrolle",
+	"new.policy_failed":          "Genehmigerrichtlinie konnte nicht geprüft werden. Bitte versuchen Sie es erneut.",
+	"new.description_too_long":   "Die Beschreibung hat %d Zeichen (max. %d). Bitte kürzen Sie Ihre Anfrage.",
+	"new.invalid_visibility":     "Ungültige Sichtbarkeit '%s'. Wählen Sie nur Genehmiger-DM oder eine Statuskarte im Kanal.",
+	"new.self_approval":          "Sie können Ihre eigene Anfrage nicht genehmigen. Bitte wählen Sie einen anderen Genehmiger aus.",
+	"new.approver_inactive":      "Der ausgewählte Genehmiger ist kein aktiver Benutzer. Bitte wählen Sie einen aktiven Benutzer aus.",
+	"new.approver_lookup_failed": "Der ausgewählte Genehmiger konnte nicht überprüft werden. Bitte versuchen Sie es erneut.",
+	"new.approver_not_allowed":   "Der ausgewählte Genehmiger ist laut Richtlinie für %s nicht zulässig. Zulässige Genehmiger: %s",
+	"new.policy_scope_team":      "dieses Team",
+	"new.policy_scope_template":  "Anfragen vom Typ '%s'",
+	"new.field_required":         "%s ist erforderlich.",
+	"new.field_too_long":         "%s hat %d Zeichen (max. %d).",
+	"new.field_not_number":       "%s muss eine Zahl sein.",
+	"new.field_not_date":         "%s muss ein Datum im Format JJJJ-MM-TT sein.",
+	"new.field_not_option":       "%s muss einer der folgenden Werte sein: %s.",
+	"new.requester_failed":       "Informationen zum Antragsteller konnten nicht abgerufen werden",
+	"new.send_at_invalid":        "Ungültiger Sendezeitpunkt '%s'. Verwenden Sie JJJJ-MM-TT HH:MM.",
+	"new.send_at_past":           "Der Sendezeitpunkt %s liegt in der Vergangenheit. Lassen Sie das Feld leer, um sofort zu senden.",
+	"new.send_at_too_far":        "Der Sendezeitpunkt %s liegt zu weit in der Zukunft. Anfragen können bis zu %d Tage im Voraus geplant werden.",
+	"new.code_failed":            "Es konnte kein eindeutiger Genehmigungscode erzeugt werden. Bitte versuchen Sie es erneut.",
+	"new.save_failed":            "Genehmigungsanfrage konnte nicht erstellt werden. Das System ist vorübergehend nicht verfügbar. Bitte versuchen Sie es erneut.",
+	"new.submitted.approver":     "**Genehmiger:** @%s (%s)",
+	"new.submitted.group":        "**Genehmiger:** %s (%d Mitglieder, wer zuerst bestätigt, entscheidet)",
+	"new.submitted":              "✅ **Genehmigungsanfrage gesendet**\n\n%s\n**Anfrage-ID:** `%s`\n\nSie werden benachrichtigt, sobald eine Entscheidung getroffen wurde.",
+	"new.submitted.channel_card": "\n\nIn diesem Kanal wurde eine Statuskarte gepostet, die mit dem Fortschritt der Anfrage aktualisiert wird.",
+	"new.scheduled":              "🕒 **Genehmigungsanfrage geplant**\n\n%s\n**Anfrage-ID:** `%s`\n**Senden am:** %s\n\nDer Genehmiger wird zu diesem Zeitpunkt benachrichtigt. Mit `/approve cancel %s` können Sie die Anfrage vorher stornieren.",
+
+	// Approve/deny confirmation dialog
+	"dialog.invalid_request":      "Ungültiges Anfrageformat",
+	"confirm.dialog_failed":       "Bestätigungsdialog konnte nicht geöffnet werden",
+	"confirm.approve_title":       "Genehmigung bestätigen",
+	"confirm.deny_title":          "Ablehnung bestätigen",
+	"confirm.approve_text":        "Bestätigen Sie, dass Sie Folgendes genehmigen:",
+	"confirm.deny_text":           "Bestätigen Sie, dass Sie Folgendes ablehnen:",
+	"confirm.intro":               "%s\n\n%s\n\n**Von:** @%s (%s)\n**Anfrage-ID:** `%s`\n\n**Diese Entscheidung wird gespeichert und kann nicht bearbeitet werden.**",
+	"confirm.comment":             "Kommentar hinzufügen (optional)",
+	"confirm.comment_placeholder": "Optional: Kontext oder Begründung für Ihre Entscheidung",
+	"confirm.submit":              "Bestätigen",
+	"confirm.already_decided":     "Entscheidung bereits gespeichert: %s",
+	"confirm.already_decided_by":  "Entscheidung bereits gespeichert: %s von @%s",
+	"confirm.invalid_action":      "Ungültige Aktion",
+	"confirm.not_found":           "Genehmigung nicht gefunden",
+	"confirm.permission_denied":   "Zugriff verweigert",
+	"confirm.self_approval":       "Sie können nicht über Ihre eigene Genehmigungsanfrage entscheiden (Funktionstrennung).",
+	"confirm.reciprocal":          "Genehmigung nicht möglich: %s.",
+	"confirm.claimed":             "Ein anderer Genehmiger entscheidet bereits über diese Anfrage.",
+	"confirm.failed":              "Entscheidung konnte nicht gespeichert werden. Bitte versuchen Sie es erneut.",
+
+	// Approver post updates after a decision or resend
+	"decision.approved":      "✅ **Entscheidung gespeichert: Genehmigt**",
+	"decision.denied":        "❌ **Entscheidung gespeichert: Abgelehnt**",
+	"decision.approved_by":   "✅ **Entscheidung gespeichert: Genehmigt von @%s**",
+	"decision.denied_by":     "❌ **Entscheidung gespeichert: Abgelehnt von @%s**",
+	"decision.fallback":      "\n\nÜber die Genehmigungsanfrage `%s` wurde entschieden.\n\nDer Antragsteller wird über Ihre Entscheidung benachrichtigt.",
+	"resend.header":          "🔁 **Benachrichtigung erneut gesendet** - Verwenden Sie die neuere Nachricht für Ihre Entscheidung.",
+	"resend.canceled_header": "🚫 **Anfrage storniert**",
+
+	// Errors shared by the code-based commands
+	"error.invalid_code": "❌ Ungültiges Format des Genehmigungscodes: '%s'. Erwartet wird ein Format wie 'A-X7K9Q2'.",
+	"error.not_found":    "❌ Genehmigungsanfrage '%s' nicht gefunden. Mit `/approve list` sehen Sie Ihre Anfragen.",
+
+	// /approve cancel and the cancellation dialog
+	"cancel.usage":                          "Verwendung: /approve cancel <APPROVAL_ID>",
+	"cancel.too_many_arguments":             "\n\nFehler: Zu viele Argumente angegeben.",
+	"cancel.permission_denied":              "❌ Zugriff verweigert. Sie können nur Ihre eigenen Genehmigungsanfragen stornieren.",
+	"cancel.dialog_failed":                  "Stornierungsdialog konnte nicht geöffnet werden. Bitte versuchen Sie es erneut.",
+	"cancel.dialog.title":                   "Genehmigungsanfrage stornieren",
+	"cancel.dialog.intro":                   "Sie sind dabei, die Genehmigungsanfrage **%s** zu stornieren.\n\n**Diese Aktion kann nicht rückgängig gemacht werden.**\n\nBitte wählen Sie einen Grund für die Stornierung:",
+	"cancel.dialog.reason":                  "Grund für die Stornierung",
+	"cancel.dialog.reason_placeholder":      "Grund auswählen",
+	"cancel.dialog.reason_no_longer_needed": "Nicht mehr benötigt",
+	"cancel.dialog.reason_wrong_approver":   "Falscher Genehmiger",
+	"cancel.dialog.reason_sensitive_info":   "Vertrauliche Informationen",
+	"cancel.dialog.reason_other":            "Anderer Grund",
+	"cancel.dialog.reason_help":             "Helfen Sie uns zu verstehen, warum Anfragen storniert werden",
+	"cancel.dialog.details":                 "Weitere Details (optional)",
+	"cancel.dialog.details_placeholder":     "z. B. Projektumfang geändert, Anforderungen aktualisiert...",
+	"cancel.dialog.details_help":            "Kontext oder Erläuterung hinzufügen (optional)",
+	"cancel.dialog.submit":                  "Anfrage stornieren",
+	"cancel.reason_required":                "Ein Grund ist erforderlich",
+	"cancel.details_required":               "Bitte geben Sie Details an, wenn Sie 'Anderer Grund' auswählen",
+	"cancel.not_found":                      "Genehmigungsanfrage nicht gefunden",
+	"cancel.requester_only":                 "Nur der Antragsteller kann diese Genehmigung stornieren",
+	"cancel.user_failed":                    "Benutzerinformationen konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"cancel.already_approved":               "❌ Genehmigungsanfrage %s kann nicht storniert werden. Sie wurde bereits genehmigt.",
+	"cancel.already_denied":                 "❌ Genehmigungsanfrage %s kann nicht storniert werden. Sie wurde bereits abgelehnt.",
+	"cancel.already_canceled":               "❌ Genehmigungsanfrage %s kann nicht storniert werden. Sie wurde bereits storniert.",
+	"cancel.already_decided":                "❌ Genehmigungsanfrage %s kann nicht storniert werden. Über die Anfrage wurde bereits entschieden.",
+	"cancel.failed":                         "❌ Genehmigungsanfrage konnte nicht storniert werden. Bitte versuchen Sie es erneut.",
+
+	// /approve verify
+	"verify.usage":               "Verwendung: /approve verify <APPROVAL_CODE> [comment]\n\nBeispiel: `/approve verify A-X7K9Q2` oder `/approve verify A-X7K9Q2 Deployment erfolgreich abgeschlossen`",
+	"verify.comment_too_long":    "❌ Verifizierungskommentar zu lang (%d Zeichen). Die maximale Länge beträgt 500 Zeichen.",
+	"verify.permission_denied":   "❌ Zugriff verweigert. Nur der Antragsteller kann seine Genehmigungsanfragen verifizieren.",
+	"verify.not_approved_status": "❌ Genehmigungsanfrage %s kann nicht verifiziert werden. Nur genehmigte Anfragen können verifiziert werden (aktueller Status: %s).",
+	"verify.not_approved":        "❌ Genehmigungsanfrage %s kann nicht verifiziert werden. Nur genehmigte Anfragen können verifiziert werden.",
+	"verify.already_verified":    "❌ Genehmigungsanfrage %s wurde bereits verifiziert.",
+	"verify.success":             "✅ Genehmigungsanfrage **%s** als verifiziert markiert.",
+	"verify.success_note":        "\n\n**Verifizierungsnotiz:** %s",
+	"verify.success_notified":    "\n\n%s wurde benachrichtigt.",
+	"verify.failed":              "❌ Genehmigungsanfrage konnte nicht verifiziert werden. Bitte versuchen Sie es erneut.",
+
+	// /approve resubmit
+	"resubmit.usage":               "Verwendung: /approve resubmit <APPROVAL_CODE>\n\nBeispiel: `/approve resubmit A-X7K9Q2`",
+	"resubmit.success":             "✅ **Genehmigungsanfrage erneut eingereicht**\n\n**Genehmiger:** @%s (%s)\n**Anfrage-ID:** `%s`\n**Erneut eingereicht aus:** `%s`\n\nSie werden benachrichtigt, sobald eine Entscheidung getroffen wurde.",
+	"resubmit.permission_denied":   "❌ Zugriff verweigert. Sie können nur Ihre eigenen Genehmigungsanfragen erneut einreichen.",
+	"resubmit.still_pending":       "❌ Genehmigungsanfrage %s kann nicht erneut eingereicht werden. Sie ist noch ausstehend.",
+	"resubmit.already_resubmitted": "❌ Genehmigungsanfrage %s wurde bereits erneut eingereicht. Mit `/approve get %s` sehen Sie die neue Anfrage.",
+	"resubmit.invalid_approver":    "❌ Genehmigungsanfrage %s kann nicht erneut eingereicht werden. Der ursprüngliche Genehmiger ist kein aktiver Benutzer mehr. Verwenden Sie stattdessen `/approve new`.",
+	"resubmit.not_allowed":         "❌ Genehmigungsanfrage %s kann nicht erneut eingereicht werden (%s). Verwenden Sie `/approve new`, um einen anderen Genehmiger zu wählen.",
+	"resubmit.group":               "❌ Genehmigungsanfrage %s kann nicht erneut eingereicht werden. Gruppen-Genehmigungsanfragen müssen mit `/approve new --group` oder `--role` neu erstellt werden.",
+	"resubmit.failed":              "❌ Genehmigungsanfrage konnte nicht erneut eingereicht werden. Bitte versuchen Sie es erneut.",
+
+	// /approve comment
+	"comment.usage":             "Verwendung: /approve comment <APPROVAL_CODE> <text>\n\nBeispiel: `/approve comment A-X7K9Q2 Für welche Umgebung ist das?`",
+	"comment.sent":              "💬 Kommentar zu `%s` gesendet.",
+	"comment.not_delivered":     "⚠️ Kommentar zu `%s` gespeichert, aber die Benachrichtigung konnte nicht zugestellt werden. Er ist mit `/approve get %s` sichtbar.",
+	"comment.permission_denied": "❌ Zugriff verweigert. Nur der Antragsteller und die Genehmiger können eine Genehmigungsanfrage kommentieren.",
+	"comment.scheduled":         "❌ Genehmigungsanfrage %s wurde noch nicht gesendet. Kommentare sind möglich, sobald der Genehmiger sie erhalten hat.",
+	"comment.required":          "❌ Kommentartext ist erforderlich.",
+	"comment.too_long":          "❌ Kommentar zu lang. Die maximale Länge beträgt %d Zeichen.",
+	"comment.failed":            "❌ Kommentar konnte nicht hinzugefügt werden. Bitte versuchen Sie es erneut.",
+
+	// /approve digest
+	"digest.usage": "**Genehmigungsübersicht:**\n\n" +
+		"* **/approve digest on** - Eine geplante Übersicht der Anfragen erhalten, die auf Ihre Entscheidung warten\n" +
+		"* **/approve digest off** - Die Übersicht nicht mehr erhalten\n" +
+		"* **/approve digest status** - Anzeigen, ob Sie die Übersicht erhalten\n\n" +
+		"Systemadministratoren erhalten in ihrer Übersicht zusätzlich Genehmigungsstatistiken und fehlgeschlagene Benachrichtigungen. Rhythmus und Uhrzeit legt ein Administrator in den Plugin-Einstellungen fest.",
+	"digest.update_failed": "❌ Ihr Abonnement der Übersicht konnte nicht aktualisiert werden. Bitte versuchen Sie es erneut.",
+	"digest.subscribed":    "✅ Sie erhalten die Genehmigungsübersicht nach dem von Ihrem Administrator festgelegten Zeitplan.",
+	"digest.unsubscribed":  "✅ Sie erhalten die Genehmigungsübersicht nicht mehr.",
+	"digest.status_failed": "❌ Ihr Abonnement der Übersicht konnte nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"digest.status_on":     "📬 Sie erhalten die Genehmigungsübersicht. Mit `/approve digest off` beenden Sie sie.",
+	"digest.status_off":    "📭 Sie erhalten die Genehmigungsübersicht nicht. Mit `/approve digest on` abonnieren Sie sie.",
+
+	// Digest DM
+	"digest.title_daily":           "📬 **Tägliche Genehmigungsübersicht**\n\n",
+	"digest.title_weekly":          "📬 **Wöchentliche Genehmigungsübersicht**\n\n",
+	"digest.none_pending":          "✅ Keine Anfragen warten auf Ihre Entscheidung.\n",
+	"digest.pending_header":        "**⏳ Warten auf Ihre Entscheidung (%d):**\n",
+	"digest.pending_row":           "- `%s` von @%s · wartet seit %s · %s\n",
+	"digest.pending_more":          "... und %d weitere. Verwenden Sie `/approve list`, um alle anzuzeigen.\n",
+	"digest.age_days":              "%d T. %d Std.",
+	"digest.age_hours":             "%d Std. %d Min.",
+	"digest.age_minutes":           "%d Min.",
+	"digest.admin_header":          "**📊 Genehmigungssystem (Administrator):**\n",
+	"digest.admin_counts":          "- Gesamt: %d · Ausstehend: %d · Genehmigt: %d · Abgelehnt: %d · Storniert: %d\n",
+	"digest.admin_failed_approver": "- ❌ Fehlgeschlagene Benachrichtigungen an Genehmiger: %d\n",
+	"digest.admin_failed_outcome":  "- ❌ Fehlgeschlagene Ergebnisbenachrichtigungen: %d\n",
+	"digest.admin_affected":        "- Betroffene Anfragen: %s\n",
+	"digest.admin_affected_more":   "und %d weitere",
+	"digest.admin_details":         "- Details mit `/approve status --failed-notifications`\n",
+	"digest.footer":                "\n_Diese Übersicht mit `/approve digest off` abbestellen._",
+
+	// Channel status card
+	"card.header":             "📋 **Genehmigungsanfrage** `%s`\n\n",
+	"card.status":             "**Status:** %s\n",
+	"card.requester":          "**Antragsteller:** @%s\n",
+	"card.approver":           "**Genehmiger:** %s",
+	"card.approver_for_group": "@%s (für %s)",
+	"card.private":            "\n\n_Details sind nur für Antragsteller und Genehmiger sichtbar._",
+	"card.description":        "\n**Beschreibung:**\n%s",
+	"card.decided":            "\n\n**Entschieden:** %s",
+	"card.comment":            "\n**Kommentar:** %s",
+	"card.closed":             "\n\n**Geschlossen:** %s",
+	"card.reason":             "\n**Grund:** %s",
+	"card.verified":           "\n**Verifiziert:** ✔️ %s",
+	"card.approved_by":        "✅ Genehmigt von @%s",
+	"card.denied_by":          "❌ Abgelehnt von @%s",
+	"card.timed_out":          "⏱️ Zeitüberschreitung (keine Antwort)",
+	"card.canceled_by":        "🚫 Storniert von @%s",
+	"card.pending":            "⏳ Ausstehend",
+
+	// Approve/Deny button verification
+	"action.invalid_request": "Ungültige Anfrage",
+	"action.expired":         "Diese Schaltflächen sind abgelaufen. Suchen Sie die Anfrage mit `/approve list` und prüfen Sie sie mit `/approve get <code>`.",
+
+	// /approve admin
+	"admin.usage": "**Administratorbefehle:**\n\n" +
+		"* **/approve admin template list** - Anfragevorlagen auflisten\n" +
+		"* **/approve admin template set <JSON>** - Eine Anfragevorlage erstellen oder ersetzen\n" +
+		"* **/approve admin template delete <name>** - Eine Anfragevorlage löschen\n" +
+		"* **/approve admin policy list|set|delete** - Genehmigerrichtlinien verwalten (ohne Argumente ausführen für Details)\n" +
+		"* **/approve admin sla list|set|delete** - SLA-Ziele und Eskalationskanäle für Überschreitungen verwalten (ohne Argumente ausführen für Details)\n" +
+		"* **/approve admin resend <code> [approver|requester]** - Die DM für den aktuellen Stand einer Anfrage erneut senden (an den Genehmiger, solange sie aussteht, danach an den Antragsteller)\n\n" +
+		"**Vorlagenbeispiel:**\n" +
+		"```\n" +
+		`/approve admin template set {"name":"prod-access","displayName":"Production Access","defaultApprovers":["bob"],"fields":[{"name":"system","label":"System","type":"select","required":true,"options":["database","kubernetes"]},{"name":"hours","label":"Duration (hours)","type":"number","required":true}]}` + "\n" +
+		"```\n" +
+		"Feldtypen: text, select, number, date (YYYY-MM-DD). Höchstens 3 Felder pro Vorlage.",
+
+	// /approve admin template
+	"template.set_usage":          "Verwendung: /approve admin template set <JSON>",
+	"template.delete_usage":       "Verwendung: /approve admin template delete <name>",
+	"template.list_failed":        "❌ Anfragevorlagen konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"template.none":               "Keine Anfragevorlagen definiert. Erstellen Sie eine mit `/approve admin template set <JSON>`.",
+	"template.list_header":        "**📋 Anfragevorlagen (%d)**\n\n| Name | Anzeigename | Standard-Genehmiger | Felder |\n|------|-------------|---------------------|--------|\n",
+	"template.list_footer":        "\n*Pflichtfelder sind mit \\* markiert*",
+	"template.invalid_json":       "❌ Ungültiges Vorlagen-JSON: %s",
+	"template.invalid":            "❌ Ungültige Vorlage: %s",
+	"template.approver_not_found": "❌ Ungültige Vorlage: Standard-Genehmiger @%s nicht gefunden.",
+	"template.save_failed":        "❌ Anfragevorlage konnte nicht gespeichert werden. Bitte versuchen Sie es erneut.",
+	"template.saved":              "✅ Anfragevorlage `%s` gespeichert. Benutzer können jetzt `/approve new %s` ausführen.",
+	"template.not_found":          "❌ Anfragevorlage '%s' nicht gefunden.",
+	"template.delete_failed":      "❌ Anfragevorlage konnte nicht gelöscht werden. Bitte versuchen Sie es erneut.",
+	"template.deleted":            "✅ Anfragevorlage `%s` gelöscht. Bestehende Anfragen behalten ihre erfassten Feldwerte.",
+
+	// /approve admin policy
+	"policy.usage": "**Befehle für Genehmigerrichtlinien:**\n\n" +
+		"* **/approve admin policy list** - Genehmigerrichtlinien auflisten\n" +
+		"* **/approve admin policy set team <rules>** - Genehmiger für das aktuelle Team einschränken\n" +
+		"* **/approve admin policy set template <name> <rules>** - Genehmiger für eine Anfragevorlage einschränken\n" +
+		"* **/approve admin policy delete team** - Die Richtlinie des aktuellen Teams entfernen\n" +
+		"* **/approve admin policy delete template <name>** - Die Richtlinie einer Vorlage entfernen\n\n" +
+		"**Regeln** (mindestens eine, Werte durch Kommas getrennt):\n" +
+		"* `users=@alice,@bob` - bestimmte Benutzer\n" +
+		"* `groups=sre,dba` - Mitglieder von Mattermost-Gruppen\n" +
+		"* `roles=team_admin,channel_admin` - Inhaber einer Team- oder Kanalrolle\n\n" +
+		"Ein Genehmiger ist zulässig, wenn er eine der Regeln erfüllt. Gelten eine Team- und eine Vorlagenrichtlinie, müssen beide den Genehmiger zulassen.",
+	"policy.delete_usage":           "Verwendung: /approve admin policy delete team|template <name>",
+	"policy.scope_required":         "Geltungsbereich der Richtlinie erforderlich: verwenden Sie 'team' oder 'template <name>'",
+	"policy.team_channel_required":  "Teamrichtlinien müssen aus einem Teamkanal verwaltet werden",
+	"policy.template_name_required": "Vorlagenname erforderlich",
+	"policy.invalid_scope":          "Ungültiger Geltungsbereich '%s': verwenden Sie 'team' oder 'template <name>'",
+	"policy.rule_required":          "❌ Mindestens eine Regel ist erforderlich.",
+	"policy.invalid_rule":           "❌ Ungültige Regel '%s'. Erwartet: users=..., groups=... oder roles=...",
+	"policy.user_not_found":         "❌ Ungültige Regel: Benutzer @%s nicht gefunden.",
+	"policy.group_not_found":        "❌ Ungültige Regel: Gruppe '%s' nicht gefunden.",
+	"policy.invalid":                "❌ Ungültige Richtlinie: %s",
+	"policy.save_failed":            "❌ Genehmigerrichtlinie konnte nicht gespeichert werden. Bitte versuchen Sie es erneut.",
+	"policy.saved":                  "✅ Genehmigerrichtlinie für %s gespeichert. Zulässige Genehmiger: %s",
+	"policy.not_found":              "❌ Für %s ist keine Genehmigerrichtlinie definiert.",
+	"policy.delete_failed":          "❌ Genehmigerrichtlinie konnte nicht gelöscht werden. Bitte versuchen Sie es erneut.",
+	"policy.deleted":                "✅ Genehmigerrichtlinie für %s gelöscht. Jetzt kann jeder aktive Benutzer als Genehmiger gewählt werden.",
+	"policy.list_failed":            "❌ Genehmigerrichtlinien konnten nicht abgerufen werden. Bitte versuchen Sie es erneut.",
+	"policy.none":                   "Keine Genehmigerrichtlinien definiert. Jeder aktive Benutzer kann als Genehmiger gewählt werden.",
+	"policy.list_header":            "**🔒 Genehmigerrichtlinien (%d)**\n\n| Geltungsbereich | Zulässige Genehmiger |\n|-----------------|----------------------|\n",
+	"policy.scope_template":         "Vorlage `%s`",
+	"policy.scope_team":             "Team %s",
+	"policy.scope_team_id":          "Team `%s`",
+	"policy.rule_group":             "Gruppe %s",
+	"policy.rule_role":              "Rolle %s",
+
+	// /approve admin resend
+	"resend.usage":                     "Verwendung: /approve admin resend <APPROVAL_CODE> [approver|requester]\n\nBeispiel: `/approve admin resend A-X7K9Q2 approver`",
+	"resend.not_found":                 "❌ Genehmigungsanfrage '%s' nicht gefunden.",
+	"resend.load_failed":               "❌ Die Genehmigungsanfrage konnte nicht geladen werden. Bitte versuchen Sie es erneut.",
+	"resend.scheduled":                 "❌ Genehmigungsanfrage `%s` ist für %s UTC geplant und wurde noch nicht gesendet.",
+	"resend.unknown_target":            "❌ Unbekannter Empfänger '%s'. Verwenden Sie `approver` oder `requester`.",
+	"resend.failed":                    "❌ Die Benachrichtigung für `%s` konnte nicht erneut an den %s gesendet werden.\n\n**Vorschlag:** %s",
+	"resend.sent":                      "📨 %s-DM für `%s` erneut an den %s gesendet.",
+	"resend.target.approver":           "Genehmiger",
+	"resend.target.requester":          "Antragsteller",
+	"resend.notification.request":      "Anfrage",
+	"resend.notification.decision":     "Entscheidungs",
+	"resend.notification.cancellation": "Stornierungs",
+	"resend.notification.confirmation": "Bestätigungs",
+	"resend.notification.outcome":      "Ergebnis",
+	"resend.notification.timeout":      "Zeitüberschreitungs",
+}
@@ -0,0 +1,595 @@
+package i18n
+
+// en is the English catalog and the fallback for every other locale.
+// Every key must also exist in each shipped locale (see TestCatalogsComplete).
+var en = map[string]string{
+	// Shared labels
+	"approval.role_label": "channel role `%s`",
+
+	// Approver request DM
+	"dm.request": "📋 **Approval Request**\n\n" +
+		"**From:** @%s (%s)\n" +
+		"**Requested:** %s\n" +
+		"**Description:**\n%s\n\n" +
+		"**Request ID:** `%s`",
+	"dm.request.resubmission": "\n**Resubmission of:** `%s`",
+	"dm.request.group": "\n**Approver group:** %s\n\n" +
+		"_Sent to %d members. The first member to confirm a decision claims this request._",
+	"dm.request.approve":        "Approve",
+	"dm.request.deny":           "Deny",
+	"dm.custom_fields.template": "\n\n**Template:** %s",
+
+	// Requester confirmation DM (thread root)
+	"dm.confirmation": "📨 **Approval Request Sent**\n\n" +
+		"**Request ID:** `%s`\n" +
+		"**Approver:** %s\n" +
+		"**Description:**\n%s",
+	"dm.confirmation.footer": "\n\n_Updates about this request are posted in this thread. " +
+		"Use `/approve comment %s <text>` to message the approver._",
+
+	// Relayed comment DM
+	"dm.comment": "💬 **Comment from @%s** on `%s`\n\n%s\n\n" +
+		"_Reply with `/approve comment %s <text>`._",
+
+	// Outcome DM
+	"dm.outcome": "%s\n\n" +
+		"**Approver:** @%s (%s)\n" +
+		"**Decision Time:** %s\n" +
+		"**Request ID:** `%s`\n\n" +
+		"**Original Request:**\n> %s",
+	"dm.outcome.approved":        "✅ **Approval Request Approved**",
+	"dm.outcome.approved_status": "**Status:** You may proceed with this action.",
+	"dm.outcome.denied":          "❌ **Approval Request Denied**",
+	"dm.outcome.denied_status":   "**Status:** This request has been denied.",
+	"dm.outcome.comment":         "\n\n**Comment:**\n%s",
+
+	// Approver post replaced on cancellation
+	"dm.canceled_post": "🚫 **Approval Request (Canceled)**\n\n" +
+		"**From:** @%s\n" +
+		"**Request ID:** `%s`\n" +
+		"**Description:**\n%s\n\n" +
+		"---\n" +
+		"_Canceled by @%s at %s_",
+
+	// Approver cancellation DM
+	"dm.cancellation": "🚫 **Approval Request Canceled**\n\n" +
+		"**Reference:** `%s`\n" +
+		"**Requester:** @%s\n" +
+		"**Reason:** %s",
+	"dm.cancellation.no_reason": "Not specified",
+	"dm.cancellation.details":   "\n**Details:** %s",
+	"dm.cancellation.footer": "\n**Canceled:** %s\n\n" +
+		"The approval request you received has been canceled by the requester.",
+
+	// Requester cancellation DM
+	"dm.requester_cancellation": "🚫 **Your Approval Request Was Canceled**\n\n" +
+		"**Request ID:** `%s`\n" +
+		"**Original Request:** %s\n" +
+		"**Approver:** @%s\n" +
+		"**Reason:** %s",
+	"dm.requester_cancellation.footer": "\n**Canceled:** %s\n\n" +
+		"---\n\n" +
+		"The approver has canceled this approval request. You may submit a new request if needed.",
+
+	// Timeout DM
+	"dm.timeout": "⏱️ **Approval Request Timed Out**\n\n" +
+		"**Request ID:** `%s`\n\n" +
+		"**Original Request:**\n> %s\n\n" +
+		"**Approver:** @%s (%s)\n\n" +
+		"**Reason:** No response within 30 minutes\n\n" +
+		"**Status:** This request has been automatically canceled. You may create a new request if still needed.",
+
+	// Verification DM
+	"dm.verification": "✅ **Approval Request Verified**\n\n" +
+		"**Request ID:** `%s`\n\n" +
+		"**Original Request:**\n> %s\n\n" +
+		"**Requester:** @%s (%s)\n" +
+		"**Verified:** %s",
+	"dm.verification.note": "\n\n**Verification Note:**\n> %s",
+
+	// SLA breach DM
+	"dm.sla_breach": "🚨 **Approval SLA Breached**\n\n" +
+		"**Request ID:** `%s`\n" +
+		"**From:** @%s (%s)\n" +
+		"**Requested:** %s\n" +
+		"**SLA:** Decide within %s\n\n" +
+		"**Description:**\n> %s\n\n" +
+		"This request is past its SLA. Please approve or deny it now.",
+
+	// Slash commands
+	"command.invalid_format":          "Invalid command format.",
+	"command.error":                   "An error occurred while processing your command. Please try again.",
+	"command.permission_check_failed": "Failed to verify permissions. Please try again.",
+	"command.help": "### Mattermost Approval Workflow\n\n" +
+		"**Available Commands:**\n\n" +
+		"* **/approve new [template]** - Create a new approval request, optionally from a request template\n" +
+		"  * **--group <name>** - send to every member of a user group; the first to confirm decides\n" +
+		"  * **--role <role>** - send to channel members with a role (e.g. channel_admin); the first to confirm decides\n" +
+		"* **/approve list [inbox|outbox] [filter] [--page N] [--limit N]** - View your approval requests and decisions, 20 per page\n" +
+		"  * **inbox** - only requests sent to you, oldest first, with Approve and Deny buttons\n" +
+		"  * **outbox** - only requests you sent\n" +
+		"  * No filter: shows pending requests (default)\n" +
+		"  * **pending** - pending approval requests\n" +
+		"  * **approved** - approved requests\n" +
+		"  * **denied** - denied requests\n" +
+		"  * **canceled** - canceled requests\n" +
+		"  * **all** - all requests (pending, approved, denied, canceled)\n" +
+		"* **/approve get [ID]** - View a specific approval by ID\n" +
+		"* **/approve bulk** - Approve or deny several pending requests at once\n" +
+		"* **/approve yes|no <APPROVAL_ID> [comment]** - Approve or deny a pending request without the DM buttons\n" +
+		"* **/approve stats [@user|~team] [--since 30d]** - View time-to-decision and outcome statistics (your own; admins may query any user or team)\n" +
+		"* **/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:STATUS]** - Search your requests by description words and filters\n" +
+		"* **/approve cancel <APPROVAL_ID>** - Cancel a pending approval request\n" +
+		"* **/approve verify <APPROVAL_CODE> [comment]** - Mark an approved request as verified/complete\n" +
+		"* **/approve resubmit <APPROVAL_CODE>** - Create a new request from a denied, canceled or approved one\n" +
+		"* **/approve comment <APPROVAL_CODE> <text>** - Send a comment to the other party in the approval's thread\n" +
+		"* **/approve digest [on|off|status]** - Subscribe to a scheduled digest of requests awaiting your decision\n" +
+		"* **/approve status** - View approval system statistics (admin only)\n" +
+		"* **/approve help** - Display this help text\n\n" +
+		"**Admin Commands:**\n\n" +
+		"* **/approve status** - View overall approval statistics and notification health\n" +
+		"* **/approve status --failed-notifications** - List specific approvals with failed notifications\n" +
+		"* **/approve status --sod** - List blocked separation-of-duties violations\n" +
+		"* **/approve admin template list|set|delete** - Manage request templates with custom fields\n" +
+		"* **/approve admin policy list|set|delete** - Manage approver policies per team or template\n" +
+		"* **/approve admin sla list|set|delete** - Manage SLA targets and breach escalation channels\n" +
+		"* **/approve admin resend <code> [approver|requester]** - Resend the notification DM for a request's current state\n\n" +
+		"**Examples:**\n" +
+		"`/approve new` - Opens a modal to create an approval request\n" +
+		"`/approve list` - Shows pending approval requests\n" +
+		"`/approve list approved` - Shows approved requests\n" +
+		"`/approve list all` - Shows all requests\n" +
+		"`/approve list all --page 2` - Shows the next 20 requests\n" +
+		"`/approve list inbox` - Shows pending requests awaiting your decision\n" +
+		"`/approve search firewall from:@alice` - Finds requests by @alice that mention \"firewall\"\n\n" +
+		"For more information, visit the plugin documentation.",
+	"command.unknown": "Unknown command: **%s**\n\n" +
+		"Valid commands: `new`, `list`, `get`, `cancel`, `comment`, `search`, `verify`, `resubmit`, `bulk`, `yes`, `no`, `stats`, `status`, `digest`, `admin`, `help`\n\n" +
+		"Type `/approve help` for more information.",
+	"admin.permission_denied":  "❌ Permission denied. Only system administrators can use admin commands.",
+	"status.permission_denied": "❌ Permission denied. Only system administrators can view approval statistics.",
+	"status.failed":            "❌ Failed to retrieve approval statistics. Please try again.",
+
+	// Timestamps and relative ages
+	"time.with_age":    "%s (%s)",
+	"time.just_now":    "just now",
+	"time.minutes_ago": "%d min ago",
+	"time.hours_ago":   "%d h ago",
+	"time.day_ago":     "1 day ago",
+	"time.days_ago":    "%d days ago",
+
+	// Status labels and list filters
+	"status.pending":         "⏳ Pending",
+	"status.approved":        "✅ Approved",
+	"status.denied":          "❌ Denied",
+	"status.canceled":        "🚫 Canceled",
+	"status.canceled_reason": "🚫 Canceled (%s)",
+	"status.scheduled":       "🕒 Scheduled",
+	"filter.pending":         "pending",
+	"filter.approved":        "approved",
+	"filter.denied":          "denied",
+	"filter.canceled":        "canceled",
+	"filter.scheduled":       "scheduled",
+	"filter.all":             "all",
+
+	// /approve new and the create-request dialog
+	"new.missing_trigger":     "Missing trigger ID. Please try the command again.",
+	"new.config_error":        "Plugin configuration error. Please contact your system administrator.",
+	"new.invalid_args":        "❌ %s\n\nUsage: `/approve new [template] [--group <name> | --role <role>]`",
+	"new.template_failed":     "Failed to load request template. Please try again.",
+	"new.template_not_found":  "❌ Request template '%s' not found.",
+	"new.no_templates":        "\n\nNo templates are defined. Use `/approve new` to create a free-form request.",
+	"new.available_templates": "\n\nAvailable templates: %s",
+	"new.group_not_found":     "❌ Group @%s not found.",
+	"new.dialog_failed":       "Failed to open approval request form. Please try again.",
+
+	"new.dialog.title":                   "Create Approval Request",
+	"new.dialog.submit":                  "Submit Request",
+	"new.dialog.description":             "What needs approval? *",
+	"new.dialog.description_placeholder": "Describe the action requiring approval",
+	"new.dialog.approver":                "Select approver *",
+	"new.dialog.group_intro":             "**Approver group:** @%s - every member is notified and the first to confirm decides.",
+	"new.dialog.role_intro":              "**Approvers:** channel members with role `%s` - every holder is notified and the first to confirm decides.",
+	"new.dialog.template_intro":          "**Template:** %s",
+	"new.dialog.suggested":               "Suggested: @%s",
+	"new.dialog.visibility":              "Channel visibility",
+	"new.dialog.visibility_help":         "A status card in this channel is updated as the request is decided. Private cards hide the description.",
+	"new.dialog.visibility_dm":           "Approver DM only",
+	"new.dialog.visibility_channel":      "Post status card in this channel",
+	"new.dialog.visibility_private":      "Post status card without details (private)",
+	"new.dialog.send_at":                 "Send at",
+	"new.dialog.send_at_help":            "Leave empty to send now. To send the request later, enter a time in your timezone (%s) up to 30 days ahead.",
+
+	// /approve list
+	"list.invalid_filter":   "Invalid filter '%s'. Valid filters: pending, scheduled, approved, denied, canceled, all, optionally with inbox or outbox",
+	"list.failed":           "❌ Failed to retrieve approval records. Please try again.",
+	"list.empty":            "No %s approval requests. Use `/approve list all` to see all requests.",
+	"list.header":           "## Your Approval Requests (%d %s)\n\n",
+	"list.pending_section":  "**Pending Approvals:**\n\n",
+	"list.decided_section":  "**Decided Approvals:**\n\n",
+	"list.canceled_section": "**Canceled Requests:**\n\n",
+	"list.table_header": "| Code | Status | Requestor | Approver | Created |\n" +
+		"|------|--------|-----------|----------|----------|\n",
+	"list.header_page":        "## Your Approval Requests (%s, page %d)\n\n",
+	"list.page_footer":        "*Page %d.* Older requests: **Next ▶** or `%s`. Use `/approve get <ID>` to view specific requests.",
+	"list.page_empty":         "No more %s approval requests.",
+	"list.invalid_page":       "Invalid page '%s'. Use a page number of 1 or more, e.g. `/approve list all --page 2`.",
+	"list.invalid_limit":      "Invalid limit '%s'. Use a number from 1 to %d.",
+	"list.previous":           "◀ Previous",
+	"list.next":               "Next ▶",
+	"list.bulk":               "Decide several...",
+	"list.inbox_header":       "## Requests Sent to You (%d %s)\n\n",
+	"list.inbox_header_page":  "## Requests Sent to You (%s, page %d)\n\n",
+	"list.inbox_page_footer":  "*Page %d.* Newer requests: **Next ▶** or `%s`. Use `/approve get <ID>` to view specific requests.",
+	"list.inbox_empty":        "No %s requests were sent to you. Use `/approve list outbox` to see the requests you sent.",
+	"list.inbox_row":          "**%s** from @%s: %s",
+	"list.outbox_header":      "## Requests You Sent (%d %s)\n\n",
+	"list.outbox_header_page": "## Requests You Sent (%s, page %d)\n\n",
+	"list.outbox_empty":       "You sent no %s approval requests. Use `/approve list outbox all` to see all of them.",
+
+	// /approve bulk
+	"bulk.usage":             "Usage: `/approve bulk`\n\nOpens a dialog to approve or deny several of the requests awaiting your decision at once.",
+	"bulk.none":              "No pending requests await your decision.",
+	"bulk.dialog_failed":     "Failed to open the bulk decision dialog. Please try again.",
+	"bulk.dialog.title":      "Decide Pending Requests",
+	"bulk.dialog.intro":      "Check the requests to decide. The decision and comment apply to each checked request, and each requester gets the usual outcome message.\n\n**Decisions are recorded and cannot be edited.**",
+	"bulk.dialog.more":       "\n\nShowing your %d oldest pending requests. Run `/approve bulk` again for the rest.",
+	"bulk.dialog.decision":   "Decision",
+	"bulk.dialog.record":     "@%s: %s",
+	"bulk.decision_required": "Choose Approve or Deny.",
+	"bulk.none_selected":     "Check at least one request.",
+	"bulk.result_header":     "### Bulk decision: %d of %d recorded\n\n",
+	"bulk.result_ok":         "- ✅ **%s** %s\n",
+	"bulk.result_failed":     "- ❌ **%s**: %s\n",
+
+	// /approve yes|no
+	"decide.usage":            "Usage: `/approve yes <APPROVAL_CODE> [comment]` or `/approve no <APPROVAL_CODE> [comment]`\n\nExample: `/approve yes A-X7K9Q2 Looks good`",
+	"decide.approved":         "✅ Approved **%s**. The requester will be notified.",
+	"decide.denied":           "❌ Denied **%s**. The requester will be notified.",
+	"decide.comment_too_long": "Comment is too long (%d characters, max %d).",
+
+	// /approve search
+	"search.usage": "Usage: `/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:scheduled|pending|approved|denied|canceled]`\n\n" +
+		"All words and filters must match. Dates are in your timezone; `after` and `before` exclude the given day.",
+	"search.invalid_args": "❌ Invalid search: %s",
+	"search.failed":       "❌ Failed to search approval records. Please try again.",
+	"search.empty":        "No approval requests match `%s`.",
+	"search.header":       "## Search Results for `%s` (%d)\n\n",
+	"search.footer":       "*Showing %d of %d matches.* Add words or filters to narrow the search, or use `/approve get <ID>` to view a request.",
+
+	// /approve stats
+	"stats.usage":             "Usage: `/approve stats [@user|~team] [--since 30d]`\n\nShows statistics for requests created in the window (default 30d; use h, d or w). Only system administrators can query other users or teams.",
+	"stats.invalid_args":      "❌ Invalid stats query: %s",
+	"stats.permission_denied": "❌ Permission denied. Only system administrators can view statistics of other users or teams.",
+	"stats.user_not_found":    "❌ User @%s not found.",
+	"stats.team_not_found":    "❌ Team ~%s not found.",
+	"stats.failed":            "❌ Failed to retrieve approval statistics. Please try again.",
+	"stats.header":            "## 📈 Approval Statistics for %s\n\n",
+	"stats.period":            "*Requests created in the last %s (since %s)*\n",
+	"stats.section.received":  "\n### Requests Received (%d)\n\n",
+	"stats.section.sent":      "\n### Requests Sent (%d)\n\n",
+	"stats.section.team":      "\n### Team Requests (%d)\n\n",
+	"stats.empty":             "No requests in this period.\n",
+	"stats.counts":            "- Pending: %d · Approved: %d · Denied: %d · Canceled: %d\n",
+	"stats.decision_time":     "- Time to decision: median %s, p90 %s\n",
+	"stats.rates":             "- Approval rate: %s · Denial rate: %s · Timeout rate: %s · Verification rate: %s\n",
+	"stats.approvers_header":  "\n**Busiest approvers:**\n\n| Approver | Decisions | Median time to decision |\n|----------|-----------|-------------------------|\n",
+	"stats.duration.minutes":  "%d min",
+	"stats.duration.hours":    "%d h %d min",
+	"stats.duration.days":     "%d d %d h",
+
+	// /approve get
+	"get.usage":             "Usage: /approve get <APPROVAL_ID>\n\nExample: /approve get A-X7K9Q2",
+	"get.not_found":         "❌ Approval record '%s' not found.\n\nUse `/approve list` to see your approval records.",
+	"get.failed":            "❌ Failed to retrieve approval record. Please try again.",
+	"get.permission_denied": "❌ Permission denied. You can only view approval records where you are the requester or approver.",
+
+	"get.header":                 "**📋 Approval Record: %s**\n\n",
+	"get.status":                 "**Status:** %s\n\n",
+	"get.requester":              "**Requester:** @%s (%s)\n",
+	"get.approver":               "**Approver:** @%s (%s)\n",
+	"get.approver_group":         "**Approver group:** %s\n\n",
+	"get.approver_group_pending": "**Approver group:** %s (%d members, first to confirm decides)\n\n",
+	"get.description":            "**Description:**\n%s\n\n",
+	"get.template":               "**Template:** %s\n",
+	"get.cancellation":           "**Cancellation:**\n",
+	"get.no_reason":              "No reason recorded (canceled before v0.2.0)",
+	"get.reason":                 "**Reason:** %s\n",
+	"get.details":                "**Details:** %s\n",
+	"get.canceled_by":            "**Canceled by:** @%s\n",
+	"get.canceled":               "**Canceled:** %s\n",
+	"get.unknown":                "Unknown",
+	"get.requested":              "**Requested:** %s\n",
+	"get.scheduled":              "**Scheduled for:** %s\n",
+	"get.sent":                   "**Sent:** %s\n",
+	"get.decided":                "**Decided:** %s\n",
+	"get.not_decided":            "Not yet decided",
+	"get.sla":                    "**SLA:** %s (%s)\n",
+	"get.sla.on_track":           "on track",
+	"get.sla.met":                "met",
+	"get.sla.breached":           "🚨 breached",
+	"get.decision_comment":       "\n**Decision Comment:**\n%s\n",
+	"get.verification":           "**✅ Verification:**\n",
+	"get.verified":               "**Verified:** %s\n",
+	"get.verified_by":            "**Verified by:** @%s\n",
+	"get.verification_note":      "**Note:** %s\n",
+	"get.comments":               "**💬 Comments (%d):**\n",
+	"get.context":                "\n**Context:**\n",
+	"get.context.request_id":     "- Request ID: %s\n",
+	"get.context.full_id":        "- Full ID: %s\n",
+	"get.context.channel_id":     "- Channel ID: %s\n",
+	"get.context.team_id":        "- Team ID: %s\n",
+	"get.lineage":                "\n**Lineage:**\n",
+	"get.lineage.from":           "- Resubmitted from: `%s`\n",
+	"get.lineage.as":             "- Resubmitted as: `%s`\n",
+	"get.immutable":              "\n**This record is immutable and cannot be edited.**\n",
+
+	// Create-request dialog submission
+	"new.approver_required":      "Approver field is required. Please select a user.",
+	"new.description_required":   "Description field is required. Please describe what needs approval.",
+	"new.invalid_submission":     "Invalid submission format. Please try again.",
+	"new.template_unavailable":   "The request template is no longer available. Please start again with `/approve new`.",
+	"new.group_rejected":         "Cannot create group approval request: %s.",
+	"new.group_failed":           "Failed to resolve group approvers. Please try again.",
+	"new.group_display_name":     "%s group",
+	"new.role_display_name":      "channel role",
+	"new.policy_failed":          "Failed to check approver policy. Please try again.",
+	"new.description_too_long":   "Description is %d characters (max %d). Please shorten your request.",
+	"new.invalid_visibility":     "Invalid visibility '%s'. Choose approver DM only or a channel status card.",
+	"new.self_approval":          "You cannot approve your own request. Please select a different approver.",
+	"new.approver_inactive":      "The selected approver is not an active user. Please select an active user.",
+	"new.approver_lookup_failed": "Failed to validate the selected approver. Please try again.",
+	"new.approver_not_allowed":   "The selected approver is not allowed by policy for %s. Allowed approvers: %s",
+	"new.policy_scope_team":      "this team",
+	"new.policy_scope_template":  "'%s' requests",
+	"new.field_required":         "%s is required.",
+	"new.field_too_long":         "%s is %d characters (max %d).",
+	"new.field_not_number":       "%s must be a number.",
+	"new.field_not_date":         "%s must be a date in YYYY-MM-DD format.",
+	"new.field_not_option":       "%s must be one of: %s.",
+	"new.requester_failed":       "Failed to retrieve requester information",
+	"new.send_at_invalid":        "Invalid send time '%s'. Use YYYY-MM-DD HH:MM.",
+	"new.send_at_past":           "Send time %s is in the past. Leave it empty to send now.",
+	"new.send_at_too_far":        "Send time %s is too far ahead. Requests can be scheduled up to %d days ahead.",
+	"new.code_failed":            "Failed to generate unique approval code. Please try again.",
+	"new.save_failed":            "Failed to create approval request. The system is temporarily unavailable. Please try again.",
+	"new.submitted.approver":     "**Approver:** @%s (%s)",
+	"new.submitted.group":        "**Approvers:** %s (%d members, first to confirm decides)",
+	"new.submitted":              "✅ **Approval Request Submitted**\n\n%s\n**Request ID:** `%s`\n\nYou will be notified when a decision is made.",
+	"new.submitted.channel_card": "\n\nA status card was posted in this channel and will update as the request progresses.",
+	"new.scheduled":              "🕒 **Approval Request Scheduled**\n\n%s\n**Request ID:** `%s`\n**Send at:** %s\n\nThe approver will be notified at that time. Use `/approve cancel %s` to cancel it before then.",
+
+	// Approve/deny confirmation dialog
+	"dialog.invalid_request":      "Invalid request format",
+	"confirm.dialog_failed":       "Failed to open confirmation modal",
+	"confirm.approve_title":       "Confirm Approval",
+	"confirm.deny_title":          "Confirm Denial",
+	"confirm.approve_text":        "Confirm you are approving:",
+	"confirm.deny_text":           "Confirm you are denying:",
+	"confirm.intro":               "%s\n\n%s\n\n**From:** @%s (%s)\n**Request ID:** `%s`\n\n**This decision will be recorded and cannot be edited.**",
+	"confirm.comment":             "Add comment (optional)",
+	"confirm.comment_placeholder": "Optional: Provide context or reasoning for your decision",
+	"confirm.submit":              "Confirm",
+	"confirm.already_decided":     "Decision already recorded: %s",
+	"confirm.already_decided_by":  "Decision already recorded: %s by @%s",
+	"confirm.invalid_action":      "Invalid action",
+	"confirm.not_found":           "Approval not found",
+	"confirm.permission_denied":   "Permission denied",
+	"confirm.self_approval":       "You cannot decide your own approval request (separation of duties).",
+	"confirm.reciprocal":          "Cannot approve: %s.",
+	"confirm.claimed":             "Another approver is already deciding this request.",
+	"confirm.failed":              "Failed to record decision. Please try again.",
+
+	// Approver post updates after a decision or resend
+	"decision.approved":      "✅ **Decision Recorded: Approved**",
+	"decision.denied":        "❌ **Decision Recorded: Denied**",
+	"decision.approved_by":   "✅ **Decision Recorded: Approved by @%s**",
+	"decision.denied_by":     "❌ **Decision Recorded: Denied by @%s**",
+	"decision.fallback":      "\n\nApproval request `%s` has been decided.\n\nThe requester will be notified of your decision.",
+	"resend.header":          "🔁 **Notification Resent** - Use the newer message to decide.",
+	"resend.canceled_header": "🚫 **Request Canceled**",
+
+	// Errors shared by the code-based commands
+	"error.invalid_code": "❌ Invalid approval code format: '%s'. Expected format like 'A-X7K9Q2'.",
+	"error.not_found":    "❌ Approval request '%s' not found. Use `/approve list` to see your requests.",
+
+	// /approve cancel and the cancellation dialog
+	"cancel.usage":                          "Usage: /approve cancel <APPROVAL_ID>",
+	"cancel.too_many_arguments":             "\n\nError: Too many arguments provided.",
+	"cancel.permission_denied":              "❌ Permission denied. You can only cancel your own approval requests.",
+	"cancel.dialog_failed":                  "Failed to open cancellation dialog. Please try again.",
+	"cancel.dialog.title":                   "Cancel Approval Request",
+	"cancel.dialog.intro":                   "You are about to cancel approval request **%s**\n\n**This action cannot be undone.**\n\nPlease select a reason for cancellation:",
+	"cancel.dialog.reason":                  "Reason for cancellation",
+	"cancel.dialog.reason_placeholder":      "Select a reason",
+	"cancel.dialog.reason_no_longer_needed": "No longer needed",
+	"cancel.dialog.reason_wrong_approver":   "Wrong approver",
+	"cancel.dialog.reason_sensitive_info":   "Sensitive information",
+	"cancel.dialog.reason_other":            "Other reason",
+	"cancel.dialog.reason_help":             "Help us understand why requests are canceled",
+	"cancel.dialog.details":                 "Additional details (optional)",
+	"cancel.dialog.details_placeholder":     "e.g., Project scope changed, requirements updated...",
+	"cancel.dialog.details_help":            "Add context or explanation (optional)",
+	"cancel.dialog.submit":                  "Cancel Request",
+	"cancel.reason_required":                "Reason is required",
+	"cancel.details_required":               "Please provide details when selecting 'Other reason'",
+	"cancel.not_found":                      "Approval request not found",
+	"cancel.requester_only":                 "Only the requester can cancel this approval",
+	"cancel.user_failed":                    "Failed to retrieve user information. Please try again.",
+	"cancel.already_approved":               "❌ Cannot cancel approval request %s. Status is already approved.",
+	"cancel.already_denied":                 "❌ Cannot cancel approval request %s. Status is already denied.",
+	"cancel.already_canceled":               "❌ Cannot cancel approval request %s. Status is already canceled.",
+	"cancel.already_decided":                "❌ Cannot cancel approval request %s. Request has already been decided.",
+	"cancel.failed":                         "❌ Failed to cancel approval request. Please try again.",
+
+	// /approve verify
+	"verify.usage":               "Usage: /approve verify <APPROVAL_CODE> [comment]\n\nExample: `/approve verify A-X7K9Q2` or `/approve verify A-X7K9Q2 Deployment completed successfully`",
+	"verify.comment_too_long":    "❌ Verification comment too long (%d characters). Maximum length is 500 characters.",
+	"verify.permission_denied":   "❌ Permission denied. Only the requester can verify their approval requests.",
+	"verify.not_approved_status": "❌ Cannot verify approval request %s. Only approved requests can be verified (current status: %s).",
+	"verify.not_approved":        "❌ Cannot verify approval request %s. Only approved requests can be verified.",
+	"verify.already_verified":    "❌ Approval request %s has already been verified.",
+	"verify.success":             "✅ Approval request **%s** marked as verified.",
+	"verify.success_note":        "\n\n**Verification note:** %s",
+	"verify.success_notified":    "\n\n%s has been notified.",
+	"verify.failed":              "❌ Failed to verify approval request. Please try again.",
+
+	// /approve resubmit
+	"resubmit.usage":               "Usage: /approve resubmit <APPROVAL_CODE>\n\nExample: `/approve resubmit A-X7K9Q2`",
+	"resubmit.success":             "✅ **Approval Request Resubmitted**\n\n**Approver:** @%s (%s)\n**Request ID:** `%s`\n**Resubmitted from:** `%s`\n\nYou will be notified when a decision is made.",
+	"resubmit.permission_denied":   "❌ Permission denied. You can only resubmit your own approval requests.",
+	"resubmit.still_pending":       "❌ Cannot resubmit approval request %s. It is still pending.",
+	"resubmit.already_resubmitted": "❌ Approval request %s has already been resubmitted. Use `/approve get %s` to see the new request.",
+	"resubmit.invalid_approver":    "❌ Cannot resubmit approval request %s. The original approver is no longer an active user. Use `/approve new` instead.",
+	"resubmit.not_allowed":         "❌ Cannot resubmit approval request %s: the %s. Use `/approve new` to choose another approver.",
+	"resubmit.group":               "❌ Cannot resubmit approval request %s. Group approval requests must be created again with `/approve new --group` or `--role`.",
+	"resubmit.failed":              "❌ Failed to resubmit approval request. Please try again.",
+
+	// /approve comment
+	"comment.usage":             "Usage: /approve comment <APPROVAL_CODE> <text>\n\nExample: `/approve comment A-X7K9Q2 Which environment is this for?`",
+	"comment.sent":              "💬 Comment sent on `%s`.",
+	"comment.not_delivered":     "⚠️ Comment saved on `%s`, but the notification could not be delivered. It is visible with `/approve get %s`.",
+	"comment.permission_denied": "❌ Permission denied. Only the requester and approvers can comment on an approval request.",
+	"comment.scheduled":         "❌ Approval request %s has not been sent yet. Comments can be added once the approver receives it.",
+	"comment.required":          "❌ Comment text is required.",
+	"comment.too_long":          "❌ Comment too long. Maximum length is %d characters.",
+	"comment.failed":            "❌ Failed to add comment. Please try again.",
+
+	// /approve digest
+	"digest.usage": "**Approval Digest:**\n\n" +
+		"* **/approve digest on** - Receive a scheduled summary of requests awaiting your decision\n" +
+		"* **/approve digest off** - Stop receiving the digest\n" +
+		"* **/approve digest status** - Show whether you receive the digest\n\n" +
+		"System administrators also get approval statistics and failed notifications in their digest. The cadence and hour are configured by an administrator in the plugin settings.",
+	"digest.update_failed": "❌ Failed to update your digest subscription. Please try again.",
+	"digest.subscribed":    "✅ You will receive the approval digest on the schedule set by your administrator.",
+	"digest.unsubscribed":  "✅ You will no longer receive the approval digest.",
+	"digest.status_failed": "❌ Failed to retrieve your digest subscription. Please try again.",
+	"digest.status_on":     "📬 You receive the approval digest. Use `/approve digest off` to stop.",
+	"digest.status_off":    "📭 You do not receive the approval digest. Use `/approve digest on` to subscribe.",
+
+	// Digest DM
+	"digest.title_daily":           "📬 **Daily Approval Digest**\n\n",
+	"digest.title_weekly":          "📬 **Weekly Approval Digest**\n\n",
+	"digest.none_pending":          "✅ No requests are awaiting your decision.\n",
+	"digest.pending_header":        "**⏳ Awaiting your decision (%d):**\n",
+	"digest.pending_row":           "- `%s` from @%s · waiting %s · %s\n",
+	"digest.pending_more":          "... and %d more. Use `/approve list` to see all.\n",
+	"digest.age_days":              "%dd %dh",
+	"digest.age_hours":             "%dh %dm",
+	"digest.age_minutes":           "%dm",
+	"digest.admin_header":          "**📊 Approval System (admin):**\n",
+	"digest.admin_counts":          "- Total: %d · Pending: %d · Approved: %d · Denied: %d · Canceled: %d\n",
+	"digest.admin_failed_approver": "- ❌ Failed approver notifications: %d\n",
+	"digest.admin_failed_outcome":  "- ❌ Failed outcome notifications: %d\n",
+	"digest.admin_affected":        "- Affected requests: %s\n",
+	"digest.admin_affected_more":   "and %d more",
+	"digest.admin_details":         "- Use `/approve status --failed-notifications` for details\n",
+	"digest.footer":                "\n_Turn off this digest with `/approve digest off`._",
+
+	// Channel status card
+	"card.header":             "📋 **Approval Request** `%s`\n\n",
+	"card.status":             "**Status:** %s\n",
+	"card.requester":          "**Requester:** @%s\n",
+	"card.approver":           "**Approver:** %s",
+	"card.approver_for_group": "@%s (for %s)",
+	"card.private":            "\n\n_Details are private to the requester and approver._",
+	"card.description":        "\n**Description:**\n%s",
+	"card.decided":            "\n\n**Decided:** %s",
+	"card.comment":            "\n**Comment:** %s",
+	"card.closed":             "\n\n**Closed:** %s",
+	"card.reason":             "\n**Reason:** %s",
+	"card.verified":           "\n**Verified:** ✔️ %s",
+	"card.approved_by":        "✅ Approved by @%s",
+	"card.denied_by":          "❌ Denied by @%s",
+	"card.timed_out":          "⏱️ Timed out (no response)",
+	"card.canceled_by":        "🚫 Canceled by @%s",
+	"card.pending":            "⏳ Pending",
+
+	// Approve/Deny button verification
+	"action.invalid_request": "Invalid request",
+	"action.expired":         "These buttons have expired. Use `/approve list` to find the request and `/approve get <code>` to review it.",
+
+	// /approve admin
+	"admin.usage": "**Admin Commands:**\n\n" +
+		"* **/approve admin template list** - List request templates\n" +
+		"* **/approve admin template set <JSON>** - Create or replace a request template\n" +
+		"* **/approve admin template delete <name>** - Delete a request template\n" +
+		"* **/approve admin policy list|set|delete** - Manage approver policies (run without arguments for details)\n" +
+		"* **/approve admin sla list|set|delete** - Manage SLA targets and breach escalation channels (run without arguments for details)\n" +
+		"* **/approve admin resend <code> [approver|requester]** - Resend the DM for a request's current state (the approver while pending, the requester once finalized)\n\n" +
+		"**Template example:**\n" +
+		"```\n" +
+		`/approve admin template set {"name":"prod-access","displayName":"Production Access","defaultApprovers":["bob"],"fields":[{"name":"system","label":"System","type":"select","required":true,"options":["database","kubernetes"]},{"name":"hours","label":"Duration (hours)","type":"number","required":true}]}` + "\n" +
+		"```\n" +
+		"Field types: text, select, number, date (YYYY-MM-DD). Maximum 3 fields per template.",
+
+	// /approve admin template
+	"template.set_usage":          "Usage: /approve admin template set <JSON>",
+	"template.delete_usage":       "Usage: /approve admin template delete <name>",
+	"template.list_failed":        "❌ Failed to retrieve request templates. Please try again.",
+	"template.none":               "No request templates defined. Use `/approve admin template set <JSON>` to create one.",
+	"template.list_header":        "**📋 Request Templates (%d)**\n\n| Name | Display Name | Default Approvers | Fields |\n|------|--------------|-------------------|--------|\n",
+	"template.list_footer":        "\n*Required fields are marked with \\**",
+	"template.invalid_json":       "❌ Invalid template JSON: %s",
+	"template.invalid":            "❌ Invalid template: %s",
+	"template.approver_not_found": "❌ Invalid template: default approver @%s not found.",
+	"template.save_failed":        "❌ Failed to save request template. Please try again.",
+	"template.saved":              "✅ Request template `%s` saved. Users can now run `/approve new %s`.",
+	"template.not_found":          "❌ Request template '%s' not found.",
+	"template.delete_failed":      "❌ Failed to delete request template. Please try again.",
+	"template.deleted":            "✅ Request template `%s` deleted. Existing requests keep their recorded field values.",
+
+	// /approve admin policy
+	"policy.usage": "**Approver Policy Commands:**\n\n" +
+		"* **/approve admin policy list** - List approver policies\n" +
+		"* **/approve admin policy set team <rules>** - Restrict approvers for the current team\n" +
+		"* **/approve admin policy set template <name> <rules>** - Restrict approvers for a request template\n" +
+		"* **/approve admin policy delete team** - Remove the current team's policy\n" +
+		"* **/approve admin policy delete template <name>** - Remove a template's policy\n\n" +
+		"**Rules** (at least one, comma-separated values):\n" +
+		"* `users=@alice,@bob` - specific users\n" +
+		"* `groups=sre,dba` - members of Mattermost groups\n" +
+		"* `roles=team_admin,channel_admin` - holders of a team or channel role\n\n" +
+		"An approver is allowed when they match any rule. When a team policy and a template policy both apply, both must allow the approver.",
+	"policy.delete_usage":           "Usage: /approve admin policy delete team|template <name>",
+	"policy.scope_required":         "policy scope is required: use 'team' or 'template <name>'",
+	"policy.team_channel_required":  "team policies must be managed from a team channel",
+	"policy.template_name_required": "template name is required",
+	"policy.invalid_scope":          "invalid policy scope '%s': use 'team' or 'template <name>'",
+	"policy.rule_required":          "❌ At least one rule is required.",
+	"policy.invalid_rule":           "❌ Invalid rule '%s'. Expected users=..., groups=... or roles=...",
+	"policy.user_not_found":         "❌ Invalid rule: user @%s not found.",
+	"policy.group_not_found":        "❌ Invalid rule: group '%s' not found.",
+	"policy.invalid":                "❌ Invalid policy: %s",
+	"policy.save_failed":            "❌ Failed to save approver policy. Please try again.",
+	"policy.saved":                  "✅ Approver policy for %s saved. Allowed approvers: %s",
+	"policy.not_found":              "❌ No approver policy defined for %s.",
+	"policy.delete_failed":          "❌ Failed to delete approver policy. Please try again.",
+	"policy.deleted":                "✅ Approver policy for %s deleted. Any active user can now be selected as approver.",
+	"policy.list_failed":            "❌ Failed to retrieve approver policies. Please try again.",
+	"policy.none":                   "No approver policies defined. Any active user can be selected as approver.",
+	"policy.list_header":            "**🔒 Approver Policies (%d)**\n\n| Scope | Allowed Approvers |\n|-------|-------------------|\n",
+	"policy.scope_template":         "template `%s`",
+	"policy.scope_team":             "team %s",
+	"policy.scope_team_id":          "team `%s`",
+	"policy.rule_group":             "group %s",
+	"policy.rule_role":              "role %s",
+
+	// /approve admin resend
+	"resend.usage":                     "Usage: /approve admin resend <APPROVAL_CODE> [approver|requester]\n\nExample: `/approve admin resend A-X7K9Q2 approver`",
+	"resend.not_found":                 "❌ Approval request '%s' not found.",
+	"resend.load_failed":               "❌ Failed to load the approval request. Please try again.",
+	"resend.scheduled":                 "❌ Approval request `%s` is scheduled for %s UTC and has not been sent yet.",
+	"resend.unknown_target":            "❌ Unknown recipient '%s'. Use `approver` or `requester`.",
+	"resend.failed":                    "❌ Could not resend the notification for `%s` to the %s.\n\n**Suggestion:** %s",
+	"resend.sent":                      "📨 Resent the %s DM for `%s` to the %s.",
+	"resend.target.approver":           "approver",
+	"resend.target.requester":          "requester",
+	"resend.notification.request":      "request",
+	"resend.notification.decision":     "decision",
+	"resend.notification.cancellation": "cancellation",
+	"resend.notification.confirmation": "confirmation",
+	"resend.notification.outcome":      "outcome",
+	"resend.notification.timeout":      "timeout",
+}
@@ -0,0 +1,60 @@
+// Package i18n translates the bot's user-facing messages and dialogs into the Mattermost
+// user's locale. Each shipped locale is a catalog of message keys to fmt format strings;
+// keys missing from a catalog fall back to English.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultLocale is used for unknown or unset locales and for keys missing from a catalog
+const DefaultLocale = "en"
+
+// catalogs maps each shipped locale to its messages
+var catalogs = map[string]map[string]string{
+	"en": en,
+	"de": de,
+	"ja": ja,
+}
+
+// Locales returns the shipped locales, sorted
+func Locales() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// ResolveLocale maps a Mattermost user locale (e.g. "de", "de-DE", "ja") to a shipped locale,
+// falling back to DefaultLocale
+func ResolveLocale(locale string) string {
+	locale = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+	if _, ok := catalogs[locale]; ok {
+		return locale
+	}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		if _, ok := catalogs[base]; ok {
+			return base
+		}
+	}
+	return DefaultLocale
+}
+
+// T returns the message for key in the given locale, formatted with args.
+// Unknown keys return the key itself so a missing translation is visible rather than blank.
+func T(locale, key string, args ...any) string {
+	message, ok := catalogs[ResolveLocale(locale)][key]
+	if !ok {
+		if message, ok = en[key]; !ok {
+			return key
+		}
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
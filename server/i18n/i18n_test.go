@@ -0,0 +1,110 @@
+package i18n
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// formatVerb matches a fmt verb with an optional explicit argument index, e.g. %s, %d, %[2]s
+var formatVerb = regexp.MustCompile(`%(?:\[(\d+)\])?[-+# 0-9.]*([a-zA-Z%])`)
+
+// verbsByArgument maps each argument index (1-based) of a format string to its verb
+func verbsByArgument(format string) map[int]string {
+	verbs := map[int]string{}
+	next := 1
+	for _, match := range formatVerb.FindAllStringSubmatch(format, -1) {
+		if match[2] == "%" {
+			continue
+		}
+		if match[1] != "" {
+			next, _ = strconv.Atoi(match[1])
+		}
+		verbs[next] = match[2]
+		next++
+	}
+	return verbs
+}
+
+func TestCatalogsComplete(t *testing.T) {
+	for _, locale := range Locales() {
+		if locale == DefaultLocale {
+			continue
+		}
+		catalog := catalogs[locale]
+
+		t.Run(locale, func(t *testing.T) {
+			for key, message := range en {
+				translated, ok := catalog[key]
+				if !assert.True(t, ok, "missing key %q", key) {
+					continue
+				}
+				assert.Equal(t, verbsByArgument(message), verbsByArgument(translated), "format verbs differ for %q", key)
+			}
+			for key := range catalog {
+				_, ok := en[key]
+				assert.True(t, ok, "key %q is not in the English catalog", key)
+			}
+		})
+	}
+}
+
+func TestLocales(t *testing.T) {
+	assert.Equal(t, []string{"de", "en", "ja"}, Locales())
+}
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "en", want: "en"},
+		{locale: "de", want: "de"},
+		{locale: "ja", want: "ja"},
+		{locale: "de-DE", want: "de"},
+		{locale: "de_AT", want: "de"},
+		{locale: "JA", want: "ja"},
+		{locale: "pt-BR", want: "en"},
+		{locale: "zh-CN", want: "en"},
+		{locale: "", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveLocale(tt.locale))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	t.Run("formats in the requested locale", func(t *testing.T) {
+		assert.Equal(t, "Vorgeschlagen: @alice", T("de", "new.dialog.suggested", "alice"))
+		assert.Equal(t, "候補: @alice", T("ja", "new.dialog.suggested", "alice"))
+	})
+
+	t.Run("reorders arguments with explicit indexes", func(t *testing.T) {
+		assert.Equal(t, "## 自分の承認リクエスト (すべて: 3 件)\n\n", T("ja", "list.header", 3, "すべて"))
+	})
+
+	t.Run("unknown and empty locales use English", func(t *testing.T) {
+		assert.Equal(t, "Suggested: @alice", T("pt-BR", "new.dialog.suggested", "alice"))
+		assert.Equal(t, "Suggested: @alice", T("", "new.dialog.suggested", "alice"))
+	})
+
+	t.Run("messages without arguments are not formatted", func(t *testing.T) {
+		assert.Equal(t, "Approve", T("en", "dm.request.approve"))
+	})
+
+	t.Run("keys missing from a locale fall back to English", func(t *testing.T) {
+		en["test.only_english"] = "English only"
+		defer delete(en, "test.only_english")
+
+		assert.Equal(t, "English only", T("de", "test.only_english"))
+	})
+
+	t.Run("unknown keys return the key", func(t *testing.T) {
+		assert.Equal(t, "no.such.key", T("de", "no.such.key"))
+	})
+}
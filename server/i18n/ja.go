@@ -0,0 +1,594 @@
+package i18n
+
+// ja is the Japanese catalog
+var ja = map[string]string{
+	// Shared labels
+	"approval.role_label": "チャンネルロール `%s`",
+
+	// Approver request DM
+	"dm.request": "📋 **承認リクエスト**\n\n" +
+		"**依頼者:** @%s (%s)\n" +
+		"**依頼日時:** %s\n" +
+		"**内容:**\n%s\n\n" +
+		"**リクエストID:** `%s`",
+	"dm.request.resubmission": "\n**再申請元:** `%s`",
+	"dm.request.group": "\n**承認者グループ:** %s\n\n" +
+		"_%d 人のメンバーに送信されました。最初に判断を確定したメンバーがこのリクエストを担当します。_",
+	"dm.request.approve":        "承認",
+	"dm.request.deny":           "却下",
+	"dm.custom_fields.template": "\n\n**テンプレート:** %s",
+
+	// Requester confirmation DM (thread root)
+	"dm.confirmation": "📨 **承認リクエストを送信しました**\n\n" +
+		"**リクエストID:** `%s`\n" +
+		"**承認者:** %s\n" +
+		"**内容:**\n%s",
+	"dm.confirmation.footer": "\n\n_このリクエストの更新はこのスレッドに投稿されます。" +
+		"承認者へのメッセージは `/approve comment %s <text>` で送信できます。_",
+
+	// Relayed comment DM
+	"dm.comment": "💬 **@%s からのコメント** (`%s`)\n\n%s\n\n" +
+		"_`/approve comment %s <text>` で返信できます。_",
+
+	// Outcome DM
+	"dm.outcome": "%s\n\n" +
+		"**承認者:** @%s (%s)\n" +
+		"**判断日時:** %s\n" +
+		"**リクエストID:** `%s`\n\n" +
+		"**元のリクエスト:**\n> %s",
+	"dm.outcome.approved":        "✅ **承認リクエストが承認されました**",
+	"dm.outcome.approved_status": "**ステータス:** この作業を進めてかまいません。",
+	"dm.outcome.denied":          "❌ **承認リクエストが却下されました**",
+	"dm.outcome.denied_status":   "**ステータス:** このリクエストは却下されました。",
+	"dm.outcome.comment":         "\n\n**コメント:**\n%s",
+
+	// Approver post replaced on cancellation
+	"dm.canceled_post": "🚫 **承認リクエスト (取り消し済み)**\n\n" +
+		"**依頼者:** @%s\n" +
+		"**リクエストID:** `%s`\n" +
+		"**内容:**\n%s\n\n" +
+		"---\n" +
+		"_@%s が %s に取り消しました_",
+
+	// Approver cancellation DM
+	"dm.cancellation": "🚫 **承認リクエストが取り消されました**\n\n" +
+		"**参照:** `%s`\n" +
+		"**依頼者:** @%s\n" +
+		"**理由:** %s",
+	"dm.cancellation.no_reason": "指定なし",
+	"dm.cancellation.details":   "\n**詳細:** %s",
+	"dm.cancellation.footer": "\n**取り消し日時:** %s\n\n" +
+		"受信した承認リクエストは依頼者によって取り消されました。",
+
+	// Requester cancellation DM
+	"dm.requester_cancellation": "🚫 **承認リクエストが取り消されました**\n\n" +
+		"**リクエストID:** `%s`\n" +
+		"**元のリクエスト:** %s\n" +
+		"**承認者:** @%s\n" +
+		"**理由:** %s",
+	"dm.requester_cancellation.footer": "\n**取り消し日時:** %s\n\n" +
+		"---\n\n" +
+		"承認者がこの承認リクエストを取り消しました。必要に応じて新しいリクエストを作成してください。",
+
+	// Timeout DM
+	"dm.timeout": "⏱️ **承認リクエストがタイムアウトしました**\n\n" +
+		"**リクエストID:** `%s`\n\n" +
+		"**元のリクエスト:**\n> %s\n\n" +
+		"**承認者:** @%s (%s)\n\n" +
+		"**理由:** 30 分以内に応答がありませんでした\n\n" +
+		"**ステータス:** このリクエストは自動的に取り消されました。まだ必要な場合は新しいリクエストを作成してください。",
+
+	// Verification DM
+	"dm.verification": "✅ **承認リクエストが検証されました**\n\n" +
+		"**リクエストID:** `%s`\n\n" +
+		"**元のリクエスト:**\n> %s\n\n" +
+		"**依頼者:** @%s (%s)\n" +
+		"**検証日時:** %s",
+	"dm.verification.note": "\n\n**検証メモ:**\n> %s",
+
+	// SLA breach DM
+	"dm.sla_breach": "🚨 **承認 SLA を超過しました**\n\n" +
+		"**リクエストID:** `%s`\n" +
+		"**依頼者:** @%s (%s)\n" +
+		"**依頼日時:** %s\n" +
+		"**SLA:** %s 以内に判断\n\n" +
+		"**説明:**\n> %s\n\n" +
+		"このリクエストは SLA を超過しています。今すぐ承認または却下してください。",
+
+	// Slash commands
+	"command.invalid_format":          "コマンドの形式が正しくありません。",
+	"command.error":                   "コマンドの処理中にエラーが発生しました。もう一度お試しください。",
+	"command.permission_check_failed": "権限を確認できませんでした。もう一度お試しください。",
+	"command.help": "### Mattermost 承認ワークフロー\n\n" +
+		"**利用可能なコマンド:**\n\n" +
+		"* **/approve new [template]** - 新しい承認リクエストを作成します (リクエストテンプレートも使用可能)\n" +
+		"  * **--group <name>** - ユーザーグループの全メンバーに送信し、最初に確定したメンバーが判断します\n" +
+		"  * **--role <role>** - 指定ロール (例: channel_admin) のチャンネルメンバーに送信し、最初に確定したメンバーが判断します\n" +
+		"* **/approve list [inbox|outbox] [filter] [--page N] [--limit N]** - 自分の承認リクエストと判断を 1 ページ 20 件で表示します\n" +
+		"  * **inbox** - 自分宛てのリクエストのみを古い順に、承認・却下ボタン付きで表示\n" +
+		"  * **outbox** - 自分が送信したリクエストのみ\n" +
+		"  * フィルターなし: 保留中のリクエストを表示 (既定)\n" +
+		"  * **pending** - 保留中の承認リクエスト\n" +
+		"  * **approved** - 承認されたリクエスト\n" +
+		"  * **denied** - 却下されたリクエスト\n" +
+		"  * **canceled** - 取り消されたリクエスト\n" +
+		"  * **all** - すべてのリクエスト (保留中、承認、却下、取り消し)\n" +
+		"* **/approve get [ID]** - ID を指定して承認を表示します\n" +
+		"* **/approve bulk** - 複数の保留中のリクエストをまとめて承認または却下します\n" +
+		"* **/approve yes|no <APPROVAL_ID> [comment]** - DM のボタンを使わずに保留中のリクエストを承認または却下します\n" +
+		"* **/approve stats [@user|~team] [--since 30d]** - 決定までの時間と結果の統計を表示します (自分のみ。管理者は任意のユーザーやチームも可)\n" +
+		"* **/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:STATUS]** - 説明の単語とフィルターで自分のリクエストを検索します\n" +
+		"* **/approve cancel <APPROVAL_ID>** - 保留中の承認リクエストを取り消します\n" +
+		"* **/approve verify <APPROVAL_CODE> [comment]** - 承認済みリクエストを検証済み/完了にします\n" +
+		"* **/approve resubmit <APPROVAL_CODE>** - 却下・取り消し・承認済みのリクエストから新しいリクエストを作成します\n" +
+		"* **/approve comment <APPROVAL_CODE> <text>** - 承認のスレッドで相手にコメントを送信します\n" +
+		"* **/approve digest [on|off|status]** - 判断待ちリクエストの定期ダイジェストを購読します\n" +
+		"* **/approve status** - 承認システムの統計を表示します (管理者のみ)\n" +
+		"* **/approve help** - このヘルプを表示します\n\n" +
+		"**管理者コマンド:**\n\n" +
+		"* **/approve status** - 全体の承認統計と通知の状態を表示します\n" +
+		"* **/approve status --failed-notifications** - 通知に失敗した承認を一覧表示します\n" +
+		"* **/approve status --sod** - ブロックされた職務分掌違反を一覧表示します\n" +
+		"* **/approve admin template list|set|delete** - カスタムフィールド付きのリクエストテンプレートを管理します\n" +
+		"* **/approve admin policy list|set|delete** - チーム・テンプレートごとの承認者ポリシーを管理します\n" +
+		"* **/approve admin sla list|set|delete** - SLA 目標と超過時のエスカレーションチャンネルを管理します\n" +
+		"* **/approve admin resend <code> [approver|requester]** - リクエストの現在の状態の通知 DM を再送信します\n\n" +
+		"**例:**\n" +
+		"`/approve new` - 承認リクエストの作成画面を開きます\n" +
+		"`/approve list` - 保留中の承認リクエストを表示します\n" +
+		"`/approve list approved` - 承認されたリクエストを表示します\n" +
+		"`/approve list all` - すべてのリクエストを表示します\n" +
+		"`/approve list all --page 2` - 次の 20 件のリクエストを表示します\n" +
+		"`/approve list inbox` - 自分の判断を待っている保留中のリクエストを表示します\n" +
+		"`/approve search firewall from:@alice` - 「firewall」を含む @alice のリクエストを検索します\n\n" +
+		"詳しくはプラグインのドキュメントを参照してください。",
+	"command.unknown": "不明なコマンド: **%s**\n\n" +
+		"有効なコマンド: `new`, `list`, `get`, `cancel`, `comment`, `search`, `verify`, `resubmit`, `bulk`, `yes`, `no`, `stats`, `status`, `digest`, `admin`, `help`\n\n" +
+		"詳しくは `/approve help` と入力してください。",
+	"admin.permission_denied":  "❌ 権限がありません。管理者コマンドはシステム管理者のみ使用できます。",
+	"status.permission_denied": "❌ 権限がありません。承認統計はシステム管理者のみ表示できます。",
+	"status.failed":            "❌ 承認統計を取得できませんでした。もう一度お試しください。",
+
+	// Timestamps and relative ages
+	"time.with_age":    "%s (%s)",
+	"time.just_now":    "たった今",
+	"time.minutes_ago": "%d 分前",
+	"time.hours_ago":   "%d 時間前",
+	"time.day_ago":     "1 日前",
+	"time.days_ago":    "%d 日前",
+
+	// Status labels and list filters
+	"status.pending":         "⏳ 保留中",
+	"status.approved":        "✅ 承認",
+	"status.denied":          "❌ 却下",
+	"status.canceled":        "🚫 取り消し",
+	"status.canceled_reason": "🚫 取り消し (%s)",
+	"status.scheduled":       "🕒 送信予定",
+	"filter.pending":         "保留中",
+	"filter.approved":        "承認",
+	"filter.denied":          "却下",
+	"filter.canceled":        "取り消し",
+	"filter.scheduled":       "送信予定",
+	"filter.all":             "すべて",
+
+	// /approve new and the create-request dialog
+	"new.missing_trigger":     "トリガー ID がありません。もう一度コマンドを実行してください。",
+	"new.config_error":        "プラグインの設定エラーです。システム管理者に連絡してください。",
+	"new.invalid_args":        "❌ %s\n\n使い方: `/approve new [template] [--group <name> | --role <role>]`",
+	"new.template_failed":     "リクエストテンプレートを読み込めませんでした。もう一度お試しください。",
+	"new.template_not_found":  "❌ リクエストテンプレート '%s' が見つかりません。",
+	"new.no_templates":        "\n\nテンプレートが定義されていません。`/approve new` で自由形式のリクエストを作成してください。",
+	"new.available_templates": "\n\n利用可能なテンプレート: %s",
+	"new.group_not_found":     "❌ グループ @%s が見つかりません。",
+	"new.dialog_failed":       "承認リクエストのフォームを開けませんでした。もう一度お試しください。",
+
+	"new.dialog.title":                   "承認リクエストの作成",
+	"new.dialog.submit":                  "リクエストを送信",
+	"new.dialog.description":             "承認が必要な内容 *",
+	"new.dialog.description_placeholder": "承認が必要な作業を説明してください",
+	"new.dialog.approver":                "承認者を選択 *",
+	"new.dialog.group_intro":             "**承認者グループ:** @%s - 全メンバーに通知され、最初に確定したメンバーが判断します。",
+	"new.dialog.role_intro":              "**承認者:** ロール `%s` を持つチャンネルメンバー - 全員に通知され、最初に確定したメンバーが判断します。",
+	"new.dialog.template_intro":          "**テンプレート:** %s",
+	"new.dialog.suggested":               "候補: @%s",
+	"new.dialog.visibility":              "チャンネルでの表示",
+	"new.dialog.visibility_help":         "このチャンネルのステータスカードはリクエストの判断に合わせて更新されます。非公開カードでは内容が表示されません。",
+	"new.dialog.visibility_dm":           "承認者への DM のみ",
+	"new.dialog.visibility_channel":      "このチャンネルにステータスカードを投稿",
+	"new.dialog.visibility_private":      "詳細なしでステータスカードを投稿 (非公開)",
+	"new.dialog.send_at":                 "送信日時",
+	"new.dialog.send_at_help":            "空欄の場合はすぐに送信されます。後で送信するには、あなたのタイムゾーン (%s) で 30 日以内の日時を入力してください。",
+
+	// /approve list
+	"list.invalid_filter":   "無効なフィルター '%s' です。有効なフィルター: pending, scheduled, approved, denied, canceled, all (inbox または outbox と併用可)",
+	"list.failed":           "❌ 承認レコードを取得できませんでした。もう一度お試しください。",
+	"list.empty":            "%s の承認リクエストはありません。すべてのリクエストは `/approve list all` で表示できます。",
+	"list.header":           "## 自分の承認リクエスト (%[2]s: %[1]d 件)\n\n",
+	"list.pending_section":  "**保留中の承認:**\n\n",
+	"list.decided_section":  "**判断済みの承認:**\n\n",
+	"list.canceled_section": "**取り消されたリクエスト:**\n\n",
+	"list.table_header": "| コード | ステータス | 依頼者 | 承認者 | 作成日時 |\n" +
+		"|------|--------|-----------|----------|----------|\n",
+	"list.header_page":        "## 自分の承認リクエスト (%s: %d ページ目)\n\n",
+	"list.page_footer":        "*%d ページ目* 古いリクエストは **次へ ▶** または `%s` で表示できます。個別のリクエストは `/approve get <ID>` で表示できます。",
+	"list.page_empty":         "これ以上 %s の承認リクエストはありません。",
+	"list.invalid_page":       "無効なページ '%s' です。1 以上のページ番号を指定してください (例: `/approve list all --page 2`)。",
+	"list.invalid_limit":      "無効な件数 '%s' です。1 から %d までの数を指定してください。",
+	"list.previous":           "◀ 前へ",
+	"list.next":               "次へ ▶",
+	"list.bulk":               "まとめて判断...",
+	"list.inbox_header":       "## 自分宛てのリクエスト (%[2]s: %[1]d 件)\n\n",
+	"list.inbox_header_page":  "## 自分宛てのリクエスト (%s: %d ページ目)\n\n",
+	"list.inbox_page_footer":  "*%d ページ目* 新しいリクエストは **次へ ▶** または `%s` で表示できます。個別のリクエストは `/approve get <ID>` で表示できます。",
+	"list.inbox_empty":        "自分宛ての %s のリクエストはありません。送信したリクエストは `/approve list outbox` で表示できます。",
+	"list.inbox_row":          "**%s** (@%s): %s",
+	"list.outbox_header":      "## 送信したリクエスト (%[2]s: %[1]d 件)\n\n",
+	"list.outbox_header_page": "## 送信したリクエスト (%s: %d ページ目)\n\n",
+	"list.outbox_empty":       "送信した %s の承認リクエストはありません。すべて表示するには `/approve list outbox all` を使用してください。",
+
+	// /approve bulk
+	"bulk.usage":             "使い方: `/approve bulk`\n\n自分の判断を待っている複数のリクエストをまとめて承認または却下するダイアログを開きます。",
+	"bulk.none":              "判断を待っている保留中のリクエストはありません。",
+	"bulk.dialog_failed":     "一括判断のダイアログを開けませんでした。もう一度お試しください。",
+	"bulk.dialog.title":      "保留中のリクエストを判断",
+	"bulk.dialog.intro":      "判断するリクエストにチェックを入れてください。判断とコメントはチェックした各リクエストに適用され、各依頼者には通常の結果メッセージが送信されます。\n\n**判断は記録され、編集できません。**",
+	"bulk.dialog.more":       "\n\n保留中のリクエストのうち古い %d 件を表示しています。残りは `/approve bulk` をもう一度実行してください。",
+	"bulk.dialog.decision":   "判断",
+	"bulk.dialog.record":     "@%s: %s",
+	"bulk.decision_required": "承認または却下を選択してください。",
+	"bulk.none_selected":     "リクエストを 1 件以上チェックしてください。",
+	"bulk.result_header":     "### 一括判断: %[2]d 件中 %[1]d 件を記録しました\n\n",
+	"bulk.result_ok":         "- ✅ **%s** %s\n",
+	"bulk.result_failed":     "- ❌ **%s**: %s\n",
+
+	// /approve yes|no
+	"decide.usage":            "使い方: `/approve yes <APPROVAL_CODE> [comment]` または `/approve no <APPROVAL_CODE> [comment]`\n\n例: `/approve yes A-X7K9Q2 問題ありません`",
+	"decide.approved":         "✅ **%s** を承認しました。依頼者に通知されます。",
+	"decide.denied":           "❌ **%s** を却下しました。依頼者に通知されます。",
+	"decide.comment_too_long": "コメントが長すぎます (%d 文字、最大 %d 文字)。",
+
+	// /approve search
+	"search.usage": "使い方: `/approve search <words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:scheduled|pending|approved|denied|canceled]`\n\n" +
+		"すべての単語とフィルターに一致するリクエストを表示します。日付はあなたのタイムゾーンで解釈され、`after` と `before` は指定した日を含みません。",
+	"search.invalid_args": "❌ 無効な検索です: %s",
+	"search.failed":       "❌ 承認レコードを検索できませんでした。もう一度お試しください。",
+	"search.empty":        "`%s` に一致する承認リクエストはありません。",
+	"search.header":       "## `%s` の検索結果 (%d 件)\n\n",
+	"search.footer":       "*%[2]d 件中 %[1]d 件を表示しています。* 単語やフィルターを追加して絞り込むか、`/approve get <ID>` でリクエストを表示してください。",
+
+	// /approve stats
+	"stats.usage":             "使い方: `/approve stats [@user|~team] [--since 30d]`\n\n期間内に作成されたリクエストの統計を表示します (既定は 30d。h、d、w を使用できます)。他のユーザーやチームを指定できるのはシステム管理者のみです。",
+	"stats.invalid_args":      "❌ 無効な統計クエリです: %s",
+	"stats.permission_denied": "❌ 権限がありません。他のユーザーやチームの統計を表示できるのはシステム管理者のみです。",
+	"stats.user_not_found":    "❌ ユーザー @%s が見つかりません。",
+	"stats.team_not_found":    "❌ チーム ~%s が見つかりません。",
+	"stats.failed":            "❌ 承認統計を取得できませんでした。もう一度お試しください。",
+	"stats.header":            "## 📈 %s の承認統計\n\n",
+	"stats.period":            "*過去 %s に作成されたリクエスト (%s 以降)*\n",
+	"stats.section.received":  "\n### 受け取ったリクエスト (%d 件)\n\n",
+	"stats.section.sent":      "\n### 送信したリクエスト (%d 件)\n\n",
+	"stats.section.team":      "\n### チームのリクエスト (%d 件)\n\n",
+	"stats.empty":             "この期間のリクエストはありません。\n",
+	"stats.counts":            "- 保留中: %d · 承認済み: %d · 却下: %d · キャンセル: %d\n",
+	"stats.decision_time":     "- 決定までの時間: 中央値 %s、p90 %s\n",
+	"stats.rates":             "- 承認率: %s · 却下率: %s · タイムアウト率: %s · 検証率: %s\n",
+	"stats.approvers_header":  "\n**決定数の多い承認者:**\n\n| 承認者 | 決定数 | 決定までの時間 (中央値) |\n|--------|--------|-------------------------|\n",
+	"stats.duration.minutes":  "%d 分",
+	"stats.duration.hours":    "%d 時間 %d 分",
+	"stats.duration.days":     "%d 日 %d 時間",
+
+	// /approve get
+	"get.usage":             "使い方: /approve get <APPROVAL_ID>\n\n例: /approve get A-X7K9Q2",
+	"get.not_found":         "❌ 承認レコード '%s' が見つかりません。\n\n承認レコードは `/approve list` で確認できます。",
+	"get.failed":            "❌ 承認レコードを取得できませんでした。もう一度お試しください。",
+	"get.permission_denied": "❌ 権限がありません。自分が依頼者または承認者である承認レコードのみ表示できます。",
+
+	"get.header":                 "**📋 承認レコード: %s**\n\n",
+	"get.status":                 "**ステータス:** %s\n\n",
+	"get.requester":              "**依頼者:** @%s (%s)\n",
+	"get.approver":               "**承認者:** @%s (%s)\n",
+	"get.approver_group":         "**承認者グループ:** %s\n\n",
+	"get.approver_group_pending": "**承認者グループ:** %s (%d 人、最初に確定したメンバーが判断)\n\n",
+	"get.description":            "**内容:**\n%s\n\n",
+	"get.template":               "**テンプレート:** %s\n",
+	"get.cancellation":           "**取り消し:**\n",
+	"get.no_reason":              "理由の記録なし (v0.2.0 より前に取り消し)",
+	"get.reason":                 "**理由:** %s\n",
+	"get.details":                "**詳細:** %s\n",
+	"get.canceled_by":            "**取り消した人:** @%s\n",
+	"get.canceled":               "**取り消し日時:** %s\n",
+	"get.unknown":                "不明",
+	"get.requested":              "**依頼日時:** %s\n",
+	"get.scheduled":              "**送信予定日時:** %s\n",
+	"get.sent":                   "**送信日時:** %s\n",
+	"get.decided":                "**判断日時:** %s\n",
+	"get.not_decided":            "未判断",
+	"get.sla":                    "**SLA:** %s (%s)\n",
+	"get.sla.on_track":           "期限内",
+	"get.sla.met":                "達成",
+	"get.sla.breached":           "🚨 超過",
+	"get.decision_comment":       "\n**判断コメント:**\n%s\n",
+	"get.verification":           "**✅ 検証:**\n",
+	"get.verified":               "**検証日時:** %s\n",
+	"get.verified_by":            "**検証した人:** @%s\n",
+	"get.verification_note":      "**メモ:** %s\n",
+	"get.comments":               "**💬 コメント (%d):**\n",
+	"get.context":                "\n**コンテキスト:**\n",
+	"get.context.request_id":     "- リクエストID: %s\n",
+	"get.context.full_id":        "- 完全な ID: %s\n",
+	"get.context.channel_id":     "- チャンネル ID: %s\n",
+	"get.context.team_id":        "- チーム ID: %s\n",
+	"get.lineage":                "\n**履歴:**\n",
+	"get.lineage.from":           "- 再申請元: `%s`\n",
+	"get.lineage.as":             "- 再申請先: `%s`\n",
+	"get.immutable":              "\n**このレコードは変更できません。**\n",
+
+	// Create-request dialog submission
+	"new.approver_required":      "承認者は必須です。ユーザーを選択してください。",
+	"new.description_required":   "内容は必須です。承認が必要な内容を記入してください。",
+	"new.invalid_submission":     "送信内容の形式が正しくありません。もう一度お試しください。",
+	"new.template_unavailable":   "リクエストテンプレートは利用できなくなりました。`/approve new` からやり直してください。",
+	"new.group_rejected":         "グループ承認リクエストを作成できません: %s。",
+	"new.group_failed":           "グループの承認者を特定できませんでした。もう一度お試しください。",
+	"new.group_display_name":     "%s グループ",
+	"new.role_display_name":      "チャンネルロール",
+	"new.policy_failed":          "承認者ポリシーを確認できませんでした。もう一度お試しください。",
+	"new.description_too_long":   "内容が %d 文字です (最大 %d 文字)。リクエストを短くしてください。",
+	"new.invalid_visibility":     "表示設定 '%s' が無効です。承認者への DM のみ、またはチャンネルのステータスカードを選択してください。",
+	"new.self_approval":          "自分のリクエストは承認できません。別の承認者を選択してください。",
+	"new.approver_inactive":      "選択した承認者は有効なユーザーではありません。有効なユーザーを選択してください。",
+	"new.approver_lookup_failed": "選択した承認者を確認できませんでした。もう一度お試しください。",
+	"new.approver_not_allowed":   "選択した承認者は %s のポリシーで許可されていません。許可されている承認者: %s",
+	"new.policy_scope_team":      "このチーム",
+	"new.policy_scope_template":  "'%s' のリクエスト",
+	"new.field_required":         "%s は必須です。",
+	"new.field_too_long":         "%s が %d 文字です (最大 %d 文字)。",
+	"new.field_not_number":       "%s は数値で入力してください。",
+	"new.field_not_date":         "%s は YYYY-MM-DD 形式の日付で入力してください。",
+	"new.field_not_option":       "%s は次のいずれかを入力してください: %s。",
+	"new.requester_failed":       "依頼者の情報を取得できませんでした",
+	"new.send_at_invalid":        "送信日時 '%s' が無効です。YYYY-MM-DD HH:MM の形式で入力してください。",
+	"new.send_at_past":           "送信日時 %s は過去の日時です。今すぐ送信する場合は空欄にしてください。",
+	"new.send_at_too_far":        "送信日時 %s は先すぎます。リクエストは最大 %d 日先まで予約できます。",
+	"new.code_failed":            "一意の承認コードを生成できませんでした。もう一度お試しください。",
+	"new.save_failed":            "承認リクエストを作成できませんでした。システムが一時的に利用できません。もう一度お試しください。",
+	"new.submitted.approver":     "**承認者:** @%s (%s)",
+	"new.submitted.group":        "**承認者:** %s (%d 人、最初に確定したメンバーが判断)",
+	"new.submitted":              "✅ **承認リクエストを送信しました**\n\n%s\n**リクエストID:** `%s`\n\n判断が行われると通知されます。",
+	"new.submitted.channel_card": "\n\nこのチャンネルにステータスカードを投稿しました。リクエストの進行に合わせて更新されます。",
+	"new.scheduled":              "🕒 **承認リクエストを予約しました**\n\n%s\n**リクエストID:** `%s`\n**送信日時:** %s\n\nその時刻に承認者へ通知されます。それまでに取り消すには `/approve cancel %s` を使用してください。",
+
+	// Approve/deny confirmation dialog
+	"dialog.invalid_request":      "リクエストの形式が正しくありません",
+	"confirm.dialog_failed":       "確認画面を開けませんでした",
+	"confirm.approve_title":       "承認の確認",
+	"confirm.deny_title":          "却下の確認",
+	"confirm.approve_text":        "次の内容を承認します:",
+	"confirm.deny_text":           "次の内容を却下します:",
+	"confirm.intro":               "%s\n\n%s\n\n**依頼者:** @%s (%s)\n**リクエストID:** `%s`\n\n**この判断は記録され、編集できません。**",
+	"confirm.comment":             "コメントを追加 (任意)",
+	"confirm.comment_placeholder": "任意: 判断の背景や理由を記入してください",
+	"confirm.submit":              "確定",
+	"confirm.already_decided":     "判断は既に記録されています: %s",
+	"confirm.already_decided_by":  "判断は既に記録されています: %s (@%s)",
+	"confirm.invalid_action":      "無効な操作です",
+	"confirm.not_found":           "承認が見つかりません",
+	"confirm.permission_denied":   "権限がありません",
+	"confirm.self_approval":       "自分の承認リクエストは判断できません (職務分掌)。",
+	"confirm.reciprocal":          "承認できません: %s。",
+	"confirm.claimed":             "別の承認者がこのリクエストを判断中です。",
+	"confirm.failed":              "判断を記録できませんでした。もう一度お試しください。",
+
+	// Approver post updates after a decision or resend
+	"decision.approved":      "✅ **判断を記録しました: 承認**",
+	"decision.denied":        "❌ **判断を記録しました: 却下**",
+	"decision.approved_by":   "✅ **判断を記録しました: @%s が承認**",
+	"decision.denied_by":     "❌ **判断を記録しました: @%s が却下**",
+	"decision.fallback":      "\n\n承認リクエスト `%s` は判断済みです。\n\n依頼者にあなたの判断が通知されます。",
+	"resend.header":          "🔁 **通知を再送信しました** - 新しいメッセージから判断してください。",
+	"resend.canceled_header": "🚫 **リクエストは取り消されました**",
+
+	// Errors shared by the code-based commands
+	"error.invalid_code": "❌ 承認コードの形式が正しくありません: '%s'。'A-X7K9Q2' のような形式で指定してください。",
+	"error.not_found":    "❌ 承認リクエスト '%s' が見つかりません。自分のリクエストは `/approve list` で確認できます。",
+
+	// /approve cancel and the cancellation dialog
+	"cancel.usage":                          "使い方: /approve cancel <APPROVAL_ID>",
+	"cancel.too_many_arguments":             "\n\nエラー: 引数が多すぎます。",
+	"cancel.permission_denied":              "❌ 権限がありません。取り消せるのは自分の承認リクエストのみです。",
+	"cancel.dialog_failed":                  "取り消し画面を開けませんでした。もう一度お試しください。",
+	"cancel.dialog.title":                   "承認リクエストの取り消し",
+	"cancel.dialog.intro":                   "承認リクエスト **%s** を取り消そうとしています\n\n**この操作は元に戻せません。**\n\n取り消しの理由を選択してください:",
+	"cancel.dialog.reason":                  "取り消しの理由",
+	"cancel.dialog.reason_placeholder":      "理由を選択",
+	"cancel.dialog.reason_no_longer_needed": "不要になった",
+	"cancel.dialog.reason_wrong_approver":   "承認者の誤り",
+	"cancel.dialog.reason_sensitive_info":   "機密情報を含む",
+	"cancel.dialog.reason_other":            "その他の理由",
+	"cancel.dialog.reason_help":             "リクエストが取り消される理由の把握にご協力ください",
+	"cancel.dialog.details":                 "詳細 (任意)",
+	"cancel.dialog.details_placeholder":     "例: プロジェクトの範囲が変わった、要件が更新された...",
+	"cancel.dialog.details_help":            "背景や説明を追加してください (任意)",
+	"cancel.dialog.submit":                  "リクエストを取り消す",
+	"cancel.reason_required":                "理由は必須です",
+	"cancel.details_required":               "'その他の理由' を選択した場合は詳細を入力してください",
+	"cancel.not_found":                      "承認リクエストが見つかりません",
+	"cancel.requester_only":                 "この承認を取り消せるのは依頼者のみです",
+	"cancel.user_failed":                    "ユーザー情報を取得できませんでした。もう一度お試しください。",
+	"cancel.already_approved":               "❌ 承認リクエスト %s は取り消せません。既に承認されています。",
+	"cancel.already_denied":                 "❌ 承認リクエスト %s は取り消せません。既に却下されています。",
+	"cancel.already_canceled":               "❌ 承認リクエスト %s は取り消せません。既に取り消されています。",
+	"cancel.already_decided":                "❌ 承認リクエスト %s は取り消せません。既に判断済みです。",
+	"cancel.failed":                         "❌ 承認リクエストを取り消せませんでした。もう一度お試しください。",
+
+	// /approve verify
+	"verify.usage":               "使い方: /approve verify <APPROVAL_CODE> [comment]\n\n例: `/approve verify A-X7K9Q2` または `/approve verify A-X7K9Q2 デプロイが正常に完了しました`",
+	"verify.comment_too_long":    "❌ 検証コメントが長すぎます (%d 文字)。最大 500 文字です。",
+	"verify.permission_denied":   "❌ 権限がありません。承認リクエストを検証できるのは依頼者のみです。",
+	"verify.not_approved_status": "❌ 承認リクエスト %s は検証できません。検証できるのは承認済みのリクエストのみです (現在のステータス: %s)。",
+	"verify.not_approved":        "❌ 承認リクエスト %s は検証できません。検証できるのは承認済みのリクエストのみです。",
+	"verify.already_verified":    "❌ 承認リクエスト %s は既に検証済みです。",
+	"verify.success":             "✅ 承認リクエスト **%s** を検証済みにしました。",
+	"verify.success_note":        "\n\n**検証メモ:** %s",
+	"verify.success_notified":    "\n\n%s に通知しました。",
+	"verify.failed":              "❌ 承認リクエストを検証できませんでした。もう一度お試しください。",
+
+	// /approve resubmit
+	"resubmit.usage":               "使い方: /approve resubmit <APPROVAL_CODE>\n\n例: `/approve resubmit A-X7K9Q2`",
+	"resubmit.success":             "✅ **承認リクエストを再申請しました**\n\n**承認者:** @%s (%s)\n**リクエストID:** `%s`\n**再申請元:** `%s`\n\n判断が行われると通知されます。",
+	"resubmit.permission_denied":   "❌ 権限がありません。再申請できるのは自分の承認リクエストのみです。",
+	"resubmit.still_pending":       "❌ 承認リクエスト %s は再申請できません。まだ保留中です。",
+	"resubmit.already_resubmitted": "❌ 承認リクエスト %s は既に再申請されています。新しいリクエストは `/approve get %s` で確認できます。",
+	"resubmit.invalid_approver":    "❌ 承認リクエスト %s は再申請できません。元の承認者は有効なユーザーではなくなりました。代わりに `/approve new` を使用してください。",
+	"resubmit.not_allowed":         "❌ 承認リクエスト %s は再申請できません (%s)。別の承認者を選ぶには `/approve new` を使用してください。",
+	"resubmit.group":               "❌ 承認リクエスト %s は再申請できません。グループ承認リクエストは `/approve new --group` または `--role` で作成し直してください。",
+	"resubmit.failed":              "❌ 承認リクエストを再申請できませんでした。もう一度お試しください。",
+
+	// /approve comment
+	"comment.usage":             "使い方: /approve comment <APPROVAL_CODE> <text>\n\n例: `/approve comment A-X7K9Q2 どの環境向けですか?`",
+	"comment.sent":              "💬 `%s` にコメントを送信しました。",
+	"comment.not_delivered":     "⚠️ `%s` にコメントを保存しましたが、通知を配信できませんでした。`/approve get %s` で確認できます。",
+	"comment.permission_denied": "❌ 権限がありません。承認リクエストにコメントできるのは依頼者と承認者のみです。",
+	"comment.scheduled":         "❌ 承認リクエスト %s はまだ送信されていません。承認者に届いた後にコメントできます。",
+	"comment.required":          "❌ コメントの本文は必須です。",
+	"comment.too_long":          "❌ コメントが長すぎます。最大 %d 文字です。",
+	"comment.failed":            "❌ コメントを追加できませんでした。もう一度お試しください。",
+
+	// /approve digest
+	"digest.usage": "**承認ダイジェスト:**\n\n" +
+		"* **/approve digest on** - 自分の判断を待っているリクエストの定期サマリーを受け取ります\n" +
+		"* **/approve digest off** - ダイジェストの受信を停止します\n" +
+		"* **/approve digest status** - ダイジェストを受信しているかを表示します\n\n" +
+		"システム管理者のダイジェストには承認統計と失敗した通知も含まれます。送信間隔と時刻は管理者がプラグイン設定で指定します。",
+	"digest.update_failed": "❌ ダイジェストの購読を更新できませんでした。もう一度お試しください。",
+	"digest.subscribed":    "✅ 管理者が設定したスケジュールで承認ダイジェストを受け取ります。",
+	"digest.unsubscribed":  "✅ 承認ダイジェストの受信を停止しました。",
+	"digest.status_failed": "❌ ダイジェストの購読状況を取得できませんでした。もう一度お試しください。",
+	"digest.status_on":     "📬 承認ダイジェストを受信しています。停止するには `/approve digest off` を使用してください。",
+	"digest.status_off":    "📭 承認ダイジェストを受信していません。購読するには `/approve digest on` を使用してください。",
+
+	// Digest DM
+	"digest.title_daily":           "📬 **承認ダイジェスト (日次)**\n\n",
+	"digest.title_weekly":          "📬 **承認ダイジェスト (週次)**\n\n",
+	"digest.none_pending":          "✅ あなたの判断を待っているリクエストはありません。\n",
+	"digest.pending_header":        "**⏳ あなたの判断待ち (%d 件):**\n",
+	"digest.pending_row":           "- `%s` (@%s) · 待機 %s · %s\n",
+	"digest.pending_more":          "... ほか %d 件。すべて表示するには `/approve list` を使用してください。\n",
+	"digest.age_days":              "%d日%d時間",
+	"digest.age_hours":             "%d時間%d分",
+	"digest.age_minutes":           "%d分",
+	"digest.admin_header":          "**📊 承認システム (管理者):**\n",
+	"digest.admin_counts":          "- 合計: %d · 保留中: %d · 承認: %d · 却下: %d · 取り消し: %d\n",
+	"digest.admin_failed_approver": "- ❌ 承認者への通知の失敗: %d\n",
+	"digest.admin_failed_outcome":  "- ❌ 結果通知の失敗: %d\n",
+	"digest.admin_affected":        "- 対象のリクエスト: %s\n",
+	"digest.admin_affected_more":   "ほか %d 件",
+	"digest.admin_details":         "- 詳細は `/approve status --failed-notifications` で確認できます\n",
+	"digest.footer":                "\n_このダイジェストは `/approve digest off` で停止できます。_",
+
+	// Channel status card
+	"card.header":             "📋 **承認リクエスト** `%s`\n\n",
+	"card.status":             "**ステータス:** %s\n",
+	"card.requester":          "**依頼者:** @%s\n",
+	"card.approver":           "**承認者:** %s",
+	"card.approver_for_group": "@%s (%s 代表)",
+	"card.private":            "\n\n_詳細は依頼者と承認者のみが閲覧できます。_",
+	"card.description":        "\n**説明:**\n%s",
+	"card.decided":            "\n\n**判断日時:** %s",
+	"card.comment":            "\n**コメント:** %s",
+	"card.closed":             "\n\n**終了日時:** %s",
+	"card.reason":             "\n**理由:** %s",
+	"card.verified":           "\n**検証済み:** ✔️ %s",
+	"card.approved_by":        "✅ @%s が承認",
+	"card.denied_by":          "❌ @%s が却下",
+	"card.timed_out":          "⏱️ タイムアウト (応答なし)",
+	"card.canceled_by":        "🚫 @%s が取り消し",
+	"card.pending":            "⏳ 保留中",
+
+	// Approve/Deny button verification
+	"action.invalid_request": "無効なリクエストです",
+	"action.expired":         "このボタンは期限切れです。`/approve list` でリクエストを探し、`/approve get <code>` で確認してください。",
+
+	// /approve admin
+	"admin.usage": "**管理者コマンド:**\n\n" +
+		"* **/approve admin template list** - リクエストテンプレートを一覧表示します\n" +
+		"* **/approve admin template set <JSON>** - リクエストテンプレートを作成または置き換えます\n" +
+		"* **/approve admin template delete <name>** - リクエストテンプレートを削除します\n" +
+		"* **/approve admin policy list|set|delete** - 承認者ポリシーを管理します (詳細は引数なしで実行)\n" +
+		"* **/approve admin sla list|set|delete** - SLA 目標と超過時のエスカレーションチャンネルを管理します (詳細は引数なしで実行)\n" +
+		"* **/approve admin resend <code> [approver|requester]** - リクエストの現在の状態の DM を再送信します (保留中は承認者へ、確定後は依頼者へ)\n\n" +
+		"**テンプレートの例:**\n" +
+		"```\n" +
+		`/approve admin template set {"name":"prod-access","displayName":"Production Access","defaultApprovers":["bob"],"fields":[{"name":"system","label":"System","type":"select","required":true,"options":["database","kubernetes"]},{"name":"hours","label":"Duration (hours)","type":"number","required":true}]}` + "\n" +
+		"```\n" +
+		"フィールドの種類: text, select, number, date (YYYY-MM-DD)。テンプレートあたり最大 3 フィールドです。",
+
+	// /approve admin template
+	"template.set_usage":          "使い方: /approve admin template set <JSON>",
+	"template.delete_usage":       "使い方: /approve admin template delete <name>",
+	"template.list_failed":        "❌ リクエストテンプレートを取得できませんでした。もう一度お試しください。",
+	"template.none":               "リクエストテンプレートは定義されていません。`/approve admin template set <JSON>` で作成してください。",
+	"template.list_header":        "**📋 リクエストテンプレート (%d)**\n\n| 名前 | 表示名 | 既定の承認者 | フィールド |\n|------|--------|--------------|------------|\n",
+	"template.list_footer":        "\n*必須フィールドには \\* が付いています*",
+	"template.invalid_json":       "❌ テンプレートの JSON が無効です: %s",
+	"template.invalid":            "❌ テンプレートが無効です: %s",
+	"template.approver_not_found": "❌ テンプレートが無効です: 既定の承認者 @%s が見つかりません。",
+	"template.save_failed":        "❌ リクエストテンプレートを保存できませんでした。もう一度お試しください。",
+	"template.saved":              "✅ リクエストテンプレート `%s` を保存しました。`/approve new %s` で使用できます。",
+	"template.not_found":          "❌ リクエストテンプレート '%s' が見つかりません。",
+	"template.delete_failed":      "❌ リクエストテンプレートを削除できませんでした。もう一度お試しください。",
+	"template.deleted":            "✅ リクエストテンプレート `%s` を削除しました。既存のリクエストは記録済みのフィールド値を保持します。",
+
+	// /approve admin policy
+	"policy.usage": "**承認者ポリシーのコマンド:**\n\n" +
+		"* **/approve admin policy list** - 承認者ポリシーを一覧表示します\n" +
+		"* **/approve admin policy set team <rules>** - 現在のチームの承認者を制限します\n" +
+		"* **/approve admin policy set template <name> <rules>** - リクエストテンプレートの承認者を制限します\n" +
+		"* **/approve admin policy delete team** - 現在のチームのポリシーを削除します\n" +
+		"* **/approve admin policy delete template <name>** - テンプレートのポリシーを削除します\n\n" +
+		"**ルール** (1 つ以上、値はカンマ区切り):\n" +
+		"* `users=@alice,@bob` - 特定のユーザー\n" +
+		"* `groups=sre,dba` - Mattermost グループのメンバー\n" +
+		"* `roles=team_admin,channel_admin` - チームまたはチャンネルのロールの保持者\n\n" +
+		"いずれかのルールに一致する承認者が許可されます。チームポリシーとテンプレートポリシーの両方が適用される場合は、両方が承認者を許可する必要があります。",
+	"policy.delete_usage":           "使い方: /approve admin policy delete team|template <name>",
+	"policy.scope_required":         "ポリシーの範囲が必要です: 'team' または 'template <name>' を指定してください",
+	"policy.team_channel_required":  "チームポリシーはチームのチャンネルから管理する必要があります",
+	"policy.template_name_required": "テンプレート名が必要です",
+	"policy.invalid_scope":          "ポリシーの範囲 '%s' が無効です: 'team' または 'template <name>' を指定してください",
+	"policy.rule_required":          "❌ ルールを 1 つ以上指定してください。",
+	"policy.invalid_rule":           "❌ ルール '%s' が無効です。users=..., groups=... または roles=... を指定してください",
+	"policy.user_not_found":         "❌ ルールが無効です: ユーザー @%s が見つかりません。",
+	"policy.group_not_found":        "❌ ルールが無効です: グループ '%s' が見つかりません。",
+	"policy.invalid":                "❌ ポリシーが無効です: %s",
+	"policy.save_failed":            "❌ 承認者ポリシーを保存できませんでした。もう一度お試しください。",
+	"policy.saved":                  "✅ %s の承認者ポリシーを保存しました。許可された承認者: %s",
+	"policy.not_found":              "❌ %s の承認者ポリシーは定義されていません。",
+	"policy.delete_failed":          "❌ 承認者ポリシーを削除できませんでした。もう一度お試しください。",
+	"policy.deleted":                "✅ %s の承認者ポリシーを削除しました。すべてのアクティブユーザーを承認者に選択できます。",
+	"policy.list_failed":            "❌ 承認者ポリシーを取得できませんでした。もう一度お試しください。",
+	"policy.none":                   "承認者ポリシーは定義されていません。すべてのアクティブユーザーを承認者に選択できます。",
+	"policy.list_header":            "**🔒 承認者ポリシー (%d)**\n\n| 範囲 | 許可された承認者 |\n|------|------------------|\n",
+	"policy.scope_template":         "テンプレート `%s`",
+	"policy.scope_team":             "チーム %s",
+	"policy.scope_team_id":          "チーム `%s`",
+	"policy.rule_group":             "グループ %s",
+	"policy.rule_role":              "ロール %s",
+
+	// /approve admin resend
+	"resend.usage":                     "使い方: /approve admin resend <APPROVAL_CODE> [approver|requester]\n\n例: `/approve admin resend A-X7K9Q2 approver`",
+	"resend.not_found":                 "❌ 承認リクエスト '%s' が見つかりません。",
+	"resend.load_failed":               "❌ 承認リクエストを読み込めませんでした。もう一度お試しください。",
+	"resend.scheduled":                 "❌ 承認リクエスト `%s` は %s UTC に送信予定で、まだ送信されていません。",
+	"resend.unknown_target":            "❌ 不明な宛先 '%s' です。`approver` または `requester` を指定してください。",
+	"resend.failed":                    "❌ `%s` の通知を%sに再送信できませんでした。\n\n**対処法:** %s",
+	"resend.sent":                      "📨 `%[2]s` の%[1]s DM を%[3]sに再送信しました。",
+	"resend.target.approver":           "承認者",
+	"resend.target.requester":          "依頼者",
+	"resend.notification.request":      "リクエスト",
+	"resend.notification.decision":     "判断",
+	"resend.notification.cancellation": "取り消し",
+	"resend.notification.confirmation": "送信確認",
+	"resend.notification.outcome":      "結果",
+	"resend.notification.timeout":      "タイムアウト",
+}
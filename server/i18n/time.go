@@ -0,0 +1,48 @@
+package i18n
+
+import (
+	"time"
+	_ "time/tzdata" // Embed the timezone database so user timezones resolve on hosts without one
+)
+
+// Location resolves a Mattermost user timezone (an IANA name such as "Europe/Berlin"),
+// falling back to UTC for empty or unknown names
+func Location(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// FormatTime renders an epoch-millisecond timestamp with layout in the given timezone
+func FormatTime(millis int64, layout, timezone string) string {
+	return time.UnixMilli(millis).In(Location(timezone)).Format(layout)
+}
+
+// FormatTimeWithAge renders a timestamp like FormatTime followed by its age relative to now in
+// the given locale, e.g. "2024-01-11 13:00:00 CET (12 min ago)"
+func FormatTimeWithAge(locale string, millis int64, layout, timezone string, now time.Time) string {
+	return T(locale, "time.with_age", FormatTime(millis, layout, timezone), RelativeAge(locale, time.UnixMilli(millis), now))
+}
+
+// RelativeAge renders how long before now t was, e.g. "just now", "12 min ago", "3 h ago" or
+// "2 days ago". Times after now (clock skew between nodes) count as just now.
+func RelativeAge(locale string, t, now time.Time) string {
+	age := now.Sub(t)
+	switch {
+	case age < time.Minute:
+		return T(locale, "time.just_now")
+	case age < time.Hour:
+		return T(locale, "time.minutes_ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return T(locale, "time.hours_ago", int(age.Hours()))
+	case age < 48*time.Hour:
+		return T(locale, "time.day_ago")
+	default:
+		return T(locale, "time.days_ago", int(age.Hours())/24)
+	}
+}
@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatTime(t *testing.T) {
+	const layout = "2006-01-02 15:04:05 MST"
+	winter := time.Date(2024, time.January, 11, 12, 0, 0, 0, time.UTC).UnixMilli()
+	summer := time.Date(2024, time.July, 11, 12, 0, 0, 0, time.UTC).UnixMilli()
+
+	tests := []struct {
+		name     string
+		millis   int64
+		timezone string
+		want     string
+	}{
+		{name: "empty timezone uses UTC", millis: winter, timezone: "", want: "2024-01-11 12:00:00 UTC"},
+		{name: "UTC", millis: winter, timezone: "UTC", want: "2024-01-11 12:00:00 UTC"},
+		{name: "Berlin winter", millis: winter, timezone: "Europe/Berlin", want: "2024-01-11 13:00:00 CET"},
+		{name: "Berlin summer", millis: summer, timezone: "Europe/Berlin", want: "2024-07-11 14:00:00 CEST"},
+		{name: "Tokyo crosses midnight", millis: time.Date(2024, time.January, 11, 20, 30, 0, 0, time.UTC).UnixMilli(), timezone: "Asia/Tokyo", want: "2024-01-12 05:30:00 JST"},
+		{name: "New York", millis: winter, timezone: "America/New_York", want: "2024-01-11 07:00:00 EST"},
+		{name: "Kolkata half-hour offset", millis: winter, timezone: "Asia/Kolkata", want: "2024-01-11 17:30:00 IST"},
+		{name: "unknown timezone uses UTC", millis: winter, timezone: "Mars/Olympus_Mons", want: "2024-01-11 12:00:00 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatTime(tt.millis, layout, tt.timezone))
+		})
+	}
+}
+
+func TestRelativeAge(t *testing.T) {
+	now := time.Date(2024, time.January, 11, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		locale string
+		age    time.Duration
+		want   string
+	}{
+		{name: "seconds", locale: "en", age: 30 * time.Second, want: "just now"},
+		{name: "future (clock skew)", locale: "en", age: -2 * time.Minute, want: "just now"},
+		{name: "minutes", locale: "en", age: 12 * time.Minute, want: "12 min ago"},
+		{name: "just under an hour", locale: "en", age: 59*time.Minute + 59*time.Second, want: "59 min ago"},
+		{name: "hours", locale: "en", age: 3*time.Hour + 20*time.Minute, want: "3 h ago"},
+		{name: "one day", locale: "en", age: 30 * time.Hour, want: "1 day ago"},
+		{name: "days", locale: "en", age: 5*24*time.Hour + time.Hour, want: "5 days ago"},
+		{name: "German minutes", locale: "de", age: 12 * time.Minute, want: "vor 12 Min."},
+		{name: "German days", locale: "de", age: 3 * 24 * time.Hour, want: "vor 3 Tagen"},
+		{name: "Japanese hours", locale: "ja", age: 2 * time.Hour, want: "2 時間前"},
+		{name: "Japanese just now", locale: "ja", age: 0, want: "たった今"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RelativeAge(tt.locale, now.Add(-tt.age), now))
+		})
+	}
+}
+
+func TestFormatTimeWithAge(t *testing.T) {
+	created := time.Date(2024, time.January, 11, 12, 0, 0, 0, time.UTC)
+	now := created.Add(12 * time.Minute)
+
+	tests := []struct {
+		locale   string
+		timezone string
+		want     string
+	}{
+		{locale: "en", timezone: "", want: "2024-01-11 12:00 UTC (12 min ago)"},
+		{locale: "de", timezone: "Europe/Berlin", want: "2024-01-11 13:00 CET (vor 12 Min.)"},
+		{locale: "ja", timezone: "Asia/Tokyo", want: "2024-01-11 21:00 JST (12 分前)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.timezone, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatTimeWithAge(tt.locale, created.UnixMilli(), "2006-01-02 15:04 MST", tt.timezone, now))
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package metrics collects approval workflow counters and histograms in memory and renders
+// them in the Prometheus text exposition format.
+//
+// Values are kept in memory by each plugin process: they start at zero when the plugin is
+// activated, and each cluster node counts only the events it handled.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Request lifecycle events counted by RequestEvent
+const (
+	EventCreated  = "created"
+	EventApproved = "approved"
+	EventDenied   = "denied"
+	EventCanceled = "canceled"
+	EventTimedOut = "timed_out"
+	EventVerified = "verified"
+)
+
+// requestEvents lists the lifecycle counters in exposition order
+var requestEvents = []string{EventCreated, EventApproved, EventDenied, EventCanceled, EventTimedOut, EventVerified}
+
+// requestEventHelp describes each lifecycle counter
+var requestEventHelp = map[string]string{
+	EventCreated:  "Approval requests created (including resubmissions).",
+	EventApproved: "Approval requests approved.",
+	EventDenied:   "Approval requests denied.",
+	EventCanceled: "Approval requests canceled by the requester.",
+	EventTimedOut: "Approval requests auto-canceled after the timeout.",
+	EventVerified: "Approved requests verified by the requester.",
+}
+
+// Histogram bucket upper bounds, in seconds
+var (
+	// DecisionLatencyBuckets spans the 30 minute timeout and decisions recorded from a backlog
+	DecisionLatencyBuckets = []float64{30, 60, 120, 300, 600, 900, 1200, 1800, 3600, 14400, 86400}
+
+	// RecordDecisionBuckets spans the 2 second RecordDecision performance budget
+	RecordDecisionBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 5}
+)
+
+// PendingCacheTTL is how long a pending count is reused between scrapes. Counting reads every
+// approval record, so scrapers polling every few seconds would otherwise rescan the store.
+const PendingCacheTTL = 30 * time.Second
+
+// Metrics holds the plugin's counters and histograms. All methods are safe for concurrent use
+// and do nothing on a nil *Metrics, so components work without metrics in tests.
+type Metrics struct {
+	mu                     sync.Mutex
+	requests               map[string]uint64
+	notificationFailures   map[string]uint64
+	decisionLatency        *histogram
+	recordDecisionDuration *histogram
+
+	// pendingMu serializes pending counts so concurrent scrapes share one store scan
+	pendingMu      sync.Mutex
+	pendingCount   int
+	pendingCountAt time.Time
+	now            func() time.Time
+}
+
+// New creates an empty Metrics instance.
+func New() *Metrics {
+	return &Metrics{
+		requests:               make(map[string]uint64, len(requestEvents)),
+		notificationFailures:   make(map[string]uint64),
+		decisionLatency:        newHistogram(DecisionLatencyBuckets),
+		recordDecisionDuration: newHistogram(RecordDecisionBuckets),
+		now:                    time.Now,
+	}
+}
+
+// RequestEvent counts a request lifecycle event (one of the Event constants).
+func (m *Metrics) RequestEvent(event string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[event]++
+}
+
+// RequestDecided counts an approval or denial and observes the time from creation to decision.
+func (m *Metrics) RequestDecided(event string, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[event]++
+	m.decisionLatency.observe(latency.Seconds())
+}
+
+// NotificationFailed counts a failed notification DM by its notifications.ClassifyDMError type.
+func (m *Metrics) NotificationFailed(errorType string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notificationFailures[errorType]++
+}
+
+// ObserveRecordDecision observes the duration of a successful Service.RecordDecision call.
+func (m *Metrics) ObserveRecordDecision(duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.recordDecisionDuration.observe(duration.Seconds())
+}
+
+// PendingCount returns the pending gauge, calling count only when the last successful count is
+// older than PendingCacheTTL. Errors are returned and not cached.
+func (m *Metrics) PendingCount(count func() (int, error)) (int, error) {
+	if m == nil {
+		return count()
+	}
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+
+	now := m.now()
+	if !m.pendingCountAt.IsZero() && now.Sub(m.pendingCountAt) < PendingCacheTTL {
+		return m.pendingCount, nil
+	}
+
+	pending, err := count()
+	if err != nil {
+		return 0, err
+	}
+	m.pendingCount = pending
+	m.pendingCountAt = now
+	return pending, nil
+}
+
+// WritePrometheus renders every metric in the Prometheus text exposition format (version
+// 0.0.4). The pending gauge is passed in by the caller, usually from PendingCount.
+func (m *Metrics) WritePrometheus(w io.Writer, pending int) error {
+	var out strings.Builder
+
+	m.mu.Lock()
+	for _, event := range requestEvents {
+		name := "approval_requests_" + event + "_total"
+		writeHeader(&out, name, requestEventHelp[event], "counter")
+		fmt.Fprintf(&out, "%s %d\n", name, m.requests[event])
+	}
+
+	writeHeader(&out, "approval_notification_failures_total", "Notification DMs that failed, by error type.", "counter")
+	errorTypes := make([]string, 0, len(m.notificationFailures))
+	for errorType := range m.notificationFailures {
+		errorTypes = append(errorTypes, errorType)
+	}
+	sort.Strings(errorTypes)
+	for _, errorType := range errorTypes {
+		fmt.Fprintf(&out, "approval_notification_failures_total{error_type=\"%s\"} %d\n", labelEscaper.Replace(errorType), m.notificationFailures[errorType])
+	}
+
+	writeHeader(&out, "approval_decision_latency_seconds", "Time from request creation to approval or denial.", "histogram")
+	m.decisionLatency.write(&out, "approval_decision_latency_seconds")
+
+	writeHeader(&out, "approval_record_decision_duration_seconds", "Duration of successful RecordDecision calls.", "histogram")
+	m.recordDecisionDuration.write(&out, "approval_record_decision_duration_seconds")
+	m.mu.Unlock()
+
+	writeHeader(&out, "approval_requests_pending", "Approval requests awaiting a decision.", "gauge")
+	fmt.Fprintf(&out, "approval_requests_pending %d\n", pending)
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// writeHeader writes the HELP and TYPE lines of a metric family
+func writeHeader(out *strings.Builder, name, help, metricType string) {
+	fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// labelEscaper escapes label values as required by the text exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// histogram is a cumulative Prometheus histogram with fixed bucket upper bounds
+type histogram struct {
+	bounds []float64
+	counts []uint64 // observations per bucket (not cumulative); the last entry is +Inf
+	sum    float64
+	count  uint64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)+1),
+	}
+}
+
+func (h *histogram) observe(value float64) {
+	index := sort.SearchFloat64s(h.bounds, value) // first bound >= value
+	h.counts[index]++
+	h.sum += value
+	h.count++
+}
+
+func (h *histogram) write(out *strings.Builder, name string) {
+	var cumulative uint64
+	for i, bound := range h.bounds {
+		cumulative += h.counts[i]
+		fmt.Fprintf(out, "%s_bucket{le=\"%s\"} %d\n", name, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+	}
+	fmt.Fprintf(out, "%s_bucket{le=\"+Inf\"} %d\n", name, h.count)
+	fmt.Fprintf(out, "%s_sum %s\n", name, strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(out, "%s_count %d\n", name, h.count)
+}
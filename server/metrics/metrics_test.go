@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, m *Metrics, pending int) string {
+	t.Helper()
+	var out strings.Builder
+	require.NoError(t, m.WritePrometheus(&out, pending))
+	return out.String()
+}
+
+func TestWritePrometheus(t *testing.T) {
+	t.Run("empty metrics expose zero values", func(t *testing.T) {
+		out := render(t, New(), 0)
+
+		assert.Contains(t, out, "# HELP approval_requests_created_total Approval requests created (including resubmissions).\n"+
+			"# TYPE approval_requests_created_total counter\n"+
+			"approval_requests_created_total 0\n")
+		for _, event := range requestEvents {
+			assert.Contains(t, out, "approval_requests_"+event+"_total 0\n")
+		}
+		assert.Contains(t, out, "# TYPE approval_notification_failures_total counter\n")
+		assert.NotContains(t, out, "approval_notification_failures_total{")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"+Inf\"} 0\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_count 0\n")
+		assert.Contains(t, out, "# TYPE approval_requests_pending gauge\napproval_requests_pending 0\n")
+	})
+
+	t.Run("counts request events", func(t *testing.T) {
+		m := New()
+		m.RequestEvent(EventCreated)
+		m.RequestEvent(EventCreated)
+		m.RequestEvent(EventCanceled)
+		m.RequestEvent(EventTimedOut)
+		m.RequestEvent(EventVerified)
+		m.RequestDecided(EventApproved, time.Minute)
+		m.RequestDecided(EventDenied, time.Hour)
+
+		out := render(t, m, 3)
+
+		assert.Contains(t, out, "approval_requests_created_total 2\n")
+		assert.Contains(t, out, "approval_requests_approved_total 1\n")
+		assert.Contains(t, out, "approval_requests_denied_total 1\n")
+		assert.Contains(t, out, "approval_requests_canceled_total 1\n")
+		assert.Contains(t, out, "approval_requests_timed_out_total 1\n")
+		assert.Contains(t, out, "approval_requests_verified_total 1\n")
+		assert.Contains(t, out, "approval_requests_pending 3\n")
+	})
+
+	t.Run("notification failures are labeled by error type in sorted order", func(t *testing.T) {
+		m := New()
+		m.NotificationFailed("user_dms_disabled")
+		m.NotificationFailed("api_error")
+		m.NotificationFailed("user_dms_disabled")
+
+		out := render(t, m, 0)
+
+		apiError := strings.Index(out, "approval_notification_failures_total{error_type=\"api_error\"} 1\n")
+		dmsDisabled := strings.Index(out, "approval_notification_failures_total{error_type=\"user_dms_disabled\"} 2\n")
+		require.NotEqual(t, -1, apiError)
+		require.NotEqual(t, -1, dmsDisabled)
+		assert.Less(t, apiError, dmsDisabled)
+	})
+
+	t.Run("label values are escaped", func(t *testing.T) {
+		m := New()
+		m.NotificationFailed("bad\"type\\\n")
+
+		assert.Contains(t, render(t, m, 0), `approval_notification_failures_total{error_type="bad\"type\\\n"} 1`)
+	})
+
+	t.Run("histograms are cumulative", func(t *testing.T) {
+		m := New()
+		m.RequestDecided(EventApproved, 45*time.Second)
+		m.RequestDecided(EventApproved, 60*time.Second) // upper bounds are inclusive
+		m.RequestDecided(EventDenied, 48*time.Hour)
+
+		out := render(t, m, 0)
+
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"30\"} 0\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"60\"} 2\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"86400\"} 2\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_bucket{le=\"+Inf\"} 3\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_sum 172905\n")
+		assert.Contains(t, out, "approval_decision_latency_seconds_count 3\n")
+	})
+
+	t.Run("record decision duration", func(t *testing.T) {
+		m := New()
+		m.ObserveRecordDecision(20 * time.Millisecond)
+		m.ObserveRecordDecision(3 * time.Second)
+
+		out := render(t, m, 0)
+
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_bucket{le=\"0.01\"} 0\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_bucket{le=\"0.025\"} 1\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_bucket{le=\"2\"} 1\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_bucket{le=\"5\"} 2\n")
+		assert.Contains(t, out, "approval_record_decision_duration_seconds_count 2\n")
+	})
+}
+
+func TestPendingCount(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	t.Run("count is reused until the TTL expires", func(t *testing.T) {
+		m := New()
+		now := start
+		m.now = func() time.Time { return now }
+		calls := 0
+		count := func() (int, error) {
+			calls++
+			return calls * 10, nil
+		}
+
+		pending, err := m.PendingCount(count)
+		require.NoError(t, err)
+		assert.Equal(t, 10, pending)
+
+		now = start.Add(PendingCacheTTL - time.Second)
+		pending, err = m.PendingCount(count)
+		require.NoError(t, err)
+		assert.Equal(t, 10, pending, "cached between scrapes")
+
+		now = start.Add(PendingCacheTTL)
+		pending, err = m.PendingCount(count)
+		require.NoError(t, err)
+		assert.Equal(t, 20, pending, "recounted after the TTL")
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		m := New()
+		m.now = func() time.Time { return start }
+
+		_, err := m.PendingCount(func() (int, error) { return 0, errors.New("KV error") })
+		require.Error(t, err)
+
+		pending, err := m.PendingCount(func() (int, error) { return 4, nil })
+		require.NoError(t, err)
+		assert.Equal(t, 4, pending)
+	})
+}
+
+func TestNilMetrics(t *testing.T) {
+	var m *Metrics
+
+	assert.NotPanics(t, func() {
+		m.RequestEvent(EventCreated)
+		m.RequestDecided(EventApproved, time.Minute)
+		m.NotificationFailed("api_error")
+		m.ObserveRecordDecision(time.Second)
+	})
+
+	pending, err := m.PendingCount(func() (int, error) { return 2, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 2, pending)
+}
@@ -0,0 +1,145 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// autoCancelReasonPrefix identifies cancellations made by the timeout checker
+const autoCancelReasonPrefix = "Auto-canceled"
+
+// PostChannelStatusCard posts the status card for a request to its originating channel.
+// The card has no buttons; decisions are still made from the approver DM.
+// Returns the post ID so the card can be updated in place as the request changes.
+func PostChannelStatusCard(api plugin.API, botUserID string, record *approval.ApprovalRecord) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+	if record.RequestChannelID == "" {
+		return "", fmt.Errorf("request channel ID is empty")
+	}
+
+	post := &model.Post{
+		UserId:    botUserID,
+		ChannelId: record.RequestChannelID,
+		Message:   FormatChannelStatusCard(record),
+	}
+
+	createdPost, appErr := api.CreatePost(post)
+	if appErr != nil {
+		return "", fmt.Errorf("failed to post status card to channel %s: %w", record.RequestChannelID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
+
+// UpdateChannelStatusCard rewrites a request's channel status card to match the record.
+// Records without a card are ignored.
+//
+// IMPORTANT: Best effort only (Architecture Decision 2.2). Callers log failures and continue.
+func UpdateChannelStatusCard(api plugin.API, record *approval.ApprovalRecord) error {
+	if record == nil {
+		return fmt.Errorf("approval record is nil")
+	}
+	if record.ChannelPostID == "" {
+		return nil
+	}
+
+	post, appErr := api.GetPost(record.ChannelPostID)
+	if appErr != nil {
+		return fmt.Errorf("failed to get status card post %s: %w", record.ChannelPostID, appErr)
+	}
+
+	post.Message = FormatChannelStatusCard(record)
+	if _, appErr := api.UpdatePost(post); appErr != nil {
+		return fmt.Errorf("failed to update status card post %s: %w", record.ChannelPostID, appErr)
+	}
+
+	return nil
+}
+
+// FormatChannelStatusCard renders the channel status card for a request.
+// Private requests omit the description, template fields and free-text comments.
+// Cards are read by the whole channel, so they are rendered in the default locale.
+func FormatChannelStatusCard(record *approval.ApprovalRecord) string {
+	locale := i18n.DefaultLocale
+
+	var card strings.Builder
+	card.WriteString(i18n.T(locale, "card.header", record.Code))
+	card.WriteString(i18n.T(locale, "card.status", formatCardStatus(record, locale)))
+	card.WriteString(i18n.T(locale, "card.requester", record.RequesterUsername))
+	card.WriteString(i18n.T(locale, "card.approver", formatCardApprover(record, locale)))
+
+	if record.Private {
+		card.WriteString(i18n.T(locale, "card.private"))
+	} else {
+		card.WriteString(i18n.T(locale, "card.description", record.Description))
+		card.WriteString(formatCustomFields(record, locale))
+	}
+
+	switch record.Status {
+	case approval.StatusApproved, approval.StatusDenied:
+		card.WriteString(i18n.T(locale, "card.decided", formatCardTime(record.DecidedAt)))
+		if record.DecisionComment != "" && !record.Private {
+			card.WriteString(i18n.T(locale, "card.comment", record.DecisionComment))
+		}
+	case approval.StatusCanceled:
+		card.WriteString(i18n.T(locale, "card.closed", formatCardTime(record.CanceledAt)))
+		if record.CanceledReason != "" && !IsAutoCanceled(record) {
+			card.WriteString(i18n.T(locale, "card.reason", record.CanceledReason))
+		}
+	}
+
+	if record.Verified {
+		card.WriteString(i18n.T(locale, "card.verified", formatCardTime(record.VerifiedAt)))
+	}
+
+	return card.String()
+}
+
+// formatCardStatus describes the request state with the user who closed it, when known
+func formatCardStatus(record *approval.ApprovalRecord, locale string) string {
+	switch record.Status {
+	case approval.StatusApproved:
+		return i18n.T(locale, "card.approved_by", record.ApproverUsername)
+	case approval.StatusDenied:
+		return i18n.T(locale, "card.denied_by", record.ApproverUsername)
+	case approval.StatusCanceled:
+		if IsAutoCanceled(record) {
+			return i18n.T(locale, "card.timed_out")
+		}
+		return i18n.T(locale, "card.canceled_by", record.RequesterUsername)
+	default:
+		return i18n.T(locale, "card.pending")
+	}
+}
+
+// formatCardApprover names the approver, or the group while a group request is undecided
+func formatCardApprover(record *approval.ApprovalRecord, locale string) string {
+	if !record.IsGroupApproval() {
+		return "@" + record.ApproverUsername
+	}
+	if record.ApproverID == "" {
+		return record.ApproverGroupLabel()
+	}
+	return i18n.T(locale, "card.approver_for_group", record.ApproverUsername, record.ApproverGroupLabel())
+}
+
+// formatCardTime formats a card timestamp as "Jan 02, 2006 3:04 PM UTC"
+func formatCardTime(millis int64) string {
+	return time.UnixMilli(millis).UTC().Format("Jan 02, 2006 3:04 PM MST")
+}
+
+// IsAutoCanceled reports whether the timeout checker canceled the request
+func IsAutoCanceled(record *approval.ApprovalRecord) bool {
+	return strings.HasPrefix(record.CanceledReason, autoCancelReasonPrefix)
+}
@@ -0,0 +1,254 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostChannelStatusCard(t *testing.T) {
+	t.Run("posts the card to the request channel as the bot", func(t *testing.T) {
+		api := &plugintest.API{}
+		var posted *model.Post
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			posted = post
+			return post.ChannelId == "channel123" && post.UserId == "bot123"
+		})).Return(&model.Post{Id: "card123"}, nil)
+
+		postID, err := PostChannelStatusCard(api, "bot123", &approval.ApprovalRecord{
+			Code:              "A-CARD01",
+			RequesterUsername: "alice",
+			ApproverUsername:  "bob",
+			Description:       "Rotate the production TLS certificate",
+			Status:            approval.StatusPending,
+			CreatedAt:         1704988800000,
+			RequestChannelID:  "channel123",
+			ShareInChannel:    true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "card123", postID)
+		assert.Contains(t, posted.Message, "**Status:** ⏳ Pending")
+		assert.Empty(t, posted.Props, "the card has no action buttons")
+	})
+
+	t.Run("requires a request channel", func(t *testing.T) {
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusPending, ShareInChannel: true}
+
+		_, err := PostChannelStatusCard(&plugintest.API{}, "bot123", record)
+
+		assert.ErrorContains(t, err, "request channel ID is empty")
+	})
+
+	t.Run("requires a bot user", func(t *testing.T) {
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusPending, RequestChannelID: "channel123"}
+
+		_, err := PostChannelStatusCard(&plugintest.API{}, "", record)
+
+		assert.ErrorContains(t, err, "bot user ID not available")
+	})
+
+	t.Run("CreatePost error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("CreatePost", mock.Anything).Return(nil, &model.AppError{Message: "no permission"})
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusPending, RequestChannelID: "channel123"}
+
+		_, err := PostChannelStatusCard(api, "bot123", record)
+
+		assert.ErrorContains(t, err, "failed to post status card to channel channel123")
+	})
+}
+
+func TestUpdateChannelStatusCard(t *testing.T) {
+	t.Run("rewrites the card in place", func(t *testing.T) {
+		api := &plugintest.API{}
+		record := &approval.ApprovalRecord{
+			Code:              "A-CARD01",
+			RequesterUsername: "alice",
+			ApproverUsername:  "bob",
+			Description:       "Rotate the production TLS certificate",
+			Status:            approval.StatusDenied,
+			CreatedAt:         1704988800000,
+			DecidedAt:         1704992400000,
+			RequestChannelID:  "channel123",
+			ChannelPostID:     "card123",
+		}
+
+		api.On("GetPost", "card123").Return(&model.Post{Id: "card123", ChannelId: "channel123", Message: "old"}, nil)
+		api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.Id == "card123" && post.Message == FormatChannelStatusCard(record)
+		})).Return(&model.Post{}, nil)
+
+		assert.NoError(t, UpdateChannelStatusCard(api, record))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("records without a card are ignored", func(t *testing.T) {
+		api := &plugintest.API{}
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusApproved, RequestChannelID: "channel123"}
+
+		assert.NoError(t, UpdateChannelStatusCard(api, record))
+		api.AssertNotCalled(t, "GetPost", mock.Anything)
+	})
+
+	t.Run("GetPost error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetPost", "card123").Return(nil, &model.AppError{Message: "deleted"})
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusApproved, ChannelPostID: "card123"}
+
+		err := UpdateChannelStatusCard(api, record)
+
+		assert.ErrorContains(t, err, "failed to get status card post card123")
+	})
+
+	t.Run("UpdatePost error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetPost", "card123").Return(&model.Post{Id: "card123"}, nil)
+		api.On("UpdatePost", mock.Anything).Return(nil, &model.AppError{Message: "archived"})
+		record := &approval.ApprovalRecord{Code: "A-CARD01", Status: approval.StatusApproved, ChannelPostID: "card123"}
+
+		err := UpdateChannelStatusCard(api, record)
+
+		assert.ErrorContains(t, err, "failed to update status card post card123")
+	})
+}
+
+func TestFormatChannelStatusCard(t *testing.T) {
+	tests := []struct {
+		name        string
+		record      *approval.ApprovalRecord
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "pending",
+			record: &approval.ApprovalRecord{
+				Code:              "A-CARD01",
+				RequesterUsername: "alice",
+				ApproverID:        "approver456",
+				ApproverUsername:  "bob",
+				Description:       "Rotate the production TLS certificate",
+				Status:            approval.StatusPending,
+				CreatedAt:         1704988800000,
+			},
+			contains: []string{"`A-CARD01`", "**Status:** ⏳ Pending", "**Requester:** @alice", "**Approver:** @bob", "Rotate the production TLS certificate"},
+		},
+		{
+			name: "approved with comment",
+			record: &approval.ApprovalRecord{
+				Code:             "A-CARD01",
+				ApproverID:       "approver456",
+				ApproverUsername: "bob",
+				Status:           approval.StatusApproved,
+				CreatedAt:        1704988800000,
+				DecidedAt:        1704992400000,
+				DecisionComment:  "Go ahead",
+			},
+			contains: []string{"**Status:** ✅ Approved by @bob", "**Decided:** Jan 11, 2024 5:00 PM UTC", "**Comment:** Go ahead"},
+		},
+		{
+			name: "private approval hides description and comment",
+			record: &approval.ApprovalRecord{
+				Code:             "A-CARD01",
+				ApproverID:       "approver456",
+				ApproverUsername: "bob",
+				Description:      "Rotate the production TLS certificate",
+				Private:          true,
+				Status:           approval.StatusApproved,
+				CreatedAt:        1704988800000,
+				DecisionComment:  "Go ahead",
+				TemplateName:     "prod-access",
+				CustomFields:     []approval.CustomFieldValue{{Name: "system", Label: "System", Value: "database"}},
+			},
+			contains:    []string{"✅ Approved by @bob", "Details are private"},
+			notContains: []string{"Rotate the production TLS certificate", "Go ahead", "database"},
+		},
+		{
+			name: "denied",
+			record: &approval.ApprovalRecord{
+				Code:             "A-CARD01",
+				ApproverID:       "approver456",
+				ApproverUsername: "bob",
+				Status:           approval.StatusDenied,
+				CreatedAt:        1704988800000,
+			},
+			contains: []string{"**Status:** ❌ Denied by @bob"},
+		},
+		{
+			name: "canceled by requester",
+			record: &approval.ApprovalRecord{
+				Code:              "A-CARD01",
+				RequesterUsername: "alice",
+				Status:            approval.StatusCanceled,
+				CreatedAt:         1704988800000,
+				CanceledReason:    "No longer needed",
+				CanceledAt:        1704992400000,
+			},
+			contains: []string{"**Status:** 🚫 Canceled by @alice", "**Reason:** No longer needed"},
+		},
+		{
+			name: "timed out",
+			record: &approval.ApprovalRecord{
+				Code:           "A-CARD01",
+				Status:         approval.StatusCanceled,
+				CreatedAt:      1704988800000,
+				CanceledReason: "Auto-canceled: No response within 30 minutes",
+			},
+			contains:    []string{"**Status:** ⏱️ Timed out (no response)"},
+			notContains: []string{"**Reason:**"},
+		},
+		{
+			name: "verified",
+			record: &approval.ApprovalRecord{
+				Code:       "A-CARD01",
+				Status:     approval.StatusApproved,
+				CreatedAt:  1704988800000,
+				Verified:   true,
+				VerifiedAt: 1704996000000,
+			},
+			contains: []string{"**Verified:** ✔️ Jan 11, 2024 6:00 PM UTC"},
+		},
+		{
+			name: "pending group request names the group",
+			record: &approval.ApprovalRecord{
+				Code:                 "A-CARD01",
+				ApproverUsername:     "sre-oncall",
+				ApproverGroupName:    "sre-oncall",
+				CandidateApproverIDs: []string{"bob123", "carol123"},
+				Status:               approval.StatusPending,
+				CreatedAt:            1704988800000,
+			},
+			contains: []string{"**Approver:** @sre-oncall"},
+		},
+		{
+			name: "decided group request names the member",
+			record: &approval.ApprovalRecord{
+				Code:              "A-CARD01",
+				ApproverID:        "carol123",
+				ApproverUsername:  "carol",
+				ApproverGroupName: "sre-oncall",
+				Status:            approval.StatusApproved,
+				CreatedAt:         1704988800000,
+			},
+			contains: []string{"**Approver:** @carol (for @sre-oncall)", "✅ Approved by @carol"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := FormatChannelStatusCard(tt.record)
+
+			for _, want := range tt.contains {
+				assert.Contains(t, card, want)
+			}
+			for _, unwanted := range tt.notContains {
+				assert.NotContains(t, card, unwanted)
+			}
+		})
+	}
+}
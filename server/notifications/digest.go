@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// SendDigestDM delivers a scheduled approval digest to a user as a top-level DM.
+// Returns the created post ID.
+func SendDigestDM(api plugin.API, botUserID, userID, message string) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if userID == "" {
+		return "", fmt.Errorf("user ID is empty")
+	}
+
+	channelID, err := GetDMChannelID(api, botUserID, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DM channel for user %s: %w", userID, err)
+	}
+
+	createdPost, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: channelID,
+		Message:   message,
+	})
+	if appErr != nil {
+		return "", fmt.Errorf("failed to send digest to user %s: %w", userID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
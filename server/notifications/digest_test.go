@@ -0,0 +1,53 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendDigestDM(t *testing.T) {
+	t.Run("sends the digest as a top-level DM", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.UserId == "bot123" && post.ChannelId == "dm_channel" && post.RootId == "" && post.Message == "digest"
+		})).Return(&model.Post{Id: "digest_post"}, nil)
+
+		postID, err := SendDigestDM(api, "bot123", "user123", "digest")
+
+		require.NoError(t, err)
+		assert.Equal(t, "digest_post", postID)
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		_, err := SendDigestDM(&plugintest.API{}, "", "user123", "digest")
+		assert.ErrorContains(t, err, "bot user ID not available")
+
+		_, err = SendDigestDM(&plugintest.API{}, "bot123", "", "digest")
+		assert.ErrorContains(t, err, "user ID is empty")
+	})
+
+	t.Run("DM channel failure is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "user123").Return(nil, &model.AppError{Message: "DMs disabled"})
+
+		_, err := SendDigestDM(api, "bot123", "user123", "digest")
+
+		assert.ErrorContains(t, err, "failed to get DM channel for user user123")
+	})
+
+	t.Run("CreatePost failure is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.Anything).Return(nil, &model.AppError{Message: "blocked"})
+
+		_, err := SendDigestDM(api, "bot123", "user123", "digest")
+
+		assert.ErrorContains(t, err, "failed to send digest to user user123")
+	})
+}
@@ -2,21 +2,37 @@ package notifications
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 )
 
 // SendApprovalRequestDM sends a DM notification to the approver when a new approval request is created.
 // The message includes complete context: requester info, timestamp, description, and request ID.
+// The Approve/Deny button contexts are signed by signer and expire after ActionContextTTL.
 // Returns the post ID and error. Error returned if DM send fails (caller should log and handle gracefully).
-func SendApprovalRequestDM(api plugin.API, botUserID string, record *approval.ApprovalRecord) (string, error) {
+func SendApprovalRequestDM(api plugin.API, botUserID string, signer *ActionSigner, record *approval.ApprovalRecord) (string, error) {
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+	return SendApprovalRequestDMTo(api, botUserID, signer, record, record.ApproverID)
+}
+
+// SendApprovalRequestDMTo sends the approval request DM to a specific approver.
+// Group approvals call this once per candidate member; the message names the group and
+// explains that the first member to confirm claims the decision.
+func SendApprovalRequestDMTo(api plugin.API, botUserID string, signer *ActionSigner, record *approval.ApprovalRecord, approverID string) (string, error) {
 	// Validate inputs
 	if botUserID == "" {
 		return "", fmt.Errorf("bot user ID not available")
 	}
+	if signer == nil {
+		return "", fmt.Errorf("action signer not available")
+	}
 	if record == nil {
 		return "", fmt.Errorf("approval record is nil")
 	}
@@ -25,28 +41,44 @@ func SendApprovalRequestDM(api plugin.API, botUserID string, record *approval.Ap
 	}
 
 	// Get or create DM channel between bot and approver
-	channelID, err := GetDMChannelID(api, botUserID, record.ApproverID)
+	channelID, err := GetDMChannelID(api, botUserID, approverID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", record.ApproverID, err)
+		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", approverID, err)
 	}
 
-	// Format timestamp as YYYY-MM-DD HH:MM:SS UTC (AC2 requirement)
-	timestamp := time.UnixMilli(record.CreatedAt).UTC()
-	timestampStr := timestamp.Format("2006-01-02 15:04:05 MST")
+	// Render in the approver's locale (snapshotted on the record at creation)
+	locale := record.LocaleFor(approverID)
+
+	// Format timestamp as YYYY-MM-DD HH:MM:SS in the approver's timezone, with the request's age
+	// so retried and resent DMs show how long the request has been waiting
+	now := time.Now()
+	timestampStr := i18n.FormatTimeWithAge(locale, record.RequestedAt(), "2006-01-02 15:04:05 MST", record.TimezoneFor(approverID), now)
 
 	// Construct DM message with exact format from AC2
-	message := fmt.Sprintf("📋 **Approval Request**\n\n"+
-		"**From:** @%s (%s)\n"+
-		"**Requested:** %s\n"+
-		"**Description:**\n%s\n\n"+
-		"**Request ID:** `%s`",
+	message := i18n.T(locale, "dm.request",
 		record.RequesterUsername,
 		record.RequesterDisplayName,
 		timestampStr,
 		record.Description,
 		record.Code)
 
-	// Create post with interactive action buttons
+	// Add template custom fields when the request was created from a template
+	message += formatCustomFields(record, locale)
+
+	// Mention the original request when this is a resubmission
+	if record.PreviousCode != "" {
+		message += i18n.T(locale, "dm.request.resubmission", record.PreviousCode)
+	}
+
+	// Group approvals go to every member; explain who else received it
+	if record.IsGroupApproval() {
+		message += i18n.T(locale, "dm.request.group", record.ApproverGroupLabelIn(locale), len(record.CandidateApproverIDs))
+	}
+
+	// Apply the admin's message template, if any
+	message = applyMessageTemplate(api, TemplateRequest, TemplateData{Record: record, Recipient: RecipientApprover, Time: timestampStr, Message: message})
+
+	// Create post with interactive action buttons (signed contexts, verified by the action endpoint)
 	post := &model.Post{
 		UserId:    botUserID,
 		ChannelId: channelID,
@@ -57,25 +89,19 @@ func SendApprovalRequestDM(api plugin.API, botUserID string, record *approval.Ap
 					"actions": []any{
 						// Approve button (green/primary style)
 						map[string]any{
-							"name": "Approve",
+							"name": i18n.T(locale, "dm.request.approve"),
 							"integration": map[string]any{
-								"url": "/plugins/com.mattermost.plugin-approver2/action",
-								"context": map[string]any{
-									"approval_id": record.ID,
-									"action":      "approve",
-								},
+								"url":     "/plugins/com.mattermost.plugin-approver2/action",
+								"context": signer.SignedContext(record.ID, "approve", now),
 							},
 							"style": "primary",
 						},
 						// Deny button (red/danger style)
 						map[string]any{
-							"name": "Deny",
+							"name": i18n.T(locale, "dm.request.deny"),
 							"integration": map[string]any{
-								"url": "/plugins/com.mattermost.plugin-approver2/action",
-								"context": map[string]any{
-									"approval_id": record.ID,
-									"action":      "deny",
-								},
+								"url":     "/plugins/com.mattermost.plugin-approver2/action",
+								"context": signer.SignedContext(record.ID, "deny", now),
 							},
 							"style": "danger",
 						},
@@ -88,7 +114,7 @@ func SendApprovalRequestDM(api plugin.API, botUserID string, record *approval.Ap
 	// Send DM via CreatePost (persistent message, not ephemeral)
 	createdPost, appErr := api.CreatePost(post)
 	if appErr != nil {
-		return "", fmt.Errorf("failed to send DM to approver %s: %w", record.ApproverID, appErr)
+		return "", fmt.Errorf("failed to send DM to approver %s: %w", approverID, appErr)
 	}
 
 	return createdPost.Id, nil
@@ -120,29 +146,25 @@ func SendOutcomeNotificationDM(api plugin.API, botUserID string, record *approva
 		return "", fmt.Errorf("failed to get DM channel for requester %s: %w", record.RequesterID, err)
 	}
 
-	// Format decision timestamp as YYYY-MM-DD HH:MM:SS UTC
-	timestamp := time.UnixMilli(record.DecidedAt).UTC()
-	timestampStr := timestamp.Format("2006-01-02 15:04:05 MST")
+	// Format decision timestamp as YYYY-MM-DD HH:MM:SS in the requester's timezone
+	timestampStr := i18n.FormatTime(record.DecidedAt, "2006-01-02 15:04:05 MST", record.RequesterTimezone)
 
-	// Determine header and status based on decision
+	// Determine header and status based on decision, in the requester's locale
+	locale := record.RequesterLocale
 	var header, status string
 	switch record.Status {
 	case approval.StatusApproved:
-		header = "✅ **Approval Request Approved**"
-		status = "**Status:** You may proceed with this action."
+		header = i18n.T(locale, "dm.outcome.approved")
+		status = i18n.T(locale, "dm.outcome.approved_status")
 	case approval.StatusDenied:
-		header = "❌ **Approval Request Denied**"
-		status = "**Status:** This request has been denied."
+		header = i18n.T(locale, "dm.outcome.denied")
+		status = i18n.T(locale, "dm.outcome.denied_status")
 	default:
 		return "", fmt.Errorf("invalid status for outcome notification: %s", record.Status)
 	}
 
 	// Construct base message
-	message := fmt.Sprintf("%s\n\n"+
-		"**Approver:** @%s (%s)\n"+
-		"**Decision Time:** %s\n"+
-		"**Request ID:** `%s`\n\n"+
-		"**Original Request:**\n> %s",
+	message := i18n.T(locale, "dm.outcome",
 		header,
 		record.ApproverUsername,
 		record.ApproverDisplayName,
@@ -150,18 +172,25 @@ func SendOutcomeNotificationDM(api plugin.API, botUserID string, record *approva
 		record.Code,
 		record.Description)
 
+	// Add template custom fields when the request was created from a template
+	message += formatCustomFields(record, locale)
+
 	// Add comment section if decision comment is present
 	if record.DecisionComment != "" {
-		message += fmt.Sprintf("\n\n**Comment:**\n%s", record.DecisionComment)
+		message += i18n.T(locale, "dm.outcome.comment", record.DecisionComment)
 	}
 
 	// Add status statement
 	message += fmt.Sprintf("\n\n%s", status)
 
-	// Create post (no interactive buttons for outcome notification)
+	// Apply the admin's message template, if any
+	message = applyMessageTemplate(api, TemplateOutcome, TemplateData{Record: record, Recipient: RecipientRequester, Time: timestampStr, Message: message})
+
+	// Create post (no interactive buttons for outcome notification), threaded under the requester's confirmation
 	post := &model.Post{
 		UserId:    botUserID,
 		ChannelId: channelID,
+		RootId:    record.RequesterPostID,
 		Message:   message,
 	}
 
@@ -186,43 +215,49 @@ func UpdateApprovalPostForCancellation(api plugin.API, record *approval.Approval
 	if record == nil {
 		return fmt.Errorf("approval record is nil")
 	}
-	if record.NotificationPostID == "" {
+	postIDs := record.ApproverPostIDs()
+	if len(postIDs) == 0 {
 		api.LogWarn("Cannot update approver post: no post ID stored", "request_id", record.ID)
 		return fmt.Errorf("no approver post ID found")
 	}
 
+	// Group approvals have one post per member; update them all (each in its recipient's
+	// locale and timezone) and report the first failure
+	var firstErr error
+	for _, postID := range postIDs {
+		canceledAtStr := i18n.FormatTime(record.CanceledAt, "Jan 02, 2006 3:04 PM MST", record.ApproverPostTimezone(postID))
+		updatedMessage := i18n.T(record.ApproverPostLocale(postID), "dm.canceled_post",
+			record.RequesterUsername,
+			record.Code,
+			record.Description,
+			canceledByUsername,
+			canceledAtStr,
+		)
+		if err := replaceApproverPost(api, postID, updatedMessage); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// replaceApproverPost replaces an approver DM post's message and removes its action buttons
+func replaceApproverPost(api plugin.API, postID, message string) error {
 	// Get the original post
-	post, appErr := api.GetPost(record.NotificationPostID)
+	post, appErr := api.GetPost(postID)
 	if appErr != nil {
-		api.LogError("Failed to get post for update", "post_id", record.NotificationPostID, "error", appErr.Error())
+		api.LogError("Failed to get post for update", "post_id", postID, "error", appErr.Error())
 		return fmt.Errorf("failed to get post: %w", appErr)
 	}
 
-	// Build updated message with cancellation info
-	canceledAt := time.UnixMilli(record.CanceledAt).UTC()
-	canceledAtStr := canceledAt.Format("Jan 02, 2006 3:04 PM")
-
-	updatedMessage := fmt.Sprintf("🚫 **Approval Request (Canceled)**\n\n"+
-		"**From:** @%s\n"+
-		"**Request ID:** `%s`\n"+
-		"**Description:**\n%s\n\n"+
-		"---\n"+
-		"_Canceled by @%s at %s_",
-		record.RequesterUsername,
-		record.Code,
-		record.Description,
-		canceledByUsername,
-		canceledAtStr,
-	)
-
 	// Remove action buttons (props) - this fixes the ghost buttons bug
-	post.Message = updatedMessage
+	post.Message = message
 	post.Props = model.StringInterface{} // Clear all interactive elements
 
 	// Update the post
 	_, appErr = api.UpdatePost(post)
 	if appErr != nil {
-		api.LogError("Failed to update post", "post_id", record.NotificationPostID, "error", appErr.Error())
+		api.LogError("Failed to update post", "post_id", postID, "error", appErr.Error())
 		return fmt.Errorf("failed to update post: %w", appErr)
 	}
 
@@ -248,31 +283,50 @@ func SendCancellationNotificationDM(api plugin.API, botUserID string, record *ap
 	if record.ID == "" {
 		return "", fmt.Errorf("approval record ID is empty")
 	}
-	if record.ApproverID == "" {
+	recipients := record.ApproverRecipientIDs()
+	if len(recipients) == 0 {
 		return "", fmt.Errorf("approver ID is empty")
 	}
 
-	// Get or create DM channel between bot and approver
-	channelID, err := GetDMChannelID(api, botUserID, record.ApproverID)
-	if err != nil {
-		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", record.ApproverID, err)
-	}
+	// Group approvals notify every member; succeed if at least one DM was delivered
+	var firstPostID string
+	var firstErr error
+	for _, approverID := range recipients {
+		message := formatCancellationMessage(api, record, record.LocaleFor(approverID), record.TimezoneFor(approverID))
+		postID, err := sendCancellationPost(api, botUserID, approverID, approverThreadRootID(record, approverID), message)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			if len(recipients) > 1 {
+				api.LogWarn("Failed to notify group approver of cancellation", "request_id", record.ID, "approver_id", approverID, "error", err.Error())
+			}
+			continue
+		}
+		if firstPostID == "" {
+			firstPostID = postID
+		}
+	}
+
+	if firstPostID == "" {
+		return "", firstErr
+	}
+	return firstPostID, nil
+}
 
-	// Format cancellation timestamp as "Jan 02, 2006 3:04 PM"
-	canceledAt := time.UnixMilli(record.CanceledAt).UTC()
-	canceledAtStr := canceledAt.Format("Jan 02, 2006 3:04 PM")
+// formatCancellationMessage builds the approver cancellation DM in the given locale and timezone
+func formatCancellationMessage(api plugin.API, record *approval.ApprovalRecord, locale, timezone string) string {
+	// Format cancellation timestamp as "Jan 02, 2006 3:04 PM MST"
+	canceledAtStr := i18n.FormatTime(record.CanceledAt, "Jan 02, 2006 3:04 PM MST", timezone)
 
 	// Handle cancellation reason (may be empty)
 	canceledReason := record.CanceledReason
 	if canceledReason == "" {
-		canceledReason = "Not specified"
+		canceledReason = i18n.T(locale, "dm.cancellation.no_reason")
 	}
 
 	// Construct DM message (Story 7.3: include details if present)
-	message := fmt.Sprintf("🚫 **Approval Request Canceled**\n\n"+
-		"**Reference:** `%s`\n"+
-		"**Requester:** @%s\n"+
-		"**Reason:** %s",
+	message := i18n.T(locale, "dm.cancellation",
 		record.Code,
 		record.RequesterUsername,
 		canceledReason,
@@ -280,25 +334,35 @@ func SendCancellationNotificationDM(api plugin.API, botUserID string, record *ap
 
 	// Add details if present (Story 7.3)
 	if record.CanceledDetails != "" {
-		message += fmt.Sprintf("\n**Details:** %s", record.CanceledDetails)
+		message += i18n.T(locale, "dm.cancellation.details", record.CanceledDetails)
 	}
 
-	message += fmt.Sprintf("\n**Canceled:** %s\n\n"+
-		"The approval request you received has been canceled by the requester.",
-		canceledAtStr,
-	)
+	message += i18n.T(locale, "dm.cancellation.footer", canceledAtStr)
+
+	// Apply the admin's message template, if any
+	return applyMessageTemplate(api, TemplateCanceled, TemplateData{Record: record, Recipient: RecipientApprover, Time: canceledAtStr, Message: message})
+}
+
+// sendCancellationPost delivers a cancellation notification to one approver as a reply to rootID
+func sendCancellationPost(api plugin.API, botUserID, approverID, rootID, message string) (string, error) {
+	// Get or create DM channel between bot and approver
+	channelID, err := GetDMChannelID(api, botUserID, approverID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", approverID, err)
+	}
 
 	// Create post (no interactive buttons for cancellation notification)
 	post := &model.Post{
 		UserId:    botUserID,
 		ChannelId: channelID,
+		RootId:    rootID,
 		Message:   message,
 	}
 
 	// Send DM via CreatePost (persistent message, not ephemeral)
 	createdPost, appErr := api.CreatePost(post)
 	if appErr != nil {
-		return "", fmt.Errorf("failed to send cancellation notification to approver %s: %w", record.ApproverID, appErr)
+		return "", fmt.Errorf("failed to send cancellation notification to approver %s: %w", approverID, appErr)
 	}
 
 	return createdPost.Id, nil
@@ -333,22 +397,21 @@ func SendTimeoutNotificationDM(api plugin.API, botUserID string, record *approva
 		return "", fmt.Errorf("failed to get DM channel for requester %s: %w", record.RequesterID, err)
 	}
 
-	// Construct DM message per AC3 requirements
-	message := fmt.Sprintf("⏱️ **Approval Request Timed Out**\n\n"+
-		"**Request ID:** `%s`\n\n"+
-		"**Original Request:**\n> %s\n\n"+
-		"**Approver:** @%s (%s)\n\n"+
-		"**Reason:** No response within 30 minutes\n\n"+
-		"**Status:** This request has been automatically canceled. You may create a new request if still needed.",
+	// Construct DM message per AC3 requirements, in the requester's locale
+	message := i18n.T(record.RequesterLocale, "dm.timeout",
 		record.Code,
 		record.Description,
 		record.ApproverUsername,
 		record.ApproverDisplayName)
 
-	// Create post (no interactive buttons for timeout notification)
+	// Apply the admin's message template, if any
+	message = applyMessageTemplate(api, TemplateTimeout, TemplateData{Record: record, Recipient: RecipientRequester, Message: message})
+
+	// Create post (no interactive buttons for timeout notification), threaded under the requester's confirmation
 	post := &model.Post{
 		UserId:    botUserID,
 		ChannelId: channelID,
+		RootId:    record.RequesterPostID,
 		Message:   message,
 	}
 
@@ -391,16 +454,12 @@ func SendRequesterCancellationNotificationDM(api plugin.API, botUserID string, r
 		return "", fmt.Errorf("failed to get DM channel with requestor %s: %w", record.RequesterID, err)
 	}
 
-	// Format cancellation timestamp
-	cancelTime := time.UnixMilli(record.CanceledAt).UTC().Format("Jan 02, 2006 3:04 PM")
+	// Format cancellation timestamp in the requester's timezone
+	cancelTime := i18n.FormatTime(record.CanceledAt, "Jan 02, 2006 3:04 PM MST", record.RequesterTimezone)
 
 	// Build notification message (requestor perspective, Story 7.3: include details if present)
-	message := fmt.Sprintf(`🚫 **Your Approval Request Was Canceled**
-
-**Request ID:** `+"`%s`"+`
-**Original Request:** %s
-**Approver:** @%s
-**Reason:** %s`,
+	locale := record.RequesterLocale
+	message := i18n.T(locale, "dm.requester_cancellation",
 		record.Code,
 		record.Description,
 		record.ApproverUsername,
@@ -409,23 +468,19 @@ func SendRequesterCancellationNotificationDM(api plugin.API, botUserID string, r
 
 	// Add details if present (Story 7.3)
 	if record.CanceledDetails != "" {
-		message += fmt.Sprintf(`
-**Details:** %s`, record.CanceledDetails)
+		message += i18n.T(locale, "dm.cancellation.details", record.CanceledDetails)
 	}
 
-	message += fmt.Sprintf(`
-**Canceled:** %s
-
----
+	message += i18n.T(locale, "dm.requester_cancellation.footer", cancelTime)
 
-The approver has canceled this approval request. You may submit a new request if needed.`,
-		cancelTime,
-	)
+	// Apply the admin's message template, if any
+	message = applyMessageTemplate(api, TemplateCanceled, TemplateData{Record: record, Recipient: RecipientRequester, Time: cancelTime, Message: message})
 
-	// Create DM post
+	// Create DM post, threaded under the requester's confirmation
 	post := &model.Post{
 		ChannelId: channelID,
 		UserId:    botUserID,
+		RootId:    record.RequesterPostID,
 		Message:   message,
 	}
 
@@ -467,16 +522,12 @@ func SendVerificationNotificationDM(api plugin.API, botUserID string, record *ap
 		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", record.ApproverID, err)
 	}
 
-	// Format verification timestamp
-	timestamp := time.UnixMilli(record.VerifiedAt).UTC()
-	timestampStr := timestamp.Format("2006-01-02 15:04:05 MST")
+	// Format verification timestamp in the approver's timezone
+	timestampStr := i18n.FormatTime(record.VerifiedAt, "2006-01-02 15:04:05 MST", record.TimezoneFor(record.ApproverID))
 
-	// Construct DM message
-	message := fmt.Sprintf("✅ **Approval Request Verified**\n\n"+
-		"**Request ID:** `%s`\n\n"+
-		"**Original Request:**\n> %s\n\n"+
-		"**Requester:** @%s (%s)\n"+
-		"**Verified:** %s",
+	// Construct DM message in the approver's locale
+	locale := record.LocaleFor(record.ApproverID)
+	message := i18n.T(locale, "dm.verification",
 		record.Code,
 		record.Description,
 		record.RequesterUsername,
@@ -485,13 +536,17 @@ func SendVerificationNotificationDM(api plugin.API, botUserID string, record *ap
 
 	// Add verification comment if provided
 	if record.VerificationComment != "" {
-		message += fmt.Sprintf("\n\n**Verification Note:**\n> %s", record.VerificationComment)
+		message += i18n.T(locale, "dm.verification.note", record.VerificationComment)
 	}
 
-	// Create post (no interactive buttons for verification notification)
+	// Apply the admin's message template, if any
+	message = applyMessageTemplate(api, TemplateVerification, TemplateData{Record: record, Recipient: RecipientApprover, Time: timestampStr, Message: message})
+
+	// Create post (no interactive buttons for verification notification), threaded under the request DM
 	post := &model.Post{
 		UserId:    botUserID,
 		ChannelId: channelID,
+		RootId:    approverThreadRootID(record, record.ApproverID),
 		Message:   message,
 	}
 
@@ -504,6 +559,21 @@ func SendVerificationNotificationDM(api plugin.API, botUserID string, record *ap
 	return createdPost.Id, nil
 }
 
+// formatCustomFields renders a record's template custom fields as DM message lines in the given locale.
+// Returns an empty string for requests that were not created from a template.
+func formatCustomFields(record *approval.ApprovalRecord, locale string) string {
+	if record.TemplateName == "" {
+		return ""
+	}
+
+	var lines strings.Builder
+	lines.WriteString(i18n.T(locale, "dm.custom_fields.template", record.TemplateName))
+	for _, field := range record.CustomFields {
+		lines.WriteString(fmt.Sprintf("\n**%s:** %s", field.Label, field.Value))
+	}
+	return lines.String()
+}
+
 // GetDMChannelID gets or creates a DM channel between the bot and the target user.
 // Returns the channel ID if successful, or an error if the channel cannot be created.
 func GetDMChannelID(api plugin.API, botUserID, targetUserID string) (string, error) {
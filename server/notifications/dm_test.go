@@ -44,7 +44,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		}
 
 		// Execute
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 
 		// Assert
 		assert.NoError(t, err)
@@ -74,7 +74,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 			CreatedAt:            1704988800000, // 2024-01-11 12:00:00 UTC
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Verify exact format
@@ -109,7 +109,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 			CreatedAt:            1704988800000, // 2024-01-11 12:00:00 UTC
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Verify timestamp format: YYYY-MM-DD HH:MM:SS UTC
@@ -138,7 +138,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		}
 
 		// Execute - should return error
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 
 		// Assert error is returned for caller to log
 		assert.Error(t, err)
@@ -164,7 +164,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		}
 
 		// Execute - should return error for DM channel creation failure
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 
 		// Assert error is returned
 		assert.Error(t, err)
@@ -186,7 +186,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		}
 
 		// Execute - should return error for empty bot user ID
-		_, err := SendApprovalRequestDM(api, "", record)
+		_, err := SendApprovalRequestDM(api, "", testSigner, record)
 
 		// Assert error is returned
 		assert.Error(t, err)
@@ -198,7 +198,7 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		api := &plugintest.API{}
 
 		// Execute - should return error for nil record
-		_, err := SendApprovalRequestDM(api, "bot123", nil)
+		_, err := SendApprovalRequestDM(api, "bot123", testSigner, nil)
 
 		// Assert error is returned
 		assert.Error(t, err)
@@ -220,13 +220,30 @@ func TestSendApprovalRequestDM(t *testing.T) {
 		}
 
 		// Execute - should return error for empty record ID
-		_, err := SendApprovalRequestDM(api, "bot123", record)
+		_, err := SendApprovalRequestDM(api, "bot123", testSigner, record)
 
 		// Assert error is returned
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "approval record ID is empty")
 		api.AssertExpectations(t)
 	})
+
+	t.Run("nil signer validation", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		record := &approval.ApprovalRecord{
+			ID:         "record123",
+			Code:       "A-X7K9Q2",
+			ApproverID: "approver456",
+		}
+
+		// Execute - unsigned buttons must never be sent
+		_, err := SendApprovalRequestDM(api, "bot123", nil, record)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "action signer not available")
+		api.AssertExpectations(t)
+	})
 }
 
 func TestGetDMChannelID(t *testing.T) {
@@ -288,7 +305,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 		}
 
 		// Execute
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 
 		// Assert no error
 		assert.NoError(t, err)
@@ -328,7 +345,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 			CreatedAt:            1704988800000,
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Extract approve button
@@ -349,6 +366,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 		context := integration["context"].(map[string]any)
 		assert.Equal(t, "record123", context["approval_id"])
 		assert.Equal(t, "approve", context["action"])
+		assert.NoError(t, testSigner.Verify(context, time.Now()), "button context must carry a valid signature")
 	})
 
 	t.Run("deny button configured correctly", func(t *testing.T) {
@@ -374,7 +392,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 			CreatedAt:            1704988800000,
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Extract deny button
@@ -395,6 +413,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 		context := integration["context"].(map[string]any)
 		assert.Equal(t, "record123", context["approval_id"])
 		assert.Equal(t, "deny", context["action"])
+		assert.NoError(t, testSigner.Verify(context, time.Now()), "button context must carry a valid signature")
 	})
 
 	t.Run("message format remains intact with buttons", func(t *testing.T) {
@@ -420,7 +439,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 			CreatedAt:            1704988800000,
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Verify message content is unchanged
@@ -462,7 +481,7 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 			CreatedAt:            1704988800000,
 		}
 
-		_, err := SendApprovalRequestDM(api, botUserID, record)
+		_, err := SendApprovalRequestDM(api, botUserID, testSigner, record)
 		assert.NoError(t, err)
 
 		// Verify buttons still present with long description
@@ -518,8 +537,8 @@ func TestSendApprovalRequestDM_WithActionButtons(t *testing.T) {
 		}
 
 		// Send both notifications
-		_, err1 := SendApprovalRequestDM(api, botUserID, record1)
-		_, err2 := SendApprovalRequestDM(api, botUserID, record2)
+		_, err1 := SendApprovalRequestDM(api, botUserID, testSigner, record1)
+		_, err2 := SendApprovalRequestDM(api, botUserID, testSigner, record2)
 
 		assert.NoError(t, err1)
 		assert.NoError(t, err2)
@@ -2004,3 +2023,327 @@ func TestSendRequesterCancellationNotificationDM(t *testing.T) {
 
 // Helper function to verify the plugin.API interface is satisfied
 var _ plugin.API = (*plugintest.API)(nil)
+
+func TestSendApprovalRequestDM_TemplateAndLineage(t *testing.T) {
+	t.Run("includes custom fields and resubmission reference", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedMessage string
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm789"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedMessage = post.Message
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			ApproverID:           "approver456",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Carter",
+			Description:          "Need database access",
+			CreatedAt:            1704988800000,
+			TemplateName:         "prod-access",
+			CustomFields: []approval.CustomFieldValue{
+				{Name: "system", Label: "System", Value: "database"},
+			},
+			PreviousCode: "A-OLD234",
+		}
+
+		_, err := SendApprovalRequestDM(api, "bot123", testSigner, record)
+		assert.NoError(t, err)
+
+		assert.Contains(t, capturedMessage, "**Template:** prod-access\n**System:** database")
+		assert.Contains(t, capturedMessage, "**Resubmission of:** `A-OLD234`")
+	})
+
+	t.Run("omits template section for free-form requests", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedMessage string
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm789"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedMessage = post.Message
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			ApproverID:  "approver456",
+			Description: "Deploy hotfix",
+			CreatedAt:   1704988800000,
+		}
+
+		_, err := SendApprovalRequestDM(api, "bot123", testSigner, record)
+		assert.NoError(t, err)
+		assert.NotContains(t, capturedMessage, "**Template:**")
+		assert.NotContains(t, capturedMessage, "Resubmission of")
+	})
+}
+
+func TestGroupApprovalNotifications(t *testing.T) {
+	groupRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-GROUP1",
+			RequesterUsername:    "alice",
+			Description:          "Deploy hotfix",
+			CreatedAt:            1704988800000,
+			CanceledAt:           1736725200000,
+			ApproverUsername:     "sre-oncall",
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob", "carol"},
+			CandidatePostIDs:     map[string]string{"bob": "post_bob", "carol": "post_carol"},
+			NotificationPostID:   "post_bob",
+		}
+	}
+
+	t.Run("request DM to a member names the group", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedMessage string
+		api.On("GetDirectChannel", "bot123", "carol").Return(&model.Channel{Id: "dm_carol"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedMessage = post.Message
+			return post.ChannelId == "dm_carol"
+		})).Return(&model.Post{Id: "post_carol"}, nil)
+
+		postID, err := SendApprovalRequestDMTo(api, "bot123", testSigner, groupRecord(), "carol")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "post_carol", postID)
+		assert.Contains(t, capturedMessage, "**Approver group:** @sre-oncall")
+		assert.Contains(t, capturedMessage, "Sent to 2 members. The first member to confirm a decision claims this request.")
+	})
+
+	t.Run("cancellation updates every member post", func(t *testing.T) {
+		api := &plugintest.API{}
+		for _, postID := range []string{"post_bob", "post_carol"} {
+			api.On("GetPost", postID).Return(&model.Post{Id: postID, Props: model.StringInterface{"attachments": "x"}}, nil)
+			api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+				return post.Id == postID && len(post.Props) == 0 && strings.Contains(post.Message, "Canceled by @alice")
+			})).Return(&model.Post{}, nil)
+		}
+
+		err := UpdateApprovalPostForCancellation(api, groupRecord(), "alice")
+
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("cancellation DM reaches remaining members when one fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "bob").Return(nil, model.NewAppError("GetDirectChannel", "error", nil, "", 500))
+		api.On("GetDirectChannel", "bot123", "carol").Return(&model.Channel{Id: "dm_carol"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "cancel_carol"}, nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		postID, err := SendCancellationNotificationDM(api, "bot123", groupRecord(), "alice")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cancel_carol", postID)
+		api.AssertNumberOfCalls(t, "CreatePost", 1)
+	})
+}
+
+func TestLocalizedNotifications(t *testing.T) {
+	t.Run("request DM and buttons use the approver's locale", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedPost *model.Post
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm789"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedPost = post
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			ApproverID:           "approver456",
+			ApproverLocale:       "ja",
+			RequesterID:          "requester789",
+			RequesterLocale:      "de",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Carter",
+			Description:          "Deploy hotfix",
+			CreatedAt:            1704988800000,
+		}
+
+		_, err := SendApprovalRequestDM(api, "bot123", testSigner, record)
+		assert.NoError(t, err)
+
+		assert.Contains(t, capturedPost.Message, "📋 **承認リクエスト**")
+		assert.Contains(t, capturedPost.Message, "**依頼者:** @alice (Alice Carter)")
+		actions := capturedPost.Props["attachments"].([]any)[0].(map[string]any)["actions"].([]any)
+		assert.Equal(t, "承認", actions[0].(map[string]any)["name"])
+		assert.Equal(t, "却下", actions[1].(map[string]any)["name"])
+	})
+
+	t.Run("outcome DM uses the requester's locale", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedMessage string
+		api.On("GetDirectChannel", "bot123", "requester789").Return(&model.Channel{Id: "dm456"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedMessage = post.Message
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:                  "record123",
+			Code:                "A-X7K9Q2",
+			RequesterID:         "requester789",
+			RequesterLocale:     "de-DE",
+			ApproverUsername:    "jordan",
+			ApproverDisplayName: "Jordan Lee",
+			ApproverLocale:      "ja",
+			Description:         "Deploy hotfix",
+			Status:              approval.StatusApproved,
+			DecisionComment:     "Go ahead",
+			DecidedAt:           1704988800000,
+		}
+
+		_, err := SendOutcomeNotificationDM(api, "bot123", record)
+		assert.NoError(t, err)
+
+		assert.Contains(t, capturedMessage, "✅ **Genehmigungsanfrage genehmigt**")
+		assert.Contains(t, capturedMessage, "**Kommentar:**\nGo ahead")
+		assert.Contains(t, capturedMessage, "Sie können mit dieser Aktion fortfahren.")
+	})
+
+	t.Run("unshipped locales fall back to English", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		var capturedMessage string
+		api.On("GetDirectChannel", "bot123", "requester789").Return(&model.Channel{Id: "dm456"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			capturedMessage = post.Message
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:              "record123",
+			Code:            "A-X7K9Q2",
+			RequesterID:     "requester789",
+			RequesterLocale: "pt-BR",
+			Status:          approval.StatusDenied,
+			DecidedAt:       1704988800000,
+		}
+
+		_, err := SendOutcomeNotificationDM(api, "bot123", record)
+		assert.NoError(t, err)
+
+		assert.Contains(t, capturedMessage, "❌ **Approval Request Denied**")
+	})
+
+	t.Run("group member posts are canceled in each member's locale", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetPost", "post_bob").Return(&model.Post{Id: "post_bob"}, nil)
+		api.On("GetPost", "post_carol").Return(&model.Post{Id: "post_carol"}, nil)
+		api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.Id == "post_bob" && strings.Contains(post.Message, "_Storniert von @alice am ")
+		})).Return(&model.Post{}, nil)
+		api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.Id == "post_carol" && strings.Contains(post.Message, "_@alice が ")
+		})).Return(&model.Post{}, nil)
+
+		record := &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-GROUP1",
+			RequesterUsername:    "alice",
+			Description:          "Deploy hotfix",
+			CanceledAt:           1736725200000,
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob", "carol"},
+			CandidatePostIDs:     map[string]string{"bob": "post_bob", "carol": "post_carol"},
+			CandidateLocales:     map[string]string{"bob": "de", "carol": "ja"},
+			NotificationPostID:   "post_bob",
+		}
+
+		err := UpdateApprovalPostForCancellation(api, record, "alice")
+
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+}
+
+func TestTimezoneNotifications(t *testing.T) {
+	tests := []struct {
+		name          string
+		timezone      string
+		wantRequested string
+		wantDecided   string
+	}{
+		{name: "no timezone uses UTC", timezone: "", wantRequested: "2024-01-11 12:00:00 UTC (", wantDecided: "2024-01-11 12:30:00 UTC"},
+		{name: "Europe/Berlin", timezone: "Europe/Berlin", wantRequested: "2024-01-11 13:00:00 CET (", wantDecided: "2024-01-11 13:30:00 CET"},
+		{name: "Asia/Tokyo", timezone: "Asia/Tokyo", wantRequested: "2024-01-11 21:00:00 JST (", wantDecided: "2024-01-11 21:30:00 JST"},
+		{name: "unknown timezone uses UTC", timezone: "Not/A_Zone", wantRequested: "2024-01-11 12:00:00 UTC (", wantDecided: "2024-01-11 12:30:00 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &approval.ApprovalRecord{
+				ID:                "record123",
+				Code:              "A-X7K9Q2",
+				RequesterID:       "requester789",
+				RequesterTimezone: tt.timezone,
+				ApproverID:        "approver456",
+				ApproverTimezone:  tt.timezone,
+				RequesterUsername: "alice",
+				ApproverUsername:  "bob",
+				Description:       "Deploy hotfix",
+				Status:            approval.StatusApproved,
+				CreatedAt:         1704974400000, // 2024-01-11 12:00:00 UTC
+				DecidedAt:         1704976200000, // 2024-01-11 12:30:00 UTC
+			}
+
+			var messages []string
+			api := &plugintest.API{}
+			api.On("GetDirectChannel", "bot123", mock.Anything).Return(&model.Channel{Id: "dm"}, nil)
+			api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+				messages = append(messages, post.Message)
+				return true
+			})).Return(&model.Post{Id: "post_123"}, nil)
+
+			_, err := SendApprovalRequestDM(api, "bot123", testSigner, record)
+			assert.NoError(t, err)
+			_, err = SendOutcomeNotificationDM(api, "bot123", record)
+			assert.NoError(t, err)
+
+			assert.Len(t, messages, 2)
+			assert.Contains(t, messages[0], "**Requested:** "+tt.wantRequested)
+			assert.Contains(t, messages[0], " days ago)")
+			assert.Contains(t, messages[1], "**Decision Time:** "+tt.wantDecided)
+		})
+	}
+
+	t.Run("group members get cancellation times in their own timezone", func(t *testing.T) {
+		record := &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-GROUP1",
+			RequesterUsername:    "alice",
+			CanceledAt:           1704974400000, // 2024-01-11 12:00:00 UTC
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob", "carol"},
+			CandidateTimezones:   map[string]string{"bob": "America/New_York"},
+		}
+
+		messages := map[string]string{}
+		api := &plugintest.API{}
+		for _, member := range []string{"bob", "carol"} {
+			api.On("GetDirectChannel", "bot123", member).Return(&model.Channel{Id: "dm_" + member}, nil)
+		}
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			messages[post.ChannelId] = post.Message
+			return true
+		})).Return(&model.Post{Id: "post_123"}, nil)
+
+		_, err := SendCancellationNotificationDM(api, "bot123", record, "alice")
+
+		assert.NoError(t, err)
+		assert.Contains(t, messages["dm_bob"], "**Canceled:** Jan 11, 2024 7:00 AM EST")
+		assert.Contains(t, messages["dm_carol"], "**Canceled:** Jan 11, 2024 12:00 PM UTC")
+	})
+}
@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// maxAlertedAdmins caps how many system admins receive a delivery failure alert
+const maxAlertedAdmins = 100
+
+// SendDeliveryFailureAlert DMs every active system admin that the retry queue gave up on a notification.
+// Returns the number of admins alerted; an error only if none could be reached.
+func SendDeliveryFailureAlert(api plugin.API, botUserID string, retry *approval.NotificationRetry) (int, error) {
+	if botUserID == "" {
+		return 0, fmt.Errorf("bot user ID not available")
+	}
+	if retry == nil {
+		return 0, fmt.Errorf("notification retry is nil")
+	}
+
+	admins, appErr := api.GetUsers(&model.UserGetOptions{
+		Role:    model.SystemAdminRoleId,
+		Active:  true,
+		Page:    0,
+		PerPage: maxAlertedAdmins,
+	})
+	if appErr != nil {
+		return 0, fmt.Errorf("failed to list system admins: %w", appErr)
+	}
+	if len(admins) == 0 {
+		return 0, fmt.Errorf("no active system admins to alert")
+	}
+
+	message := FormatDeliveryFailureAlert(retry)
+
+	alerted := 0
+	var firstErr error
+	for _, admin := range admins {
+		if err := sendAlertPost(api, botUserID, admin.Id, message); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		alerted++
+	}
+
+	if alerted == 0 {
+		return 0, firstErr
+	}
+	return alerted, nil
+}
+
+// sendAlertPost delivers a delivery failure alert to one admin as a top-level DM
+func sendAlertPost(api plugin.API, botUserID, adminID, message string) error {
+	channelID, err := GetDMChannelID(api, botUserID, adminID)
+	if err != nil {
+		return fmt.Errorf("failed to get DM channel for admin %s: %w", adminID, err)
+	}
+
+	if _, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: channelID,
+		Message:   message,
+	}); appErr != nil {
+		return fmt.Errorf("failed to send delivery failure alert to admin %s: %w", adminID, appErr)
+	}
+
+	return nil
+}
+
+// FormatDeliveryFailureAlert renders the admin alert for an abandoned notification retry
+func FormatDeliveryFailureAlert(retry *approval.NotificationRetry) string {
+	what := "approval request DM to the approver"
+	if retry.Kind == approval.RetryKindOutcome {
+		what = "outcome DM to the requester"
+	}
+
+	_, suggestion := ClassifyDMError(fmt.Errorf("%s", retry.LastError))
+
+	return fmt.Sprintf("🚨 **Notification Delivery Failed**\n\n"+
+		"The approval bot gave up delivering the %s for `%s` after %d attempts.\n\n"+
+		"**Error type:** %s\n"+
+		"**Suggestion:** %s\n"+
+		"**Last error:** %s\n\n"+
+		"Use `/approve status --failed-notifications` to review affected requests.",
+		what,
+		retry.Code,
+		retry.Attempts,
+		retry.LastErrorType,
+		suggestion,
+		retry.LastError)
+}
@@ -0,0 +1,103 @@
+package notifications
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func abandonedRetry() *approval.NotificationRetry {
+	return &approval.NotificationRetry{
+		Kind:          approval.RetryKindApproverRequest,
+		RecordID:      "record123",
+		Code:          "A-X7K9Q2",
+		Attempts:      6,
+		LastError:     "failed to get DM channel: DMs disabled",
+		LastErrorType: "user_dms_disabled",
+	}
+}
+
+func TestSendDeliveryFailureAlert(t *testing.T) {
+	adminQuery := &model.UserGetOptions{Role: model.SystemAdminRoleId, Active: true, Page: 0, PerPage: 100}
+
+	t.Run("DMs every active system admin", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUsers", adminQuery).Return([]*model.User{{Id: "admin1"}, {Id: "admin2"}}, nil)
+		api.On("GetDirectChannel", "bot123", "admin1").Return(&model.Channel{Id: "dm_admin1"}, nil)
+		api.On("GetDirectChannel", "bot123", "admin2").Return(&model.Channel{Id: "dm_admin2"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.UserId == "bot123" && strings.Contains(post.Message, "Notification Delivery Failed")
+		})).Return(&model.Post{Id: "alert"}, nil)
+
+		alerted, err := SendDeliveryFailureAlert(api, "bot123", abandonedRetry())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, alerted)
+		api.AssertNumberOfCalls(t, "CreatePost", 2)
+	})
+
+	t.Run("succeeds if some admins are reached", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUsers", adminQuery).Return([]*model.User{{Id: "admin1"}, {Id: "admin2"}}, nil)
+		api.On("GetDirectChannel", "bot123", "admin1").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("GetDirectChannel", "bot123", "admin2").Return(&model.Channel{Id: "dm_admin2"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		alerted, err := SendDeliveryFailureAlert(api, "bot123", abandonedRetry())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, alerted)
+	})
+
+	t.Run("fails if no admin is reached", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUsers", adminQuery).Return([]*model.User{{Id: "admin1"}}, nil)
+		api.On("GetDirectChannel", "bot123", "admin1").Return(&model.Channel{Id: "dm_admin1"}, nil)
+		api.On("CreatePost", mock.Anything).Return(nil, &model.AppError{Message: "blocked"})
+
+		_, err := SendDeliveryFailureAlert(api, "bot123", abandonedRetry())
+
+		assert.ErrorContains(t, err, "failed to send delivery failure alert to admin admin1")
+	})
+
+	t.Run("fails without active admins", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUsers", adminQuery).Return([]*model.User{}, nil)
+
+		_, err := SendDeliveryFailureAlert(api, "bot123", abandonedRetry())
+
+		assert.ErrorContains(t, err, "no active system admins")
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		_, err := SendDeliveryFailureAlert(&plugintest.API{}, "", abandonedRetry())
+		assert.ErrorContains(t, err, "bot user ID not available")
+
+		_, err = SendDeliveryFailureAlert(&plugintest.API{}, "bot123", nil)
+		assert.ErrorContains(t, err, "notification retry is nil")
+	})
+}
+
+func TestFormatDeliveryFailureAlert(t *testing.T) {
+	t.Run("approver request", func(t *testing.T) {
+		message := FormatDeliveryFailureAlert(abandonedRetry())
+
+		assert.Contains(t, message, "approval request DM to the approver for `A-X7K9Q2` after 6 attempts")
+		assert.Contains(t, message, "**Error type:** user_dms_disabled")
+		assert.Contains(t, message, "**Last error:** failed to get DM channel: DMs disabled")
+		assert.Contains(t, message, "/approve status --failed-notifications")
+	})
+
+	t.Run("outcome", func(t *testing.T) {
+		retry := abandonedRetry()
+		retry.Kind = approval.RetryKindOutcome
+
+		assert.Contains(t, FormatDeliveryFailureAlert(retry), "outcome DM to the requester")
+	})
+}
@@ -0,0 +1,115 @@
+package notifications
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ActionContextTTL is how long Approve/Deny buttons stay valid after the DM is sent.
+// Pending requests time out well before this, so only stale or replayed payloads hit it.
+const ActionContextTTL = 24 * time.Hour
+
+var (
+	// ErrActionContextInvalid is returned when a button context is missing fields or its signature does not match
+	ErrActionContextInvalid = errors.New("invalid action context signature")
+
+	// ErrActionContextExpired is returned when a correctly signed button context is past its expiry
+	ErrActionContextExpired = errors.New("action context expired")
+
+	// ErrActionContextUnsigned is returned for a button context without a signature, such as buttons
+	// posted before contexts were signed. It wraps ErrActionContextInvalid.
+	ErrActionContextUnsigned = fmt.Errorf("%w: missing signature", ErrActionContextInvalid)
+)
+
+// ActionSigner signs and verifies interactive button contexts with HMAC-SHA256
+// so the action endpoint only accepts payloads the plugin produced itself.
+type ActionSigner struct {
+	secret []byte
+}
+
+// NewActionSigner creates a signer from the plugin-held secret
+func NewActionSigner(secret []byte) *ActionSigner {
+	return &ActionSigner{secret: secret}
+}
+
+// SignedContext builds the integration context for an approve/deny button,
+// valid until ActionContextTTL after now
+func (s *ActionSigner) SignedContext(approvalID, action string, now time.Time) map[string]any {
+	expiresAt := now.Add(ActionContextTTL).UnixMilli()
+	return map[string]any{
+		"approval_id": approvalID,
+		"action":      action,
+		"expires_at":  expiresAt,
+		"signature":   s.sign(approvalID, action, expiresAt),
+	}
+}
+
+// Verify checks the signature and expiry of a button context received by the action endpoint.
+// Returns ErrActionContextInvalid for missing or forged fields, ErrActionContextUnsigned for buttons
+// without a signature and ErrActionContextExpired for stale buttons.
+func (s *ActionSigner) Verify(context map[string]any, now time.Time) error {
+	approvalID, _ := context["approval_id"].(string)
+	action, _ := context["action"].(string)
+	signature, _ := context["signature"].(string)
+	if approvalID == "" || action == "" {
+		return fmt.Errorf("%w: missing fields", ErrActionContextInvalid)
+	}
+	if signature == "" {
+		return ErrActionContextUnsigned
+	}
+
+	expiresAt, ok := contextMillis(context["expires_at"])
+	if !ok {
+		return fmt.Errorf("%w: missing expiry", ErrActionContextInvalid)
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, s.mac(approvalID, action, expiresAt)) {
+		return ErrActionContextInvalid
+	}
+
+	if now.UnixMilli() > expiresAt {
+		return ErrActionContextExpired
+	}
+
+	return nil
+}
+
+// sign returns the hex-encoded HMAC for a button context
+func (s *ActionSigner) sign(approvalID, action string, expiresAt int64) string {
+	return hex.EncodeToString(s.mac(approvalID, action, expiresAt))
+}
+
+// mac computes the HMAC over every field the action endpoint trusts
+func (s *ActionSigner) mac(approvalID, action string, expiresAt int64) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(h, "%s|%s|%d", approvalID, action, expiresAt)
+	return h.Sum(nil)
+}
+
+// contextMillis reads a unix millisecond timestamp from a context value.
+// Contexts round-trip through JSON, so numbers usually arrive as float64.
+func contextMillis(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, false
+		}
+		return int64(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
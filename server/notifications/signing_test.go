@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSigner signs button contexts in notification tests
+var testSigner = NewActionSigner([]byte("test-action-signing-secret"))
+
+func TestActionSigner(t *testing.T) {
+	now := time.UnixMilli(1704988800000)
+
+	// roundTrip mimics Mattermost echoing the context back as JSON
+	roundTrip := func(t *testing.T, context map[string]any) map[string]any {
+		t.Helper()
+		data, err := json.Marshal(context)
+		require.NoError(t, err)
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		return decoded
+	}
+
+	t.Run("signed context verifies after JSON round-trip", func(t *testing.T) {
+		context := roundTrip(t, testSigner.SignedContext("record123", "approve", now))
+
+		assert.Equal(t, "record123", context["approval_id"])
+		assert.Equal(t, "approve", context["action"])
+		assert.NotEmpty(t, context["signature"])
+		assert.NoError(t, testSigner.Verify(context, now.Add(time.Minute)))
+	})
+
+	t.Run("expired context is rejected", func(t *testing.T) {
+		context := roundTrip(t, testSigner.SignedContext("record123", "approve", now))
+
+		err := testSigner.Verify(context, now.Add(ActionContextTTL+time.Second))
+		assert.ErrorIs(t, err, ErrActionContextExpired)
+	})
+
+	tests := []struct {
+		name   string
+		mutate func(context map[string]any)
+	}{
+		{
+			name:   "tampered action",
+			mutate: func(context map[string]any) { context["action"] = "deny" },
+		},
+		{
+			name:   "tampered approval ID",
+			mutate: func(context map[string]any) { context["approval_id"] = "record999" },
+		},
+		{
+			name: "extended expiry",
+			mutate: func(context map[string]any) {
+				context["expires_at"] = float64(now.Add(365 * 24 * time.Hour).UnixMilli())
+			},
+		},
+		{
+			name:   "missing signature",
+			mutate: func(context map[string]any) { delete(context, "signature") },
+		},
+		{
+			name:   "non-hex signature",
+			mutate: func(context map[string]any) { context["signature"] = "not-hex" },
+		},
+		{
+			name:   "missing expiry",
+			mutate: func(context map[string]any) { delete(context, "expires_at") },
+		},
+		{
+			name:   "fractional expiry",
+			mutate: func(context map[string]any) { context["expires_at"] = 1.5 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" is rejected", func(t *testing.T) {
+			context := roundTrip(t, testSigner.SignedContext("record123", "approve", now))
+			tt.mutate(context)
+
+			err := testSigner.Verify(context, now)
+			assert.ErrorIs(t, err, ErrActionContextInvalid)
+		})
+	}
+
+	t.Run("legacy unsigned context is rejected", func(t *testing.T) {
+		err := testSigner.Verify(map[string]any{"approval_id": "record123", "action": "approve"}, now)
+		assert.ErrorIs(t, err, ErrActionContextInvalid)
+		assert.ErrorIs(t, err, ErrActionContextUnsigned)
+	})
+
+	t.Run("context signed with another secret is rejected", func(t *testing.T) {
+		other := NewActionSigner([]byte("other-secret"))
+		context := roundTrip(t, other.SignedContext("record123", "approve", now))
+
+		assert.ErrorIs(t, testSigner.Verify(context, now), ErrActionContextInvalid)
+	})
+}
@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// SendSLABreachDM tells an approver that a pending request is past its SLA target, as a reply
+// in the approver's thread for the request.
+//
+// IMPORTANT: Best effort only (Architecture Decision 2.2). Callers log failures and continue.
+func SendSLABreachDM(api plugin.API, botUserID string, record *approval.ApprovalRecord, approverID string, now time.Time) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+	if approverID == "" {
+		return "", fmt.Errorf("approver ID is empty")
+	}
+
+	locale := record.LocaleFor(approverID)
+	requested := i18n.FormatTimeWithAge(locale, record.CreatedAt, "2006-01-02 15:04:05 MST", record.TimezoneFor(approverID), now)
+
+	message := i18n.T(locale, "dm.sla_breach",
+		record.Code,
+		record.RequesterUsername,
+		record.RequesterDisplayName,
+		requested,
+		approval.FormatSLATarget(record.SLAMinutes),
+		record.Description)
+
+	channelID, err := GetDMChannelID(api, botUserID, approverID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DM channel for approver %s: %w", approverID, err)
+	}
+
+	createdPost, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: channelID,
+		RootId:    approverThreadRootID(record, approverID),
+		Message:   message,
+	})
+	if appErr != nil {
+		return "", fmt.Errorf("failed to send SLA breach alert to approver %s: %w", approverID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
+
+// PostSLAEscalation posts an SLA breach to the escalation channel snapshotted on the record.
+// Records without an escalation channel are ignored.
+//
+// IMPORTANT: Best effort only (Architecture Decision 2.2). Callers log failures and continue.
+func PostSLAEscalation(api plugin.API, botUserID string, record *approval.ApprovalRecord, now time.Time) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+	if record.SLAEscalationChannelID == "" {
+		return "", nil
+	}
+
+	createdPost, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: record.SLAEscalationChannelID,
+		Message:   FormatSLAEscalation(record, now),
+	})
+	if appErr != nil {
+		return "", fmt.Errorf("failed to post SLA escalation to channel %s: %w", record.SLAEscalationChannelID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
+
+// FormatSLAEscalation renders the escalation channel post for a breached request.
+// Like the channel status card it is read by the whole channel, so it is rendered in the
+// default locale and private requests omit their description.
+func FormatSLAEscalation(record *approval.ApprovalRecord, now time.Time) string {
+	var post strings.Builder
+	post.WriteString(fmt.Sprintf("🚨 **Approval SLA Breached** `%s`\n\n", record.Code))
+	post.WriteString(fmt.Sprintf("**Requester:** @%s\n", record.RequesterUsername))
+	post.WriteString(fmt.Sprintf("**Approver:** %s\n", formatCardApprover(record, i18n.DefaultLocale)))
+	post.WriteString(fmt.Sprintf("**SLA:** %s (due %s)\n", approval.FormatSLATarget(record.SLAMinutes), formatCardTime(record.SLADeadline())))
+	post.WriteString(fmt.Sprintf("**Requested:** %s (%s)",
+		formatCardTime(record.CreatedAt), i18n.RelativeAge(i18n.DefaultLocale, time.UnixMilli(record.CreatedAt), now)))
+
+	if !record.Private {
+		post.WriteString(fmt.Sprintf("\n\n**Description:**\n%s", record.Description))
+	}
+
+	return post.String()
+}
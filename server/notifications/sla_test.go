@@ -0,0 +1,193 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSLABreachDM(t *testing.T) {
+	now := time.UnixMilli(1704988800000).Add(25 * time.Minute)
+
+	t.Run("replies in the approver thread", func(t *testing.T) {
+		api := &plugintest.API{}
+		var captured *model.Post
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			captured = post
+			return true
+		})).Return(&model.Post{Id: "alert123"}, nil)
+
+		postID, err := SendSLABreachDM(api, "bot123", &approval.ApprovalRecord{
+			Code:                 "A-X7K9Q2",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Carter",
+			Description:          "Deploy hotfix to production",
+			CreatedAt:            1704988800000, // 2024-01-11 16:00:00 UTC,
+			NotificationPostID:   "post_bob",
+			SLAMinutes:           20,
+		}, "bob123", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "alert123", postID)
+		assert.Equal(t, "dm_bob", captured.ChannelId)
+		assert.Equal(t, "post_bob", captured.RootId)
+		assert.Contains(t, captured.Message, "🚨 **Approval SLA Breached**")
+		assert.Contains(t, captured.Message, "`A-X7K9Q2`")
+		assert.Contains(t, captured.Message, "@alice (Alice Carter)")
+		assert.Contains(t, captured.Message, "20m")
+		assert.Contains(t, captured.Message, "Deploy hotfix to production")
+	})
+
+	t.Run("localized for the approver", func(t *testing.T) {
+		api := &plugintest.API{}
+		var captured *model.Post
+		record := &approval.ApprovalRecord{
+			Code:              "A-X7K9Q2",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			ApproverLocale:    "de",
+			CreatedAt:         1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:        20,
+		}
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			captured = post
+			return true
+		})).Return(&model.Post{Id: "alert123"}, nil)
+
+		_, err := SendSLABreachDM(api, "bot123", record, "bob123", now)
+
+		require.NoError(t, err)
+		assert.NotContains(t, captured.Message, "Approval SLA Breached")
+		assert.Contains(t, captured.Message, "`A-X7K9Q2`")
+	})
+
+	t.Run("DM channel failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "bob123").Return(nil, &model.AppError{Message: "DMs disabled"})
+
+		_, err := SendSLABreachDM(api, "bot123", &approval.ApprovalRecord{Code: "A-X7K9Q2", SLAMinutes: 20}, "bob123", now)
+
+		assert.Error(t, err)
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("missing bot user", func(t *testing.T) {
+		api := &plugintest.API{}
+
+		_, err := SendSLABreachDM(api, "", &approval.ApprovalRecord{Code: "A-X7K9Q2", SLAMinutes: 20}, "bob123", now)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPostSLAEscalation(t *testing.T) {
+	now := time.UnixMilli(1704988800000).Add(25 * time.Minute)
+
+	t.Run("posts to the escalation channel", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.UserId == "bot123" && post.ChannelId == "escalation123" && post.RootId == ""
+		})).Return(&model.Post{Id: "escalation_post"}, nil)
+
+		postID, err := PostSLAEscalation(api, "bot123", &approval.ApprovalRecord{
+			Code:                   "A-X7K9Q2",
+			CreatedAt:              1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:             20,
+			SLAEscalationChannelID: "escalation123",
+		}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "escalation_post", postID)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("no escalation channel", func(t *testing.T) {
+		api := &plugintest.API{}
+		record := &approval.ApprovalRecord{
+			Code:                   "A-X7K9Q2",
+			CreatedAt:              1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:             20,
+			SLAEscalationChannelID: "",
+		}
+
+		postID, err := PostSLAEscalation(api, "bot123", record, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, postID)
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("create post failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("CreatePost", mock.Anything).Return(nil, &model.AppError{Message: "channel archived"})
+
+		_, err := PostSLAEscalation(api, "bot123", &approval.ApprovalRecord{
+			Code:                   "A-X7K9Q2",
+			CreatedAt:              1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:             20,
+			SLAEscalationChannelID: "escalation123",
+		}, now)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatSLAEscalation(t *testing.T) {
+	now := time.UnixMilli(1704988800000).Add(25 * time.Minute)
+
+	t.Run("includes the SLA and deadline", func(t *testing.T) {
+		post := FormatSLAEscalation(&approval.ApprovalRecord{
+			Code:              "A-X7K9Q2",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			ApproverUsername:  "bob",
+			Description:       "Deploy hotfix to production",
+			CreatedAt:         1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:        20,
+		}, now)
+
+		assert.Contains(t, post, "🚨 **Approval SLA Breached** `A-X7K9Q2`")
+		assert.Contains(t, post, "**Requester:** @alice")
+		assert.Contains(t, post, "**Approver:** @bob")
+		assert.Contains(t, post, "**SLA:** 20m (due Jan 11, 2024 4:20 PM UTC)")
+		assert.Contains(t, post, "(25 min ago)")
+		assert.Contains(t, post, "Deploy hotfix to production")
+	})
+
+	t.Run("private requests omit the description", func(t *testing.T) {
+		record := &approval.ApprovalRecord{
+			Code:        "A-X7K9Q2",
+			Description: "Deploy hotfix to production",
+			Private:     true,
+			CreatedAt:   1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:  20,
+		}
+
+		post := FormatSLAEscalation(record, now)
+
+		assert.NotContains(t, post, "Deploy hotfix to production")
+	})
+
+	t.Run("undecided group requests name the group", func(t *testing.T) {
+		record := &approval.ApprovalRecord{
+			Code:                 "A-X7K9Q2",
+			Status:               approval.StatusPending,
+			ApproverGroupName:    "sre",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			CreatedAt:            1704988800000, // 2024-01-11 16:00:00 UTC,
+			SLAMinutes:           20,
+		}
+
+		post := FormatSLAEscalation(record, now)
+
+		assert.Contains(t, post, "**Approver:** "+record.ApproverGroupLabel())
+	})
+}
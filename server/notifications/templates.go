@@ -0,0 +1,173 @@
+package notifications
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// Notification kinds whose DM text admins can override with a message template
+const (
+	TemplateRequest      = "request"      // Approval request DM to each approver
+	TemplateOutcome      = "outcome"      // Approved/denied DM to the requester
+	TemplateCanceled     = "canceled"     // Cancellation DMs to the approvers and the requester
+	TemplateTimeout      = "timeout"      // Auto-cancellation DM to the requester
+	TemplateVerification = "verification" // Verified-completion DM to the approver
+)
+
+// TemplateKinds lists the overridable notification kinds in settings order
+var TemplateKinds = []string{TemplateRequest, TemplateOutcome, TemplateCanceled, TemplateTimeout, TemplateVerification}
+
+// Recipient roles exposed to message templates as .Recipient
+const (
+	RecipientApprover  = "approver"
+	RecipientRequester = "requester"
+)
+
+// TemplateData is the data a message template is executed with
+type TemplateData struct {
+	Record    *approval.ApprovalRecord // The request the notification is about
+	Recipient string                   // "approver" or "requester"
+	Time      string                   // Event time as shown in the built-in message, in the recipient's timezone; empty for timeouts
+	Message   string                   // The built-in message, for templates that only add wording around it
+}
+
+// MessageTemplates holds the admin-configured overrides for notification DM text.
+// Kinds without a template use the built-in message. Safe for concurrent use.
+type MessageTemplates struct {
+	templates map[string]*template.Template
+}
+
+// activeTemplates is the set installed by SetMessageTemplates; nil uses the built-in messages
+var activeTemplates atomic.Pointer[MessageTemplates]
+
+// NewMessageTemplates parses the template sources keyed by notification kind and validates each
+// by rendering it against a sample record. Blank sources are skipped. Templates that fail to
+// parse, fail to render or render to blank text are left out, so their kind keeps the built-in
+// message, and reported together in the returned error.
+func NewMessageTemplates(sources map[string]string) (*MessageTemplates, error) {
+	templates := &MessageTemplates{templates: map[string]*template.Template{}}
+
+	var errs []error
+	for _, kind := range TemplateKinds {
+		source := sources[kind]
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+
+		tmpl, err := template.New(kind).Option("missingkey=error").Parse(source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s template: %w", kind, err))
+			continue
+		}
+		if _, err := execute(tmpl, sampleTemplateData(kind)); err != nil {
+			errs = append(errs, fmt.Errorf("%s template: %w", kind, err))
+			continue
+		}
+		templates.templates[kind] = tmpl
+	}
+
+	for kind := range sources {
+		if !slices.Contains(TemplateKinds, kind) {
+			errs = append(errs, fmt.Errorf("unknown notification kind %q", kind))
+		}
+	}
+
+	return templates, errors.Join(errs...)
+}
+
+// SetMessageTemplates installs the templates used for all subsequent notification DMs.
+// Passing nil restores the built-in messages.
+func SetMessageTemplates(templates *MessageTemplates) {
+	activeTemplates.Store(templates)
+}
+
+// Has reports whether kind has a template override
+func (t *MessageTemplates) Has(kind string) bool {
+	return t != nil && t.templates[kind] != nil
+}
+
+// applyMessageTemplate renders the installed template for kind, returning data.Message (the
+// built-in message) when there is none or when it fails on this record
+func applyMessageTemplate(api plugin.API, kind string, data TemplateData) string {
+	templates := activeTemplates.Load()
+	if !templates.Has(kind) {
+		return data.Message
+	}
+
+	message, err := execute(templates.templates[kind], data)
+	if err != nil {
+		api.LogWarn("Notification message template failed; using the built-in message",
+			"kind", kind,
+			"approval_id", data.Record.ID,
+			"error", err.Error(),
+		)
+		return data.Message
+	}
+	return message
+}
+
+// execute renders a message template, rejecting output that would be an empty post
+func execute(tmpl *template.Template, data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(buf.String()) == "" {
+		return "", errors.New("template rendered an empty message")
+	}
+	return buf.String(), nil
+}
+
+// sampleTemplateData builds the data templates are validated against: a record with every
+// field populated, so templates may reference any of them
+func sampleTemplateData(kind string) TemplateData {
+	record := &approval.ApprovalRecord{
+		ID:                   "sampleapprovalrecordid0000",
+		Code:                 "A-X7K9Q2",
+		RequesterID:          "samplerequesterid000000000",
+		RequesterUsername:    "alice",
+		RequesterDisplayName: "Alice Carter",
+		ApproverID:           "sampleapproverid0000000000",
+		ApproverUsername:     "bob",
+		ApproverDisplayName:  "Bob Stone",
+		Description:          "Deploy hotfix 2.4.1 to production",
+		TemplateName:         "deploy",
+		CustomFields: []approval.CustomFieldValue{
+			{Name: "environment", Label: "Environment", Value: "production"},
+		},
+		Status:              approval.StatusApproved,
+		DecisionComment:     "Go ahead",
+		CreatedAt:           1704974400000,
+		DecidedAt:           1704975120000,
+		CanceledReason:      "No longer needed",
+		CanceledDetails:     "Fixed by the previous release",
+		CanceledAt:          1704975120000,
+		Verified:            true,
+		VerifiedAt:          1704978720000,
+		VerificationComment: "Deployed and monitored",
+		PreviousCode:        "A-B2C3D4",
+	}
+
+	data := TemplateData{
+		Record:    record,
+		Recipient: RecipientApprover,
+		Time:      "2024-01-11 12:00:00 UTC",
+		Message:   "Sample built-in message",
+	}
+	switch kind {
+	case TemplateOutcome:
+		data.Recipient = RecipientRequester
+	case TemplateTimeout:
+		data.Recipient = RecipientRequester
+		data.Time = ""
+	}
+	return data
+}
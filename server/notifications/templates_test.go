@@ -0,0 +1,174 @@
+package notifications
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewMessageTemplates(t *testing.T) {
+	tests := []struct {
+		name      string
+		sources   map[string]string
+		wantKinds []string
+		wantErr   string
+	}{
+		{
+			name:    "no sources",
+			sources: nil,
+		},
+		{
+			name:    "blank sources are skipped",
+			sources: map[string]string{TemplateRequest: "", TemplateOutcome: "  \n"},
+		},
+		{
+			name: "valid templates for every kind",
+			sources: map[string]string{
+				TemplateRequest:      "{{.Message}}\n\n_Legal footer_",
+				TemplateOutcome:      "Request {{.Record.Code}} was {{.Record.Status}} at {{.Time}}",
+				TemplateCanceled:     "{{if eq .Recipient \"requester\"}}Your{{else}}A{{end}} request was canceled: {{.Record.CanceledReason}}",
+				TemplateTimeout:      "{{.Record.Code}} timed out",
+				TemplateVerification: "{{range .Record.CustomFields}}{{.Label}}={{.Value}} {{end}}verified",
+			},
+			wantKinds: []string{TemplateRequest, TemplateOutcome, TemplateCanceled, TemplateTimeout, TemplateVerification},
+		},
+		{
+			name:      "parse error keeps the other kinds",
+			sources:   map[string]string{TemplateRequest: "{{.Message", TemplateOutcome: "{{.Message}}"},
+			wantKinds: []string{TemplateOutcome},
+			wantErr:   "request template:",
+		},
+		{
+			name:    "unknown field fails the sample render",
+			sources: map[string]string{TemplateOutcome: "{{.Record.Nope}}"},
+			wantErr: "outcome template:",
+		},
+		{
+			name:    "blank output is rejected",
+			sources: map[string]string{TemplateTimeout: "{{if false}}x{{end}}  "},
+			wantErr: "template rendered an empty message",
+		},
+		{
+			name:    "unknown kind",
+			sources: map[string]string{"reminder": "{{.Message}}"},
+			wantErr: `unknown notification kind "reminder"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, err := NewMessageTemplates(tt.sources)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+			for _, kind := range TemplateKinds {
+				assert.Equal(t, slices.Contains(tt.wantKinds, kind), templates.Has(kind), kind)
+			}
+		})
+	}
+}
+
+func TestMessageTemplatesApplied(t *testing.T) {
+	templates, err := NewMessageTemplates(map[string]string{
+		TemplateRequest:      "{{.Message}}\n\n_Recorded for compliance._",
+		TemplateOutcome:      "{{.Record.Code}} {{.Record.Status}} by @{{.Record.ApproverUsername}} at {{.Time}}",
+		TemplateCanceled:     "{{.Record.Code}} canceled for the {{.Recipient}} at {{.Time}}",
+		TemplateTimeout:      "{{.Record.Code}} timed out",
+		TemplateVerification: "{{.Record.Code}} verified by @{{.Record.RequesterUsername}}",
+	})
+	assert.NoError(t, err)
+	SetMessageTemplates(templates)
+	t.Cleanup(func() { SetMessageTemplates(nil) })
+
+	record := &approval.ApprovalRecord{
+		ID:                "record123",
+		Code:              "A-X7K9Q2",
+		RequesterID:       "requester789",
+		RequesterUsername: "alice",
+		ApproverID:        "approver456",
+		ApproverUsername:  "bob",
+		Description:       "Deploy hotfix",
+		Status:            approval.StatusApproved,
+		CreatedAt:         1704974400000, // 2024-01-11 12:00:00 UTC
+		DecidedAt:         1704976200000, // 2024-01-11 12:30:00 UTC
+		CanceledReason:    "No longer needed",
+		CanceledAt:        1704976200000,
+		VerifiedAt:        1704976200000,
+	}
+
+	var posts []*model.Post
+	api := &plugintest.API{}
+	api.On("GetDirectChannel", "bot123", mock.Anything).Return(&model.Channel{Id: "dm"}, nil)
+	api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+		posts = append(posts, post)
+		return true
+	})).Return(&model.Post{Id: "post_123"}, nil)
+
+	_, err = SendApprovalRequestDM(api, "bot123", testSigner, record)
+	assert.NoError(t, err)
+	_, err = SendOutcomeNotificationDM(api, "bot123", record)
+	assert.NoError(t, err)
+	_, err = SendCancellationNotificationDM(api, "bot123", record, "alice")
+	assert.NoError(t, err)
+	_, err = SendRequesterCancellationNotificationDM(api, "bot123", record)
+	assert.NoError(t, err)
+	_, err = SendTimeoutNotificationDM(api, "bot123", record)
+	assert.NoError(t, err)
+	_, err = SendVerificationNotificationDM(api, "bot123", record)
+	assert.NoError(t, err)
+
+	if assert.Len(t, posts, 6) {
+		assert.Contains(t, posts[0].Message, "**Request ID:** `A-X7K9Q2`")
+		assert.Contains(t, posts[0].Message, "\n\n_Recorded for compliance._")
+		assert.NotEmpty(t, posts[0].Props["attachments"], "buttons are kept")
+		assert.Equal(t, "A-X7K9Q2 approved by @bob at 2024-01-11 12:30:00 UTC", posts[1].Message)
+		assert.Equal(t, "A-X7K9Q2 canceled for the approver at Jan 11, 2024 12:30 PM UTC", posts[2].Message)
+		assert.Equal(t, "A-X7K9Q2 canceled for the requester at Jan 11, 2024 12:30 PM UTC", posts[3].Message)
+		assert.Equal(t, "A-X7K9Q2 timed out", posts[4].Message)
+		assert.Equal(t, "A-X7K9Q2 verified by @alice", posts[5].Message)
+	}
+}
+
+func TestMessageTemplateFallsBackOnRenderError(t *testing.T) {
+	// Valid against the sample record, which has a custom field, but fails on a record without one
+	templates, err := NewMessageTemplates(map[string]string{
+		TemplateTimeout: "{{(index .Record.CustomFields 0).Value}}",
+	})
+	assert.NoError(t, err)
+	SetMessageTemplates(templates)
+	t.Cleanup(func() { SetMessageTemplates(nil) })
+
+	record := &approval.ApprovalRecord{
+		ID:                "record123",
+		Code:              "A-X7K9Q2",
+		RequesterID:       "requester789",
+		ApproverUsername:  "bob",
+		Description:       "Deploy hotfix",
+		RequesterUsername: "alice",
+	}
+
+	var message string
+	api := &plugintest.API{}
+	api.On("GetDirectChannel", "bot123", "requester789").Return(&model.Channel{Id: "dm"}, nil)
+	api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+		message = post.Message
+		return true
+	})).Return(&model.Post{Id: "post_123"}, nil)
+	api.On("LogWarn", "Notification message template failed; using the built-in message",
+		"kind", TemplateTimeout, "approval_id", "record123", "error", mock.Anything).Once()
+
+	_, err = SendTimeoutNotificationDM(api, "bot123", record)
+
+	assert.NoError(t, err)
+	assert.Contains(t, message, "A-X7K9Q2")
+	assert.Contains(t, message, "Deploy hotfix")
+	api.AssertExpectations(t)
+}
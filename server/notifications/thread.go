@@ -0,0 +1,146 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// Bot DMs about one approval are threaded: the approver's request DM (NotificationPostID, or the
+// member's CandidatePostIDs entry for group approvals) and the requester's confirmation DM
+// (RequesterPostID) are the thread roots. Records created before threading have no requester
+// root, so their DMs stay top-level.
+
+// SendRequesterConfirmationDM sends the requester a DM confirming the request was sent.
+// The post roots the requester's thread for outcome, cancellation, timeout and comment DMs.
+// Returns the post ID (caller stores it as RequesterPostID).
+func SendRequesterConfirmationDM(api plugin.API, botUserID string, record *approval.ApprovalRecord) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+	if record.RequesterID == "" {
+		return "", fmt.Errorf("requester ID is empty")
+	}
+
+	channelID, err := GetDMChannelID(api, botUserID, record.RequesterID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DM channel for requester %s: %w", record.RequesterID, err)
+	}
+
+	locale := record.RequesterLocale
+	approver := "@" + record.ApproverUsername
+	if record.IsGroupApproval() {
+		approver = record.ApproverGroupLabelIn(locale)
+	}
+
+	message := i18n.T(locale, "dm.confirmation",
+		record.Code,
+		approver,
+		record.Description)
+	message += formatCustomFields(record, locale)
+	message += i18n.T(locale, "dm.confirmation.footer", record.Code)
+
+	createdPost, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: channelID,
+		Message:   message,
+	})
+	if appErr != nil {
+		return "", fmt.Errorf("failed to send confirmation DM to requester %s: %w", record.RequesterID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
+
+// SendCommentDM relays a comment to the other party of an approval as a reply in their thread:
+// requester comments go to the approver (every candidate while a group request is pending),
+// approver comments go to the requester. Returns the first delivered post ID.
+func SendCommentDM(api plugin.API, botUserID string, record *approval.ApprovalRecord, comment approval.ApprovalComment) (string, error) {
+	if botUserID == "" {
+		return "", fmt.Errorf("bot user ID not available")
+	}
+	if record == nil {
+		return "", fmt.Errorf("approval record is nil")
+	}
+
+	type recipient struct{ userID, rootID string }
+	var recipients []recipient
+	if comment.UserID == record.RequesterID {
+		approverIDs := []string{record.ApproverID}
+		if record.IsGroupApproval() && record.Status == approval.StatusPending {
+			approverIDs = record.ApproverRecipientIDs()
+		}
+		for _, approverID := range approverIDs {
+			if approverID != "" {
+				recipients = append(recipients, recipient{approverID, approverThreadRootID(record, approverID)})
+			}
+		}
+	} else {
+		recipients = append(recipients, recipient{record.RequesterID, record.RequesterPostID})
+	}
+	if len(recipients) == 0 {
+		return "", fmt.Errorf("no recipient for comment on approval %s", record.Code)
+	}
+
+	var firstPostID string
+	var firstErr error
+	for _, r := range recipients {
+		message := i18n.T(record.LocaleFor(r.userID), "dm.comment",
+			comment.Username,
+			record.Code,
+			comment.Message,
+			record.Code)
+		postID, err := sendThreadPost(api, botUserID, r.userID, r.rootID, message)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if firstPostID == "" {
+			firstPostID = postID
+		}
+	}
+
+	if firstPostID == "" {
+		return "", firstErr
+	}
+	return firstPostID, nil
+}
+
+// sendThreadPost sends a DM to the user, as a reply to rootID when set
+func sendThreadPost(api plugin.API, botUserID, userID, rootID, message string) (string, error) {
+	channelID, err := GetDMChannelID(api, botUserID, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DM channel for user %s: %w", userID, err)
+	}
+
+	createdPost, appErr := api.CreatePost(&model.Post{
+		UserId:    botUserID,
+		ChannelId: channelID,
+		RootId:    rootID,
+		Message:   message,
+	})
+	if appErr != nil {
+		return "", fmt.Errorf("failed to send comment to user %s: %w", userID, appErr)
+	}
+
+	return createdPost.Id, nil
+}
+
+// approverThreadRootID returns the approval request DM that roots the approver's thread
+func approverThreadRootID(record *approval.ApprovalRecord, approverID string) string {
+	if postID := record.CandidatePostIDs[approverID]; postID != "" {
+		return postID
+	}
+	if record.IsGroupApproval() {
+		return ""
+	}
+	return record.NotificationPostID
+}
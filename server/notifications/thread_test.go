@@ -0,0 +1,361 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// capturePosts records every CreatePost call and returns a post ID per DM channel
+func capturePosts(api *plugintest.API) *[]*model.Post {
+	posts := &[]*model.Post{}
+	api.On("GetDirectChannel", "bot123", mock.AnythingOfType("string")).Return(func(_, userID string) *model.Channel {
+		return &model.Channel{Id: "dm_" + userID}
+	}, nil)
+	api.On("CreatePost", mock.Anything).Return(func(post *model.Post) *model.Post {
+		*posts = append(*posts, post)
+		return &model.Post{Id: "post_" + post.ChannelId}
+	}, nil)
+	return posts
+}
+
+func TestSendRequesterConfirmationDM(t *testing.T) {
+	t.Run("sends a top-level confirmation to the requester", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-THRD01",
+			RequesterID:       "requester123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Rotate the production TLS certificate",
+			Status:            approval.StatusPending,
+			CreatedAt:         1704988800000,
+		}
+		postID, err := SendRequesterConfirmationDM(api, "bot123", record)
+
+		require.NoError(t, err)
+		assert.Equal(t, "post_dm_requester123", postID)
+		require.Len(t, *posts, 1)
+		post := (*posts)[0]
+		assert.Empty(t, post.RootId, "the confirmation is the thread root")
+		assert.Contains(t, post.Message, "📨 **Approval Request Sent**")
+		assert.Contains(t, post.Message, "**Request ID:** `A-THRD01`")
+		assert.Contains(t, post.Message, "**Approver:** @bob")
+		assert.Contains(t, post.Message, "`/approve comment A-THRD01 <text>`")
+	})
+
+	t.Run("group request names the group", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-THRD01",
+			RequesterID:          "requester123",
+			RequesterUsername:    "alice",
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			Description:          "Rotate the production TLS certificate",
+			Status:               approval.StatusPending,
+			CreatedAt:            1704988800000,
+		}
+		_, err := SendRequesterConfirmationDM(api, "bot123", record)
+
+		require.NoError(t, err)
+		assert.Contains(t, (*posts)[0].Message, "**Approver:** @sre-oncall")
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		_, err := SendRequesterConfirmationDM(&plugintest.API{}, "", &approval.ApprovalRecord{Code: "A-THRD01", RequesterID: "requester123"})
+		assert.ErrorContains(t, err, "bot user ID not available")
+
+		_, err = SendRequesterConfirmationDM(&plugintest.API{}, "bot123", nil)
+		assert.ErrorContains(t, err, "approval record is nil")
+
+		_, err = SendRequesterConfirmationDM(&plugintest.API{}, "bot123", &approval.ApprovalRecord{Code: "A-THRD01"})
+		assert.ErrorContains(t, err, "requester ID is empty")
+	})
+
+	t.Run("CreatePost error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_requester123"}, nil)
+		api.On("CreatePost", mock.Anything).Return(nil, &model.AppError{Message: "blocked"})
+
+		_, err := SendRequesterConfirmationDM(api, "bot123", &approval.ApprovalRecord{
+			Code:             "A-THRD01",
+			RequesterID:      "requester123",
+			ApproverUsername: "bob",
+			Status:           approval.StatusPending,
+		})
+
+		assert.ErrorContains(t, err, "failed to send confirmation DM to requester requester123")
+	})
+}
+
+func TestSendCommentDM(t *testing.T) {
+	requesterComment := approval.ApprovalComment{UserID: "requester123", Username: "alice", Message: "It is the EU cluster"}
+	approverComment := approval.ApprovalComment{UserID: "approver456", Username: "bob", Message: "Which cluster?"}
+
+	t.Run("requester comment is relayed in the approver thread", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			Code:               "A-THRD01",
+			RequesterID:        "requester123",
+			ApproverID:         "approver456",
+			Status:             approval.StatusPending,
+			NotificationPostID: "approver_root",
+			RequesterPostID:    "requester_root",
+		}
+
+		postID, err := SendCommentDM(api, "bot123", record, requesterComment)
+
+		require.NoError(t, err)
+		assert.Equal(t, "post_dm_approver456", postID)
+		require.Len(t, *posts, 1)
+		assert.Equal(t, "dm_approver456", (*posts)[0].ChannelId)
+		assert.Equal(t, "approver_root", (*posts)[0].RootId)
+		assert.Contains(t, (*posts)[0].Message, "💬 **Comment from @alice** on `A-THRD01`")
+		assert.Contains(t, (*posts)[0].Message, "It is the EU cluster")
+	})
+
+	t.Run("approver comment is relayed in the requester thread", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			Code:               "A-THRD01",
+			RequesterID:        "requester123",
+			ApproverID:         "approver456",
+			Status:             approval.StatusPending,
+			NotificationPostID: "approver_root",
+			RequesterPostID:    "requester_root",
+		}
+
+		_, err := SendCommentDM(api, "bot123", record, approverComment)
+
+		require.NoError(t, err)
+		require.Len(t, *posts, 1)
+		assert.Equal(t, "dm_requester123", (*posts)[0].ChannelId)
+		assert.Equal(t, "requester_root", (*posts)[0].RootId)
+	})
+
+	t.Run("requester comment reaches every member of a pending group", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			Code:                 "A-THRD01",
+			RequesterID:          "requester123",
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			CandidatePostIDs:     map[string]string{"bob123": "root_bob", "carol123": "root_carol"},
+			Status:               approval.StatusPending,
+			RequesterPostID:      "requester_root",
+		}
+
+		_, err := SendCommentDM(api, "bot123", record, requesterComment)
+
+		require.NoError(t, err)
+		require.Len(t, *posts, 2)
+		assert.Equal(t, "root_bob", (*posts)[0].RootId)
+		assert.Equal(t, "root_carol", (*posts)[1].RootId)
+	})
+
+	t.Run("decided group request goes to the deciding member only", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			Code:                 "A-THRD01",
+			RequesterID:          "requester123",
+			ApproverID:           "carol123",
+			ApproverGroupName:    "sre-oncall",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			CandidatePostIDs:     map[string]string{"bob123": "root_bob", "carol123": "root_carol"},
+			Status:               approval.StatusApproved,
+			RequesterPostID:      "requester_root",
+		}
+
+		_, err := SendCommentDM(api, "bot123", record, requesterComment)
+
+		require.NoError(t, err)
+		require.Len(t, *posts, 1)
+		assert.Equal(t, "dm_carol123", (*posts)[0].ChannelId)
+		assert.Equal(t, "root_carol", (*posts)[0].RootId)
+	})
+
+	t.Run("records without a requester root post top-level", func(t *testing.T) {
+		api := &plugintest.API{}
+		posts := capturePosts(api)
+
+		record := &approval.ApprovalRecord{
+			Code:               "A-THRD01",
+			RequesterID:        "requester123",
+			ApproverID:         "approver456",
+			Status:             approval.StatusPending,
+			NotificationPostID: "approver_root",
+		}
+		_, err := SendCommentDM(api, "bot123", record, approverComment)
+
+		require.NoError(t, err)
+		assert.Empty(t, (*posts)[0].RootId)
+	})
+
+	t.Run("delivery failure is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetDirectChannel", "bot123", "approver456").Return(nil, &model.AppError{Message: "DMs disabled"})
+
+		record := &approval.ApprovalRecord{Code: "A-THRD01", RequesterID: "requester123", ApproverID: "approver456", Status: approval.StatusPending}
+
+		_, err := SendCommentDM(api, "bot123", record, requesterComment)
+
+		assert.ErrorContains(t, err, "failed to get DM channel for user approver456")
+	})
+}
+
+func TestThreadedNotifications(t *testing.T) {
+	tests := []struct {
+		name       string
+		record     *approval.ApprovalRecord
+		send       func(api *plugintest.API, record *approval.ApprovalRecord) error
+		wantRootID string
+	}{
+		{
+			name: "outcome replies to the requester root",
+			record: &approval.ApprovalRecord{
+				ID:                 "record123",
+				Code:               "A-THRD01",
+				RequesterID:        "requester123",
+				RequesterUsername:  "alice",
+				ApproverID:         "approver456",
+				ApproverUsername:   "bob",
+				Description:        "Rotate the production TLS certificate",
+				Status:             approval.StatusApproved,
+				CreatedAt:          1704988800000,
+				DecidedAt:          1704992400000,
+				NotificationPostID: "approver_root",
+				RequesterPostID:    "requester_root",
+			},
+			send: func(api *plugintest.API, record *approval.ApprovalRecord) error {
+				_, err := SendOutcomeNotificationDM(api, "bot123", record)
+				return err
+			},
+			wantRootID: "requester_root",
+		},
+		{
+			name: "timeout replies to the requester root",
+			record: &approval.ApprovalRecord{
+				ID:                 "record123",
+				Code:               "A-THRD01",
+				RequesterID:        "requester123",
+				RequesterUsername:  "alice",
+				ApproverID:         "approver456",
+				ApproverUsername:   "bob",
+				Description:        "Rotate the production TLS certificate",
+				Status:             approval.StatusCanceled,
+				CreatedAt:          1704988800000,
+				CanceledReason:     "Auto-canceled: No response within 30 minutes",
+				CanceledAt:         1704992400000,
+				NotificationPostID: "approver_root",
+				RequesterPostID:    "requester_root",
+			},
+			send: func(api *plugintest.API, record *approval.ApprovalRecord) error {
+				_, err := SendTimeoutNotificationDM(api, "bot123", record)
+				return err
+			},
+			wantRootID: "requester_root",
+		},
+		{
+			name: "requester cancellation confirmation replies to the requester root",
+			record: &approval.ApprovalRecord{
+				ID:                 "record123",
+				Code:               "A-THRD01",
+				RequesterID:        "requester123",
+				RequesterUsername:  "alice",
+				ApproverID:         "approver456",
+				ApproverUsername:   "bob",
+				Description:        "Rotate the production TLS certificate",
+				Status:             approval.StatusCanceled,
+				CreatedAt:          1704988800000,
+				CanceledReason:     "No longer needed",
+				CanceledAt:         1704992400000,
+				NotificationPostID: "approver_root",
+				RequesterPostID:    "requester_root",
+			},
+			send: func(api *plugintest.API, record *approval.ApprovalRecord) error {
+				_, err := SendRequesterCancellationNotificationDM(api, "bot123", record)
+				return err
+			},
+			wantRootID: "requester_root",
+		},
+		{
+			name: "approver cancellation notice replies to the approver root",
+			record: &approval.ApprovalRecord{
+				ID:                 "record123",
+				Code:               "A-THRD01",
+				RequesterID:        "requester123",
+				RequesterUsername:  "alice",
+				ApproverID:         "approver456",
+				ApproverUsername:   "bob",
+				Description:        "Rotate the production TLS certificate",
+				Status:             approval.StatusCanceled,
+				CreatedAt:          1704988800000,
+				CanceledReason:     "No longer needed",
+				CanceledAt:         1704992400000,
+				NotificationPostID: "approver_root",
+				RequesterPostID:    "requester_root",
+			},
+			send: func(api *plugintest.API, record *approval.ApprovalRecord) error {
+				_, err := SendCancellationNotificationDM(api, "bot123", record, "alice")
+				return err
+			},
+			wantRootID: "approver_root",
+		},
+		{
+			name: "verification replies to the approver root",
+			record: &approval.ApprovalRecord{
+				ID:                 "record123",
+				Code:               "A-THRD01",
+				RequesterID:        "requester123",
+				RequesterUsername:  "alice",
+				ApproverID:         "approver456",
+				ApproverUsername:   "bob",
+				Description:        "Rotate the production TLS certificate",
+				Status:             approval.StatusApproved,
+				CreatedAt:          1704988800000,
+				Verified:           true,
+				VerifiedAt:         1704996000000,
+				NotificationPostID: "approver_root",
+				RequesterPostID:    "requester_root",
+			},
+			send: func(api *plugintest.API, record *approval.ApprovalRecord) error {
+				_, err := SendVerificationNotificationDM(api, "bot123", record)
+				return err
+			},
+			wantRootID: "approver_root",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &plugintest.API{}
+			posts := capturePosts(api)
+
+			require.NoError(t, tt.send(api, tt.record))
+
+			require.Len(t, *posts, 1)
+			assert.Equal(t, tt.wantRootID, (*posts)[0].RootId)
+		})
+	}
+}
@@ -1,19 +1,44 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
 	"github.com/mattermost/mattermost-plugin-approver2/server/command"
+	"github.com/mattermost/mattermost-plugin-approver2/server/digest"
+	"github.com/mattermost/mattermost-plugin-approver2/server/i18n"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
 	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/retry"
+	"github.com/mattermost/mattermost-plugin-approver2/server/schedule"
+	"github.com/mattermost/mattermost-plugin-approver2/server/sla"
 	"github.com/mattermost/mattermost-plugin-approver2/server/store"
 	"github.com/mattermost/mattermost-plugin-approver2/server/timeout"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 )
 
+const (
+	// maxBulkDecisions caps the requests listed in one bulk decision dialog
+	maxBulkDecisions = 25
+
+	// bulkDecisionCallbackID identifies bulk decision dialog submissions
+	bulkDecisionCallbackID = "bulk_decision"
+
+	// bulkRecordPrefix prefixes the record ID in the names of the bulk dialog checkboxes
+	bulkRecordPrefix = "record_"
+
+	// maxDecisionCommentLength caps decision comments, as in the confirmation dialogs
+	maxDecisionCommentLength = 500
+)
+
+// errNoPendingDecisions is returned when the bulk decision dialog would be empty
+var errNoPendingDecisions = errors.New("no pending requests to decide")
+
 // Plugin implements the interface expected by the Mattermost server to communicate between the server and plugin processes.
 type Plugin struct {
 	plugin.MattermostPlugin
@@ -34,8 +59,26 @@ type Plugin struct {
 	// timeoutChecker periodically scans for timed-out pending requests
 	timeoutChecker *timeout.TimeoutChecker
 
+	// digestScheduler sends scheduled approval digests to opted-in users
+	digestScheduler *digest.Scheduler
+
+	// retryWorker redelivers failed approver and outcome DMs
+	retryWorker *retry.Worker
+
+	// metrics counts request lifecycle events and notification failures for /api/v1/metrics
+	metrics *metrics.Metrics
+
+	// slaMonitor alerts approvers and escalation channels when pending requests breach their SLA
+	slaMonitor *sla.Monitor
+
+	// requestScheduler sends scheduled approval requests once their send time arrives
+	requestScheduler *schedule.Scheduler
+
 	// botUserID is the ID of the bot user for sending notifications
 	botUserID string
+
+	// actionSigner signs and verifies the Approve/Deny button contexts in approver DMs
+	actionSigner *notifications.ActionSigner
 }
 
 // OnActivate is called when the plugin is activated.
@@ -56,22 +99,75 @@ func (p *Plugin) OnActivate() error {
 	// Initialize store
 	p.store = store.NewKVStore(p.API)
 
+	// Load (or create on first activation) the secret used to sign DM button contexts
+	secret, err := p.store.GetOrCreateActionSigningSecret()
+	if err != nil {
+		return fmt.Errorf("failed to load action signing secret: %w", err)
+	}
+	p.actionSigner = notifications.NewActionSigner(secret)
+
+	// Collected in memory from activation; scraped via /api/v1/metrics
+	p.metrics = metrics.New()
+
 	// Initialize approval service
 	p.service = approval.NewService(p.store, p.API, botID)
+	p.service.SetMetrics(p.metrics)
+	p.service.SetSeparationOfDuties(p.getConfiguration().separationOfDuties())
 
 	// Initialize and start timeout checker (Story 6.1)
-	p.timeoutChecker = timeout.NewChecker(p.store, p.service, p.API, botID)
+	p.timeoutChecker = timeout.NewChecker(p.store, p.service, p.API, botID, p.metrics)
 	p.timeoutChecker.Start()
 
+	// Start the digest scheduler; it reads the schedule from the live configuration on each check
+	p.digestScheduler = digest.NewScheduler(p.store, p.API, botID, func() digest.Schedule {
+		return p.getConfiguration().digestSchedule()
+	})
+	p.digestScheduler.Start()
+
+	// Start the notification retry queue; the attempt limit is read from the live configuration
+	p.retryWorker = retry.NewWorker(p.store, p.API, botID, p.actionSigner, p.metrics, func() int {
+		return p.getConfiguration().NotificationMaxRetries
+	})
+	p.retryWorker.Start()
+
+	// Start the SLA monitor; targets are snapshotted on each record when it is created
+	p.slaMonitor = sla.NewMonitor(p.store, p.API, botID, p.metrics)
+	p.slaMonitor.Start()
+
+	// Start the request scheduler; it delivers scheduled requests the way new requests are delivered
+	p.requestScheduler = schedule.NewScheduler(p.store, p.service, p.API, func(record *approval.ApprovalRecord) {
+		p.deliverApprovalRequest(p.store, record)
+	})
+	p.requestScheduler.Start()
+
 	// Register slash command
 	if err := p.registerCommand(); err != nil {
 		return fmt.Errorf("failed to register slash command: %w", err)
 	}
 
+	// Add requests created before /approve search existed to its index, once per installation
+	if built, err := p.store.SearchIndexBuilt(); err != nil {
+		p.API.LogWarn("Failed to check search index state", "error", err.Error())
+	} else if !built {
+		go p.indexExistingApprovals()
+	}
+
 	p.API.LogInfo("Mattermost Approval Workflow plugin activated successfully", "bot_user_id", botID)
 	return nil
 }
 
+// indexExistingApprovals builds the search index for records saved before it existed
+func (p *Plugin) indexExistingApprovals() {
+	indexed, err := p.store.IndexExistingApprovals()
+	if err != nil {
+		p.API.LogError("Failed to index existing approval requests for search", "indexed", indexed, "error", err.Error())
+		return
+	}
+	if indexed > 0 {
+		p.API.LogInfo("Indexed existing approval requests for search", "count", indexed)
+	}
+}
+
 // OnDeactivate is called when the plugin is deactivated.
 func (p *Plugin) OnDeactivate() error {
 	p.API.LogInfo("Deactivating Mattermost Approval Workflow plugin")
@@ -81,6 +177,22 @@ func (p *Plugin) OnDeactivate() error {
 		p.timeoutChecker.Stop()
 	}
 
+	if p.digestScheduler != nil {
+		p.digestScheduler.Stop()
+	}
+
+	if p.retryWorker != nil {
+		p.retryWorker.Stop()
+	}
+
+	if p.slaMonitor != nil {
+		p.slaMonitor.Stop()
+	}
+
+	if p.requestScheduler != nil {
+		p.requestScheduler.Stop()
+	}
+
 	p.API.LogInfo("Mattermost Approval Workflow plugin deactivated successfully")
 	return nil
 }
@@ -91,7 +203,7 @@ func (p *Plugin) registerCommand() error {
 		Trigger:          "approve",
 		AutoComplete:     true,
 		AutoCompleteDesc: "Manage approval requests",
-		AutoCompleteHint: "[new|list|get|cancel|verify|status|help]",
+		AutoCompleteHint: "[new|list|bulk|yes|no|search|get|cancel|verify|resubmit|comment|digest|stats|status|admin|help]",
 		DisplayName:      "Approval Request",
 		Description:      "Create, manage, and view approval requests",
 	}
@@ -110,24 +222,50 @@ func (p *Plugin) registerCommand() error {
 // getAutocompleteData creates rich autocomplete structure for /approve command
 // Story 7.4: Provides nested autocomplete for subcommands and arguments
 func (p *Plugin) getAutocompleteData() *model.AutocompleteData {
-	approve := model.NewAutocompleteData("approve", "[new|list|get|cancel|verify|status|help]", "Manage approval requests")
+	approve := model.NewAutocompleteData("approve", "[new|list|bulk|yes|no|search|get|cancel|verify|resubmit|comment|digest|stats|status|admin|help]", "Manage approval requests")
 
 	// New subcommand
-	new := model.NewAutocompleteData("new", "", "Create a new approval request")
+	new := model.NewAutocompleteData("new", "[template] [--group <name>|--role <role>]", "Create a new approval request")
+	new.AddTextArgument("Request template (optional)", "Template name defined by an administrator", "")
 	approve.AddCommand(new)
 
 	// List subcommand with filter autocomplete
-	list := model.NewAutocompleteData("list", "[pending|approved|denied|canceled|all]", "View your approval requests")
+	list := model.NewAutocompleteData("list", "[inbox|outbox] [pending|scheduled|approved|denied|canceled|all] [--page N] [--limit N]", "View your approval requests")
 	listFilters := []model.AutocompleteListItem{
+		{HelpText: "Requests sent to you, oldest first, with Approve and Deny buttons", Item: "inbox"},
+		{HelpText: "Requests you sent", Item: "outbox"},
 		{HelpText: "Show only pending requests", Item: "pending"},
+		{HelpText: "Show only scheduled requests that have not been sent yet", Item: "scheduled"},
 		{HelpText: "Show only approved requests", Item: "approved"},
 		{HelpText: "Show only denied requests", Item: "denied"},
 		{HelpText: "Show only canceled requests", Item: "canceled"},
 		{HelpText: "Show all requests", Item: "all"},
 	}
-	list.AddStaticListArgument("Filter requests by status", false, listFilters)
+	list.AddStaticListArgument("Filter requests by role or status", false, listFilters)
+	list.AddNamedTextArgument("page", "Page number, counted from the newest request", "N", "^[0-9]+$", false)
+	list.AddNamedTextArgument("limit", "Requests per page (default 20, up to 50)", "N", "^[0-9]+$", false)
 	approve.AddCommand(list)
 
+	// Bulk subcommand
+	bulk := model.NewAutocompleteData("bulk", "", "Approve or deny several pending requests at once")
+	approve.AddCommand(bulk)
+
+	// Yes and no subcommands decide a request without the DM buttons
+	yes := model.NewAutocompleteData("yes", "<approval-code> [comment]", "Approve a pending request")
+	yes.AddTextArgument("Approval code", "Enter the approval code (e.g., A-X7K9Q2)", "")
+	yes.AddTextArgument("Comment", "Optional comment for the requester", "")
+	approve.AddCommand(yes)
+
+	no := model.NewAutocompleteData("no", "<approval-code> [comment]", "Deny a pending request")
+	no.AddTextArgument("Approval code", "Enter the approval code (e.g., A-X7K9Q2)", "")
+	no.AddTextArgument("Comment", "Optional comment for the requester", "")
+	approve.AddCommand(no)
+
+	// Search subcommand
+	search := model.NewAutocompleteData("search", "<words> [from:@user] [to:@user] [after:YYYY-MM-DD] [before:YYYY-MM-DD] [status:STATUS]", "Search your approval requests")
+	search.AddTextArgument("Search query", "Description words and optional filters, e.g. firewall from:@alice after:2024-01-01", "")
+	approve.AddCommand(search)
+
 	// Get subcommand
 	get := model.NewAutocompleteData("get", "<approval-code>", "Display specific approval request")
 	get.AddTextArgument("Approval code", "Enter the approval code (e.g., A-X7K9Q2)", "")
@@ -144,10 +282,73 @@ func (p *Plugin) getAutocompleteData() *model.AutocompleteData {
 	verify.AddTextArgument("Comment", "Optional verification comment", "")
 	approve.AddCommand(verify)
 
+	// Resubmit subcommand
+	resubmit := model.NewAutocompleteData("resubmit", "<approval-code>", "Create a new request from a finalized one")
+	resubmit.AddTextArgument("Approval code", "Enter the approval code (e.g., A-X7K9Q2)", "")
+	approve.AddCommand(resubmit)
+
+	// Comment subcommand
+	comment := model.NewAutocompleteData("comment", "<approval-code> <text>", "Send a comment to the other party of a request")
+	comment.AddTextArgument("Approval code", "Enter the approval code (e.g., A-X7K9Q2)", "")
+	comment.AddTextArgument("Comment", "Message relayed in the approval's thread", "")
+	approve.AddCommand(comment)
+
+	// Digest subcommand
+	digestCmd := model.NewAutocompleteData("digest", "[on|off|status]", "Subscribe to a scheduled digest of requests awaiting your decision")
+	digestCmd.AddStaticListArgument("Digest subscription", false, []model.AutocompleteListItem{
+		{HelpText: "Receive the scheduled approval digest", Item: "on"},
+		{HelpText: "Stop receiving the digest", Item: "off"},
+		{HelpText: "Show whether you receive the digest", Item: "status"},
+	})
+	approve.AddCommand(digestCmd)
+
+	// Stats subcommand
+	stats := model.NewAutocompleteData("stats", "[@user|~team] [--since 30d]", "View time-to-decision and outcome statistics")
+	stats.AddTextArgument("Scope and window", "@user or ~team (admins only) and an optional window such as --since 30d", "")
+	approve.AddCommand(stats)
+
 	// Status subcommand (admin only)
-	status := model.NewAutocompleteData("status", "[--failed-notifications]", "View approval statistics (admin only)")
+	status := model.NewAutocompleteData("status", "[--failed-notifications|--sod]", "View approval statistics (admin only)")
 	approve.AddCommand(status)
 
+	// Admin subcommand (admin only)
+	admin := model.NewAutocompleteData("admin", "[template|policy|sla|resend]", "Administer the approval plugin (admin only)")
+	template := model.NewAutocompleteData("template", "[list|set|delete]", "Manage request templates")
+	template.AddCommand(model.NewAutocompleteData("list", "", "List request templates"))
+	templateSet := model.NewAutocompleteData("set", "<JSON>", "Create or replace a request template")
+	templateSet.AddTextArgument("Template definition", "JSON template definition", "")
+	template.AddCommand(templateSet)
+	templateDelete := model.NewAutocompleteData("delete", "<name>", "Delete a request template")
+	templateDelete.AddTextArgument("Template name", "Name of the template to delete", "")
+	template.AddCommand(templateDelete)
+	admin.AddCommand(template)
+	policy := model.NewAutocompleteData("policy", "[list|set|delete]", "Manage approver policies")
+	policy.AddCommand(model.NewAutocompleteData("list", "", "List approver policies"))
+	policySet := model.NewAutocompleteData("set", "team|template <name> users=@a,@b groups=g roles=r", "Restrict allowed approvers")
+	policySet.AddTextArgument("Scope and rules", "team|template <name> followed by users=, groups= or roles= rules", "")
+	policy.AddCommand(policySet)
+	policyDelete := model.NewAutocompleteData("delete", "team|template <name>", "Remove an approver policy")
+	policyDelete.AddTextArgument("Scope", "team or template <name>", "")
+	policy.AddCommand(policyDelete)
+	admin.AddCommand(policy)
+	slaTargets := model.NewAutocompleteData("sla", "[list|set|delete]", "Manage SLA targets")
+	slaTargets.AddCommand(model.NewAutocompleteData("list", "", "List SLA targets"))
+	slaSet := model.NewAutocompleteData("set", "global|team|template <name> <duration> [channel=~name]", "Set the time to decision for requests")
+	slaSet.AddTextArgument("Scope and target", "global, team or template <name> followed by a duration below 30m such as 10m or 20m", "")
+	slaTargets.AddCommand(slaSet)
+	slaDelete := model.NewAutocompleteData("delete", "global|team|template <name>", "Remove an SLA target")
+	slaDelete.AddTextArgument("Scope", "global, team or template <name>", "")
+	slaTargets.AddCommand(slaDelete)
+	admin.AddCommand(slaTargets)
+	resend := model.NewAutocompleteData("resend", "<code> [approver|requester]", "Resend the notification DM for a request's current state")
+	resend.AddTextArgument("Approval code", "Code of the request, e.g. A-X7K9Q2", "")
+	resend.AddStaticListArgument("Recipient", false, []model.AutocompleteListItem{
+		{HelpText: "The approver (default while pending)", Item: "approver"},
+		{HelpText: "The requester (default once finalized)", Item: "requester"},
+	})
+	admin.AddCommand(resend)
+	approve.AddCommand(admin)
+
 	// Help subcommand
 	help := model.NewAutocompleteData("help", "", "Show command help")
 	approve.AddCommand(help)
@@ -157,11 +358,14 @@ func (p *Plugin) getAutocompleteData() *model.AutocompleteData {
 
 // ExecuteCommand executes a command that has been previously registered via the RegisterCommand API.
 func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*model.CommandResponse, *model.AppError) {
+	// Responses are rendered in the invoking user's Mattermost locale and timezone
+	locale, timezone := p.userPreferences(args.UserId)
+
 	// Parse command into parts
 	split := strings.Fields(args.Command)
 	if len(split) < 2 {
 		// Use router for help/empty command
-		router := command.NewRouter(p.API, p.store)
+		router := p.newCommandRouter(locale, timezone)
 		response, _ := router.Route(args)
 		return response, nil
 	}
@@ -170,36 +374,82 @@ func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 
 	// Handle cancel command directly (bypass router for direct commands)
 	if subcommand == "cancel" {
-		return p.handleCancelCommand(args, split), nil
+		return p.handleCancelCommand(args, split, locale), nil
 	}
 
 	// Handle verify command directly (Story 6.2)
 	if subcommand == "verify" {
-		return p.handleVerifyCommand(args, split), nil
+		return p.handleVerifyCommand(args, split, locale), nil
+	}
+
+	// Handle resubmit command directly (needs service and bot for approver DM)
+	if subcommand == "resubmit" {
+		return p.handleResubmitCommand(args, split, locale), nil
+	}
+
+	// Handle comment command directly (needs service and bot to relay the comment)
+	if subcommand == "comment" {
+		return p.handleCommentCommand(args, split, locale), nil
+	}
+
+	// Handle yes/no directly (needs service and bot for the outcome DM)
+	if subcommand == "yes" || subcommand == "no" {
+		return p.handleDecideCommand(args, split, locale), nil
+	}
+
+	// Handle bulk command directly (opens a dialog)
+	if subcommand == "bulk" {
+		return p.handleBulkCommand(args, split, locale, timezone), nil
+	}
+
+	// Handle admin resend directly (needs bot and signer to rebuild the DMs)
+	if subcommand == "admin" && len(split) > 2 && split[2] == "resend" {
+		return p.handleAdminResendCommand(args, split, locale), nil
 	}
 
 	// For other commands, use the router
-	router := command.NewRouter(p.API, p.store)
+	router := p.newCommandRouter(locale, timezone)
 	response, err := router.Route(args)
 	if err != nil {
 		p.API.LogError("Command execution failed", "error", err.Error(), "command", args.Command)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "An error occurred while processing your command. Please try again.",
+			Text:         i18n.T(locale, "command.error"),
 		}, nil
 	}
 
 	return response, nil
 }
 
+// newCommandRouter creates a command router that renders in the user's locale and timezone
+func (p *Plugin) newCommandRouter(locale, timezone string) *command.Router {
+	router := command.NewRouter(p.API, p.store)
+	router.SetLocale(locale)
+	router.SetTimezone(timezone)
+	if p.actionSigner != nil {
+		router.SetActionSigner(p.actionSigner)
+	}
+	return router
+}
+
+// userPreferences returns the user's Mattermost locale and preferred timezone, or empty strings
+// (the default locale and UTC) if the user cannot be loaded
+func (p *Plugin) userPreferences(userID string) (locale, timezone string) {
+	user, appErr := p.API.GetUser(userID)
+	if appErr != nil || user == nil {
+		return "", ""
+	}
+	return user.Locale, user.GetPreferredTimezone()
+}
+
 // handleCancelCommand processes the /approve cancel <ID> command
 // Story 4.3: Opens a modal to collect cancellation reason instead of immediate cancel
-func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *model.CommandResponse {
+func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
 	// Validate command format: /approve cancel <ID>
 	if len(split) < 3 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Usage: /approve cancel <APPROVAL_ID>",
+			Text:         i18n.T(locale, "cancel.usage"),
 		}
 	}
 
@@ -207,7 +457,7 @@ func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *m
 	if len(split) > 3 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Usage: /approve cancel <APPROVAL_ID>\n\nError: Too many arguments provided.",
+			Text:         i18n.T(locale, "cancel.usage") + i18n.T(locale, "cancel.too_many_arguments"),
 		}
 	}
 
@@ -225,7 +475,7 @@ func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *m
 		)
 
 		// Determine user-friendly error message
-		errorMsg := p.formatCancelError(err, approvalCode)
+		errorMsg := p.formatCancelError(err, approvalCode, locale)
 
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
@@ -242,12 +492,12 @@ func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *m
 		)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Permission denied. You can only cancel your own approval requests.",
+			Text:         i18n.T(locale, "cancel.permission_denied"),
 		}
 	}
 
 	// Open cancellation modal (Story 4.3)
-	if err := p.openCancellationModal(args.TriggerId, approvalCode, record); err != nil {
+	if err := p.openCancellationModal(args.TriggerId, approvalCode, record, locale); err != nil {
 		p.API.LogError("Failed to open cancellation modal",
 			"error", err.Error(),
 			"approval_code", approvalCode,
@@ -255,7 +505,7 @@ func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *m
 		)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Failed to open cancellation dialog. Please try again.",
+			Text:         i18n.T(locale, "cancel.dialog_failed"),
 		}
 	}
 
@@ -265,44 +515,177 @@ func (p *Plugin) handleCancelCommand(args *model.CommandArgs, split []string) *m
 	}
 }
 
+// handleBulkCommand processes the /approve bulk command by opening the bulk decision dialog
+func (p *Plugin) handleBulkCommand(args *model.CommandArgs, split []string, locale, timezone string) *model.CommandResponse {
+	if len(split) > 2 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "bulk.usage"),
+		}
+	}
+
+	if err := p.openBulkDecisionModal(args.TriggerId, args.UserId, locale, timezone); err != nil {
+		if errors.Is(err, errNoPendingDecisions) {
+			return &model.CommandResponse{
+				ResponseType: model.CommandResponseTypeEphemeral,
+				Text:         i18n.T(locale, "bulk.none"),
+			}
+		}
+		p.API.LogError("Failed to open bulk decision dialog",
+			"error", err.Error(),
+			"user_id", args.UserId,
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "bulk.dialog_failed"),
+		}
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+	}
+}
+
+// openBulkDecisionModal opens a dialog listing the user's oldest pending requests with a checkbox
+// each, one decision and one comment. Returns errNoPendingDecisions when nothing awaits the user.
+func (p *Plugin) openBulkDecisionModal(triggerID, userID, locale, timezone string) error {
+	records, more, err := p.pendingDecisions(userID)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return errNoPendingDecisions
+	}
+
+	introText := i18n.T(locale, "bulk.dialog.intro")
+	if more {
+		introText += i18n.T(locale, "bulk.dialog.more", maxBulkDecisions)
+	}
+
+	elements := []model.DialogElement{
+		{
+			DisplayName: i18n.T(locale, "bulk.dialog.decision"),
+			Name:        "decision",
+			Type:        "radio",
+			Options: []*model.PostActionOptions{
+				{Text: i18n.T(locale, "dm.request.approve"), Value: "approve"},
+				{Text: i18n.T(locale, "dm.request.deny"), Value: "deny"},
+			},
+		},
+	}
+
+	now := time.Now()
+	for _, record := range records {
+		elements = append(elements, model.DialogElement{
+			DisplayName: record.Code,
+			Name:        bulkRecordPrefix + record.ID,
+			Type:        "bool",
+			Placeholder: i18n.T(locale, "bulk.dialog.record", record.RequesterUsername, record.DescriptionSummary()),
+			HelpText:    i18n.FormatTimeWithAge(locale, record.CreatedAt, "2006-01-02 15:04", timezone, now),
+			Optional:    true,
+		})
+	}
+
+	elements = append(elements, model.DialogElement{
+		DisplayName: i18n.T(locale, "confirm.comment"),
+		Name:        "comment",
+		Type:        "textarea",
+		Placeholder: i18n.T(locale, "confirm.comment_placeholder"),
+		MaxLength:   maxDecisionCommentLength,
+		Optional:    true,
+	})
+
+	dialog := model.OpenDialogRequest{
+		TriggerId: triggerID,
+		URL:       "/plugins/com.mattermost.plugin-approver2/dialog/submit",
+		Dialog: model.Dialog{
+			CallbackId:       bulkDecisionCallbackID,
+			Title:            i18n.T(locale, "bulk.dialog.title"),
+			IntroductionText: introText,
+			Elements:         elements,
+			SubmitLabel:      i18n.T(locale, "confirm.submit"),
+			State:            locale,
+		},
+	}
+
+	if appErr := p.API.OpenInteractiveDialog(dialog); appErr != nil {
+		return fmt.Errorf("failed to open dialog: %w", appErr)
+	}
+
+	return nil
+}
+
+// pendingDecisions returns up to maxBulkDecisions pending requests the user may decide, oldest
+// first, and whether more are waiting
+func (p *Plugin) pendingDecisions(userID string) ([]*approval.ApprovalRecord, bool, error) {
+	entries, err := p.store.GetUserApprovalIndex(userID, approval.IndexRoleApprover)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var records []*approval.ApprovalRecord
+	for i := len(entries) - 1; i >= 0; i-- {
+		record, err := p.store.GetApproval(entries[i].RecordID)
+		if err != nil {
+			p.API.LogWarn("Failed to retrieve approval record for bulk decision",
+				"record_id", entries[i].RecordID,
+				"user_id", userID,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if record.Status != approval.StatusPending || !record.CanDecide(userID) {
+			continue
+		}
+		if len(records) == maxBulkDecisions {
+			return records, true, nil
+		}
+		records = append(records, record)
+	}
+
+	return records, false, nil
+}
+
 // openCancellationModal opens an interactive dialog for cancellation reason selection
 // Story 4.3: Collect structured cancellation reasons for data instrumentation
-func (p *Plugin) openCancellationModal(triggerID string, approvalCode string, record *approval.ApprovalRecord) error {
+// The dialog is rendered in the requester's locale, which the dialog State carries to the submission.
+func (p *Plugin) openCancellationModal(triggerID string, approvalCode string, record *approval.ApprovalRecord, locale string) error {
 	// Create interactive dialog
 	dialog := model.OpenDialogRequest{
 		TriggerId: triggerID,
 		URL:       "/plugins/com.mattermost.plugin-approver2/dialog/submit",
 		Dialog: model.Dialog{
 			CallbackId:       fmt.Sprintf("cancel_approval_%s", record.ID),
-			Title:            "Cancel Approval Request",
-			IntroductionText: fmt.Sprintf("You are about to cancel approval request **%s**\n\n**This action cannot be undone.**\n\nPlease select a reason for cancellation:", approvalCode),
+			Title:            i18n.T(locale, "cancel.dialog.title"),
+			IntroductionText: i18n.T(locale, "cancel.dialog.intro", approvalCode),
 			Elements: []model.DialogElement{
 				{
-					DisplayName: "Reason for cancellation",
+					DisplayName: i18n.T(locale, "cancel.dialog.reason"),
 					Name:        "reason_code",
 					Type:        "select",
-					Placeholder: "Select a reason",
+					Placeholder: i18n.T(locale, "cancel.dialog.reason_placeholder"),
 					Options: []*model.PostActionOptions{
-						{Text: "No longer needed", Value: "no_longer_needed"},
-						{Text: "Wrong approver", Value: "wrong_approver"},
-						{Text: "Sensitive information", Value: "sensitive_info"},
-						{Text: "Other reason", Value: "other"},
+						{Text: i18n.T(locale, "cancel.dialog.reason_no_longer_needed"), Value: "no_longer_needed"},
+						{Text: i18n.T(locale, "cancel.dialog.reason_wrong_approver"), Value: "wrong_approver"},
+						{Text: i18n.T(locale, "cancel.dialog.reason_sensitive_info"), Value: "sensitive_info"},
+						{Text: i18n.T(locale, "cancel.dialog.reason_other"), Value: "other"},
 					},
 					Default:  "no_longer_needed",
 					Optional: false,
-					HelpText: "Help us understand why requests are canceled",
+					HelpText: i18n.T(locale, "cancel.dialog.reason_help"),
 				},
 				{
-					DisplayName: "Additional details (optional)",
+					DisplayName: i18n.T(locale, "cancel.dialog.details"),
 					Name:        "additional_details",
 					Type:        "textarea",
-					Placeholder: "e.g., Project scope changed, requirements updated...",
+					Placeholder: i18n.T(locale, "cancel.dialog.details_placeholder"),
 					Optional:    true,
 					MaxLength:   500,
-					HelpText:    "Add context or explanation (optional)",
+					HelpText:    i18n.T(locale, "cancel.dialog.details_help"),
 				},
 			},
-			SubmitLabel: "Cancel Request",
+			SubmitLabel: i18n.T(locale, "cancel.dialog.submit"),
+			State:       locale,
 		},
 	}
 
@@ -313,43 +696,43 @@ func (p *Plugin) openCancellationModal(triggerID string, approvalCode string, re
 	return nil
 }
 
-// formatCancelError converts service errors into user-friendly messages
-func (p *Plugin) formatCancelError(err error, code string) string {
+// formatCancelError converts service errors into user-friendly messages in the given locale
+func (p *Plugin) formatCancelError(err error, code, locale string) string {
 	errorStr := err.Error()
 
 	// Check for specific error types
 	switch {
 	case strings.Contains(errorStr, "invalid approval code format"):
-		return fmt.Sprintf("❌ Invalid approval code format: '%s'. Expected format like 'A-X7K9Q2'.", code)
+		return i18n.T(locale, "error.invalid_code", code)
 	case strings.Contains(errorStr, "approval record not found"):
-		return fmt.Sprintf("❌ Approval request '%s' not found. Use `/approve list` to see your requests.", code)
+		return i18n.T(locale, "error.not_found", code)
 	case strings.Contains(errorStr, "permission denied"):
-		return "❌ Permission denied. You can only cancel your own approval requests."
+		return i18n.T(locale, "cancel.permission_denied")
 	case strings.Contains(errorStr, "cannot cancel"):
 		// Extract status from error message if present
 		switch {
 		case strings.Contains(errorStr, "approved"):
-			return fmt.Sprintf("❌ Cannot cancel approval request %s. Status is already approved.", code)
+			return i18n.T(locale, "cancel.already_approved", code)
 		case strings.Contains(errorStr, "denied"):
-			return fmt.Sprintf("❌ Cannot cancel approval request %s. Status is already denied.", code)
+			return i18n.T(locale, "cancel.already_denied", code)
 		case strings.Contains(errorStr, "canceled"):
-			return fmt.Sprintf("❌ Cannot cancel approval request %s. Status is already canceled.", code)
+			return i18n.T(locale, "cancel.already_canceled", code)
 		default:
-			return fmt.Sprintf("❌ Cannot cancel approval request %s. Request has already been decided.", code)
+			return i18n.T(locale, "cancel.already_decided", code)
 		}
 	default:
-		return "❌ Failed to cancel approval request. Please try again."
+		return i18n.T(locale, "cancel.failed")
 	}
 }
 
 // handleVerifyCommand processes the /approve verify <CODE> [comment] command
 // Story 6.2: Allows requester to mark approved requests as verified
-func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *model.CommandResponse {
+func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
 	// Validate command format: /approve verify <CODE> [comment]
 	if len(split) < 3 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "Usage: /approve verify <APPROVAL_CODE> [comment]\n\nExample: `/approve verify A-X7K9Q2` or `/approve verify A-X7K9Q2 Deployment completed successfully`",
+			Text:         i18n.T(locale, "verify.usage"),
 		}
 	}
 
@@ -366,7 +749,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 	if len(comment) > 500 {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         fmt.Sprintf("❌ Verification comment too long (%d characters). Maximum length is 500 characters.", len(comment)),
+			Text:         i18n.T(locale, "verify.comment_too_long", len(comment)),
 		}
 	}
 
@@ -379,7 +762,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 			"user_id", requesterID,
 		)
 
-		errorMsg := p.formatVerifyError(err, approvalCode)
+		errorMsg := p.formatVerifyError(err, approvalCode, locale)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
 			Text:         errorMsg,
@@ -395,7 +778,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 		)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         "❌ Permission denied. Only the requester can verify their approval requests.",
+			Text:         i18n.T(locale, "verify.permission_denied"),
 		}
 	}
 
@@ -403,7 +786,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 	if record.Status != approval.StatusApproved {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         fmt.Sprintf("❌ Cannot verify approval request %s. Only approved requests can be verified (current status: %s).", approvalCode, record.Status),
+			Text:         i18n.T(locale, "verify.not_approved_status", approvalCode, i18n.T(locale, "filter."+record.Status)),
 		}
 	}
 
@@ -411,7 +794,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 	if record.Verified {
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
-			Text:         fmt.Sprintf("❌ Approval request %s has already been verified.", approvalCode),
+			Text:         i18n.T(locale, "verify.already_verified", approvalCode),
 		}
 	}
 
@@ -424,7 +807,7 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 			"user_id", requesterID,
 		)
 
-		errorMsg := p.formatVerifyError(err, approvalCode)
+		errorMsg := p.formatVerifyError(err, approvalCode, locale)
 		return &model.CommandResponse{
 			ResponseType: model.CommandResponseTypeEphemeral,
 			Text:         errorMsg,
@@ -440,23 +823,29 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 		)
 		// Continue - notification failure doesn't block success response
 	} else {
+		// Show the verification on the channel status card (best-effort)
+		p.updateChannelStatusCard(updatedRecord)
+
 		// Send verification notification to approver (best-effort, graceful degradation)
 		if _, err := notifications.SendVerificationNotificationDM(p.API, p.botUserID, updatedRecord); err != nil {
+			errorType, _ := notifications.ClassifyDMError(err)
+			p.metrics.NotificationFailed(errorType)
 			p.API.LogWarn("Failed to send verification notification",
 				"approval_code", approvalCode,
 				"approver_id", record.ApproverID,
 				"error", err.Error(),
+				"error_type", errorType,
 			)
 			// Continue - notification failure doesn't affect verification
 		}
 	}
 
 	// Success response
-	successMsg := fmt.Sprintf("✅ Approval request **%s** marked as verified.", approvalCode)
+	successMsg := i18n.T(locale, "verify.success", approvalCode)
 	if comment != "" {
-		successMsg += fmt.Sprintf("\n\n**Verification note:** %s", comment)
+		successMsg += i18n.T(locale, "verify.success_note", comment)
 	}
-	successMsg += fmt.Sprintf("\n\n%s has been notified.", record.ApproverDisplayName)
+	successMsg += i18n.T(locale, "verify.success_notified", record.ApproverDisplayName)
 
 	return &model.CommandResponse{
 		ResponseType: model.CommandResponseTypeEphemeral,
@@ -465,23 +854,357 @@ func (p *Plugin) handleVerifyCommand(args *model.CommandArgs, split []string) *m
 }
 
 // formatVerifyError converts service errors into user-friendly messages for verify command
-func (p *Plugin) formatVerifyError(err error, code string) string {
+func (p *Plugin) formatVerifyError(err error, code, locale string) string {
 	errorStr := err.Error()
 
 	// Check for specific error types
 	switch {
 	case strings.Contains(errorStr, "invalid approval code format"):
-		return fmt.Sprintf("❌ Invalid approval code format: '%s'. Expected format like 'A-X7K9Q2'.", code)
+		return i18n.T(locale, "error.invalid_code", code)
 	case strings.Contains(errorStr, "approval record not found"):
-		return fmt.Sprintf("❌ Approval request '%s' not found. Use `/approve list` to see your requests.", code)
+		return i18n.T(locale, "error.not_found", code)
 	case strings.Contains(errorStr, "permission denied"):
-		return "❌ Permission denied. Only the requester can verify their approval requests."
+		return i18n.T(locale, "verify.permission_denied")
 	case strings.Contains(errorStr, "not approved"):
-		return fmt.Sprintf("❌ Cannot verify approval request %s. Only approved requests can be verified.", code)
+		return i18n.T(locale, "verify.not_approved", code)
 	case strings.Contains(errorStr, "already verified"):
-		return fmt.Sprintf("❌ Approval request %s has already been verified.", code)
+		return i18n.T(locale, "verify.already_verified", code)
+	default:
+		return i18n.T(locale, "verify.failed")
+	}
+}
+
+// handleResubmitCommand processes the /approve resubmit <CODE> command
+// Creates a new pending request pre-populated from a finalized one and notifies the approver
+func (p *Plugin) handleResubmitCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
+	// Validate command format: /approve resubmit <CODE>
+	if len(split) != 3 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "resubmit.usage"),
+		}
+	}
+
+	approvalCode := split[2]
+	requesterID := args.UserId
+
+	record, err := p.service.ResubmitApproval(approvalCode, requesterID)
+	if err != nil {
+		p.API.LogError("Failed to resubmit approval request",
+			"error", err.Error(),
+			"approval_code", approvalCode,
+			"user_id", requesterID,
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         p.formatResubmitError(err, approvalCode, locale),
+		}
+	}
+
+	// Notify approver (best effort, graceful degradation)
+	p.sendApprovalRequestNotification(p.store, record)
+	p.sendRequesterConfirmation(p.store, record)
+	p.postChannelStatusCard(p.store, record)
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text: i18n.T(locale, "resubmit.success",
+			record.ApproverUsername, record.ApproverDisplayName,
+			record.Code,
+			record.PreviousCode),
+	}
+}
+
+// formatResubmitError converts service errors into user-friendly messages for resubmit command
+func (p *Plugin) formatResubmitError(err error, code, locale string) string {
+	errorStr := err.Error()
+
+	switch {
+	case strings.Contains(errorStr, "invalid approval code format"):
+		return i18n.T(locale, "error.invalid_code", code)
+	case strings.Contains(errorStr, "approval record not found"):
+		return i18n.T(locale, "error.not_found", code)
+	case strings.Contains(errorStr, "permission denied"):
+		return i18n.T(locale, "resubmit.permission_denied")
+	case strings.Contains(errorStr, "still pending"):
+		return i18n.T(locale, "resubmit.still_pending", code)
+	case strings.Contains(errorStr, "already resubmitted"):
+		return i18n.T(locale, "resubmit.already_resubmitted", code, code)
+	case strings.Contains(errorStr, "invalid approver"):
+		return i18n.T(locale, "resubmit.invalid_approver", code)
+	case strings.Contains(errorStr, "not allowed by policy"):
+		return i18n.T(locale, "resubmit.not_allowed", code, errorStr)
+	case strings.Contains(errorStr, "group approval requests"):
+		return i18n.T(locale, "resubmit.group", code)
+	default:
+		return i18n.T(locale, "resubmit.failed")
+	}
+}
+
+// handleCommentCommand processes the /approve comment <CODE> <text> command.
+// The comment is recorded on the approval and relayed to the other party in the approval's DM thread.
+func (p *Plugin) handleCommentCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
+	// Validate command format: /approve comment <CODE> <text>
+	if len(split) < 4 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "comment.usage"),
+		}
+	}
+
+	approvalCode := split[2]
+	userID := args.UserId
+	text := strings.Join(split[3:], " ")
+
+	record, err := p.service.AddComment(approvalCode, userID, text)
+	if err != nil {
+		p.API.LogError("Failed to add approval comment",
+			"error", err.Error(),
+			"approval_code", approvalCode,
+			"user_id", userID,
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         p.formatCommentError(err, approvalCode, locale),
+		}
+	}
+
+	// Relay to the other party (best effort, the comment is already recorded)
+	comment := record.Comments[len(record.Comments)-1]
+	if _, err := notifications.SendCommentDM(p.API, p.botUserID, record, comment); err != nil {
+		p.API.LogWarn("Failed to relay approval comment",
+			"approval_code", approvalCode,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "comment.not_delivered", approvalCode, approvalCode),
+		}
+	}
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         i18n.T(locale, "comment.sent", approvalCode),
+	}
+}
+
+// formatCommentError converts service errors into user-friendly messages for comment command
+func (p *Plugin) formatCommentError(err error, code, locale string) string {
+	errorStr := err.Error()
+
+	switch {
+	case strings.Contains(errorStr, "invalid approval code format"):
+		return i18n.T(locale, "error.invalid_code", code)
+	case strings.Contains(errorStr, "approval record not found"):
+		return i18n.T(locale, "error.not_found", code)
+	case strings.Contains(errorStr, approval.ErrNotParticipant.Error()):
+		return i18n.T(locale, "comment.permission_denied")
+	case strings.Contains(errorStr, approval.ErrRequestScheduled.Error()):
+		return i18n.T(locale, "comment.scheduled", code)
+	case strings.Contains(errorStr, "comment text is required"):
+		return i18n.T(locale, "comment.required")
+	case strings.Contains(errorStr, "characters (max"):
+		return i18n.T(locale, "comment.too_long", approval.MaxCommentLength)
 	default:
-		return "❌ Failed to verify approval request. Please try again."
+		return i18n.T(locale, "comment.failed")
+	}
+}
+
+// handleDecideCommand processes /approve yes|no <CODE> [comment], the keyboard alternative to the
+// Approve and Deny buttons. It applies the same checks as the confirmation dialog, records the
+// decision and runs the same follow-ups: outcome DM, approver DM update and status card.
+func (p *Plugin) handleDecideCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
+	// Validate command format: /approve yes|no <CODE> [comment]
+	if len(split) < 3 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "decide.usage"),
+		}
+	}
+
+	action := "approve"
+	decision := approval.StatusApproved
+	if split[1] == "no" {
+		action = "deny"
+		decision = approval.StatusDenied
+	}
+
+	approvalCode := split[2]
+	approverID := args.UserId
+	comment := strings.Join(split[3:], " ")
+	if length := len([]rune(comment)); length > maxDecisionCommentLength {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "decide.comment_too_long", length, maxDecisionCommentLength),
+		}
+	}
+
+	record, err := p.store.GetApprovalByCode(approvalCode)
+	if err != nil {
+		p.API.LogError("Failed to get approval record for decision command",
+			"error", err.Error(),
+			"approval_code", approvalCode,
+			"user_id", approverID,
+		)
+		text := i18n.T(locale, "confirm.failed")
+		if errors.Is(err, approval.ErrRecordNotFound) {
+			text = i18n.T(locale, "error.not_found", approvalCode)
+		}
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         text,
+		}
+	}
+
+	// Same authorization and immutability guards as the buttons and confirmation dialog
+	if !record.CanDecide(approverID) {
+		p.API.LogError("Unauthorized decision attempt",
+			"approval_id", record.ID,
+			"authenticated_user", approverID,
+			"designated_approver", record.ApproverID,
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "confirm.permission_denied"),
+		}
+	}
+	if record.Status != approval.StatusPending {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         formatAlreadyDecided(record, locale),
+		}
+	}
+
+	updatedRecord, err := p.service.RecordDecision(record.ID, approverID, decision, comment)
+	if err != nil {
+		p.API.LogError("Failed to record decision",
+			"approval_id", record.ID,
+			"action", action,
+			"error", err.Error(),
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         p.formatDecisionError(err, record.ID, locale),
+		}
+	}
+
+	p.completeDecision(updatedRecord, approverID, decision)
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         i18n.T(locale, "decide."+decision, updatedRecord.Code),
+	}
+}
+
+// handleAdminResendCommand processes /approve admin resend <CODE> [approver|requester] (system admin only).
+// It rebuilds the DM for the request's current state, disables buttons on earlier approver posts
+// and records the redelivery on the approval.
+func (p *Plugin) handleAdminResendCommand(args *model.CommandArgs, split []string, locale string) *model.CommandResponse {
+	// Validate command format: /approve admin resend <CODE> [approver|requester]
+	if len(split) < 4 || len(split) > 5 {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "resend.usage"),
+		}
+	}
+
+	admin, appErr := p.API.GetUser(args.UserId)
+	if appErr != nil {
+		p.API.LogError("Failed to get user for admin command", "user_id", args.UserId, "error", appErr.Error())
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "command.permission_check_failed"),
+		}
+	}
+	if !approval.IsSystemAdminUser(admin) {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "admin.permission_denied"),
+		}
+	}
+
+	approvalCode := split[3]
+	record, err := p.store.GetApprovalByCode(approvalCode)
+	if err != nil {
+		if errors.Is(err, approval.ErrRecordNotFound) {
+			return &model.CommandResponse{
+				ResponseType: model.CommandResponseTypeEphemeral,
+				Text:         i18n.T(locale, "resend.not_found", approvalCode),
+			}
+		}
+		p.API.LogError("Failed to get approval for resend", "approval_code", approvalCode, "error", err.Error())
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "resend.load_failed"),
+		}
+	}
+
+	if record.Status == approval.StatusScheduled {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text: i18n.T(locale, "resend.scheduled",
+				record.Code, i18n.FormatTime(record.ScheduledAt, approval.SendAtLayout, "")),
+		}
+	}
+
+	target := record.DefaultRedeliveryTarget()
+	if len(split) == 5 {
+		target = split[4]
+	}
+	if target != approval.RedeliveryTargetApprover && target != approval.RedeliveryTargetRequester {
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "resend.unknown_target", target),
+		}
+	}
+
+	notification, postID, err := p.resendNotification(record, target)
+	if err != nil {
+		errorType, suggestion := notifications.ClassifyDMError(err)
+		p.metrics.NotificationFailed(errorType)
+		p.API.LogWarn("Admin notification resend failed",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"target", target,
+			"admin_id", args.UserId,
+			"error", err.Error(),
+			"error_type", errorType,
+		)
+		return &model.CommandResponse{
+			ResponseType: model.CommandResponseTypeEphemeral,
+			Text:         i18n.T(locale, "resend.failed", record.Code, i18n.T(locale, "resend.target."+target), suggestion),
+		}
+	}
+
+	record.Redeliveries = append(record.Redeliveries, approval.NotificationRedelivery{
+		Target:           target,
+		Notification:     notification,
+		ResentBy:         args.UserId,
+		ResentByUsername: admin.Username,
+		ResentAt:         model.GetMillis(),
+		PostID:           postID,
+	})
+	if err := p.store.SaveApproval(record); err != nil {
+		// The DM went out; only the tracking fields are stale
+		p.API.LogWarn("Failed to record notification redelivery",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"error", err.Error(),
+		)
+	}
+
+	p.API.LogInfo("Audit: notification resent by admin",
+		"approval_id", record.ID,
+		"code", record.Code,
+		"target", target,
+		"notification", notification,
+		"admin_id", args.UserId,
+	)
+
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         i18n.T(locale, "resend.sent", i18n.T(locale, "resend.notification."+notification), record.Code, i18n.T(locale, "resend.target."+target)),
 	}
 }
 
@@ -7,19 +7,26 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOnActivate(t *testing.T) {
 	t.Run("successfully registers command and initializes store", func(t *testing.T) {
 		api := &plugintest.API{}
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
 		p := &Plugin{}
@@ -29,12 +36,14 @@ func TestOnActivate(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, p.store, "store should be initialized")
 		assert.Equal(t, "bot123", p.botUserID, "bot user ID should be initialized")
+		assert.NotNil(t, p.actionSigner, "action signer should be initialized")
 		api.AssertExpectations(t)
 	})
 
 	t.Run("handles registration failure", func(t *testing.T) {
 		api := &plugintest.API{}
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(model.NewAppError("test", "test.error", nil, "", 500))
 		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -58,6 +67,72 @@ func TestOnActivate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to ensure bot user")
 	})
+
+	t.Run("handles action signing secret failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return(nil, &model.AppError{Message: "KV error"})
+		api.On("LogInfo", mock.Anything, mock.Anything).Return()
+
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		err := p.OnActivate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load action signing secret")
+		api.AssertNotCalled(t, "RegisterCommand", mock.Anything)
+	})
+}
+
+func TestOnConfigurationChange_MessageTemplates(t *testing.T) {
+	t.Cleanup(func() { notifications.SetMessageTemplates(nil) })
+
+	t.Run("invalid template is logged without rejecting the configuration", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("LoadPluginConfiguration", mock.AnythingOfType("*main.configuration")).Run(func(args mock.Arguments) {
+			config := args.Get(0).(*configuration)
+			config.DigestHour = 9
+			config.RequestMessageTemplate = "{{.Message}}\n\n_Recorded for compliance._"
+			config.OutcomeMessageTemplate = "{{.Record.Nope}}"
+		}).Return(nil)
+		api.On("LogError", "Invalid notification message template; using the built-in message",
+			"error", mock.MatchedBy(func(msg string) bool { return strings.Contains(msg, "outcome template:") })).Once()
+
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		err := p.OnConfigurationChange()
+		assert.NoError(t, err)
+		assert.Equal(t, 9, p.getConfiguration().DigestHour)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("valid templates are not logged", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("LoadPluginConfiguration", mock.AnythingOfType("*main.configuration")).Run(func(args mock.Arguments) {
+			args.Get(0).(*configuration).TimeoutMessageTemplate = "{{.Record.Code}} timed out"
+		}).Return(nil)
+
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		assert.NoError(t, p.OnConfigurationChange())
+		api.AssertNotCalled(t, "LogError", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// mockUserLocale stubs the caller lookup ExecuteCommand uses to pick the response locale and timezone.
+// Register it after any test-specific GetUser expectations, which take precedence.
+func mockUserLocale(api *plugintest.API) {
+	api.On("GetUser", mock.Anything).Return(&model.User{}, nil).Maybe()
+}
+
+// mockSearchIndex stubs the search index writes of newly saved records.
+// Register it before broader KVGet expectations so token keys resolve to an empty index.
+func mockSearchIndex(api *plugintest.API) {
+	isTokenKey := mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, "approval:token:") })
+	api.On("KVGet", isTokenKey).Return(nil, nil).Maybe()
+	api.On("KVSetWithOptions", isTokenKey, mock.Anything, mock.Anything).Return(true, nil).Maybe()
 }
 
 func TestExecuteCommand(t *testing.T) {
@@ -103,6 +178,7 @@ func TestExecuteCommand(t *testing.T) {
 				Command: tt.command,
 			}
 
+			mockUserLocale(api)
 			resp, appErr := p.ExecuteCommand(nil, args)
 			assert.Nil(t, appErr)
 			assert.NotNil(t, resp)
@@ -112,6 +188,34 @@ func TestExecuteCommand(t *testing.T) {
 	}
 }
 
+func TestExecuteCommand_Locale(t *testing.T) {
+	t.Run("responses use the caller's Mattermost locale", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Locale: "de"}, nil)
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve help", UserId: "user123"})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "**Verfügbare Befehle:**")
+
+		resp, appErr = p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve cancel", UserId: "user123"})
+		assert.Nil(t, appErr)
+		assert.Equal(t, "Verwendung: /approve cancel <APPROVAL_ID>", resp.Text)
+	})
+
+	t.Run("unloadable caller falls back to English", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUser", "user123").Return(nil, model.NewAppError("GetUser", "not_found", nil, "", 404))
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve verify", UserId: "user123"})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: /approve verify <APPROVAL_CODE> [comment]")
+	})
+}
+
 func TestHandleCancelCommand(t *testing.T) {
 	t.Run("missing approval code shows usage", func(t *testing.T) {
 		api := &plugintest.API{}
@@ -124,6 +228,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -137,7 +242,9 @@ func TestHandleCancelCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations for GetByCode
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -176,6 +283,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			TriggerId: "trigger123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -189,7 +297,9 @@ func TestHandleCancelCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -220,6 +330,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -237,7 +348,9 @@ func TestHandleCancelCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -272,6 +385,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			TriggerId: "trigger123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -285,7 +399,9 @@ func TestHandleCancelCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations - code not found
 		api.On("KVGet", "approval:code:Z-NOTFND").Return(nil, nil)
@@ -305,6 +421,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -330,6 +447,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -345,7 +463,9 @@ func TestHandleCancelCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV lookup for non-existent code (returns nil = not found)
 		api.On("KVGet", "approval:code:A-NOTFND").Return(nil, nil)
@@ -365,6 +485,7 @@ func TestHandleCancelCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -388,6 +509,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -408,6 +530,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -422,7 +545,9 @@ func TestHandleVerifyCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -455,6 +580,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -470,7 +596,9 @@ func TestHandleVerifyCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -501,6 +629,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -517,7 +646,9 @@ func TestHandleVerifyCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations
 		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
@@ -549,6 +680,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -563,7 +695,9 @@ func TestHandleVerifyCommand(t *testing.T) {
 
 		// Mock plugin activation
 		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 
 		// Mock KV store operations - code not found
 		api.On("KVGet", "approval:code:A-NOTFND").Return(nil, nil)
@@ -582,6 +716,7 @@ func TestHandleVerifyCommand(t *testing.T) {
 			ChannelId: "channel123",
 		}
 
+		mockUserLocale(api)
 		resp, appErr := p.ExecuteCommand(nil, args)
 		assert.Nil(t, appErr)
 		assert.NotNil(t, resp)
@@ -625,6 +760,480 @@ func TestServeHTTP(t *testing.T) {
 	})
 }
 
+func TestServeHTTP_VerifiesRequestUser(t *testing.T) {
+	actionBody := `{"user_id": "approver456", "trigger_id": "trigger123", "context": {"approval_id": "record123", "action": "approve"}}`
+	dialogBody := `{"user_id": "approver456", "callback_id": "confirm_approve_record123", "submission": {"comment": "ok"}}`
+
+	setup := func() *plugintest.API {
+		api := &plugintest.API{}
+		api.On("LogWarn", "Audit: rejected interactive request with mismatched user ID",
+			"endpoint", mock.Anything, "header_user_id", mock.Anything, "body_user_id", mock.Anything, "remote_addr", mock.Anything).Maybe().Return()
+		return api
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		headerUserID string
+		expectedCode int
+		audited      bool
+	}{
+		{name: "action without header is unauthorized", path: "/action", body: actionBody, expectedCode: http.StatusUnauthorized},
+		{name: "action with forged body user is forbidden", path: "/action", body: actionBody, headerUserID: "attacker789", expectedCode: http.StatusForbidden, audited: true},
+		{name: "action with empty body user is forbidden", path: "/action", body: `{"context": {"approval_id": "record123", "action": "approve"}}`, headerUserID: "attacker789", expectedCode: http.StatusForbidden, audited: true},
+		{name: "dialog without header is unauthorized", path: "/dialog/submit", body: dialogBody, expectedCode: http.StatusUnauthorized},
+		{name: "dialog with forged body user is forbidden", path: "/dialog/submit", body: dialogBody, headerUserID: "attacker789", expectedCode: http.StatusForbidden, audited: true},
+		{name: "bulk dialog with forged body user is forbidden", path: "/bulk", body: `{"user_id": "approver456", "trigger_id": "trigger123"}`, headerUserID: "attacker789", expectedCode: http.StatusForbidden, audited: true},
+		{name: "list page with forged body user is forbidden", path: "/list/page", body: `{"user_id": "user123", "context": {"filter": "all", "page": 2, "limit": 20}}`, headerUserID: "attacker789", expectedCode: http.StatusForbidden, audited: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := setup()
+			p := &Plugin{}
+			p.SetAPI(api)
+
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			if tt.headerUserID != "" {
+				req.Header.Set("Mattermost-User-ID", tt.headerUserID)
+			}
+			w := httptest.NewRecorder()
+
+			p.ServeHTTP(nil, w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.audited {
+				api.AssertCalled(t, "LogWarn", "Audit: rejected interactive request with mismatched user ID",
+					"endpoint", tt.path, "header_user_id", tt.headerUserID, "body_user_id", mock.Anything, "remote_addr", mock.Anything)
+			} else {
+				api.AssertNotCalled(t, "LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			// Forged requests never reach the approval store
+			api.AssertNotCalled(t, "KVGet", mock.Anything)
+		})
+	}
+
+	t.Run("matching header reaches the handler", func(t *testing.T) {
+		api := setup()
+		api.On("KVGet", "approval:record:record123").Return(nil, nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456"}, nil)
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+		p := &Plugin{actionSigner: testActionSigner}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+
+		body := signedActionBody("approver456", testActionSigner.SignedContext("record123", "approve", time.Now()))
+		req := httptest.NewRequest("POST", "/action", strings.NewReader(body))
+		req.Header.Set("Mattermost-User-ID", "approver456")
+		w := httptest.NewRecorder()
+
+		p.ServeHTTP(nil, w, req)
+
+		var response model.PostActionIntegrationResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "Approval not found", response.EphemeralText)
+	})
+}
+
+func TestHandleMetrics(t *testing.T) {
+	setup := func(roles string) (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Roles: roles}, nil)
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		p := &Plugin{metrics: metrics.New()}
+		p.SetAPI(api)
+		return api, p
+	}
+
+	scrape := func(p *Plugin, userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/metrics", nil)
+		if userID != "" {
+			req.Header.Set("Mattermost-User-ID", userID)
+		}
+		w := httptest.NewRecorder()
+		p.ServeHTTP(nil, w, req)
+		return w
+	}
+
+	t.Run("system admin gets Prometheus text", func(t *testing.T) {
+		api, p := setup("system_user system_admin")
+		older, _ := json.Marshal(&approval.ApprovalRecord{ID: "record1", Status: approval.StatusPending, CreatedAt: time.Now().Add(-time.Hour).UnixMilli()})
+		justCreated, _ := json.Marshal(&approval.ApprovalRecord{ID: "record2", Status: approval.StatusPending, CreatedAt: time.Now().UnixMilli()})
+		decided, _ := json.Marshal(&approval.ApprovalRecord{ID: "record3", Status: approval.StatusApproved, CreatedAt: time.Now().UnixMilli()})
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return([]string{
+			"approval:code:A-X7K9Q2",
+			"approval:record:record1",
+			"approval:record:record2",
+			"approval:record:record3",
+		}, nil)
+		api.On("KVGet", "approval:record:record1").Return(older, nil)
+		api.On("KVGet", "approval:record:record2").Return(justCreated, nil)
+		api.On("KVGet", "approval:record:record3").Return(decided, nil)
+		p.metrics.RequestEvent(metrics.EventCreated)
+		p.metrics.NotificationFailed("bot_blocked")
+
+		w := scrape(p, "user123")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "approval_requests_created_total 1\n")
+		assert.Contains(t, w.Body.String(), "approval_notification_failures_total{error_type=\"bot_blocked\"} 1\n")
+		assert.Contains(t, w.Body.String(), "approval_requests_pending 2\n", "requests created this second are counted")
+		api.AssertNotCalled(t, "LogDebug", "Completed timeout scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pending count is reused between scrapes", func(t *testing.T) {
+		api, p := setup("system_user system_admin")
+		pending, _ := json.Marshal(&approval.ApprovalRecord{ID: "record1", Status: approval.StatusPending})
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return([]string{"approval:record:record1"}, nil)
+		api.On("KVGet", "approval:record:record1").Return(pending, nil)
+
+		first := scrape(p, "user123")
+		second := scrape(p, "user123")
+
+		assert.Contains(t, first.Body.String(), "approval_requests_pending 1\n")
+		assert.Contains(t, second.Body.String(), "approval_requests_pending 1\n")
+		api.AssertNumberOfCalls(t, "KVList", 1)
+	})
+
+	t.Run("non-admin is forbidden", func(t *testing.T) {
+		api, p := setup("system_user")
+
+		w := scrape(p, "user123")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		api.AssertNotCalled(t, "KVList", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unauthenticated request is rejected", func(t *testing.T) {
+		api, p := setup("system_user system_admin")
+
+		w := scrape(p, "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		api.AssertNotCalled(t, "GetUser", mock.Anything)
+	})
+
+	t.Run("store failure returns 500", func(t *testing.T) {
+		api, p := setup("system_user system_admin")
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(nil, &model.AppError{Message: "KV error"})
+
+		w := scrape(p, "user123")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandleListPage(t *testing.T) {
+	listRecord := func(id string, createdAt int64) *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                id,
+			Code:              "A-" + strings.ToUpper(id),
+			Status:            approval.StatusPending,
+			RequesterID:       "user123",
+			RequesterUsername: "alice",
+			ApproverUsername:  "bob",
+			CreatedAt:         createdAt,
+		}
+	}
+
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		mockUserLocale(api)
+		p := &Plugin{}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		return api, p
+	}
+
+	request := func(context string) *http.Request {
+		body := `{"user_id": "user123", "channel_id": "channel123", "post_id": "post123", "context": ` + context + `}`
+		req := httptest.NewRequest("POST", "/list/page", strings.NewReader(body))
+		req.Header.Set("Mattermost-User-ID", "user123")
+		return req
+	}
+
+	t.Run("replaces the ephemeral post with the next page", func(t *testing.T) {
+		api, p := setup()
+
+		newer := listRecord("record1", 2000)
+		older := listRecord("record2", 1000)
+		api.On("KVList", 0, 10000).Return([]string{
+			"approval:index:requester:user123:9999999997999:record1",
+			"approval:index:requester:user123:9999999998999:record2",
+		}, nil)
+		for _, record := range []*approval.ApprovalRecord{newer, older} {
+			data, _ := json.Marshal(record)
+			api.On("KVGet", "approval:record:"+record.ID).Return(data, nil)
+		}
+
+		var updated *model.Post
+		api.On("UpdateEphemeralPost", "user123", mock.MatchedBy(func(post *model.Post) bool {
+			updated = post
+			return true
+		})).Return(&model.Post{})
+
+		w := httptest.NewRecorder()
+		p.ServeHTTP(nil, w, request(`{"filter": "pending", "page": 2, "limit": 1, "after": "9999999997999:record1"}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, updated)
+		assert.Equal(t, "post123", updated.Id)
+		assert.Equal(t, "channel123", updated.ChannelId)
+		assert.Contains(t, updated.Message, "A-RECORD2")
+		assert.NotContains(t, updated.Message, "A-RECORD1")
+		assert.NotNil(t, updated.Props["attachments"], "Previous button")
+	})
+
+	t.Run("invalid context is rejected", func(t *testing.T) {
+		api, p := setup()
+		api.On("LogError", "Invalid list page context", "user_id", "user123", "error", mock.Anything).Once()
+
+		w := httptest.NewRecorder()
+		p.ServeHTTP(nil, w, request(`{"filter": "pending", "page": 0, "limit": 20}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		api.AssertNotCalled(t, "UpdateEphemeralPost", mock.Anything, mock.Anything)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("store failure is reported", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVList", 0, 10000).Return(nil, &model.AppError{Message: "KV error"})
+		api.On("LogError", "Failed to retrieve approval records for list page", "user_id", "user123", "error", mock.Anything).Once()
+
+		w := httptest.NewRecorder()
+		p.ServeHTTP(nil, w, request(`{"filter": "pending", "page": 2, "limit": 20}`))
+
+		var response model.PostActionIntegrationResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, response.EphemeralText, "Failed to retrieve approval records")
+		api.AssertExpectations(t)
+	})
+}
+
+func TestHandleBulkCommand(t *testing.T) {
+	pendingRecord := func(id string, status string, createdAt int64) []byte {
+		data, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:                id,
+			Code:              "A-" + strings.ToUpper(id),
+			RequesterID:       "requester123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Deploy\nrelease " + id,
+			Status:            status,
+			CreatedAt:         createdAt,
+		})
+		return data
+	}
+
+	setup := func() (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		mockUserLocale(api)
+		p := &Plugin{}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		return api, p
+	}
+
+	args := func(command string) *model.CommandArgs {
+		return &model.CommandArgs{Command: command, UserId: "approver456", TriggerId: "trigger123"}
+	}
+
+	t.Run("lists pending requests oldest first", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVList", 0, 10000).Return([]string{
+			"approval:index:approver:approver456:9999999996999:recnew",
+			"approval:index:approver:approver456:9999999997999:recdone",
+			"approval:index:approver:approver456:9999999998999:recold",
+			"approval:index:requester:approver456:9999999995999:recmine",
+		}, nil)
+		api.On("KVGet", "approval:record:recnew").Return(pendingRecord("recnew", approval.StatusPending, 3000), nil)
+		api.On("KVGet", "approval:record:recdone").Return(pendingRecord("recdone", approval.StatusApproved, 2000), nil)
+		api.On("KVGet", "approval:record:recold").Return(pendingRecord("recold", approval.StatusPending, 1000), nil)
+
+		var dialog model.OpenDialogRequest
+		api.On("OpenInteractiveDialog", mock.MatchedBy(func(request model.OpenDialogRequest) bool {
+			dialog = request
+			return true
+		})).Return(nil)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve bulk"))
+		require.Nil(t, appErr)
+		assert.Empty(t, resp.Text)
+
+		assert.Equal(t, "trigger123", dialog.TriggerId)
+		assert.Equal(t, bulkDecisionCallbackID, dialog.Dialog.CallbackId)
+		assert.NotContains(t, dialog.Dialog.IntroductionText, "oldest pending requests")
+
+		elements := dialog.Dialog.Elements
+		require.Len(t, elements, 4)
+		assert.Equal(t, "decision", elements[0].Name)
+		assert.Equal(t, "radio", elements[0].Type)
+		assert.Equal(t, "record_recold", elements[1].Name)
+		assert.Equal(t, "bool", elements[1].Type)
+		assert.Equal(t, "A-RECOLD", elements[1].DisplayName)
+		assert.Equal(t, "@alice: Deploy release recold", elements[1].Placeholder)
+		assert.Equal(t, "record_recnew", elements[2].Name)
+		assert.Equal(t, "comment", elements[3].Name)
+		api.AssertNotCalled(t, "KVGet", "approval:record:recmine")
+	})
+
+	t.Run("caps the dialog and says more are waiting", func(t *testing.T) {
+		api, p := setup()
+		keys := make([]string, 0, maxBulkDecisions+2)
+		for i := range maxBulkDecisions + 2 {
+			id := fmt.Sprintf("rec%02d", i)
+			keys = append(keys, fmt.Sprintf("approval:index:approver:approver456:%013d:%s", 9999999999999-int64(1000+i), id))
+			api.On("KVGet", "approval:record:"+id).Return(pendingRecord(id, approval.StatusPending, int64(1000+i)), nil).Maybe()
+		}
+		api.On("KVList", 0, 10000).Return(keys, nil)
+
+		var dialog model.OpenDialogRequest
+		api.On("OpenInteractiveDialog", mock.MatchedBy(func(request model.OpenDialogRequest) bool {
+			dialog = request
+			return true
+		})).Return(nil)
+
+		_, appErr := p.ExecuteCommand(nil, args("/approve bulk"))
+		require.Nil(t, appErr)
+		assert.Len(t, dialog.Dialog.Elements, maxBulkDecisions+2)
+		assert.Equal(t, "record_rec00", dialog.Dialog.Elements[1].Name)
+		assert.Contains(t, dialog.Dialog.IntroductionText, "Showing your 25 oldest pending requests.")
+	})
+
+	t.Run("nothing to decide", func(t *testing.T) {
+		api, p := setup()
+		api.On("KVList", 0, 10000).Return([]string{}, nil)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve bulk"))
+		require.Nil(t, appErr)
+		assert.Equal(t, "No pending requests await your decision.", resp.Text)
+		api.AssertNotCalled(t, "OpenInteractiveDialog", mock.Anything)
+	})
+
+	t.Run("extra arguments show usage", func(t *testing.T) {
+		_, p := setup()
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve bulk approve"))
+		require.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: `/approve bulk`")
+	})
+}
+
+func TestHandleDecideCommand(t *testing.T) {
+	recordJSON := func(status string) []byte {
+		data, _ := json.Marshal(&approval.ApprovalRecord{
+			ID:                 "record123",
+			Code:               "A-X7K9Q2",
+			RequesterID:        "requester123",
+			RequesterUsername:  "alice",
+			ApproverID:         "approver456",
+			ApproverUsername:   "bob",
+			Description:        "Deploy v2.5.0 to production",
+			Status:             status,
+			CreatedAt:          1704931200000,
+			NotificationPostID: "dm_post",
+			SchemaVersion:      1,
+		})
+		return data
+	}
+
+	setup := func(status string) (*plugintest.API, *Plugin) {
+		api := &plugintest.API{}
+		mockUserLocale(api)
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return(recordJSON(status), nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner}
+		p.SetAPI(api)
+		p.store = store.NewKVStore(api)
+		p.service = approval.NewService(p.store, api, "bot123")
+		return api, p
+	}
+
+	args := func(command, userID string) *model.CommandArgs {
+		return &model.CommandArgs{Command: command, UserId: userID}
+	}
+
+	t.Run("missing approval code shows usage", func(t *testing.T) {
+		_, p := setup(approval.StatusPending)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve yes", "approver456"))
+		require.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: `/approve yes <APPROVAL_CODE> [comment]`")
+	})
+
+	t.Run("denies with comment like the button flow", func(t *testing.T) {
+		api, p := setup(approval.StatusPending)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("GetDirectChannel", "bot123", "requester123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "outcome_post"}, nil)
+		api.On("GetPost", "dm_post").Return(&model.Post{Id: "dm_post", Message: "📋 **Approval Request**"}, nil)
+		api.On("UpdatePost", mock.Anything).Return(&model.Post{}, nil)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve no A-X7K9Q2 Wait for the freeze to end", "approver456"))
+		require.Nil(t, appErr)
+		assert.Equal(t, "❌ Denied **A-X7K9Q2**. The requester will be notified.", resp.Text)
+
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.MatchedBy(func(data []byte) bool {
+			var saved approval.ApprovalRecord
+			return json.Unmarshal(data, &saved) == nil &&
+				saved.Status == approval.StatusDenied &&
+				saved.DecisionComment == "Wait for the freeze to end"
+		}))
+		api.AssertCalled(t, "CreatePost", mock.MatchedBy(func(post *model.Post) bool { return post.ChannelId == "dm_alice" }))
+		api.AssertCalled(t, "UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.Id == "dm_post" && strings.HasPrefix(post.Message, "❌ **Decision Recorded: Denied")
+		}))
+	})
+
+	t.Run("only the approver may decide", func(t *testing.T) {
+		api, p := setup(approval.StatusPending)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve yes A-X7K9Q2", "requester123"))
+		require.Nil(t, appErr)
+		assert.Equal(t, "Permission denied", resp.Text)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("finalized request is not changed", func(t *testing.T) {
+		api, p := setup(approval.StatusApproved)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve no A-X7K9Q2", "approver456"))
+		require.Nil(t, appErr)
+		assert.Equal(t, "Decision already recorded: approved", resp.Text)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		api, p := setup(approval.StatusPending)
+		api.On("KVGet", "approval:code:A-NOPE00").Return(nil, nil)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve yes A-NOPE00", "approver456"))
+		require.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "'A-NOPE00' not found")
+	})
+
+	t.Run("comment too long", func(t *testing.T) {
+		api, p := setup(approval.StatusPending)
+
+		resp, appErr := p.ExecuteCommand(nil, args("/approve yes A-X7K9Q2 "+strings.Repeat("x", 501), "approver456"))
+		require.Nil(t, appErr)
+		assert.Equal(t, "Comment is too long (501 characters, max 500).", resp.Text)
+		api.AssertNotCalled(t, "KVGet", "approval:code:A-X7K9Q2")
+	})
+}
+
 func TestHandleAction(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -634,20 +1243,15 @@ func TestHandleAction(t *testing.T) {
 		userID         string
 		approverID     string
 		recordStatus   string
+		signedAt       time.Time
+		locale         string
 		setupMocks     func(*plugintest.API, *approval.Service)
 		expectedStatus int
 		expectedError  string
+		rejectedAction bool
 	}{
 		{
-			name: "approve button opens modal for pending approval",
-			requestBody: `{
-				"user_id": "approver456",
-				"trigger_id": "trigger123",
-				"context": {
-					"approval_id": "record123",
-					"action": "approve"
-				}
-			}`,
+			name:         "approve button opens modal for pending approval",
 			approvalID:   "record123",
 			action:       "approve",
 			userID:       "approver456",
@@ -662,15 +1266,7 @@ func TestHandleAction(t *testing.T) {
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name: "deny button opens modal for pending approval",
-			requestBody: `{
-				"user_id": "approver456",
-				"trigger_id": "trigger123",
-				"context": {
-					"approval_id": "record123",
-					"action": "deny"
-				}
-			}`,
+			name:         "deny button opens modal for pending approval",
 			approvalID:   "record123",
 			action:       "deny",
 			userID:       "approver456",
@@ -685,15 +1281,7 @@ func TestHandleAction(t *testing.T) {
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name: "non-approver rejected with permission denied",
-			requestBody: `{
-				"user_id": "unauthorized789",
-				"trigger_id": "trigger123",
-				"context": {
-					"approval_id": "record123",
-					"action": "approve"
-				}
-			}`,
+			name:           "non-approver rejected with permission denied",
 			approvalID:     "record123",
 			action:         "approve",
 			userID:         "unauthorized789",
@@ -703,15 +1291,18 @@ func TestHandleAction(t *testing.T) {
 			expectedError:  "Permission denied",
 		},
 		{
-			name: "already approved request rejected",
-			requestBody: `{
-				"user_id": "approver456",
-				"trigger_id": "trigger123",
-				"context": {
-					"approval_id": "record123",
-					"action": "approve"
-				}
-			}`,
+			name:           "permission denied answered in the user's locale",
+			approvalID:     "record123",
+			action:         "approve",
+			userID:         "unauthorized789",
+			approverID:     "approver456",
+			recordStatus:   "pending",
+			locale:         "de",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Zugriff verweigert",
+		},
+		{
+			name:           "already approved request rejected",
 			approvalID:     "record123",
 			action:         "approve",
 			userID:         "approver456",
@@ -721,15 +1312,7 @@ func TestHandleAction(t *testing.T) {
 			expectedError:  "Decision already recorded",
 		},
 		{
-			name: "canceled request rejected",
-			requestBody: `{
-				"user_id": "approver456",
-				"trigger_id": "trigger123",
-				"context": {
-					"approval_id": "record123",
-					"action": "approve"
-				}
-			}`,
+			name:           "canceled request rejected",
 			approvalID:     "record123",
 			action:         "approve",
 			userID:         "approver456",
@@ -741,23 +1324,61 @@ func TestHandleAction(t *testing.T) {
 		{
 			name:           "invalid JSON returns error",
 			requestBody:    `{invalid json}`,
+			userID:         "approver456",
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "Invalid request",
 		},
 		{
-			name: "approval not found returns error",
+			name:           "approval not found returns error",
+			approvalID:     "notfound",
+			action:         "approve",
+			userID:         "approver456",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Approval not found",
+		},
+		{
+			name: "unsigned context rejected like expired buttons before record lookup",
 			requestBody: `{
 				"user_id": "approver456",
 				"trigger_id": "trigger123",
 				"context": {
-					"approval_id": "notfound",
+					"approval_id": "record123",
 					"action": "approve"
 				}
 			}`,
-			approvalID:     "notfound",
 			userID:         "approver456",
 			expectedStatus: http.StatusBadRequest,
-			expectedError:  "Approval not found",
+			expectedError:  "These buttons have expired",
+			rejectedAction: true,
+		},
+		{
+			name:           "tampered action rejected before record lookup",
+			requestBody:    tamperedActionBody("approver456", "record123"),
+			userID:         "approver456",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request",
+			rejectedAction: true,
+		},
+		{
+			name:           "expired buttons rejected before record lookup",
+			approvalID:     "record123",
+			action:         "approve",
+			signedAt:       time.Now().Add(-notifications.ActionContextTTL - time.Minute),
+			userID:         "approver456",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "These buttons have expired",
+			rejectedAction: true,
+		},
+		{
+			name:           "expired buttons answered in the approver's locale",
+			approvalID:     "record123",
+			action:         "approve",
+			signedAt:       time.Now().Add(-notifications.ActionContextTTL - time.Minute),
+			userID:         "approver456",
+			locale:         "de",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Diese Schaltflächen sind abgelaufen",
+			rejectedAction: true,
 		},
 	}
 
@@ -765,12 +1386,21 @@ func TestHandleAction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			api := &plugintest.API{}
 			api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+			api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
 			api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+			api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
 			api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
 			api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
 
+			// Rejections are answered in the clicking user's locale
+			api.On("GetUser", tt.userID).Return(&model.User{Id: tt.userID, Locale: tt.locale}, nil).Maybe()
+
 			// Setup service mocks
-			if tt.approvalID != "" && tt.approvalID != "notfound" {
+			if tt.rejectedAction {
+				api.On("LogWarn", "Audit: rejected action with invalid or expired signature",
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			} else if tt.approvalID != "" && tt.approvalID != "notfound" {
 				// Mock GetByID for service
 				recordJSON := fmt.Sprintf(`{
 					"id": "%s",
@@ -803,7 +1433,16 @@ func TestHandleAction(t *testing.T) {
 			assert.NoError(t, err)
 
 			// Create HTTP request
-			req := httptest.NewRequest("POST", "/action", strings.NewReader(tt.requestBody))
+			body := tt.requestBody
+			if body == "" {
+				signedAt := tt.signedAt
+				if signedAt.IsZero() {
+					signedAt = time.Now()
+				}
+				body = signedActionBody(tt.userID, testActionSigner.SignedContext(tt.approvalID, tt.action, signedAt))
+			}
+			req := httptest.NewRequest("POST", "/action", strings.NewReader(body))
+			req.Header.Set("Mattermost-User-ID", tt.userID)
 			w := httptest.NewRecorder()
 
 			p.ServeHTTP(nil, w, req)
@@ -817,7 +1456,443 @@ func TestHandleAction(t *testing.T) {
 				assert.Contains(t, response.EphemeralText, tt.expectedError)
 			}
 
+			if tt.rejectedAction {
+				// Stale or forged buttons never reach the approval store
+				api.AssertNotCalled(t, "KVGet", "approval:record:record123")
+			}
 			api.AssertExpectations(t)
 		})
 	}
 }
+
+// signedActionBody builds the PostActionIntegrationRequest Mattermost sends for a button click
+func signedActionBody(userID string, context map[string]any) string {
+	body, _ := json.Marshal(model.PostActionIntegrationRequest{
+		UserId:    userID,
+		TriggerId: "trigger123",
+		Context:   context,
+	})
+	return string(body)
+}
+
+// tamperedActionBody signs an approve click and then rewrites the action to deny
+func tamperedActionBody(userID, approvalID string) string {
+	context := testActionSigner.SignedContext(approvalID, "approve", time.Now())
+	context["action"] = "deny"
+	return signedActionBody(userID, context)
+}
+
+func TestHandleResubmitCommand(t *testing.T) {
+	deniedRecordJSON := `{
+		"id": "record123",
+		"code": "A-X7K9Q2",
+		"requesterId": "user123",
+		"requesterUsername": "alice",
+		"requesterDisplayName": "Alice Carter",
+		"approverId": "approver456",
+		"approverUsername": "bob",
+		"approverDisplayName": "Bob Smith",
+		"description": "Deploy v2.5.0 to production",
+		"status": "denied",
+		"createdAt": 1704931200000,
+		"decidedAt": 1704931300000,
+		"requestChannelId": "channel123",
+		"schemaVersion": 1
+	}`
+
+	activate := func(t *testing.T, api *plugintest.API) *Plugin {
+		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
+		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+
+		p := &Plugin{}
+		p.SetAPI(api)
+		assert.NoError(t, p.OnActivate())
+		return p
+	}
+
+	t.Run("missing approval code shows usage", func(t *testing.T) {
+		api := &plugintest.API{}
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve resubmit",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: /approve resubmit <APPROVAL_CODE>")
+	})
+
+	t.Run("creates new request and notifies approver", func(t *testing.T) {
+		api := &plugintest.API{}
+		mockSearchIndex(api)
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(deniedRecordJSON), nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
+		// Registered before the catch-all so activation does not start indexing existing requests
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
+		api.On("KVGet", mock.AnythingOfType("string")).Return(nil, nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob", FirstName: "Bob", LastName: "Smith"}, nil)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_channel"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_channel" &&
+				strings.Contains(post.Message, "**Resubmission of:** `A-X7K9Q2`") &&
+				strings.Contains(post.Message, "Deploy v2.5.0 to production")
+		})).Return(&model.Post{Id: "post789"}, nil)
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_requester"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_requester" && strings.Contains(post.Message, "📨 **Approval Request Sent**")
+		})).Return(&model.Post{Id: "post_requester"}, nil)
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve resubmit A-X7K9Q2",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "✅ **Approval Request Resubmitted**")
+		assert.Contains(t, resp.Text, "**Approver:** @bob (Bob Smith)")
+		assert.Contains(t, resp.Text, "**Resubmitted from:** `A-X7K9Q2`")
+
+		// Original record is linked to the new request
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.MatchedBy(func(data []byte) bool {
+			return strings.Contains(string(data), `"nextCode":"A-`)
+		}))
+		api.AssertCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("pending request cannot be resubmitted", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(strings.Replace(deniedRecordJSON, `"denied"`, `"pending"`, 1)), nil)
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve resubmit A-X7K9Q2",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "It is still pending")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("permission denied for different user", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(deniedRecordJSON), nil)
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve resubmit A-X7K9Q2",
+			UserId:  "user999",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "❌ Permission denied")
+	})
+}
+
+func TestHandleCommentCommand(t *testing.T) {
+	recordJSON := `{
+		"id": "record123",
+		"code": "A-X7K9Q2",
+		"requesterId": "user123",
+		"requesterUsername": "alice",
+		"approverId": "approver456",
+		"approverUsername": "bob",
+		"description": "Deploy v2.5.0 to production",
+		"status": "pending",
+		"createdAt": 1704931200000,
+		"notificationPostId": "approver_root",
+		"requesterPostId": "requester_root",
+		"schemaVersion": 1
+	}`
+
+	activate := func(t *testing.T, api *plugintest.API) *Plugin {
+		api.On("EnsureBotUser", mock.AnythingOfType("*model.Bot")).Return("bot123", nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("test-action-signing-secret"), nil)
+		api.On("RegisterCommand", mock.AnythingOfType("*model.Command")).Return(nil)
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
+
+		p := &Plugin{}
+		p.SetAPI(api)
+		assert.NoError(t, p.OnActivate())
+		return p
+	}
+
+	t.Run("missing text shows usage", func(t *testing.T) {
+		api := &plugintest.API{}
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve comment A-X7K9Q2",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: /approve comment <APPROVAL_CODE> <text>")
+	})
+
+	t.Run("approver comment is recorded and relayed in the requester thread", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(recordJSON), nil)
+		api.On("KVSet", "approval:record:record123", mock.Anything).Return(nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetUser", "approver456").Return(&model.User{Id: "approver456", Username: "bob"}, nil)
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_alice" && post.RootId == "requester_root" &&
+				strings.Contains(post.Message, "💬 **Comment from @bob**") &&
+				strings.Contains(post.Message, "Which cluster is this for?")
+		})).Return(&model.Post{Id: "comment_post"}, nil)
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve comment A-X7K9Q2 Which cluster is this for?",
+			UserId:  "approver456",
+		})
+		assert.Nil(t, appErr)
+		assert.Equal(t, "💬 Comment sent on `A-X7K9Q2`.", resp.Text)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.MatchedBy(func(data []byte) bool {
+			return strings.Contains(string(data), `"message":"Which cluster is this for?"`)
+		}))
+		api.AssertCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("comment is kept when relay fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(recordJSON), nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Username: "alice"}, nil)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(nil, &model.AppError{Message: "DMs disabled"})
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve comment A-X7K9Q2 It is the EU cluster",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "⚠️ Comment saved on `A-X7K9Q2`")
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+
+	t.Run("non-participant is denied", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(recordJSON), nil)
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve comment A-X7K9Q2 hello",
+			UserId:  "mallory789",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "❌ Permission denied. Only the requester and approvers can comment")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid code format", func(t *testing.T) {
+		api := &plugintest.API{}
+		p := activate(t, api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{
+			Command: "/approve comment bogus hello",
+			UserId:  "user123",
+		})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "❌ Invalid approval code format: 'bogus'")
+	})
+}
+
+func TestHandleAdminResendCommand(t *testing.T) {
+	pendingJSON := `{
+		"id": "record123",
+		"code": "A-X7K9Q2",
+		"requesterId": "user123",
+		"requesterUsername": "alice",
+		"approverId": "approver456",
+		"approverUsername": "bob",
+		"description": "Deploy v2.5.0 to production",
+		"status": "pending",
+		"createdAt": 1704931200000,
+		"notificationPostId": "old_post",
+		"schemaVersion": 1
+	}`
+	approvedJSON := `{
+		"id": "record123",
+		"code": "A-X7K9Q2",
+		"requesterId": "user123",
+		"requesterUsername": "alice",
+		"approverId": "approver456",
+		"approverUsername": "bob",
+		"description": "Deploy v2.5.0 to production",
+		"status": "approved",
+		"createdAt": 1704931200000,
+		"decidedAt": 1704931300000,
+		"notificationSent": true,
+		"notificationPostId": "old_post",
+		"schemaVersion": 1
+	}`
+
+	setup := func(recordJSON string) (*Plugin, *plugintest.API) {
+		api := &plugintest.API{}
+		api.On("GetUser", "admin1").Return(&model.User{Id: "admin1", Username: "root", Roles: "system_user system_admin"}, nil)
+		api.On("KVGet", "approval:code:A-X7K9Q2").Return([]byte(`"record123"`), nil)
+		api.On("KVGet", "approval:record:record123").Return([]byte(recordJSON), nil)
+		api.On("KVSet", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+		api.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		p := &Plugin{botUserID: "bot123", actionSigner: testActionSigner, store: store.NewKVStore(api)}
+		p.SetAPI(api)
+		return p, api
+	}
+
+	savedRecord := func(t *testing.T, api *plugintest.API) *approval.ApprovalRecord {
+		t.Helper()
+		for _, call := range api.Calls {
+			if call.Method == "KVSet" && call.Arguments.String(0) == "approval:record:record123" {
+				var record approval.ApprovalRecord
+				require.NoError(t, json.Unmarshal(call.Arguments.Get(1).([]byte), &record))
+				return &record
+			}
+		}
+		t.Fatal("approval record was not saved")
+		return nil
+	}
+
+	t.Run("missing code shows usage", func(t *testing.T) {
+		api := &plugintest.API{}
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		mockUserLocale(api)
+		resp, appErr := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend", UserId: "admin1"})
+		assert.Nil(t, appErr)
+		assert.Contains(t, resp.Text, "Usage: /approve admin resend <APPROVAL_CODE> [approver|requester]")
+	})
+
+	t.Run("non-admins are rejected", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("GetUser", "user123").Return(&model.User{Id: "user123", Roles: "system_user"}, nil)
+		p := &Plugin{}
+		p.SetAPI(api)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2", UserId: "user123"})
+		assert.Contains(t, resp.Text, "Permission denied")
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		p, api := setup(pendingJSON)
+		api.On("KVGet", "approval:code:A-NOPE99").Return(nil, nil)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-NOPE99", UserId: "admin1"})
+		assert.Equal(t, "❌ Approval request 'A-NOPE99' not found.", resp.Text)
+	})
+
+	t.Run("unknown recipient", func(t *testing.T) {
+		p, api := setup(pendingJSON)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2 everyone", UserId: "admin1"})
+		assert.Contains(t, resp.Text, "Unknown recipient 'everyone'")
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("pending request is resent to the approver with live buttons", func(t *testing.T) {
+		p, api := setup(pendingJSON)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_bob" && hasActionButtons(post)
+		})).Return(&model.Post{Id: "new_post"}, nil)
+		oldPost := &model.Post{Id: "old_post", Message: "📋 **Approval Request**"}
+		oldPost.AddProp("attachments", []*model.SlackAttachment{{Actions: []*model.PostAction{{Id: "approve"}}}})
+		api.On("GetPost", "old_post").Return(oldPost, nil)
+		api.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.Id == "old_post" && len(post.Props) == 0 &&
+				strings.HasPrefix(post.Message, "🔁 **Notification Resent**")
+		})).Return(oldPost, nil)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2", UserId: "admin1"})
+
+		assert.Equal(t, "📨 Resent the request DM for `A-X7K9Q2` to the approver.", resp.Text)
+		api.AssertCalled(t, "UpdatePost", mock.Anything)
+		record := savedRecord(t, api)
+		assert.True(t, record.NotificationSent)
+		assert.Equal(t, "new_post", record.NotificationPostID)
+		require.Len(t, record.Redeliveries, 1)
+		assert.Equal(t, approval.RedeliveryTargetApprover, record.Redeliveries[0].Target)
+		assert.Equal(t, "request", record.Redeliveries[0].Notification)
+		assert.Equal(t, "root", record.Redeliveries[0].ResentByUsername)
+		assert.Equal(t, "new_post", record.Redeliveries[0].PostID)
+	})
+
+	t.Run("approved request resends the outcome to the requester", func(t *testing.T) {
+		p, api := setup(approvedJSON)
+		api.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_alice" && strings.Contains(post.Message, "Approval Request Approved")
+		})).Return(&model.Post{Id: "outcome_post"}, nil)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2", UserId: "admin1"})
+
+		assert.Equal(t, "📨 Resent the outcome DM for `A-X7K9Q2` to the requester.", resp.Text)
+		record := savedRecord(t, api)
+		assert.True(t, record.OutcomeNotified)
+		require.Len(t, record.Redeliveries, 1)
+		assert.Equal(t, "outcome", record.Redeliveries[0].Notification)
+	})
+
+	t.Run("approved request resends the decision to the approver", func(t *testing.T) {
+		p, api := setup(approvedJSON)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_bob" && strings.Contains(post.Message, "Decision Recorded: Approved")
+		})).Return(&model.Post{Id: "decision_post"}, nil)
+		api.On("GetPost", "old_post").Return(&model.Post{Id: "old_post", Message: "✅ **Decision Recorded: Approved**"}, nil)
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2 approver", UserId: "admin1"})
+
+		assert.Equal(t, "📨 Resent the decision DM for `A-X7K9Q2` to the approver.", resp.Text)
+		// The earlier post no longer has buttons, so it is left alone
+		api.AssertNotCalled(t, "UpdatePost", mock.Anything)
+		assert.Len(t, savedRecord(t, api).Redeliveries, 1)
+	})
+
+	t.Run("delivery failure is reported with a suggestion", func(t *testing.T) {
+		p, api := setup(pendingJSON)
+		api.On("GetDirectChannel", "bot123", "approver456").Return(nil, &model.AppError{Message: "DMs disabled"})
+
+		mockUserLocale(api)
+		resp, _ := p.ExecuteCommand(nil, &model.CommandArgs{Command: "/approve admin resend A-X7K9Q2", UserId: "admin1"})
+
+		assert.Contains(t, resp.Text, "❌ Could not resend the notification for `A-X7K9Q2` to the approver.")
+		assert.Contains(t, resp.Text, "**Suggestion:**")
+		api.AssertNotCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+}
@@ -0,0 +1,322 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+const (
+	// CheckInterval is how often the worker looks for due retries
+	CheckInterval = time.Minute
+
+	// leaseDuration is how long a node holds a retry while delivering it; a node that dies
+	// mid-delivery leaves the retry to be picked up again once the lease expires
+	leaseDuration = 2 * time.Minute
+
+	// errorTypeUserNotFound is the ClassifyDMError classification that retrying cannot fix
+	errorTypeUserNotFound = "user_not_found"
+)
+
+// errNothingToDeliver marks retries whose notification is no longer needed
+var errNothingToDeliver = errors.New("notification no longer needed")
+
+// Worker redelivers failed approver and outcome DMs from a persistent KV queue with exponential
+// backoff, and alerts system admins when it gives up.
+type Worker struct {
+	store      *store.KVStore
+	api        plugin.API
+	botUserID  string
+	signer     *notifications.ActionSigner
+	metrics    *metrics.Metrics
+	maxRetries func() int
+	now        func() time.Time
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// NewWorker creates a new retry Worker. maxRetries is read on every use so configuration
+// changes apply without restarting the plugin; zero disables retries. Failed redeliveries are
+// counted in metrics, which may be nil.
+func NewWorker(store *store.KVStore, api plugin.API, botUserID string, signer *notifications.ActionSigner, metrics *metrics.Metrics, maxRetries func() int) *Worker {
+	return &Worker{
+		store:      store,
+		api:        api,
+		botUserID:  botUserID,
+		signer:     signer,
+		metrics:    metrics,
+		maxRetries: maxRetries,
+		now:        time.Now,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start launches the background goroutine that delivers due retries.
+func (w *Worker) Start() {
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+
+	go w.run()
+
+	w.api.LogInfo("Notification retry worker started", "check_interval", CheckInterval.String())
+}
+
+// Stop gracefully shuts down the retry worker goroutine.
+func (w *Worker) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	// Wait for goroutine to exit
+	<-w.done
+
+	w.api.LogInfo("Notification retry worker stopped")
+}
+
+// run is the main loop that periodically delivers due retries.
+func (w *Worker) run() {
+	defer close(w.done)
+	defer func() {
+		if r := recover(); r != nil {
+			w.api.LogError("Notification retry worker panic recovered", "panic", r)
+		}
+	}()
+
+	ticker := time.NewTicker(CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.processRetries(); err != nil {
+				w.api.LogError("Notification retry check failed", "error", err.Error())
+			}
+		}
+	}
+}
+
+// Enqueue queues a failed notification for redelivery. Failures that retrying cannot fix
+// (deleted users) are reported to admins immediately. Does nothing when retries are disabled.
+func (w *Worker) Enqueue(kind string, record *approval.ApprovalRecord, sendErr error) error {
+	if w.maxRetries() <= 0 {
+		return nil
+	}
+
+	now := w.now()
+	errorType, _ := notifications.ClassifyDMError(sendErr)
+	retry := &approval.NotificationRetry{
+		Kind:          kind,
+		RecordID:      record.ID,
+		Code:          record.Code,
+		Attempts:      1,
+		NextAttemptAt: now.Add(approval.RetryBackoff(1)).UnixMilli(),
+		LastError:     sendErr.Error(),
+		LastErrorType: errorType,
+		CreatedAt:     now.UnixMilli(),
+	}
+
+	if errorType == errorTypeUserNotFound {
+		w.giveUp(retry)
+		return nil
+	}
+
+	if err := w.store.SaveNotificationRetry(retry); err != nil {
+		return fmt.Errorf("failed to queue %s notification retry for %s: %w", kind, record.Code, err)
+	}
+
+	w.api.LogInfo("Notification queued for retry",
+		"approval_id", record.ID,
+		"code", record.Code,
+		"kind", kind,
+		"error_type", errorType,
+		"next_attempt_at", retry.NextAttemptAt)
+
+	return nil
+}
+
+// processRetries attempts every due retry that this node manages to lease.
+func (w *Worker) processRetries() error {
+	retries, err := w.store.ListNotificationRetries()
+	if err != nil {
+		return fmt.Errorf("failed to list notification retries: %w", err)
+	}
+
+	now := w.now()
+	for _, retry := range retries {
+		if retry.NextAttemptAt > now.UnixMilli() {
+			break // sorted by NextAttemptAt; the rest are not due yet
+		}
+
+		// Only one cluster node attempts each retry
+		leased, err := w.store.LeaseNotificationRetry(retry, now.Add(leaseDuration).UnixMilli())
+		if err != nil {
+			w.api.LogWarn("Failed to lease notification retry", "approval_id", retry.RecordID, "kind", retry.Kind, "error", err.Error())
+			continue
+		}
+		if !leased {
+			continue
+		}
+
+		w.attempt(retry, now)
+	}
+
+	return nil
+}
+
+// attempt redelivers one notification, then removes, reschedules or abandons the retry.
+func (w *Worker) attempt(retry *approval.NotificationRetry, now time.Time) {
+	err := w.deliver(retry)
+	if err == nil || errors.Is(err, errNothingToDeliver) {
+		if deleteErr := w.store.DeleteNotificationRetry(retry.Kind, retry.RecordID); deleteErr != nil {
+			w.api.LogWarn("Failed to remove notification retry", "approval_id", retry.RecordID, "kind", retry.Kind, "error", deleteErr.Error())
+		}
+		if err == nil {
+			w.api.LogInfo("Notification retry delivered",
+				"approval_id", retry.RecordID,
+				"code", retry.Code,
+				"kind", retry.Kind,
+				"attempts", retry.Attempts+1)
+		}
+		return
+	}
+
+	retry.Attempts++
+	retry.LastError = err.Error()
+	retry.LastErrorType, _ = notifications.ClassifyDMError(err)
+	w.metrics.NotificationFailed(retry.LastErrorType)
+
+	// Attempts counts the original send, so the queue allows maxRetries redeliveries
+	if retry.LastErrorType == errorTypeUserNotFound || retry.Attempts > w.maxRetries() {
+		w.giveUp(retry)
+		return
+	}
+
+	retry.NextAttemptAt = now.Add(approval.RetryBackoff(retry.Attempts)).UnixMilli()
+	if saveErr := w.store.SaveNotificationRetry(retry); saveErr != nil {
+		w.api.LogWarn("Failed to reschedule notification retry", "approval_id", retry.RecordID, "kind", retry.Kind, "error", saveErr.Error())
+	}
+}
+
+// deliver sends the queued notification and records the delivery on the approval.
+// Returns errNothingToDeliver when the record no longer needs the notification.
+func (w *Worker) deliver(retry *approval.NotificationRetry) error {
+	record, err := w.store.GetApproval(retry.RecordID)
+	if err != nil {
+		if errors.Is(err, approval.ErrRecordNotFound) {
+			return errNothingToDeliver
+		}
+		return fmt.Errorf("failed to load approval %s: %w", retry.RecordID, err)
+	}
+
+	switch retry.Kind {
+	case approval.RetryKindApproverRequest:
+		return w.deliverApproverRequest(record)
+	case approval.RetryKindOutcome:
+		return w.deliverOutcome(record)
+	default:
+		return errNothingToDeliver
+	}
+}
+
+// deliverApproverRequest resends the approval request DM while the request is still pending.
+// Group requests are resent to every candidate who has not received one.
+func (w *Worker) deliverApproverRequest(record *approval.ApprovalRecord) error {
+	if record.Status != approval.StatusPending || record.NotificationSent {
+		return errNothingToDeliver
+	}
+
+	if !record.IsGroupApproval() {
+		postID, err := notifications.SendApprovalRequestDM(w.api, w.botUserID, w.signer, record)
+		if err != nil {
+			return err
+		}
+		record.NotificationSent = true
+		record.NotificationPostID = postID
+		return w.saveDelivery(record)
+	}
+
+	if record.CandidatePostIDs == nil {
+		record.CandidatePostIDs = make(map[string]string, len(record.CandidateApproverIDs))
+	}
+	var firstErr error
+	for _, approverID := range record.CandidateApproverIDs {
+		if record.CandidatePostIDs[approverID] != "" {
+			continue
+		}
+		postID, err := notifications.SendApprovalRequestDMTo(w.api, w.botUserID, w.signer, record, approverID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		record.CandidatePostIDs[approverID] = postID
+		if record.NotificationPostID == "" {
+			record.NotificationPostID = postID
+		}
+	}
+
+	if record.NotificationPostID == "" {
+		if firstErr == nil {
+			return errNothingToDeliver
+		}
+		return firstErr
+	}
+	record.NotificationSent = true
+	return w.saveDelivery(record)
+}
+
+// deliverOutcome resends the decision outcome DM to the requester
+func (w *Worker) deliverOutcome(record *approval.ApprovalRecord) error {
+	if (record.Status != approval.StatusApproved && record.Status != approval.StatusDenied) || record.OutcomeNotified {
+		return errNothingToDeliver
+	}
+
+	if _, err := notifications.SendOutcomeNotificationDM(w.api, w.botUserID, record); err != nil {
+		return err
+	}
+	record.OutcomeNotified = true
+	return w.saveDelivery(record)
+}
+
+// saveDelivery records a successful redelivery. The DM was sent, so a save failure is logged
+// rather than retried (retrying would send a duplicate DM).
+func (w *Worker) saveDelivery(record *approval.ApprovalRecord) error {
+	if err := w.store.SaveApproval(record); err != nil {
+		w.api.LogWarn("Failed to update notification tracking fields after retry",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"error", err.Error())
+	}
+	return nil
+}
+
+// giveUp removes a retry and alerts system admins that the notification could not be delivered.
+func (w *Worker) giveUp(retry *approval.NotificationRetry) {
+	if err := w.store.DeleteNotificationRetry(retry.Kind, retry.RecordID); err != nil {
+		w.api.LogWarn("Failed to remove abandoned notification retry", "approval_id", retry.RecordID, "kind", retry.Kind, "error", err.Error())
+	}
+
+	w.api.LogWarn("Audit: notification delivery abandoned",
+		"approval_id", retry.RecordID,
+		"code", retry.Code,
+		"kind", retry.Kind,
+		"attempts", retry.Attempts,
+		"error_type", retry.LastErrorType,
+		"error", retry.LastError)
+
+	if _, err := notifications.SendDeliveryFailureAlert(w.api, w.botUserID, retry); err != nil {
+		w.api.LogError("Failed to alert admins of abandoned notification",
+			"approval_id", retry.RecordID,
+			"code", retry.Code,
+			"error", err.Error())
+	}
+}
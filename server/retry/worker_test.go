@@ -0,0 +1,341 @@
+package retry
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+// newTestWorker returns a worker whose KV store is backed by the returned map
+func newTestWorker(t *testing.T, maxRetries int) (*Worker, *plugintest.API, map[string][]byte) {
+	t.Helper()
+
+	kv := make(map[string][]byte)
+	api := &plugintest.API{}
+	api.On("KVGet", mock.Anything).Return(
+		func(key string) []byte { return kv[key] },
+		func(string) *model.AppError { return nil },
+	)
+	api.On("KVSet", mock.Anything, mock.Anything).Return(func(key string, value []byte) *model.AppError {
+		kv[key] = value
+		return nil
+	})
+	api.On("KVDelete", mock.Anything).Return(func(key string) *model.AppError {
+		delete(kv, key)
+		return nil
+	})
+	api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(
+		func(int, int) []string {
+			keys := make([]string, 0, len(kv))
+			for key := range kv {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			return keys
+		},
+		func(int, int) *model.AppError { return nil },
+	)
+	api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(key string, value []byte, options model.PluginKVSetOptions) bool {
+			if string(kv[key]) != string(options.OldValue) {
+				return false
+			}
+			kv[key] = value
+			return true
+		},
+		func(string, []byte, model.PluginKVSetOptions) *model.AppError { return nil },
+	)
+	for _, level := range []string{"LogInfo", "LogWarn", "LogError", "LogDebug"} {
+		args := make([]interface{}, 15)
+		for i := range args {
+			args[i] = mock.Anything
+		}
+		api.On(level, args...).Maybe()
+	}
+
+	worker := NewWorker(store.NewKVStore(api), api, "bot123", notifications.NewActionSigner([]byte("secret")), nil, func() int {
+		return maxRetries
+	})
+	worker.now = func() time.Time { return now }
+
+	return worker, api, kv
+}
+
+func putRecord(t *testing.T, kv map[string][]byte, record *approval.ApprovalRecord) {
+	t.Helper()
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+	kv["approval:record:"+record.ID] = data
+}
+
+func getRecord(t *testing.T, kv map[string][]byte, id string) *approval.ApprovalRecord {
+	t.Helper()
+	var record approval.ApprovalRecord
+	require.NoError(t, json.Unmarshal(kv["approval:record:"+id], &record))
+	return &record
+}
+
+func putRetry(t *testing.T, kv map[string][]byte, retry *approval.NotificationRetry) {
+	t.Helper()
+	data, err := json.Marshal(retry)
+	require.NoError(t, err)
+	kv["approval:retry:"+retry.Kind+":"+retry.RecordID] = data
+}
+
+func getRetry(t *testing.T, kv map[string][]byte, kind, recordID string) *approval.NotificationRetry {
+	t.Helper()
+	data, ok := kv["approval:retry:"+kind+":"+recordID]
+	if !ok {
+		return nil
+	}
+	var retry approval.NotificationRetry
+	require.NoError(t, json.Unmarshal(data, &retry))
+	return &retry
+}
+
+func dueRetry(kind string, attempts int) *approval.NotificationRetry {
+	return &approval.NotificationRetry{
+		Kind:          kind,
+		RecordID:      "record123",
+		Code:          "A-X7K9Q2",
+		Attempts:      attempts,
+		NextAttemptAt: now.Add(-time.Second).UnixMilli(),
+		LastError:     "DMs disabled",
+		LastErrorType: "user_dms_disabled",
+		CreatedAt:     now.Add(-time.Hour).UnixMilli(),
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	t.Run("queues the first retry a minute out", func(t *testing.T) {
+		worker, _, kv := newTestWorker(t, 5)
+
+		err := worker.Enqueue(approval.RetryKindApproverRequest, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending}, errors.New("DMs disabled"))
+
+		require.NoError(t, err)
+		retry := getRetry(t, kv, approval.RetryKindApproverRequest, "record123")
+		require.NotNil(t, retry)
+		assert.Equal(t, 1, retry.Attempts)
+		assert.Equal(t, now.Add(time.Minute).UnixMilli(), retry.NextAttemptAt)
+		assert.Equal(t, "user_dms_disabled", retry.LastErrorType)
+		assert.Equal(t, "A-X7K9Q2", retry.Code)
+	})
+
+	t.Run("does nothing when retries are disabled", func(t *testing.T) {
+		worker, _, kv := newTestWorker(t, 0)
+
+		require.NoError(t, worker.Enqueue(approval.RetryKindApproverRequest, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending}, errors.New("DMs disabled")))
+		assert.Empty(t, kv)
+	})
+
+	t.Run("deleted users alert admins immediately", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		api.On("GetUsers", mock.Anything).Return([]*model.User{{Id: "admin1"}}, nil)
+		api.On("GetDirectChannel", "bot123", "admin1").Return(&model.Channel{Id: "dm_admin1"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, worker.Enqueue(approval.RetryKindOutcome, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending}, errors.New("user_not_found")))
+
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindOutcome, "record123"))
+		api.AssertCalled(t, "CreatePost", mock.Anything)
+	})
+}
+
+func TestProcessRetries(t *testing.T) {
+	t.Run("redelivers the approver DM and records it", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusPending,
+			RequesterID:       "alice123",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			ApproverUsername:  "bob",
+			Description:       "Deploy v2.5.0 to production",
+			CreatedAt:         now.Add(-time.Hour).UnixMilli(),
+		})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 1))
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post_bob"}, nil)
+
+		require.NoError(t, worker.processRetries())
+
+		record := getRecord(t, kv, "record123")
+		assert.True(t, record.NotificationSent)
+		assert.Equal(t, "post_bob", record.NotificationPostID)
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindApproverRequest, "record123"))
+	})
+
+	t.Run("redelivers the outcome DM and records it", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusApproved,
+			RequesterID:       "alice123",
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			ApproverUsername:  "bob",
+			Description:       "Deploy v2.5.0 to production",
+			CreatedAt:         now.Add(-time.Hour).UnixMilli(),
+			DecidedAt:         now.UnixMilli(),
+		})
+		putRetry(t, kv, dueRetry(approval.RetryKindOutcome, 1))
+		api.On("GetDirectChannel", "bot123", "alice123").Return(&model.Channel{Id: "dm_alice"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post_alice"}, nil)
+
+		require.NoError(t, worker.processRetries())
+
+		assert.True(t, getRecord(t, kv, "record123").OutcomeNotified)
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindOutcome, "record123"))
+	})
+
+	t.Run("resends only to group members that were not reached", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			Status:               approval.StatusPending,
+			RequesterID:          "alice123",
+			RequesterUsername:    "alice",
+			ApproverGroupName:    "platform-oncall",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			CandidatePostIDs:     map[string]string{"bob123": ""},
+			Description:          "Deploy v2.5.0 to production",
+			CreatedAt:            now.Add(-time.Hour).UnixMilli(),
+		})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 1))
+		api.On("GetDirectChannel", "bot123", "bob123").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("GetDirectChannel", "bot123", "carol123").Return(&model.Channel{Id: "dm_carol"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "post_carol"}, nil)
+
+		require.NoError(t, worker.processRetries())
+
+		updated := getRecord(t, kv, "record123")
+		assert.True(t, updated.NotificationSent)
+		assert.Equal(t, "post_carol", updated.NotificationPostID)
+		assert.Equal(t, "post_carol", updated.CandidatePostIDs["carol123"])
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindApproverRequest, "record123"))
+	})
+
+	t.Run("failure reschedules with backoff", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending, ApproverID: "bob123"})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 2))
+		api.On("GetDirectChannel", "bot123", "bob123").Return(nil, &model.AppError{Message: "bot is blocked"})
+
+		require.NoError(t, worker.processRetries())
+
+		retry := getRetry(t, kv, approval.RetryKindApproverRequest, "record123")
+		require.NotNil(t, retry)
+		assert.Equal(t, 3, retry.Attempts)
+		assert.Equal(t, now.Add(4*time.Minute).UnixMilli(), retry.NextAttemptAt)
+		assert.Equal(t, "bot_blocked", retry.LastErrorType)
+		assert.False(t, getRecord(t, kv, "record123").NotificationSent)
+	})
+
+	t.Run("gives up after the configured attempts and alerts admins", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 2)
+		putRecord(t, kv, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending, ApproverID: "bob123"})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 2))
+		api.On("GetDirectChannel", "bot123", "bob123").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("GetUsers", mock.Anything).Return([]*model.User{{Id: "admin1"}}, nil)
+		api.On("GetDirectChannel", "bot123", "admin1").Return(&model.Channel{Id: "dm_admin1"}, nil)
+		api.On("CreatePost", mock.MatchedBy(func(post *model.Post) bool {
+			return post.ChannelId == "dm_admin1" &&
+				strings.Contains(post.Message, "Notification Delivery Failed") &&
+				strings.Contains(post.Message, "after 3 attempts")
+		})).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, worker.processRetries())
+
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindApproverRequest, "record123"))
+		api.AssertNumberOfCalls(t, "CreatePost", 1)
+	})
+
+	t.Run("drops retries for decided requests", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusCanceled, ApproverID: "bob123"})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 1))
+
+		require.NoError(t, worker.processRetries())
+
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindApproverRequest, "record123"))
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("drops retries for deleted records", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRetry(t, kv, dueRetry(approval.RetryKindOutcome, 1))
+
+		require.NoError(t, worker.processRetries())
+
+		assert.Nil(t, getRetry(t, kv, approval.RetryKindOutcome, "record123"))
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("leaves retries that are not due", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending, ApproverID: "bob123"})
+		retry := dueRetry(approval.RetryKindApproverRequest, 1)
+		retry.NextAttemptAt = now.Add(time.Minute).UnixMilli()
+		putRetry(t, kv, retry)
+
+		require.NoError(t, worker.processRetries())
+
+		assert.Equal(t, retry, getRetry(t, kv, approval.RetryKindApproverRequest, "record123"))
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("skips retries leased by another node", func(t *testing.T) {
+		worker, api, kv := newTestWorker(t, 5)
+		putRecord(t, kv, &approval.ApprovalRecord{ID: "record123", Code: "A-X7K9Q2", Status: approval.StatusPending, ApproverID: "bob123"})
+		putRetry(t, kv, dueRetry(approval.RetryKindApproverRequest, 1))
+		retries, err := worker.store.ListNotificationRetries()
+		require.NoError(t, err)
+
+		// Another node leases the retry between listing and leasing
+		leased, err := worker.store.LeaseNotificationRetry(retries[0], now.Add(leaseDuration).UnixMilli())
+		require.NoError(t, err)
+		require.True(t, leased)
+		leased, err = worker.store.LeaseNotificationRetry(retries[0], now.Add(leaseDuration).UnixMilli())
+		require.NoError(t, err)
+		assert.False(t, leased)
+
+		require.NoError(t, worker.processRetries())
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+}
+
+func TestStartStop(t *testing.T) {
+	worker, _, _ := newTestWorker(t, 5)
+
+	worker.Start()
+
+	done := make(chan struct{})
+	go func() {
+		worker.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop() did not complete within 2 seconds")
+	}
+}
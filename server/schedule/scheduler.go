@@ -0,0 +1,133 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// CheckInterval is how often the scheduler looks for scheduled requests whose send time has come
+const CheckInterval = time.Minute
+
+// Scheduler periodically sends scheduled approval requests once their send time arrives: it
+// moves each record to pending and delivers the approver DM.
+type Scheduler struct {
+	store   *store.KVStore
+	service *approval.Service
+	api     plugin.API
+	deliver func(*approval.ApprovalRecord)
+	now     func() time.Time
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewScheduler creates a new request Scheduler instance. deliver sends the approver DM and the
+// other creation notifications for a request that has just been moved to pending.
+func NewScheduler(store *store.KVStore, service *approval.Service, api plugin.API, deliver func(*approval.ApprovalRecord)) *Scheduler {
+	return &Scheduler{
+		store:   store,
+		service: service,
+		api:     api,
+		deliver: deliver,
+		now:     time.Now,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start launches the background goroutine that sends due scheduled requests.
+func (s *Scheduler) Start() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	go s.run()
+
+	s.api.LogInfo("Request scheduler started", "check_interval", CheckInterval.String())
+}
+
+// Stop gracefully shuts down the request scheduler goroutine.
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	// Wait for goroutine to exit
+	<-s.done
+
+	s.api.LogInfo("Request scheduler stopped")
+}
+
+// run is the main loop that periodically sends due scheduled requests.
+func (s *Scheduler) run() {
+	defer close(s.done)
+	defer func() {
+		if r := recover(); r != nil {
+			s.api.LogError("Request scheduler panic recovered", "panic", r)
+		}
+	}()
+
+	ticker := time.NewTicker(CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sendDue(); err != nil {
+				s.api.LogError("Scheduled request check failed", "error", err.Error())
+			}
+		}
+	}
+}
+
+// sendDue sends every scheduled request whose send time has come, earliest first.
+func (s *Scheduler) sendDue() error {
+	now := s.now()
+	recordIDs, err := s.store.ListDueScheduledRequests(now.UnixMilli())
+	if err != nil {
+		return fmt.Errorf("failed to query scheduled requests: %w", err)
+	}
+
+	sentCount := 0
+	for _, recordID := range recordIDs {
+		// Only one cluster node sends each request. The lease expires on its own, so a node that
+		// dies before saving the request as pending leaves it to be sent on a later check.
+		leased, err := s.store.LeaseScheduledRequest(recordID)
+		if err != nil {
+			s.api.LogWarn("Failed to lease scheduled request", "approval_id", recordID, "error", err.Error())
+			continue
+		}
+		if !leased {
+			continue
+		}
+
+		// Saving the request as pending removes its schedule entry
+		record, err := s.service.SendScheduledRequest(recordID, now)
+		if err != nil {
+			if errors.Is(err, approval.ErrRecordImmutable) || errors.Is(err, approval.ErrRecordNotFound) {
+				// Canceled (or deleted) after its schedule entry was listed
+				s.api.LogInfo("Skipped scheduled request that is no longer scheduled", "approval_id", recordID, "error", err.Error())
+				if err := s.store.UnscheduleRequest(recordID); err != nil {
+					s.api.LogWarn("Failed to remove stale schedule entry", "approval_id", recordID, "error", err.Error())
+				}
+				continue
+			}
+			// The schedule entry is kept, so the request is retried once the lease expires
+			s.api.LogError("Failed to send scheduled request", "approval_id", recordID, "error", err.Error())
+			continue
+		}
+
+		s.deliver(record)
+		sentCount++
+	}
+
+	s.api.LogDebug("Completed scheduled request check",
+		"due_count", len(recordIDs),
+		"sent_count", sentCount)
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package schedule
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestScheduler returns a scheduler whose KV store is backed by the returned map and which
+// records the requests it delivers
+func newTestScheduler(t *testing.T) (*Scheduler, *plugintest.API, map[string][]byte, *[]*approval.ApprovalRecord, time.Time) {
+	t.Helper()
+
+	kv := make(map[string][]byte)
+	api := &plugintest.API{}
+	api.On("KVGet", mock.Anything).Return(
+		func(key string) []byte { return kv[key] },
+		func(string) *model.AppError { return nil },
+	)
+	api.On("KVSet", mock.Anything, mock.Anything).Return(
+		func(key string, value []byte) *model.AppError {
+			kv[key] = value
+			return nil
+		},
+	)
+	api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(key string, value []byte, options model.PluginKVSetOptions) bool {
+			if current, ok := kv[key]; (options.OldValue == nil && ok) || string(current) != string(options.OldValue) {
+				return false
+			}
+			kv[key] = value
+			return true
+		},
+		func(string, []byte, model.PluginKVSetOptions) *model.AppError { return nil },
+	)
+	api.On("KVDelete", mock.Anything).Return(
+		func(key string) *model.AppError {
+			delete(kv, key)
+			return nil
+		},
+	)
+	api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(
+		func(int, int) []string {
+			keys := make([]string, 0, len(kv))
+			for key := range kv {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			return keys
+		},
+		func(int, int) *model.AppError { return nil },
+	)
+	for _, level := range []string{"LogInfo", "LogWarn", "LogError", "LogDebug"} {
+		args := make([]interface{}, 15)
+		for i := range args {
+			args[i] = mock.Anything
+		}
+		api.On(level, args...).Maybe()
+	}
+
+	delivered := make([]*approval.ApprovalRecord, 0)
+	kvStore := store.NewKVStore(api)
+	scheduler := NewScheduler(kvStore, approval.NewService(kvStore, api, "bot123"), api, func(record *approval.ApprovalRecord) {
+		delivered = append(delivered, record)
+	})
+	now := time.Now()
+	scheduler.now = func() time.Time { return now }
+
+	return scheduler, api, kv, &delivered, now
+}
+
+// putScheduled saves a scheduled record through the store so its schedule entry is written
+func putScheduled(t *testing.T, scheduler *Scheduler, id string, sendAt time.Time) *approval.ApprovalRecord {
+	t.Helper()
+	record := &approval.ApprovalRecord{
+		ID:                id,
+		Code:              "A-" + id,
+		RequesterID:       "alice123",
+		RequesterUsername: "alice",
+		ApproverID:        "bob123",
+		ApproverUsername:  "bob",
+		Description:       "Grant prod database access",
+		CreatedAt:         sendAt.Add(-time.Hour).UnixMilli(),
+		SchemaVersion:     1,
+	}
+	record.Schedule(sendAt)
+	require.NoError(t, scheduler.store.SaveApproval(record))
+	return record
+}
+
+func getRecord(t *testing.T, kv map[string][]byte, id string) *approval.ApprovalRecord {
+	t.Helper()
+	var record approval.ApprovalRecord
+	require.NoError(t, json.Unmarshal(kv["approval:record:"+id], &record))
+	return &record
+}
+
+func TestSendDue(t *testing.T) {
+	t.Run("sends due requests once, earliest first", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		putScheduled(t, scheduler, "record2", now.Add(-time.Minute))
+		putScheduled(t, scheduler, "record1", now.Add(-2*time.Minute))
+
+		require.NoError(t, scheduler.sendDue())
+		require.NoError(t, scheduler.sendDue())
+
+		require.Len(t, *delivered, 2)
+		assert.Equal(t, "record1", (*delivered)[0].ID)
+		assert.Equal(t, "record2", (*delivered)[1].ID)
+
+		record := getRecord(t, kv, "record1")
+		assert.Equal(t, approval.StatusPending, record.Status)
+		assert.Equal(t, now.UnixMilli(), record.SentAt)
+		assert.NotContains(t, kv, "approval:schedule:record1")
+		inbox, err := scheduler.store.GetUserApprovalIndex("bob123", approval.IndexRoleApprover)
+		require.NoError(t, err)
+		assert.Len(t, inbox, 2, "sent requests appear in the approver's inbox")
+	})
+
+	t.Run("leaves future requests scheduled", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		putScheduled(t, scheduler, "record1", now.Add(time.Hour))
+
+		require.NoError(t, scheduler.sendDue())
+
+		assert.Empty(t, *delivered)
+		assert.Equal(t, approval.StatusScheduled, getRecord(t, kv, "record1").Status)
+		assert.Contains(t, kv, "approval:schedule:record1")
+	})
+
+	t.Run("skips a request canceled after it was listed", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		record := putScheduled(t, scheduler, "record1", now.Add(-time.Minute))
+		// A canceled record whose schedule entry is still present, as if the cancel raced the scan
+		record.Status = approval.StatusCanceled
+		data, err := json.Marshal(record)
+		require.NoError(t, err)
+		kv["approval:record:record1"] = data
+
+		require.NoError(t, scheduler.sendDue())
+
+		assert.Empty(t, *delivered)
+		assert.Equal(t, approval.StatusCanceled, getRecord(t, kv, "record1").Status)
+		assert.NotContains(t, kv, "approval:schedule:record1")
+	})
+
+	t.Run("drops the entry of a deleted request", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		putScheduled(t, scheduler, "record1", now.Add(-time.Minute))
+		delete(kv, "approval:record:record1")
+
+		require.NoError(t, scheduler.sendDue())
+
+		assert.Empty(t, *delivered)
+		assert.NotContains(t, kv, "approval:schedule:record1")
+	})
+
+	t.Run("keeps the entry when the record cannot be read", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		putScheduled(t, scheduler, "record1", now.Add(-time.Minute))
+		kv["approval:record:record1"] = []byte("{corrupt")
+
+		require.NoError(t, scheduler.sendDue())
+
+		assert.Empty(t, *delivered)
+		assert.Contains(t, kv, "approval:schedule:record1", "the request is retried once the lease expires")
+		assert.Contains(t, kv, "approval:schedule_lease:record1")
+	})
+
+	t.Run("request leased by another node is left alone", func(t *testing.T) {
+		scheduler, _, kv, delivered, now := newTestScheduler(t)
+		putScheduled(t, scheduler, "record1", now.Add(-time.Minute))
+		kv["approval:schedule_lease:record1"] = []byte("sending")
+
+		require.NoError(t, scheduler.sendDue())
+
+		assert.Empty(t, *delivered)
+		assert.Equal(t, approval.StatusScheduled, getRecord(t, kv, "record1").Status)
+		assert.Contains(t, kv, "approval:schedule:record1")
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(nil, &model.AppError{Message: "database unavailable"})
+		kvStore := store.NewKVStore(api)
+		scheduler := NewScheduler(kvStore, approval.NewService(kvStore, api, "bot123"), api, nil)
+
+		err := scheduler.sendDue()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "database unavailable")
+	})
+}
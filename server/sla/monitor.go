@@ -0,0 +1,164 @@
+package sla
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
+	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// CheckInterval is how often the monitor looks for pending requests past their SLA
+const CheckInterval = time.Minute
+
+// Monitor periodically scans pending approval requests for SLA breaches and alerts the
+// approver and the escalation channel once per breached request.
+type Monitor struct {
+	store     *store.KVStore
+	api       plugin.API
+	botUserID string
+	metrics   *metrics.Metrics
+	now       func() time.Time
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewMonitor creates a new SLA Monitor instance. Failed breach DMs are counted in metrics,
+// which may be nil.
+func NewMonitor(store *store.KVStore, api plugin.API, botUserID string, metrics *metrics.Metrics) *Monitor {
+	return &Monitor{
+		store:     store,
+		api:       api,
+		botUserID: botUserID,
+		metrics:   metrics,
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the background goroutine that checks for SLA breaches.
+func (m *Monitor) Start() {
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+
+	go m.run()
+
+	m.api.LogInfo("SLA monitor started", "check_interval", CheckInterval.String())
+}
+
+// Stop gracefully shuts down the SLA monitor goroutine.
+func (m *Monitor) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	// Wait for goroutine to exit
+	<-m.done
+
+	m.api.LogInfo("SLA monitor stopped")
+}
+
+// run is the main loop that periodically checks for SLA breaches.
+func (m *Monitor) run() {
+	defer close(m.done)
+	defer func() {
+		if r := recover(); r != nil {
+			m.api.LogError("SLA monitor panic recovered", "panic", r)
+		}
+	}()
+
+	ticker := time.NewTicker(CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.checkBreaches(); err != nil {
+				m.api.LogError("SLA breach check failed", "error", err.Error())
+			}
+		}
+	}
+}
+
+// checkBreaches alerts every pending request that is past its SLA and not yet alerted.
+func (m *Monitor) checkBreaches() error {
+	// Requests only get an SLA from a target, so skip loading records while none is configured.
+	// Removing every target therefore also stops alerts for requests created under one.
+	targets, err := m.store.ListSLATargets()
+	if err != nil {
+		return fmt.Errorf("failed to load SLA targets: %w", err)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	// No SLA target is shorter than MinSLATarget, so younger requests cannot have breached
+	records, err := m.store.GetPendingRequestsOlderThan(approval.MinSLATarget)
+	if err != nil {
+		return fmt.Errorf("failed to query pending requests: %w", err)
+	}
+
+	now := m.now()
+	alertedCount := 0
+	for _, record := range records {
+		if record.SLAState(now.UnixMilli()) != approval.SLAStateBreached {
+			continue
+		}
+
+		// Only one cluster node alerts each breach, and only once
+		claimed, err := m.store.ClaimSLABreachAlert(record.ID)
+		if err != nil {
+			m.api.LogWarn("Failed to claim SLA breach alert", "approval_id", record.ID, "error", err.Error())
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		m.alert(record, now)
+		alertedCount++
+	}
+
+	m.api.LogDebug("Completed SLA breach scan",
+		"pending_count", len(records),
+		"alerted_count", alertedCount)
+
+	return nil
+}
+
+// alert DMs the approver (every candidate for group requests) and posts to the escalation
+// channel. Alerts are best effort; the breach stays claimed so it is not alerted again.
+func (m *Monitor) alert(record *approval.ApprovalRecord, now time.Time) {
+	m.api.LogWarn("Audit: approval SLA breached",
+		"approval_id", record.ID,
+		"code", record.Code,
+		"sla_minutes", record.SLAMinutes,
+		"approver_id", record.ApproverID,
+		"escalation_channel_id", record.SLAEscalationChannelID)
+
+	for _, approverID := range record.ApproverRecipientIDs() {
+		if _, err := notifications.SendSLABreachDM(m.api, m.botUserID, record, approverID, now); err != nil {
+			errorType, _ := notifications.ClassifyDMError(err)
+			m.metrics.NotificationFailed(errorType)
+			m.api.LogWarn("Failed to send SLA breach DM",
+				"approval_id", record.ID,
+				"code", record.Code,
+				"approver_id", approverID,
+				"error_type", errorType,
+				"error", err.Error())
+		}
+	}
+
+	if _, err := notifications.PostSLAEscalation(m.api, m.botUserID, record, now); err != nil {
+		m.api.LogWarn("Failed to post SLA escalation",
+			"approval_id", record.ID,
+			"code", record.Code,
+			"channel_id", record.SLAEscalationChannelID,
+			"error", err.Error())
+	}
+}
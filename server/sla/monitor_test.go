@@ -0,0 +1,265 @@
+package sla
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/store"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const escalationChannelID = "channelaaaaaaaaaaaaaaaaaaa"
+
+// newTestMonitor returns a monitor whose KV store is backed by the returned map, with a global
+// SLA target configured. The store selects pending requests by wall-clock age, so the monitor's
+// clock is the real time.
+func newTestMonitor(t *testing.T) (*Monitor, *plugintest.API, map[string][]byte, time.Time) {
+	t.Helper()
+
+	kv := make(map[string][]byte)
+	target, err := json.Marshal(&approval.SLATarget{Scope: approval.SLAScopeGlobal, Minutes: 15})
+	require.NoError(t, err)
+	kv["approval:sla:target:global"] = target
+	api := &plugintest.API{}
+	api.On("KVGet", mock.Anything).Return(
+		func(key string) []byte { return kv[key] },
+		func(string) *model.AppError { return nil },
+	)
+	api.On("KVList", 0, store.MaxApprovalRecordsLimit).Return(
+		func(int, int) []string {
+			keys := make([]string, 0, len(kv))
+			for key := range kv {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			return keys
+		},
+		func(int, int) *model.AppError { return nil },
+	)
+	api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(key string, value []byte, options model.PluginKVSetOptions) bool {
+			if string(kv[key]) != string(options.OldValue) {
+				return false
+			}
+			kv[key] = value
+			return true
+		},
+		func(string, []byte, model.PluginKVSetOptions) *model.AppError { return nil },
+	)
+	for _, level := range []string{"LogInfo", "LogWarn", "LogError", "LogDebug"} {
+		args := make([]interface{}, 15)
+		for i := range args {
+			args[i] = mock.Anything
+		}
+		api.On(level, args...).Maybe()
+	}
+
+	now := time.Now()
+	monitor := NewMonitor(store.NewKVStore(api), api, "bot123", nil)
+	monitor.now = func() time.Time { return now }
+
+	return monitor, api, kv, now
+}
+
+// putPending stores a pending record and its approver index entries
+func putPending(t *testing.T, kv map[string][]byte, record *approval.ApprovalRecord) {
+	t.Helper()
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+	kv["approval:record:"+record.ID] = data
+
+	recordID, err := json.Marshal(record.ID)
+	require.NoError(t, err)
+	for _, approverID := range record.ApproverRecipientIDs() {
+		kv["approval:index:approver:"+approverID+":0000000000001:"+record.ID] = recordID
+	}
+}
+
+// postsTo returns the messages created in a channel
+func postsTo(api *plugintest.API, channelID string) []*model.Post {
+	posts := make([]*model.Post, 0)
+	for _, call := range api.Calls {
+		if call.Method != "CreatePost" {
+			continue
+		}
+		if post := call.Arguments.Get(0).(*model.Post); post.ChannelId == channelID {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+func TestCheckBreaches(t *testing.T) {
+	t.Run("alerts the approver and escalation channel once", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                     "record123",
+			Code:                   "A-X7K9Q2",
+			Status:                 approval.StatusPending,
+			RequesterUsername:      "alice",
+			ApproverID:             "bob123",
+			Description:            "Grant prod database access",
+			CreatedAt:              now.Add(-20 * time.Minute).UnixMilli(),
+			NotificationPostID:     "post_bob",
+			SLAMinutes:             15,
+			SLAEscalationChannelID: escalationChannelID,
+		})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, monitor.checkBreaches())
+		require.NoError(t, monitor.checkBreaches())
+
+		dms := postsTo(api, "dm_bob")
+		require.Len(t, dms, 1)
+		assert.Equal(t, "post_bob", dms[0].RootId)
+		assert.Contains(t, dms[0].Message, "Approval SLA Breached")
+		assert.Contains(t, dms[0].Message, "Decide within 15m")
+
+		escalations := postsTo(api, escalationChannelID)
+		require.Len(t, escalations, 1)
+		assert.Contains(t, escalations[0].Message, "`A-X7K9Q2`")
+		assert.Contains(t, escalations[0].Message, "Grant prod database access")
+		assert.Contains(t, kv, "approval:sla:breach:record123")
+	})
+
+	t.Run("ignores requests within their SLA", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                     "record123",
+			Code:                   "A-X7K9Q2",
+			Status:                 approval.StatusPending,
+			RequesterUsername:      "alice",
+			ApproverID:             "bob123",
+			Description:            "Grant prod database access",
+			CreatedAt:              now.Add(-10 * time.Minute).UnixMilli(),
+			NotificationPostID:     "post_bob",
+			SLAMinutes:             15,
+			SLAEscalationChannelID: escalationChannelID,
+		})
+
+		require.NoError(t, monitor.checkBreaches())
+
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+		assert.NotContains(t, kv, "approval:sla:breach:record123")
+	})
+
+	t.Run("ignores requests without an SLA", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusPending,
+			RequesterUsername: "alice",
+			ApproverID:        "bob123",
+			Description:       "Grant prod database access",
+			CreatedAt:         now.Add(-20 * time.Minute).UnixMilli(),
+		})
+
+		require.NoError(t, monitor.checkBreaches())
+
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("skips the scan when no SLA target is configured", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		delete(kv, "approval:sla:target:global")
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                     "record123",
+			Code:                   "A-X7K9Q2",
+			Status:                 approval.StatusPending,
+			RequesterUsername:      "alice",
+			ApproverID:             "bob123",
+			Description:            "Grant prod database access",
+			CreatedAt:              now.Add(-20 * time.Minute).UnixMilli(),
+			NotificationPostID:     "post_bob",
+			SLAMinutes:             15,
+			SLAEscalationChannelID: escalationChannelID,
+		})
+
+		require.NoError(t, monitor.checkBreaches())
+
+		api.AssertNotCalled(t, "KVGet", "approval:record:record123")
+		api.AssertNotCalled(t, "CreatePost", mock.Anything)
+	})
+
+	t.Run("alerts every group candidate", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			Status:               approval.StatusPending,
+			RequesterUsername:    "alice",
+			ApproverUsername:     "sre",
+			ApproverGroupName:    "sre",
+			CandidateApproverIDs: []string{"bob123", "carol123"},
+			Description:          "Grant prod database access",
+			CreatedAt:            now.Add(-20 * time.Minute).UnixMilli(),
+			SLAMinutes:           15,
+		})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("GetDirectChannel", "bot123", "carol123").Return(&model.Channel{Id: "dm_carol"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, monitor.checkBreaches())
+
+		assert.Len(t, postsTo(api, "dm_bob"), 1)
+		assert.Len(t, postsTo(api, "dm_carol"), 1)
+		api.AssertNumberOfCalls(t, "CreatePost", 2)
+	})
+
+	t.Run("private requests omit the description from the escalation", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                     "record123",
+			Code:                   "A-X7K9Q2",
+			Status:                 approval.StatusPending,
+			RequesterUsername:      "alice",
+			ApproverID:             "bob123",
+			Description:            "Grant prod database access",
+			Private:                true,
+			CreatedAt:              now.Add(-20 * time.Minute).UnixMilli(),
+			NotificationPostID:     "post_bob",
+			SLAMinutes:             15,
+			SLAEscalationChannelID: escalationChannelID,
+		})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(&model.Channel{Id: "dm_bob"}, nil)
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, monitor.checkBreaches())
+
+		escalations := postsTo(api, escalationChannelID)
+		require.Len(t, escalations, 1)
+		assert.False(t, strings.Contains(escalations[0].Message, "Grant prod database access"))
+	})
+
+	t.Run("DM failure still posts the escalation", func(t *testing.T) {
+		monitor, api, kv, now := newTestMonitor(t)
+		putPending(t, kv, &approval.ApprovalRecord{
+			ID:                     "record123",
+			Code:                   "A-X7K9Q2",
+			Status:                 approval.StatusPending,
+			RequesterUsername:      "alice",
+			ApproverID:             "bob123",
+			Description:            "Grant prod database access",
+			CreatedAt:              now.Add(-20 * time.Minute).UnixMilli(),
+			NotificationPostID:     "post_bob",
+			SLAMinutes:             15,
+			SLAEscalationChannelID: escalationChannelID,
+		})
+		api.On("GetDirectChannel", "bot123", "bob123").Return(nil, &model.AppError{Message: "DMs disabled"})
+		api.On("CreatePost", mock.Anything).Return(&model.Post{Id: "alert"}, nil)
+
+		require.NoError(t, monitor.checkBreaches())
+
+		assert.Len(t, postsTo(api, escalationChannelID), 1)
+	})
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// decisionClaimTTLSeconds bounds how long a decision claim survives if the claiming
+// request dies before saving, so a group approval never stays locked forever
+const decisionClaimTTLSeconds = 60
+
+// ClaimDecision atomically claims the right to decide a group approval.
+// Returns true if userID now holds the claim, false if another member claimed it first.
+func (s *KVStore) ClaimDecision(recordID, userID string) (bool, error) {
+	if recordID == "" {
+		return false, fmt.Errorf("approval ID is required")
+	}
+	if userID == "" {
+		return false, fmt.Errorf("user ID is required")
+	}
+
+	claimed, appErr := s.api.KVSetWithOptions(makeClaimKey(recordID), []byte(userID), model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil, // only set if no claim exists
+		ExpireInSeconds: decisionClaimTTLSeconds,
+	})
+	if appErr != nil {
+		return false, fmt.Errorf("failed to claim decision for approval %s: %w", recordID, appErr)
+	}
+
+	return claimed, nil
+}
+
+// ReleaseDecisionClaim removes a decision claim so another member can decide
+// (used when saving the decision fails after the claim was taken)
+func (s *KVStore) ReleaseDecisionClaim(recordID string) error {
+	if recordID == "" {
+		return fmt.Errorf("approval ID is required")
+	}
+
+	if appErr := s.api.KVDelete(makeClaimKey(recordID)); appErr != nil {
+		return fmt.Errorf("failed to release decision claim for approval %s: %w", recordID, appErr)
+	}
+
+	return nil
+}
+
+// makeClaimKey generates the KV store key for a group approval decision claim
+func makeClaimKey(recordID string) string {
+	return fmt.Sprintf("approval:claim:%s", recordID)
+}
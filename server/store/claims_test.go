@@ -0,0 +1,77 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_ClaimDecision(t *testing.T) {
+	t.Run("first claim succeeds atomically", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVSetWithOptions", "approval:claim:record1", []byte("user1"), mock.MatchedBy(func(opts model.PluginKVSetOptions) bool {
+			return opts.Atomic && opts.OldValue == nil && opts.ExpireInSeconds == decisionClaimTTLSeconds
+		})).Return(true, nil)
+
+		claimed, err := store.ClaimDecision("record1", "user1")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("existing claim is not overwritten", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:claim:record1", []byte("user2"), mock.Anything).Return(false, nil)
+
+		claimed, err := store.ClaimDecision("record1", "user2")
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:claim:record1", []byte("user1"), mock.Anything).
+			Return(false, &model.AppError{Message: "KV error"})
+
+		claimed, err := store.ClaimDecision("record1", "user1")
+		assert.Error(t, err)
+		assert.False(t, claimed)
+		assert.Contains(t, err.Error(), "failed to claim decision")
+	})
+
+	t.Run("requires record and user IDs", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		_, err := store.ClaimDecision("", "user1")
+		assert.Error(t, err)
+		_, err = store.ClaimDecision("record1", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestKVStore_ReleaseDecisionClaim(t *testing.T) {
+	t.Run("deletes the claim key", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVDelete", "approval:claim:record1").Return(nil)
+
+		assert.NoError(t, store.ReleaseDecisionClaim("record1"))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVDelete", "approval:claim:record1").Return(&model.AppError{Message: "KV error"})
+
+		assert.Error(t, store.ReleaseDecisionClaim("record1"))
+	})
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// digestSubscriberKeyPrefix marks users who opted in to the scheduled approval digest
+	digestSubscriberKeyPrefix = "approval:digest:subscriber:"
+
+	// digestRunKeyPrefix records which digest periods have been claimed by a cluster node
+	digestRunKeyPrefix = "approval:digest:run:"
+
+	// digestRunTTLSeconds keeps run claims long enough to cover a weekly period, then lets them expire
+	digestRunTTLSeconds = 8 * 24 * 60 * 60
+)
+
+// SetDigestSubscription opts a user in to (or out of) the scheduled approval digest
+func (s *KVStore) SetDigestSubscription(userID string, enabled bool) error {
+	if userID == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if !enabled {
+		if appErr := s.api.KVDelete(makeDigestSubscriberKey(userID)); appErr != nil {
+			return fmt.Errorf("failed to remove digest subscription for user %s: %w", userID, appErr)
+		}
+		return nil
+	}
+
+	if appErr := s.api.KVSet(makeDigestSubscriberKey(userID), []byte("true")); appErr != nil {
+		return fmt.Errorf("failed to save digest subscription for user %s: %w", userID, appErr)
+	}
+
+	return nil
+}
+
+// GetDigestSubscription reports whether a user opted in to the scheduled approval digest
+func (s *KVStore) GetDigestSubscription(userID string) (bool, error) {
+	if userID == "" {
+		return false, fmt.Errorf("user ID is required")
+	}
+
+	data, appErr := s.api.KVGet(makeDigestSubscriberKey(userID))
+	if appErr != nil {
+		return false, fmt.Errorf("failed to get digest subscription for user %s: %w", userID, appErr)
+	}
+
+	return data != nil, nil
+}
+
+// ListDigestSubscribers returns the IDs of users who opted in to the digest, sorted for stable delivery order
+func (s *KVStore) ListDigestSubscribers() ([]string, error) {
+	keys, err := s.listKeysWithPrefix(digestSubscriberKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest subscribers: %w", err)
+	}
+
+	subscribers := make([]string, 0, len(keys))
+	for _, key := range keys {
+		subscribers = append(subscribers, strings.TrimPrefix(key, digestSubscriberKeyPrefix))
+	}
+
+	sort.Strings(subscribers)
+	return subscribers, nil
+}
+
+// ClaimDigestRun atomically claims the digest for a period (e.g. "daily:2026-10-18").
+// Returns true for exactly one caller per period, so only one cluster node sends the digest.
+func (s *KVStore) ClaimDigestRun(period string) (bool, error) {
+	if period == "" {
+		return false, fmt.Errorf("digest period is required")
+	}
+
+	claimed, appErr := s.api.KVSetWithOptions(digestRunKeyPrefix+period, []byte("sent"), model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil, // only set if the period has not been claimed
+		ExpireInSeconds: digestRunTTLSeconds,
+	})
+	if appErr != nil {
+		return false, fmt.Errorf("failed to claim digest run %s: %w", period, appErr)
+	}
+
+	return claimed, nil
+}
+
+// makeDigestSubscriberKey generates the KV store key for a user's digest opt-in
+func makeDigestSubscriberKey(userID string) string {
+	return digestSubscriberKeyPrefix + userID
+}
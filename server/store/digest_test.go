@@ -0,0 +1,134 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_DigestSubscription(t *testing.T) {
+	t.Run("opting in stores a marker", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSet", "approval:digest:subscriber:user1", []byte("true")).Return(nil)
+
+		require.NoError(t, store.SetDigestSubscription("user1", true))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("opting out deletes the marker", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVDelete", "approval:digest:subscriber:user1").Return(nil)
+
+		require.NoError(t, store.SetDigestSubscription("user1", false))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSet", "approval:digest:subscriber:user1", mock.Anything).Return(&model.AppError{Message: "KV error"})
+
+		err := store.SetDigestSubscription("user1", true)
+		assert.ErrorContains(t, err, "failed to save digest subscription for user user1")
+	})
+
+	t.Run("reads the subscription state", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:digest:subscriber:user1").Return([]byte("true"), nil)
+		api.On("KVGet", "approval:digest:subscriber:user2").Return(nil, nil)
+
+		subscribed, err := store.GetDigestSubscription("user1")
+		require.NoError(t, err)
+		assert.True(t, subscribed)
+
+		subscribed, err = store.GetDigestSubscription("user2")
+		require.NoError(t, err)
+		assert.False(t, subscribed)
+	})
+
+	t.Run("requires a user ID", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		assert.Error(t, store.SetDigestSubscription("", true))
+		_, err := store.GetDigestSubscription("")
+		assert.Error(t, err)
+	})
+}
+
+func TestKVStore_ListDigestSubscribers(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+		"approval:digest:subscriber:user2",
+		"approval:record:record1",
+		"approval:digest:run:daily:2026-10-18",
+		"approval:digest:subscriber:user1",
+	}, nil)
+
+	subscribers, err := store.ListDigestSubscribers()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user1", "user2"}, subscribers)
+}
+
+func TestKVStore_ListDigestSubscribers_Paged(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	firstPage := make([]string, MaxApprovalRecordsLimit)
+	for i := range firstPage {
+		firstPage[i] = fmt.Sprintf("approval:code:A-%06d", i)
+	}
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+	api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:digest:subscriber:user1"}, nil)
+
+	subscribers, err := store.ListDigestSubscribers()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user1"}, subscribers, "subscribers past the first KVList page are found")
+}
+
+func TestKVStore_ClaimDigestRun(t *testing.T) {
+	t.Run("first claim for a period succeeds atomically", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:digest:run:daily:2026-10-18", []byte("sent"), mock.MatchedBy(func(opts model.PluginKVSetOptions) bool {
+			return opts.Atomic && opts.OldValue == nil && opts.ExpireInSeconds == digestRunTTLSeconds
+		})).Return(true, nil)
+
+		claimed, err := store.ClaimDigestRun("daily:2026-10-18")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("period already claimed by another node", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:digest:run:daily:2026-10-18", mock.Anything, mock.Anything).Return(false, nil)
+
+		claimed, err := store.ClaimDigestRun("daily:2026-10-18")
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(false, &model.AppError{Message: "KV error"})
+
+		_, err := store.ClaimDigestRun("daily:2026-10-18")
+		assert.ErrorContains(t, err, "failed to claim digest run")
+	})
+
+	t.Run("requires a period", func(t *testing.T) {
+		_, err := NewKVStore(&plugintest.API{}).ClaimDigestRun("")
+		assert.Error(t, err)
+	})
+}
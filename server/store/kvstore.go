@@ -3,6 +3,8 @@ package store
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -23,6 +25,68 @@ func NewKVStore(api plugin.API) *KVStore {
 	}
 }
 
+// immutableFieldsUnchanged reports whether the core fields of a finalized record are untouched.
+// These fields capture the request and its decision and must never change once decided.
+func immutableFieldsUnchanged(existing, updated *approval.ApprovalRecord) bool {
+	return existing.ID == updated.ID &&
+		existing.Code == updated.Code &&
+		existing.Status == updated.Status &&
+		existing.RequesterID == updated.RequesterID &&
+		existing.RequesterUsername == updated.RequesterUsername &&
+		existing.RequesterDisplayName == updated.RequesterDisplayName &&
+		existing.ApproverID == updated.ApproverID &&
+		existing.ApproverUsername == updated.ApproverUsername &&
+		existing.ApproverDisplayName == updated.ApproverDisplayName &&
+		existing.ApproverGroupName == updated.ApproverGroupName &&
+		existing.ApproverRole == updated.ApproverRole &&
+		slices.Equal(existing.CandidateApproverIDs, updated.CandidateApproverIDs) &&
+		maps.Equal(existing.CandidatePostIDs, updated.CandidatePostIDs) &&
+		existing.RequesterLocale == updated.RequesterLocale &&
+		existing.ApproverLocale == updated.ApproverLocale &&
+		maps.Equal(existing.CandidateLocales, updated.CandidateLocales) &&
+		existing.RequesterTimezone == updated.RequesterTimezone &&
+		existing.ApproverTimezone == updated.ApproverTimezone &&
+		maps.Equal(existing.CandidateTimezones, updated.CandidateTimezones) &&
+		existing.Description == updated.Description &&
+		existing.TemplateName == updated.TemplateName &&
+		slices.Equal(existing.CustomFields, updated.CustomFields) &&
+		existing.DecisionComment == updated.DecisionComment &&
+		existing.CreatedAt == updated.CreatedAt &&
+		existing.DecidedAt == updated.DecidedAt &&
+		existing.CanceledReason == updated.CanceledReason &&
+		existing.CanceledAt == updated.CanceledAt &&
+		existing.PreviousCode == updated.PreviousCode &&
+		existing.RequestChannelID == updated.RequestChannelID &&
+		existing.TeamID == updated.TeamID &&
+		existing.SLAMinutes == updated.SLAMinutes &&
+		existing.SLAEscalationChannelID == updated.SLAEscalationChannelID &&
+		existing.ShareInChannel == updated.ShareInChannel &&
+		existing.Private == updated.Private &&
+		existing.ChannelPostID == updated.ChannelPostID &&
+		existing.NotificationSent == updated.NotificationSent &&
+		existing.NotificationPostID == updated.NotificationPostID &&
+		existing.RequesterPostID == updated.RequesterPostID &&
+		existing.OutcomeNotified == updated.OutcomeNotified &&
+		existing.SchemaVersion == updated.SchemaVersion
+}
+
+// verificationFieldsUnchanged reports whether the verification fields of a record are untouched.
+func verificationFieldsUnchanged(existing, updated *approval.ApprovalRecord) bool {
+	return existing.Verified == updated.Verified &&
+		existing.VerifiedAt == updated.VerifiedAt &&
+		existing.VerificationComment == updated.VerificationComment
+}
+
+// commentsUnchanged reports whether the comment history of a record is untouched.
+func commentsUnchanged(existing, updated *approval.ApprovalRecord) bool {
+	return slices.Equal(existing.Comments, updated.Comments)
+}
+
+// redeliveriesUnchanged reports whether the redelivery history of a record is untouched.
+func redeliveriesUnchanged(existing, updated *approval.ApprovalRecord) bool {
+	return slices.Equal(existing.Redeliveries, updated.Redeliveries)
+}
+
 // isValidVerificationUpdate checks if an update to a decided record is a valid verification operation.
 // Story 6.2: Allows adding verification fields to approved records while keeping core fields immutable.
 // Returns true if:
@@ -35,28 +99,9 @@ func isValidVerificationUpdate(existing, updated *approval.ApprovalRecord) bool
 		return false
 	}
 
-	// Verify core immutable fields haven't changed
-	if existing.ID != updated.ID ||
-		existing.Code != updated.Code ||
-		existing.Status != updated.Status ||
-		existing.RequesterID != updated.RequesterID ||
-		existing.RequesterUsername != updated.RequesterUsername ||
-		existing.RequesterDisplayName != updated.RequesterDisplayName ||
-		existing.ApproverID != updated.ApproverID ||
-		existing.ApproverUsername != updated.ApproverUsername ||
-		existing.ApproverDisplayName != updated.ApproverDisplayName ||
-		existing.Description != updated.Description ||
-		existing.DecisionComment != updated.DecisionComment ||
-		existing.CreatedAt != updated.CreatedAt ||
-		existing.DecidedAt != updated.DecidedAt ||
-		existing.CanceledReason != updated.CanceledReason ||
-		existing.CanceledAt != updated.CanceledAt ||
-		existing.RequestChannelID != updated.RequestChannelID ||
-		existing.TeamID != updated.TeamID ||
-		existing.NotificationSent != updated.NotificationSent ||
-		existing.NotificationPostID != updated.NotificationPostID ||
-		existing.OutcomeNotified != updated.OutcomeNotified ||
-		existing.SchemaVersion != updated.SchemaVersion {
+	// Verify core immutable fields, lineage and comments haven't changed
+	if !immutableFieldsUnchanged(existing, updated) || existing.NextCode != updated.NextCode ||
+		!commentsUnchanged(existing, updated) || !redeliveriesUnchanged(existing, updated) {
 		return false
 	}
 
@@ -76,6 +121,63 @@ func isValidVerificationUpdate(existing, updated *approval.ApprovalRecord) bool
 	return true
 }
 
+// isValidLineageUpdate checks if an update to a finalized record only links it to its resubmission.
+// Allows NextCode to be set exactly once while every other field stays unchanged.
+func isValidLineageUpdate(existing, updated *approval.ApprovalRecord) bool {
+	if !immutableFieldsUnchanged(existing, updated) || !verificationFieldsUnchanged(existing, updated) ||
+		!commentsUnchanged(existing, updated) || !redeliveriesUnchanged(existing, updated) {
+		return false
+	}
+
+	return existing.NextCode == "" && updated.NextCode != ""
+}
+
+// isValidCommentUpdate checks if an update to a finalized record only appends comments.
+// Existing comments must be preserved in order; every other field stays unchanged.
+func isValidCommentUpdate(existing, updated *approval.ApprovalRecord) bool {
+	if !immutableFieldsUnchanged(existing, updated) || !verificationFieldsUnchanged(existing, updated) ||
+		existing.NextCode != updated.NextCode || !redeliveriesUnchanged(existing, updated) {
+		return false
+	}
+
+	return len(updated.Comments) > len(existing.Comments) &&
+		slices.Equal(existing.Comments, updated.Comments[:len(existing.Comments)])
+}
+
+// isValidOutcomeNotifiedUpdate checks if an update to a decided record only records that the requester
+// was notified of the outcome (set once, after the decision or by a notification retry).
+func isValidOutcomeNotifiedUpdate(existing, updated *approval.ApprovalRecord) bool {
+	if existing.OutcomeNotified || !updated.OutcomeNotified {
+		return false
+	}
+
+	unchanged := *updated
+	unchanged.OutcomeNotified = existing.OutcomeNotified
+	return immutableFieldsUnchanged(existing, &unchanged) &&
+		verificationFieldsUnchanged(existing, updated) &&
+		commentsUnchanged(existing, updated) &&
+		redeliveriesUnchanged(existing, updated) &&
+		existing.NextCode == updated.NextCode
+}
+
+// isValidRedeliveryUpdate checks if an update to a finalized record only appends redeliveries,
+// optionally recording that the resent outcome reached the requester.
+func isValidRedeliveryUpdate(existing, updated *approval.ApprovalRecord) bool {
+	if existing.OutcomeNotified && !updated.OutcomeNotified {
+		return false
+	}
+
+	unchanged := *updated
+	unchanged.OutcomeNotified = existing.OutcomeNotified
+	if !immutableFieldsUnchanged(existing, &unchanged) || !verificationFieldsUnchanged(existing, updated) ||
+		!commentsUnchanged(existing, updated) || existing.NextCode != updated.NextCode {
+		return false
+	}
+
+	return len(updated.Redeliveries) > len(existing.Redeliveries) &&
+		slices.Equal(existing.Redeliveries, updated.Redeliveries[:len(existing.Redeliveries)])
+}
+
 // SaveApproval persists an ApprovalRecord to the KV store
 func (s *KVStore) SaveApproval(record *approval.ApprovalRecord) error {
 	if record == nil {
@@ -90,9 +192,13 @@ func (s *KVStore) SaveApproval(record *approval.ApprovalRecord) error {
 	existing, err := s.GetApproval(record.ID)
 	if err == nil {
 		// Record exists - check if modifications violate immutability
-		if existing.Status != approval.StatusPending {
-			// Decided records are generally immutable, but allow verification updates (Story 6.2)
-			if !isValidVerificationUpdate(existing, record) {
+		if existing.Status != approval.StatusPending && existing.Status != approval.StatusScheduled {
+			// Decided records are generally immutable, but allow verification updates (Story 6.2),
+			// linking to a resubmitted request, appending comments, marking the outcome notified and
+			// recording admin redeliveries
+			if !isValidVerificationUpdate(existing, record) && !isValidLineageUpdate(existing, record) &&
+				!isValidCommentUpdate(existing, record) && !isValidOutcomeNotifiedUpdate(existing, record) &&
+				!isValidRedeliveryUpdate(existing, record) {
 				return fmt.Errorf("cannot modify approval record %s: %w", record.ID, approval.ErrRecordImmutable)
 			}
 		}
@@ -142,17 +248,46 @@ func (s *KVStore) SaveApproval(record *approval.ApprovalRecord) error {
 	}
 
 	// Create approver index: approval:index:approver:{userID}:{invertedTimestamp}:{recordID} → recordID
-	// This enables efficient queries for "approvals I need to decide"
-	if record.ApproverID != "" && record.CreatedAt > 0 {
-		approverKey := makeApproverIndexKey(record.ApproverID, record.CreatedAt, record.ID)
-		recordIDJSON, err := json.Marshal(record.ID)
-		if err != nil {
-			return fmt.Errorf("failed to marshal record ID for approver index: %w", err)
+	// This enables efficient queries for "approvals I need to decide".
+	// Group approvals are indexed for every candidate so each member sees the request.
+	// Scheduled requests are indexed once they are sent, keeping them out of approver lists,
+	// timeout scans and SLA checks until then.
+	if record.CreatedAt > 0 && record.Status != approval.StatusScheduled {
+		approverIDs := record.ApproverRecipientIDs()
+		if record.ApproverID != "" && !slices.Contains(approverIDs, record.ApproverID) {
+			approverIDs = append(approverIDs, record.ApproverID)
 		}
 
-		appErr = s.api.KVSet(approverKey, recordIDJSON)
-		if appErr != nil {
-			return fmt.Errorf("failed to save approver index for %s: %w", record.ID, appErr)
+		for _, approverID := range approverIDs {
+			approverKey := makeApproverIndexKey(approverID, record.CreatedAt, record.ID)
+			recordIDJSON, err := json.Marshal(record.ID)
+			if err != nil {
+				return fmt.Errorf("failed to marshal record ID for approver index: %w", err)
+			}
+
+			appErr = s.api.KVSet(approverKey, recordIDJSON)
+			if appErr != nil {
+				return fmt.Errorf("failed to save approver index for %s: %w", record.ID, appErr)
+			}
+		}
+	}
+
+	// Scheduled requests wait in the schedule index until they are sent or canceled
+	if record.Status == approval.StatusScheduled {
+		if err := s.scheduleRequest(record.ID, record.ScheduledAt); err != nil {
+			return err
+		}
+	} else if existing != nil && existing.Status == approval.StatusScheduled {
+		if err := s.UnscheduleRequest(record.ID); err != nil {
+			return err
+		}
+	}
+
+	// Index description tokens for /approve search. Descriptions are immutable, so only new
+	// records need indexing.
+	if existing == nil {
+		if err := s.indexSearchTokens(record); err != nil {
+			return fmt.Errorf("failed to save search index for %s: %w", record.ID, err)
 		}
 	}
 
@@ -466,6 +601,63 @@ func (s *KVStore) GetUserApprovals(userID string) ([]*approval.ApprovalRecord, e
 	return records, nil
 }
 
+// GetUserApprovalIndex returns the positions of the records where the specified user has the
+// given index role (approval.IndexRoleRequester or IndexRoleApprover; empty for both), newest
+// first, without loading the records. /approve list pages through them and loads only the
+// records it shows.
+//
+// Positions come from the requester and approver index keys, whose inverted timestamps sort
+// newest first; a record indexed for both roles appears once.
+func (s *KVStore) GetUserApprovalIndex(userID, role string) ([]approval.IndexEntry, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	roles := []string{approval.IndexRoleRequester, approval.IndexRoleApprover}
+	if role != "" {
+		if !slices.Contains(roles, role) {
+			return nil, fmt.Errorf("invalid index role %q", role)
+		}
+		roles = []string{role}
+	}
+
+	keys, err := s.listKeysWithPrefix("approval:index:")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval index keys: %w", err)
+	}
+
+	prefixes := make([]string, 0, len(roles))
+	for _, role := range roles {
+		prefixes = append(prefixes, fmt.Sprintf("approval:index:%s:%s:", role, userID))
+	}
+
+	seenRecords := make(map[string]bool)
+	entries := make([]approval.IndexEntry, 0)
+	for _, key := range keys {
+		for _, prefix := range prefixes {
+			position, found := strings.CutPrefix(key, prefix)
+			if !found {
+				continue
+			}
+
+			// Position is {invertedTimestamp}:{recordID}
+			_, recordID, found := strings.Cut(position, ":")
+			if !found || recordID == "" || seenRecords[recordID] {
+				continue
+			}
+
+			entries = append(entries, approval.IndexEntry{Position: position, RecordID: recordID})
+			seenRecords[recordID] = true
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Position < entries[j].Position
+	})
+
+	return entries, nil
+}
+
 // KVGet implements the Storer interface for code generation uniqueness checks
 func (s *KVStore) KVGet(key string) ([]byte, error) {
 	data, appErr := s.api.KVGet(key)
@@ -475,6 +667,35 @@ func (s *KVStore) KVGet(key string) ([]byte, error) {
 	return data, nil
 }
 
+// Ping checks that the KV store can be read, for the health endpoint
+func (s *KVStore) Ping() error {
+	if _, appErr := s.api.KVList(0, 1); appErr != nil {
+		return fmt.Errorf("failed to list KV store keys: %w", appErr)
+	}
+	return nil
+}
+
+// listKeysWithPrefix returns every key with the given prefix, reading KVList page by page.
+// Keys are listed in key order, so prefixes sorting after approval:record: are not on the first
+// page once the store holds more than MaxApprovalRecordsLimit keys.
+func (s *KVStore) listKeysWithPrefix(prefix string) ([]string, error) {
+	matched := make([]string, 0)
+	for page := 0; ; page++ {
+		keys, appErr := s.api.KVList(page, MaxApprovalRecordsLimit)
+		if appErr != nil {
+			return nil, appErr
+		}
+		for _, key := range keys {
+			if strings.HasPrefix(key, prefix) {
+				matched = append(matched, key)
+			}
+		}
+		if len(keys) < MaxApprovalRecordsLimit {
+			return matched, nil
+		}
+	}
+}
+
 // makeRecordKey generates the KV store key for an approval record
 func makeRecordKey(id string) string {
 	return fmt.Sprintf("approval:record:%s", id)
@@ -583,8 +804,8 @@ func (s *KVStore) GetPendingRequestsOlderThan(cutoffDuration time.Duration) ([]*
 			continue
 		}
 
-		// Filter 2: Check if request is older than cutoff
-		if record.CreatedAt > cutoffTime {
+		// Filter 2: Check if request is older than cutoff (scheduled requests count from when they were sent)
+		if record.RequestedAt() > cutoffTime {
 			continue // Request is too new
 		}
 
@@ -600,3 +821,30 @@ func (s *KVStore) GetPendingRequestsOlderThan(cutoffDuration time.Duration) ([]*
 
 	return records, nil
 }
+
+// CountPendingRequests returns the number of pending approval requests, including those created
+// within the current second. Scheduled requests are not pending until they are sent.
+// Record keys are read page by page, so the count is not capped at MaxApprovalRecordsLimit.
+func (s *KVStore) CountPendingRequests() (int, error) {
+	keys, err := s.listKeysWithPrefix("approval:record:")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list approval records: %w", err)
+	}
+
+	count := 0
+	for _, key := range keys {
+		record, err := s.GetApproval(strings.TrimPrefix(key, "approval:record:"))
+		if err != nil {
+			s.api.LogWarn("Failed to retrieve approval record while counting pending requests",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if record.Status == approval.StatusPending {
+			count++
+		}
+	}
+
+	return count, nil
+}
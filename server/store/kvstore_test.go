@@ -24,6 +24,7 @@ func TestKVStore_SaveApproval(t *testing.T) {
 		// and for record existence check (returns nil = new record)
 		api.On("KVGet", mock.Anything).Return(nil, nil)
 		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
 
 		record, err := approval.NewApprovalRecord(
 			store,
@@ -354,7 +355,7 @@ func TestKVStore_GetByCode(t *testing.T) {
 }
 
 func TestKVStore_SaveApproval_CodeLookupIndex(t *testing.T) {
-	t.Run("writes all required keys: record, code, requester index, approver index", func(t *testing.T) {
+	t.Run("writes all required keys: record, code, requester index, approver index, search index", func(t *testing.T) {
 		api := &plugintest.API{}
 		store := NewKVStore(api)
 
@@ -371,7 +372,7 @@ func TestKVStore_SaveApproval_CodeLookupIndex(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		// Expect KVSet for all 4 keys:
+		// Expect KVSet for all 4 keys and the search index:
 		// 1. Primary record
 		recordKey := fmt.Sprintf("approval:record:%s", record.ID)
 		api.On("KVSet", recordKey, mock.Anything).Return(nil)
@@ -390,6 +391,10 @@ func TestKVStore_SaveApproval_CodeLookupIndex(t *testing.T) {
 			return len(key) > 26 && key[:26] == "approval:index:approver:ap"
 		}), mock.Anything).Return(nil)
 
+		// 5. Search index, one posting list per description token
+		api.On("KVSetWithOptions", "approval:token:test", []byte(`["`+record.ID+`"]`), mock.Anything).Return(true, nil)
+		api.On("KVSetWithOptions", "approval:token:approval", []byte(`["`+record.ID+`"]`), mock.Anything).Return(true, nil)
+
 		err = store.SaveApproval(record)
 		assert.NoError(t, err)
 		api.AssertExpectations(t)
@@ -833,6 +838,102 @@ func TestKVStore_GetUserApprovals(t *testing.T) {
 	})
 }
 
+func TestKVStore_GetUserApprovalIndex(t *testing.T) {
+	t.Run("merges requester and approver keys newest first", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+			"approval:code:A-ABC123",
+			"approval:index:approver:user1:9999999997999:record2",
+			"approval:index:requester:user1:9999999998999:record1",
+			"approval:index:requester:user2:9999999996999:record3",
+			"approval:index:requester:user10:9999999995999:record4",
+			"approval:record:record1",
+		}, nil).Once()
+
+		entries, err := store.GetUserApprovalIndex("user1", "")
+		require.NoError(t, err)
+		assert.Equal(t, []approval.IndexEntry{
+			{Position: "9999999997999:record2", RecordID: "record2"},
+			{Position: "9999999998999:record1", RecordID: "record1"},
+		}, entries)
+		api.AssertNotCalled(t, "KVGet", mock.Anything)
+	})
+
+	t.Run("record where user is requester and approver is listed once", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+			"approval:index:approver:user1:9999999998999:record1",
+			"approval:index:requester:user1:9999999998999:record1",
+		}, nil).Once()
+
+		entries, err := store.GetUserApprovalIndex("user1", "")
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("role selects one index", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+			"approval:index:approver:user1:9999999997999:record2",
+			"approval:index:requester:user1:9999999998999:record1",
+		}, nil)
+
+		entries, err := store.GetUserApprovalIndex("user1", approval.IndexRoleApprover)
+		require.NoError(t, err)
+		assert.Equal(t, []approval.IndexEntry{{Position: "9999999997999:record2", RecordID: "record2"}}, entries)
+
+		entries, err = store.GetUserApprovalIndex("user1", approval.IndexRoleRequester)
+		require.NoError(t, err)
+		assert.Equal(t, []approval.IndexEntry{{Position: "9999999998999:record1", RecordID: "record1"}}, entries)
+
+		_, err = store.GetUserApprovalIndex("user1", "watcher")
+		assert.ErrorContains(t, err, "invalid index role")
+	})
+
+	t.Run("index keys past the first KVList page are listed", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		firstPage := make([]string, MaxApprovalRecordsLimit)
+		for i := range firstPage {
+			firstPage[i] = fmt.Sprintf("approval:code:A-%06d", i)
+		}
+		firstPage[0] = "approval:index:requester:user1:9999999998999:record1"
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+		api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{
+			"approval:index:requester:user1:9999999999999:record0",
+		}, nil)
+
+		entries, err := store.GetUserApprovalIndex("user1", "")
+		require.NoError(t, err)
+		assert.Equal(t, []approval.IndexEntry{
+			{Position: "9999999998999:record1", RecordID: "record1"},
+			{Position: "9999999999999:record0", RecordID: "record0"},
+		}, entries)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetUserApprovalIndex("user1", "")
+		assert.ErrorContains(t, err, "failed to list approval index keys")
+	})
+
+	t.Run("requires user ID", func(t *testing.T) {
+		_, err := NewKVStore(&plugintest.API{}).GetUserApprovalIndex("", "")
+		assert.Error(t, err)
+	})
+}
+
 // TestSaveApproval_VerificationUpdate tests that verification updates are allowed on approved records
 func TestSaveApproval_VerificationUpdate(t *testing.T) {
 	t.Run("allows verification update on approved record", func(t *testing.T) {
@@ -1005,3 +1106,368 @@ func TestSaveApproval_VerificationUpdate(t *testing.T) {
 		api.AssertExpectations(t)
 	})
 }
+
+func TestSaveApproval_LineageUpdate(t *testing.T) {
+	newDeniedRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                   "record123",
+			Code:                 "A-X7K9Q2",
+			Status:               approval.StatusDenied,
+			RequesterID:          "user123",
+			RequesterUsername:    "alice",
+			RequesterDisplayName: "Alice Smith",
+			ApproverID:           "approver456",
+			ApproverUsername:     "bob",
+			ApproverDisplayName:  "Bob Jones",
+			Description:          "Test approval",
+			DecisionComment:      "Not now",
+			CreatedAt:            1704931200000,
+			DecidedAt:            1704931300000,
+			SchemaVersion:        1,
+		}
+	}
+
+	t.Run("allows linking finalized record to its resubmission", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := *existingRecord
+		updatedRecord.NextCode = "A-NEWCDE"
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+
+		err := store.SaveApproval(&updatedRecord)
+		assert.NoError(t, err)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+
+	t.Run("rejects relinking an already resubmitted record", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecord.NextCode = "A-NEWCDE"
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := *existingRecord
+		updatedRecord.NextCode = "A-OTHER2"
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(&updatedRecord)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects core field changes while linking", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := *existingRecord
+		updatedRecord.NextCode = "A-NEWCDE"
+		updatedRecord.Status = approval.StatusApproved
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(&updatedRecord)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+	})
+}
+
+func TestSaveApproval_CommentUpdate(t *testing.T) {
+	existingComment := approval.ApprovalComment{UserID: "approver456", Username: "bob", Message: "Which cluster?", CreatedAt: 1704931400000}
+	newDeniedRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusDenied,
+			RequesterID:       "user123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Test approval",
+			CreatedAt:         1704931200000,
+			DecidedAt:         1704931300000,
+			Comments:          []approval.ApprovalComment{existingComment},
+			SchemaVersion:     1,
+		}
+	}
+	newComment := approval.ApprovalComment{UserID: "user123", Username: "alice", Message: "The EU one", CreatedAt: 1704931500000}
+
+	t.Run("allows appending a comment to a finalized record", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Comments = append(updatedRecord.Comments, newComment)
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+
+		err := store.SaveApproval(updatedRecord)
+		assert.NoError(t, err)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+
+	t.Run("rejects editing an existing comment", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Comments[0].Message = "Rewritten"
+		updatedRecord.Comments = append(updatedRecord.Comments, newComment)
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects removing comments", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Comments = nil
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+	})
+
+	t.Run("rejects core field changes while commenting", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newDeniedRecord()
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Comments = append(updatedRecord.Comments, newComment)
+		updatedRecord.Status = approval.StatusApproved
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+	})
+}
+
+func TestSaveApproval_OutcomeNotifiedUpdate(t *testing.T) {
+	newApprovedRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusApproved,
+			RequesterID:       "user123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Test approval",
+			CreatedAt:         1704931200000,
+			DecidedAt:         1704931300000,
+			SchemaVersion:     1,
+		}
+	}
+
+	t.Run("allows marking the outcome as notified", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newApprovedRecord())
+		updatedRecord := newApprovedRecord()
+		updatedRecord.OutcomeNotified = true
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+
+		err := store.SaveApproval(updatedRecord)
+		assert.NoError(t, err)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+
+	t.Run("rejects other changes alongside the flag", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newApprovedRecord())
+		updatedRecord := newApprovedRecord()
+		updatedRecord.OutcomeNotified = true
+		updatedRecord.DecisionComment = "Rewritten"
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects clearing the flag", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecord := newApprovedRecord()
+		existingRecord.OutcomeNotified = true
+		existingRecordJSON, _ := json.Marshal(existingRecord)
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(newApprovedRecord())
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSaveApproval_RedeliveryUpdate(t *testing.T) {
+	existingRedelivery := approval.NotificationRedelivery{Target: approval.RedeliveryTargetRequester, Notification: "outcome", ResentBy: "admin1", ResentAt: 1704931400000}
+	newDeniedRecord := func() *approval.ApprovalRecord {
+		return &approval.ApprovalRecord{
+			ID:                "record123",
+			Code:              "A-X7K9Q2",
+			Status:            approval.StatusDenied,
+			RequesterID:       "user123",
+			RequesterUsername: "alice",
+			ApproverID:        "approver456",
+			ApproverUsername:  "bob",
+			Description:       "Test approval",
+			CreatedAt:         1704931200000,
+			DecidedAt:         1704931300000,
+			Redeliveries:      []approval.NotificationRedelivery{existingRedelivery},
+			SchemaVersion:     1,
+		}
+	}
+	newRedelivery := approval.NotificationRedelivery{Target: approval.RedeliveryTargetRequester, Notification: "outcome", ResentBy: "admin1", ResentAt: 1704931500000}
+
+	t.Run("allows appending a redelivery that notified the requester", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newDeniedRecord())
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Redeliveries = append(updatedRecord.Redeliveries, newRedelivery)
+		updatedRecord.OutcomeNotified = true
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+
+		err := store.SaveApproval(updatedRecord)
+		assert.NoError(t, err)
+		api.AssertCalled(t, "KVSet", "approval:record:record123", mock.Anything)
+	})
+
+	t.Run("rejects rewriting earlier redeliveries", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newDeniedRecord())
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Redeliveries[0].ResentBy = "someone-else"
+		updatedRecord.Redeliveries = append(updatedRecord.Redeliveries, newRedelivery)
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects other changes alongside a redelivery", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newDeniedRecord())
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Redeliveries = append(updatedRecord.Redeliveries, newRedelivery)
+		updatedRecord.Status = approval.StatusApproved
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("comment updates cannot drop redeliveries", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existingRecordJSON, _ := json.Marshal(newDeniedRecord())
+		updatedRecord := newDeniedRecord()
+		updatedRecord.Redeliveries = nil
+		updatedRecord.Comments = []approval.ApprovalComment{{UserID: "user123", Message: "Why?"}}
+
+		api.On("KVGet", "approval:record:record123").Return(existingRecordJSON, nil).Once()
+
+		err := store.SaveApproval(updatedRecord)
+		assert.ErrorIs(t, err, approval.ErrRecordImmutable)
+	})
+}
+
+func TestKVStore_CountPendingRequests(t *testing.T) {
+	t.Run("counts pending records across KVList pages", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		firstPage := make([]string, MaxApprovalRecordsLimit)
+		for i := range firstPage {
+			firstPage[i] = fmt.Sprintf("approval:code:A-%05d", i)
+		}
+		pending, _ := json.Marshal(&approval.ApprovalRecord{ID: "record1", Status: approval.StatusPending})
+		scheduled, _ := json.Marshal(&approval.ApprovalRecord{ID: "record2", Status: approval.StatusScheduled})
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+		api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:record:record1", "approval:record:record2", "approval:record:record3"}, nil)
+		api.On("KVGet", "approval:record:record1").Return(pending, nil)
+		api.On("KVGet", "approval:record:record2").Return(scheduled, nil)
+		api.On("KVGet", "approval:record:record3").Return([]byte("{corrupt"), nil)
+		api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		count, err := store.CountPendingRequests()
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("KV error", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(nil, &model.AppError{Message: "database unavailable"})
+
+		_, err := NewKVStore(api).CountPendingRequests()
+
+		assert.ErrorContains(t, err, "database unavailable")
+	})
+}
+
+func TestPing(t *testing.T) {
+	t.Run("readable store", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVList", 0, 1).Return([]string{"approval:secret:action_signing"}, nil)
+
+		assert.NoError(t, NewKVStore(api).Ping())
+	})
+
+	t.Run("KV error", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVList", 0, 1).Return(nil, &model.AppError{Message: "database unavailable"})
+
+		assert.ErrorContains(t, NewKVStore(api).Ping(), "database unavailable")
+	})
+}
@@ -0,0 +1,104 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+)
+
+// policyKeyPrefix is the KV key prefix for approver policies
+const policyKeyPrefix = "approval:policy:"
+
+// SavePolicy persists an approver policy, replacing any existing policy for the same scope
+func (s *KVStore) SavePolicy(policy *approval.ApproverPolicy) error {
+	if policy == nil {
+		return fmt.Errorf("cannot save nil approver policy")
+	}
+
+	if err := approval.ValidatePolicy(policy); err != nil {
+		return fmt.Errorf("invalid approver policy: %w", err)
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal approver policy: %w", err)
+	}
+
+	if appErr := s.api.KVSet(makePolicyKey(policy.Scope), data); appErr != nil {
+		return fmt.Errorf("failed to save approver policy %s: %w", policy.Scope, appErr)
+	}
+
+	return nil
+}
+
+// GetPolicy retrieves the approver policy for a scope ("team:<teamID>" or "template:<name>")
+func (s *KVStore) GetPolicy(scope string) (*approval.ApproverPolicy, error) {
+	if scope == "" {
+		return nil, fmt.Errorf("policy scope is required")
+	}
+
+	data, appErr := s.api.KVGet(makePolicyKey(scope))
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get approver policy %s: %w", scope, appErr)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("approver policy %s: %w", scope, approval.ErrPolicyNotFound)
+	}
+
+	var policy approval.ApproverPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal approver policy %s: %w", scope, err)
+	}
+
+	return &policy, nil
+}
+
+// ListPolicies retrieves all approver policies sorted by scope
+func (s *KVStore) ListPolicies() ([]*approval.ApproverPolicy, error) {
+	keys, err := s.listKeysWithPrefix(policyKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approver policies: %w", err)
+	}
+
+	policies := make([]*approval.ApproverPolicy, 0, len(keys))
+	for _, key := range keys {
+		policy, err := s.GetPolicy(strings.TrimPrefix(key, policyKeyPrefix))
+		if err != nil {
+			s.api.LogWarn("Failed to retrieve approver policy during ListPolicies",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Scope < policies[j].Scope
+	})
+
+	return policies, nil
+}
+
+// DeletePolicy removes the approver policy for a scope, leaving the scope unrestricted
+func (s *KVStore) DeletePolicy(scope string) error {
+	if scope == "" {
+		return fmt.Errorf("policy scope is required")
+	}
+
+	if appErr := s.api.KVDelete(makePolicyKey(scope)); appErr != nil {
+		return fmt.Errorf("failed to delete approver policy %s: %w", scope, appErr)
+	}
+
+	return nil
+}
+
+// makePolicyKey generates the KV store key for an approver policy
+func makePolicyKey(scope string) string {
+	return policyKeyPrefix + scope
+}
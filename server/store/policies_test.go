@@ -0,0 +1,135 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyTeamID = "teamaaaaaaaaaaaaaaaaaaaaaa"
+
+func TestKVStore_SavePolicy(t *testing.T) {
+	t.Run("saves valid policy under scope key", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVSet", "approval:policy:team:"+testPolicyTeamID, mock.Anything).Return(nil)
+
+		err := store.SavePolicy(&approval.ApproverPolicy{
+			Scope: approval.TeamPolicyScope(testPolicyTeamID),
+			Roles: []string{"team_admin"},
+		})
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid policy", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		err := store.SavePolicy(&approval.ApproverPolicy{Scope: approval.TemplatePolicyScope("deploy")})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid approver policy")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns error for nil policy", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		assert.Error(t, store.SavePolicy(nil))
+	})
+}
+
+func TestKVStore_GetPolicy(t *testing.T) {
+	t.Run("retrieves policy", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		data, _ := json.Marshal(&approval.ApproverPolicy{Scope: "template:deploy", Groups: []string{"sre"}})
+		api.On("KVGet", "approval:policy:template:deploy").Return(data, nil)
+
+		policy, err := store.GetPolicy("template:deploy")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sre"}, policy.Groups)
+	})
+
+	t.Run("returns ErrPolicyNotFound for missing policy", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:policy:template:deploy").Return(nil, nil)
+
+		_, err := store.GetPolicy("template:deploy")
+		assert.True(t, errors.Is(err, approval.ErrPolicyNotFound))
+	})
+
+	t.Run("returns error when KV store fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:policy:template:deploy").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetPolicy("template:deploy")
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, approval.ErrPolicyNotFound))
+	})
+}
+
+func TestKVStore_ListPolicies(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	teamPolicy, _ := json.Marshal(&approval.ApproverPolicy{Scope: "team:" + testPolicyTeamID})
+	templatePolicy, _ := json.Marshal(&approval.ApproverPolicy{Scope: "template:deploy"})
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+		"approval:policy:template:deploy",
+		"approval:template:deploy",
+		"approval:policy:team:" + testPolicyTeamID,
+	}, nil)
+	api.On("KVGet", "approval:policy:template:deploy").Return(templatePolicy, nil)
+	api.On("KVGet", "approval:policy:team:"+testPolicyTeamID).Return(teamPolicy, nil)
+
+	policies, err := store.ListPolicies()
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "team:"+testPolicyTeamID, policies[0].Scope)
+	assert.Equal(t, "template:deploy", policies[1].Scope)
+}
+
+func TestKVStore_ListPolicies_Paged(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	firstPage := make([]string, MaxApprovalRecordsLimit)
+	for i := range firstPage {
+		firstPage[i] = fmt.Sprintf("approval:code:A-%06d", i)
+	}
+	templatePolicy, _ := json.Marshal(&approval.ApproverPolicy{Scope: "template:deploy"})
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+	api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:policy:template:deploy"}, nil)
+	api.On("KVGet", "approval:policy:template:deploy").Return(templatePolicy, nil)
+
+	policies, err := store.ListPolicies()
+	require.NoError(t, err)
+	require.Len(t, policies, 1, "policies past the first KVList page are found")
+	assert.Equal(t, "template:deploy", policies[0].Scope)
+}
+
+func TestKVStore_DeletePolicy(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	api.On("KVDelete", "approval:policy:template:deploy").Return(nil)
+
+	assert.NoError(t, store.DeletePolicy("template:deploy"))
+	assert.Error(t, store.DeletePolicy(""))
+	api.AssertExpectations(t)
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// retryKeyPrefix stores queued notification redeliveries, one per record and notification kind
+const retryKeyPrefix = "approval:retry:"
+
+// SaveNotificationRetry queues (or replaces) a notification redelivery
+func (s *KVStore) SaveNotificationRetry(retry *approval.NotificationRetry) error {
+	if retry == nil {
+		return fmt.Errorf("cannot save nil notification retry")
+	}
+	if retry.Kind == "" || retry.RecordID == "" {
+		return fmt.Errorf("notification retry kind and record ID are required")
+	}
+
+	data, err := json.Marshal(retry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification retry: %w", err)
+	}
+
+	if appErr := s.api.KVSet(makeRetryKey(retry.Kind, retry.RecordID), data); appErr != nil {
+		return fmt.Errorf("failed to save notification retry for %s: %w", retry.RecordID, appErr)
+	}
+
+	return nil
+}
+
+// ListNotificationRetries returns all queued notification redeliveries, earliest due first
+func (s *KVStore) ListNotificationRetries() ([]*approval.NotificationRetry, error) {
+	keys, err := s.listKeysWithPrefix(retryKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification retries: %w", err)
+	}
+
+	retries := make([]*approval.NotificationRetry, 0, len(keys))
+	for _, key := range keys {
+		data, appErr := s.api.KVGet(key)
+		if appErr != nil {
+			s.api.LogWarn("Failed to get notification retry during ListNotificationRetries",
+				"key", key,
+				"error", appErr.Error(),
+			)
+			continue
+		}
+		if data == nil {
+			continue
+		}
+
+		var retry approval.NotificationRetry
+		if err := json.Unmarshal(data, &retry); err != nil {
+			s.api.LogWarn("Failed to unmarshal notification retry during ListNotificationRetries",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		retries = append(retries, &retry)
+	}
+
+	sort.Slice(retries, func(i, j int) bool {
+		return retries[i].NextAttemptAt < retries[j].NextAttemptAt
+	})
+
+	return retries, nil
+}
+
+// LeaseNotificationRetry atomically pushes a queued retry's next attempt to leaseUntil so only one
+// cluster node delivers it. Returns false if the retry changed or was removed since it was listed.
+func (s *KVStore) LeaseNotificationRetry(retry *approval.NotificationRetry, leaseUntil int64) (bool, error) {
+	if retry == nil {
+		return false, fmt.Errorf("cannot lease nil notification retry")
+	}
+
+	key := makeRetryKey(retry.Kind, retry.RecordID)
+	current, appErr := s.api.KVGet(key)
+	if appErr != nil {
+		return false, fmt.Errorf("failed to get notification retry for %s: %w", retry.RecordID, appErr)
+	}
+
+	expected, err := json.Marshal(retry)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal notification retry: %w", err)
+	}
+	if current == nil || !bytes.Equal(current, expected) {
+		return false, nil
+	}
+
+	leased := *retry
+	leased.NextAttemptAt = leaseUntil
+	data, err := json.Marshal(&leased)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal notification retry: %w", err)
+	}
+
+	saved, appErr := s.api.KVSetWithOptions(key, data, model.PluginKVSetOptions{
+		Atomic:   true,
+		OldValue: current, // only lease if no other node changed the retry
+	})
+	if appErr != nil {
+		return false, fmt.Errorf("failed to lease notification retry for %s: %w", retry.RecordID, appErr)
+	}
+
+	return saved, nil
+}
+
+// DeleteNotificationRetry removes a queued retry after delivery or when giving up
+func (s *KVStore) DeleteNotificationRetry(kind, recordID string) error {
+	if appErr := s.api.KVDelete(makeRetryKey(kind, recordID)); appErr != nil {
+		return fmt.Errorf("failed to delete notification retry for %s: %w", recordID, appErr)
+	}
+
+	return nil
+}
+
+// makeRetryKey generates the KV store key for a queued notification retry
+func makeRetryKey(kind, recordID string) string {
+	return retryKeyPrefix + kind + ":" + recordID
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetry(recordID string, nextAttemptAt int64) *approval.NotificationRetry {
+	return &approval.NotificationRetry{
+		Kind:          approval.RetryKindApproverRequest,
+		RecordID:      recordID,
+		Code:          "A-" + recordID,
+		Attempts:      1,
+		NextAttemptAt: nextAttemptAt,
+		LastError:     "failed to create post",
+		LastErrorType: "unknown",
+	}
+}
+
+func TestKVStore_SaveNotificationRetry(t *testing.T) {
+	t.Run("stores the retry under its kind and record", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		retry := testRetry("record1", 1000)
+		data, _ := json.Marshal(retry)
+		api.On("KVSet", "approval:retry:approver_request:record1", data).Return(nil)
+
+		require.NoError(t, store.SaveNotificationRetry(retry))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("requires kind and record ID", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		assert.Error(t, store.SaveNotificationRetry(nil))
+		assert.Error(t, store.SaveNotificationRetry(&approval.NotificationRetry{RecordID: "record1"}))
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(&model.AppError{Message: "KV error"})
+
+		err := store.SaveNotificationRetry(testRetry("record1", 1000))
+		assert.ErrorContains(t, err, "failed to save notification retry for record1")
+	})
+}
+
+func TestKVStore_ListNotificationRetries(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	later, _ := json.Marshal(testRetry("record1", 2000))
+	sooner, _ := json.Marshal(testRetry("record2", 1000))
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+		"approval:retry:approver_request:record1",
+		"approval:record:record1",
+		"approval:retry:approver_request:record2",
+		"approval:retry:outcome:corrupt",
+	}, nil)
+	api.On("KVGet", "approval:retry:approver_request:record1").Return(later, nil)
+	api.On("KVGet", "approval:retry:approver_request:record2").Return(sooner, nil)
+	api.On("KVGet", "approval:retry:outcome:corrupt").Return([]byte("{"), nil)
+	api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	retries, err := store.ListNotificationRetries()
+
+	require.NoError(t, err)
+	require.Len(t, retries, 2)
+	assert.Equal(t, "record2", retries[0].RecordID)
+	assert.Equal(t, "record1", retries[1].RecordID)
+}
+
+func TestKVStore_ListNotificationRetries_Paged(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	firstPage := make([]string, MaxApprovalRecordsLimit)
+	for i := range firstPage {
+		firstPage[i] = fmt.Sprintf("approval:record:record%05d", i)
+	}
+	retry, _ := json.Marshal(testRetry("record1", 1000))
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+	api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:retry:approver_request:record1"}, nil)
+	api.On("KVGet", "approval:retry:approver_request:record1").Return(retry, nil)
+
+	retries, err := store.ListNotificationRetries()
+
+	require.NoError(t, err)
+	require.Len(t, retries, 1, "retries past the first KVList page are found")
+	assert.Equal(t, "record1", retries[0].RecordID)
+}
+
+func TestKVStore_LeaseNotificationRetry(t *testing.T) {
+	key := "approval:retry:approver_request:record1"
+
+	t.Run("leases an unchanged retry atomically", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		retry := testRetry("record1", 1000)
+		current, _ := json.Marshal(retry)
+		leased := *retry
+		leased.NextAttemptAt = 5000
+		leasedData, _ := json.Marshal(&leased)
+		api.On("KVGet", key).Return(current, nil)
+		api.On("KVSetWithOptions", key, leasedData, model.PluginKVSetOptions{Atomic: true, OldValue: current}).Return(true, nil)
+
+		ok, err := store.LeaseNotificationRetry(retry, 5000)
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("retry changed since it was listed", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		other, _ := json.Marshal(testRetry("record1", 9000))
+		api.On("KVGet", key).Return(other, nil)
+
+		ok, err := store.LeaseNotificationRetry(testRetry("record1", 1000), 5000)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+		api.AssertNotCalled(t, "KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("retry removed since it was listed", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", key).Return(nil, nil)
+
+		ok, err := store.LeaseNotificationRetry(testRetry("record1", 1000), 5000)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("another node won the lease", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		retry := testRetry("record1", 1000)
+		current, _ := json.Marshal(retry)
+		api.On("KVGet", key).Return(current, nil)
+		api.On("KVSetWithOptions", key, mock.Anything, mock.Anything).Return(false, nil)
+
+		ok, err := store.LeaseNotificationRetry(retry, 5000)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestKVStore_DeleteNotificationRetry(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	api.On("KVDelete", "approval:retry:outcome:record1").Return(nil)
+
+	require.NoError(t, store.DeleteNotificationRetry(approval.RetryKindOutcome, "record1"))
+	api.AssertExpectations(t)
+}
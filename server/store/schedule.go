@@ -0,0 +1,132 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// scheduleKeyPrefix indexes scheduled requests awaiting their send time: approval:schedule:{recordID} → send time
+	scheduleKeyPrefix = "approval:schedule:"
+
+	// scheduleLeaseKeyPrefix holds the lease of the node sending a scheduled request. It must not
+	// start with scheduleKeyPrefix, or leases would be listed as schedule entries.
+	scheduleLeaseKeyPrefix = "approval:schedule_lease:"
+
+	// scheduleLeaseTTLSeconds bounds how long a lease survives if the sending node dies before
+	// saving the request as pending
+	scheduleLeaseTTLSeconds = 60
+)
+
+// scheduleRequest adds (or moves) a scheduled request's entry in the schedule index
+func (s *KVStore) scheduleRequest(recordID string, sendAt int64) error {
+	if recordID == "" {
+		return fmt.Errorf("approval ID is required")
+	}
+
+	data, err := json.Marshal(sendAt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal send time: %w", err)
+	}
+
+	if appErr := s.api.KVSet(makeScheduleKey(recordID), data); appErr != nil {
+		return fmt.Errorf("failed to schedule approval %s: %w", recordID, appErr)
+	}
+
+	return nil
+}
+
+// ListDueScheduledRequests returns the IDs of scheduled requests whose send time is at or before
+// now (epoch milliseconds), earliest first
+func (s *KVStore) ListDueScheduledRequests(now int64) ([]string, error) {
+	keys, err := s.listKeysWithPrefix(scheduleKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled requests: %w", err)
+	}
+
+	sendTimes := make(map[string]int64)
+	for _, key := range keys {
+		recordID := strings.TrimPrefix(key, scheduleKeyPrefix)
+
+		data, appErr := s.api.KVGet(key)
+		if appErr != nil {
+			s.api.LogWarn("Failed to get schedule entry during ListDueScheduledRequests",
+				"key", key,
+				"error", appErr.Error(),
+			)
+			continue
+		}
+		if data == nil {
+			continue
+		}
+
+		var sendAt int64
+		if err := json.Unmarshal(data, &sendAt); err != nil {
+			s.api.LogWarn("Failed to unmarshal schedule entry during ListDueScheduledRequests",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if sendAt <= now {
+			sendTimes[recordID] = sendAt
+		}
+	}
+
+	recordIDs := make([]string, 0, len(sendTimes))
+	for recordID := range sendTimes {
+		recordIDs = append(recordIDs, recordID)
+	}
+	sort.Slice(recordIDs, func(i, j int) bool {
+		if sendTimes[recordIDs[i]] != sendTimes[recordIDs[j]] {
+			return sendTimes[recordIDs[i]] < sendTimes[recordIDs[j]]
+		}
+		return recordIDs[i] < recordIDs[j]
+	})
+
+	return recordIDs, nil
+}
+
+// LeaseScheduledRequest atomically takes a short-lived lease on sending a scheduled request so
+// only one cluster node sends it. The schedule entry stays until the record is saved as pending,
+// so a node that dies mid-send leaves the request to be retried once the lease expires.
+// Returns false if another node holds the lease.
+func (s *KVStore) LeaseScheduledRequest(recordID string) (bool, error) {
+	if recordID == "" {
+		return false, fmt.Errorf("approval ID is required")
+	}
+
+	leased, appErr := s.api.KVSetWithOptions(makeScheduleLeaseKey(recordID), []byte("sending"), model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil, // only lease if no other node holds the lease
+		ExpireInSeconds: scheduleLeaseTTLSeconds,
+	})
+	if appErr != nil {
+		return false, fmt.Errorf("failed to lease scheduled approval %s: %w", recordID, appErr)
+	}
+
+	return leased, nil
+}
+
+// UnscheduleRequest removes a request's schedule entry. SaveApproval calls it once a request is
+// sent or canceled; the scheduler calls it for entries whose record is gone or no longer scheduled.
+func (s *KVStore) UnscheduleRequest(recordID string) error {
+	if appErr := s.api.KVDelete(makeScheduleKey(recordID)); appErr != nil {
+		return fmt.Errorf("failed to remove schedule entry for %s: %w", recordID, appErr)
+	}
+	return nil
+}
+
+// makeScheduleLeaseKey generates the KV store key for the lease on sending a scheduled request
+func makeScheduleLeaseKey(recordID string) string {
+	return scheduleLeaseKeyPrefix + recordID
+}
+
+// makeScheduleKey generates the KV store key for a scheduled request's schedule index entry
+func makeScheduleKey(recordID string) string {
+	return scheduleKeyPrefix + recordID
+}
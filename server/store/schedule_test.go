@@ -0,0 +1,219 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// setKeys returns the keys written with KVSet
+func setKeys(api *plugintest.API) []string {
+	keys := make([]string, 0)
+	for _, call := range api.Calls {
+		if call.Method == "KVSet" {
+			keys = append(keys, call.Arguments.String(0))
+		}
+	}
+	return keys
+}
+
+func TestKVStore_SaveApproval_Scheduled(t *testing.T) {
+	sendAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("scheduled record is in the schedule index but not the approver index", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", mock.Anything).Return(nil, nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+		scheduled := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "alice123",
+			ApproverID:  "bob123",
+			Status:      approval.StatusScheduled,
+			CreatedAt:   sendAt.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: sendAt.UnixMilli(),
+		}
+		require.NoError(t, store.SaveApproval(scheduled))
+
+		keys := setKeys(api)
+		assert.Contains(t, keys, "approval:schedule:record123")
+		assert.Contains(t, keys, "approval:record:record123")
+		for _, key := range keys {
+			assert.False(t, strings.HasPrefix(key, "approval:index:approver:"), key)
+		}
+		api.AssertCalled(t, "KVSet", "approval:schedule:record123", []byte("1772442000000"))
+	})
+
+	t.Run("canceling removes the schedule entry", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		existing := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "alice123",
+			ApproverID:  "bob123",
+			Status:      approval.StatusScheduled,
+			CreatedAt:   sendAt.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: sendAt.UnixMilli(),
+		}
+		data, err := json.Marshal(existing)
+		require.NoError(t, err)
+		api.On("KVGet", "approval:record:record123").Return(data, nil)
+		api.On("KVGet", mock.Anything).Return(nil, nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("KVDelete", "approval:schedule:record123").Return(nil)
+
+		canceled := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "alice123",
+			ApproverID:  "bob123",
+			Status:      approval.StatusCanceled,
+			CreatedAt:   sendAt.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: sendAt.UnixMilli(),
+			CanceledAt:  sendAt.Add(-time.Minute).UnixMilli(),
+		}
+		require.NoError(t, store.SaveApproval(canceled))
+
+		api.AssertCalled(t, "KVDelete", "approval:schedule:record123")
+		assert.NotContains(t, setKeys(api), "approval:schedule:record123")
+	})
+
+	t.Run("sending adds the approver index", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		existing := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "alice123",
+			ApproverID:  "bob123",
+			Status:      approval.StatusScheduled,
+			CreatedAt:   sendAt.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: sendAt.UnixMilli(),
+		}
+		data, err := json.Marshal(existing)
+		require.NoError(t, err)
+		api.On("KVGet", "approval:record:record123").Return(data, nil)
+		api.On("KVGet", mock.Anything).Return(nil, nil)
+		api.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("KVDelete", "approval:schedule:record123").Return(nil)
+
+		sent := &approval.ApprovalRecord{
+			ID:          "record123",
+			Code:        "A-X7K9Q2",
+			RequesterID: "alice123",
+			ApproverID:  "bob123",
+			Status:      approval.StatusPending,
+			CreatedAt:   sendAt.Add(-time.Hour).UnixMilli(),
+			ScheduledAt: sendAt.UnixMilli(),
+			SentAt:      sendAt.UnixMilli(),
+		}
+		require.NoError(t, store.SaveApproval(sent))
+
+		assert.Contains(t, setKeys(api), makeApproverIndexKey("bob123", sent.CreatedAt, "record123"))
+		api.AssertCalled(t, "KVDelete", "approval:schedule:record123")
+	})
+}
+
+func TestKVStore_ListDueScheduledRequests(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+		"approval:record:record1",
+		"approval:schedule:record3",
+		"approval:schedule:record2",
+		"approval:schedule:record1",
+		"approval:schedule:corrupt",
+	}, nil)
+	api.On("KVGet", "approval:schedule:record1").Return([]byte("2000"), nil)
+	api.On("KVGet", "approval:schedule:record2").Return([]byte("1000"), nil)
+	api.On("KVGet", "approval:schedule:record3").Return([]byte("3001"), nil)
+	api.On("KVGet", "approval:schedule:corrupt").Return([]byte("{"), nil)
+	api.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	recordIDs, err := store.ListDueScheduledRequests(3000)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"record2", "record1"}, recordIDs, "due requests earliest first")
+}
+
+func TestKVStore_ListDueScheduledRequests_Paged(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	firstPage := make([]string, MaxApprovalRecordsLimit)
+	for i := range firstPage {
+		firstPage[i] = fmt.Sprintf("approval:record:record%05d", i)
+	}
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+	api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:schedule:record123"}, nil)
+	api.On("KVGet", "approval:schedule:record123").Return([]byte("1000"), nil)
+
+	recordIDs, err := store.ListDueScheduledRequests(3000)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"record123"}, recordIDs, "schedule keys past the first KVList page are found")
+}
+
+func TestKVStore_LeaseScheduledRequest(t *testing.T) {
+	t.Run("first lease succeeds atomically with expiry", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:schedule_lease:record123", []byte("sending"), mock.MatchedBy(func(opts model.PluginKVSetOptions) bool {
+			return opts.Atomic && opts.OldValue == nil && opts.ExpireInSeconds == scheduleLeaseTTLSeconds
+		})).Return(true, nil)
+
+		leased, err := store.LeaseScheduledRequest("record123")
+		require.NoError(t, err)
+		assert.True(t, leased)
+		api.AssertNotCalled(t, "KVDelete", mock.Anything)
+	})
+
+	t.Run("leased by another node", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:schedule_lease:record123", mock.Anything, mock.Anything).Return(false, nil)
+
+		leased, err := store.LeaseScheduledRequest("record123")
+		require.NoError(t, err)
+		assert.False(t, leased)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(false, &model.AppError{Message: "KV error"})
+
+		_, err := store.LeaseScheduledRequest("record123")
+		assert.ErrorContains(t, err, "failed to lease scheduled approval")
+	})
+
+	t.Run("requires a record ID", func(t *testing.T) {
+		_, err := NewKVStore(&plugintest.API{}).LeaseScheduledRequest("")
+		assert.Error(t, err)
+	})
+}
+
+func TestKVStore_ListDueScheduledRequests_IgnoresLeases(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{"approval:schedule_lease:record1"}, nil)
+
+	recordIDs, err := store.ListDueScheduledRequests(3000)
+
+	require.NoError(t, err)
+	assert.Empty(t, recordIDs)
+}
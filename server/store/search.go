@@ -0,0 +1,208 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// searchIndexedKey marks that records saved before the search index existed have been indexed
+	searchIndexedKey = "approval:search:indexed"
+
+	// maxSearchIndexAttempts bounds the compare-and-set retries when concurrent saves update one token
+	maxSearchIndexAttempts = 5
+)
+
+// SearchApprovals returns the records matching query where userID is the requester, the approver
+// or a group candidate (the access control of GetUserApprovals), newest first.
+//
+// Description terms are looked up in the inverted token index (one KV entry per token listing
+// the record IDs whose description contains it); the other criteria are applied to the loaded
+// records. Queries without terms filter the user's records from GetUserApprovals instead.
+func (s *KVStore) SearchApprovals(userID string, query approval.SearchQuery) ([]*approval.ApprovalRecord, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	if len(query.Terms) == 0 {
+		records, err := s.GetUserApprovals(userID)
+		if err != nil {
+			return nil, err
+		}
+		matches := make([]*approval.ApprovalRecord, 0)
+		for _, record := range records {
+			if query.Matches(record) {
+				matches = append(matches, record)
+			}
+		}
+		return matches, nil
+	}
+
+	// Intersect the posting lists of all terms, starting from the first
+	var candidateIDs []string
+	for i, term := range query.Terms {
+		recordIDs, err := s.getSearchToken(term)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			candidateIDs = recordIDs
+		} else {
+			candidateIDs = slices.DeleteFunc(candidateIDs, func(id string) bool {
+				return !slices.Contains(recordIDs, id)
+			})
+		}
+		if len(candidateIDs) == 0 {
+			return []*approval.ApprovalRecord{}, nil
+		}
+	}
+
+	records := make([]*approval.ApprovalRecord, 0)
+	for _, recordID := range candidateIDs {
+		record, err := s.GetApproval(recordID)
+		if err != nil {
+			s.api.LogWarn("Failed to retrieve approval record from search index",
+				"record_id", recordID,
+				"user_id", userID,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		// Access control: only the user's own requests, as in GetUserApprovals
+		if !record.IsParticipant(userID) || !query.Matches(record) {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].CreatedAt > records[j].CreatedAt
+	})
+
+	return records, nil
+}
+
+// SearchIndexBuilt reports whether IndexExistingApprovals has completed on this installation
+func (s *KVStore) SearchIndexBuilt() (bool, error) {
+	marker, appErr := s.api.KVGet(searchIndexedKey)
+	if appErr != nil {
+		return false, fmt.Errorf("failed to check search index state: %w", appErr)
+	}
+	return marker != nil, nil
+}
+
+// IndexExistingApprovals adds the records saved before the search index existed to it and marks
+// the index as built. Returns the number of records indexed. Records are read page by page and
+// the index is only marked built after every record was indexed, so a failed pass is repeated
+// on the next activation. Indexing is idempotent, so cluster nodes running this concurrently
+// only repeat work.
+func (s *KVStore) IndexExistingApprovals() (int, error) {
+	keys, err := s.listKeysWithPrefix("approval:record:")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list approval records: %w", err)
+	}
+
+	indexed := 0
+	for _, key := range keys {
+		record, err := s.GetApproval(strings.TrimPrefix(key, "approval:record:"))
+		if errors.Is(err, approval.ErrRecordNotFound) {
+			continue // Deleted since the keys were listed
+		}
+		if err != nil {
+			return indexed, err
+		}
+
+		if err := s.indexSearchTokens(record); err != nil {
+			return indexed, err
+		}
+		indexed++
+	}
+
+	if appErr := s.api.KVSet(searchIndexedKey, []byte("true")); appErr != nil {
+		return indexed, fmt.Errorf("failed to mark search index as built: %w", appErr)
+	}
+
+	return indexed, nil
+}
+
+// indexSearchTokens adds a record to the posting list of every token of its description
+func (s *KVStore) indexSearchTokens(record *approval.ApprovalRecord) error {
+	for _, token := range approval.SearchTokens(record.Description) {
+		if err := s.addSearchToken(token, record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSearchToken appends a record ID to a token's posting list with compare-and-set, so
+// concurrent saves of requests sharing a word do not overwrite each other
+func (s *KVStore) addSearchToken(token, recordID string) error {
+	key := makeSearchTokenKey(token)
+	for range maxSearchIndexAttempts {
+		oldValue, appErr := s.api.KVGet(key)
+		if appErr != nil {
+			return fmt.Errorf("failed to get search index for %q: %w", token, appErr)
+		}
+
+		var recordIDs []string
+		if oldValue != nil {
+			if err := json.Unmarshal(oldValue, &recordIDs); err != nil {
+				return fmt.Errorf("failed to unmarshal search index for %q: %w", token, err)
+			}
+		}
+		if slices.Contains(recordIDs, recordID) {
+			return nil
+		}
+
+		newValue, err := json.Marshal(append(recordIDs, recordID))
+		if err != nil {
+			return fmt.Errorf("failed to marshal search index for %q: %w", token, err)
+		}
+
+		saved, appErr := s.api.KVSetWithOptions(key, newValue, model.PluginKVSetOptions{
+			Atomic:   true,
+			OldValue: oldValue,
+		})
+		if appErr != nil {
+			return fmt.Errorf("failed to save search index for %q: %w", token, appErr)
+		}
+		if saved {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to save search index for %q: concurrent updates", token)
+}
+
+// getSearchToken returns the IDs of the records whose description contains token
+func (s *KVStore) getSearchToken(token string) ([]string, error) {
+	data, appErr := s.api.KVGet(makeSearchTokenKey(token))
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get search index for %q: %w", token, appErr)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var recordIDs []string
+	if err := json.Unmarshal(data, &recordIDs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search index for %q: %w", token, err)
+	}
+	return recordIDs, nil
+}
+
+// makeSearchTokenKey generates the KV store key for a token's posting list.
+// The "approval:token:" prefix sorts after every other approval key, so the many token keys
+// never push records or settings out of the KVList pages other queries scan.
+func makeSearchTokenKey(token string) string {
+	return fmt.Sprintf("approval:token:%s", token)
+}
@@ -0,0 +1,359 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockSearchToken(api *plugintest.API, token string, recordIDs ...string) {
+	data, _ := json.Marshal(recordIDs)
+	api.On("KVGet", "approval:token:"+token).Return(data, nil)
+}
+
+func mockSearchRecord(api *plugintest.API, record *approval.ApprovalRecord) {
+	data, _ := json.Marshal(record)
+	api.On("KVGet", "approval:record:"+record.ID).Return(data, nil)
+}
+
+func TestKVStore_SearchApprovals(t *testing.T) {
+	t.Run("intersects terms and returns newest first", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		older := &approval.ApprovalRecord{
+			ID:          "record1",
+			RequesterID: "user1",
+			Description: "Open firewall port 443",
+			Status:      approval.StatusPending,
+			CreatedAt:   1000,
+		}
+		newer := &approval.ApprovalRecord{
+			ID:          "record2",
+			RequesterID: "user1",
+			Description: "Close firewall port 8080",
+			Status:      approval.StatusPending,
+			CreatedAt:   2000,
+		}
+		mockSearchToken(api, "firewall", "record1", "record2", "record3")
+		mockSearchToken(api, "port", "record2", "record1")
+		mockSearchRecord(api, older)
+		mockSearchRecord(api, newer)
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"firewall", "port"}})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "record2", records[0].ID)
+		assert.Equal(t, "record1", records[1].ID)
+		api.AssertNotCalled(t, "KVGet", "approval:record:record3")
+	})
+
+	t.Run("only returns the user's own records", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		own := &approval.ApprovalRecord{
+			ID:          "record1",
+			RequesterID: "user1",
+			ApproverID:  "approver1",
+			Description: "Open firewall",
+			Status:      approval.StatusPending,
+			CreatedAt:   1000,
+		}
+		other := &approval.ApprovalRecord{
+			ID:          "record2",
+			RequesterID: "user2",
+			ApproverID:  "approver1",
+			Description: "Open firewall",
+			Status:      approval.StatusPending,
+			CreatedAt:   2000,
+		}
+		mockSearchToken(api, "firewall", "record1", "record2")
+		mockSearchRecord(api, own)
+		mockSearchRecord(api, other)
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"firewall"}})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "record1", records[0].ID)
+
+		// The approver sees both
+		records, err = store.SearchApprovals("approver1", approval.SearchQuery{Terms: []string{"firewall"}})
+		require.NoError(t, err)
+		assert.Len(t, records, 2)
+	})
+
+	t.Run("applies the other criteria to matching records", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		pending := &approval.ApprovalRecord{
+			ID:          "record1",
+			RequesterID: "user1",
+			Description: "Open firewall",
+			Status:      approval.StatusPending,
+			CreatedAt:   1000,
+		}
+		approved := &approval.ApprovalRecord{
+			ID:          "record2",
+			RequesterID: "user1",
+			Description: "Open firewall",
+			Status:      approval.StatusApproved,
+			CreatedAt:   2000,
+		}
+		mockSearchToken(api, "firewall", "record1", "record2")
+		mockSearchRecord(api, pending)
+		mockSearchRecord(api, approved)
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"firewall"}, Status: approval.StatusApproved})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "record2", records[0].ID)
+	})
+
+	t.Run("stops when a term has no records", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:token:vpn").Return(nil, nil)
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"vpn", "firewall"}})
+		require.NoError(t, err)
+		assert.Empty(t, records)
+		api.AssertNotCalled(t, "KVGet", "approval:token:firewall")
+	})
+
+	t.Run("skips records that fail to load", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		mockSearchToken(api, "firewall", "missing", "record1")
+		api.On("KVGet", "approval:record:missing").Return(nil, nil)
+		mockSearchRecord(api, &approval.ApprovalRecord{
+			ID:          "record1",
+			RequesterID: "user1",
+			Description: "Open firewall",
+			Status:      approval.StatusPending,
+			CreatedAt:   1000,
+		})
+		api.On("LogWarn", "Failed to retrieve approval record from search index",
+			"record_id", "missing", "user_id", "user1", "error", mock.Anything).Once()
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"firewall"}})
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("query without terms filters the user's approvals", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		record := &approval.ApprovalRecord{
+			ID:                "record1",
+			RequesterID:       "user1",
+			Description:       "Open firewall",
+			Status:            approval.StatusPending,
+			CreatedAt:         1000,
+			RequesterUsername: "alice",
+		}
+		recordIDJSON, _ := json.Marshal("record1")
+		indexKey := "approval:index:requester:user1:9999999998999:record1"
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{indexKey}, nil).Once()
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{}, nil).Once()
+		api.On("KVGet", indexKey).Return(recordIDJSON, nil)
+		mockSearchRecord(api, record)
+
+		records, err := store.SearchApprovals("user1", approval.SearchQuery{From: "alice"})
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{indexKey}, nil).Once()
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{}, nil).Once()
+
+		records, err = store.SearchApprovals("user1", approval.SearchQuery{From: "carol"})
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:token:firewall").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.SearchApprovals("user1", approval.SearchQuery{Terms: []string{"firewall"}})
+		assert.ErrorContains(t, err, "failed to get search index")
+	})
+
+	t.Run("requires user ID", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		_, err := store.SearchApprovals("", approval.SearchQuery{Terms: []string{"firewall"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestKVStore_AddSearchToken(t *testing.T) {
+	t.Run("appends to the posting list atomically", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		mockSearchToken(api, "firewall", "record1")
+		api.On("KVSetWithOptions", "approval:token:firewall", []byte(`["record1","record2"]`), model.PluginKVSetOptions{
+			Atomic:   true,
+			OldValue: []byte(`["record1"]`),
+		}).Return(true, nil).Once()
+
+		require.NoError(t, store.addSearchToken("firewall", "record2"))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("retries after a concurrent update", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:token:firewall").Return(nil, nil).Once()
+		api.On("KVSetWithOptions", "approval:token:firewall", []byte(`["record2"]`), mock.Anything).Return(false, nil).Once()
+		api.On("KVGet", "approval:token:firewall").Return([]byte(`["record1"]`), nil).Once()
+		api.On("KVSetWithOptions", "approval:token:firewall", []byte(`["record1","record2"]`), mock.Anything).Return(true, nil).Once()
+
+		require.NoError(t, store.addSearchToken("firewall", "record2"))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("already indexed record is not written again", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		mockSearchToken(api, "firewall", "record1")
+
+		require.NoError(t, store.addSearchToken("firewall", "record1"))
+		api.AssertNotCalled(t, "KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("gives up after repeated conflicts", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:token:firewall").Return(nil, nil)
+		api.On("KVSetWithOptions", "approval:token:firewall", mock.Anything, mock.Anything).Return(false, nil)
+
+		err := store.addSearchToken("firewall", "record1")
+		assert.ErrorContains(t, err, "concurrent updates")
+		api.AssertNumberOfCalls(t, "KVSetWithOptions", maxSearchIndexAttempts)
+	})
+}
+
+func TestKVStore_IndexExistingApprovals(t *testing.T) {
+	t.Run("indexes every record and marks the index built", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+			"approval:code:A-record1",
+			"approval:record:record1",
+			"approval:record:record2",
+		}, nil)
+		mockSearchRecord(api, &approval.ApprovalRecord{ID: "record1", Description: "Open firewall", Status: approval.StatusPending})
+		mockSearchRecord(api, &approval.ApprovalRecord{ID: "record2", Description: "Rotate keys", Status: approval.StatusPending})
+		api.On("KVGet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:token:")
+		})).Return(nil, nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("KVSet", "approval:search:indexed", []byte("true")).Return(nil).Once()
+
+		indexed, err := store.IndexExistingApprovals()
+		require.NoError(t, err)
+		assert.Equal(t, 2, indexed)
+		for _, token := range []string{"open", "firewall", "rotate", "keys"} {
+			api.AssertCalled(t, "KVSetWithOptions", "approval:token:"+token, mock.Anything, mock.Anything)
+		}
+		api.AssertExpectations(t)
+	})
+
+	t.Run("index error leaves the index unmarked", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{"approval:record:record1"}, nil)
+		mockSearchRecord(api, &approval.ApprovalRecord{ID: "record1", Description: "Open firewall", Status: approval.StatusPending})
+		api.On("KVGet", "approval:token:open").Return(nil, &model.AppError{Message: "KV error"})
+
+		indexed, err := store.IndexExistingApprovals()
+		assert.Error(t, err)
+		assert.Equal(t, 0, indexed)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("indexes records past the first KVList page", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		firstPage := make([]string, MaxApprovalRecordsLimit)
+		for i := range firstPage {
+			firstPage[i] = fmt.Sprintf("approval:code:A-%06d", i)
+		}
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+		api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:record:record1"}, nil)
+		mockSearchRecord(api, &approval.ApprovalRecord{ID: "record1", Description: "Rotate keys", Status: approval.StatusPending})
+		api.On("KVGet", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "approval:token:")
+		})).Return(nil, nil)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		api.On("KVSet", "approval:search:indexed", []byte("true")).Return(nil).Once()
+
+		indexed, err := store.IndexExistingApprovals()
+		require.NoError(t, err)
+		assert.Equal(t, 1, indexed)
+		api.AssertCalled(t, "KVSetWithOptions", "approval:token:rotate", mock.Anything, mock.Anything)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("record load error leaves the index unmarked", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{"approval:record:record1"}, nil)
+		api.On("KVGet", "approval:record:record1").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.IndexExistingApprovals()
+		assert.Error(t, err)
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestKVStore_SearchIndexBuilt(t *testing.T) {
+	t.Run("not built", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:search:indexed").Return(nil, nil)
+
+		built, err := NewKVStore(api).SearchIndexBuilt()
+		require.NoError(t, err)
+		assert.False(t, built)
+	})
+
+	t.Run("built", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:search:indexed").Return([]byte("true"), nil)
+
+		built, err := NewKVStore(api).SearchIndexBuilt()
+		require.NoError(t, err)
+		assert.True(t, built)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		api.On("KVGet", "approval:search:indexed").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := NewKVStore(api).SearchIndexBuilt()
+		assert.Error(t, err)
+	})
+}
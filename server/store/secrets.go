@@ -0,0 +1,55 @@
+package store
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// actionSigningSecretKey stores the HMAC secret used to sign interactive button contexts
+	actionSigningSecretKey = "approval:secret:action_signing"
+
+	// actionSigningSecretSize is the secret length in bytes (matches the SHA-256 block output)
+	actionSigningSecretSize = 32
+)
+
+// GetOrCreateActionSigningSecret returns the plugin's button signing secret, generating and
+// storing it on first activation. The write is atomic so concurrent cluster nodes agree on one secret.
+func (s *KVStore) GetOrCreateActionSigningSecret() ([]byte, error) {
+	secret, appErr := s.api.KVGet(actionSigningSecretKey)
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get action signing secret: %w", appErr)
+	}
+	if len(secret) > 0 {
+		return secret, nil
+	}
+
+	secret = make([]byte, actionSigningSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate action signing secret: %w", err)
+	}
+
+	saved, appErr := s.api.KVSetWithOptions(actionSigningSecretKey, secret, model.PluginKVSetOptions{
+		Atomic:   true,
+		OldValue: nil, // only set if no secret exists
+	})
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to save action signing secret: %w", appErr)
+	}
+	if saved {
+		return secret, nil
+	}
+
+	// Another node created the secret first; use theirs
+	secret, appErr = s.api.KVGet(actionSigningSecretKey)
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get action signing secret: %w", appErr)
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("action signing secret missing after concurrent creation")
+	}
+
+	return secret, nil
+}
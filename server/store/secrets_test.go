@@ -0,0 +1,77 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_GetOrCreateActionSigningSecret(t *testing.T) {
+	t.Run("returns the existing secret", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("existing-secret"), nil)
+
+		secret, err := store.GetOrCreateActionSigningSecret()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("existing-secret"), secret)
+		api.AssertNotCalled(t, "KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("generates and atomically stores a secret on first activation", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:secret:action_signing").Return(nil, nil)
+
+		var stored []byte
+		api.On("KVSetWithOptions", "approval:secret:action_signing", mock.Anything, mock.MatchedBy(func(opts model.PluginKVSetOptions) bool {
+			return opts.Atomic && opts.OldValue == nil && opts.ExpireInSeconds == 0
+		})).Run(func(args mock.Arguments) {
+			stored = args.Get(1).([]byte)
+		}).Return(true, nil)
+
+		secret, err := store.GetOrCreateActionSigningSecret()
+		require.NoError(t, err)
+		assert.Len(t, secret, actionSigningSecretSize)
+		assert.Equal(t, stored, secret)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("uses the secret another node created concurrently", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:secret:action_signing").Return(nil, nil).Once()
+		api.On("KVSetWithOptions", "approval:secret:action_signing", mock.Anything, mock.Anything).Return(false, nil)
+		api.On("KVGet", "approval:secret:action_signing").Return([]byte("winner-secret"), nil).Once()
+
+		secret, err := store.GetOrCreateActionSigningSecret()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("winner-secret"), secret)
+	})
+
+	t.Run("KV errors are returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:secret:action_signing").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetOrCreateActionSigningSecret()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get action signing secret")
+	})
+
+	t.Run("save error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:secret:action_signing").Return(nil, nil)
+		api.On("KVSetWithOptions", "approval:secret:action_signing", mock.Anything, mock.Anything).
+			Return(false, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetOrCreateActionSigningSecret()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to save action signing secret")
+	})
+}
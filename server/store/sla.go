@@ -0,0 +1,128 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// slaTargetKeyPrefix is the KV key prefix for SLA targets
+	slaTargetKeyPrefix = "approval:sla:target:"
+
+	// slaBreachKeyPrefix is the KV key prefix marking that a record's SLA breach was alerted
+	slaBreachKeyPrefix = "approval:sla:breach:"
+)
+
+// SaveSLATarget persists an SLA target, replacing any existing target for the same scope
+func (s *KVStore) SaveSLATarget(target *approval.SLATarget) error {
+	if target == nil {
+		return fmt.Errorf("cannot save nil SLA target")
+	}
+
+	if err := approval.ValidateSLATarget(target); err != nil {
+		return fmt.Errorf("invalid SLA target: %w", err)
+	}
+
+	data, err := json.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SLA target: %w", err)
+	}
+
+	if appErr := s.api.KVSet(makeSLATargetKey(target.Scope), data); appErr != nil {
+		return fmt.Errorf("failed to save SLA target %s: %w", target.Scope, appErr)
+	}
+
+	return nil
+}
+
+// GetSLATarget retrieves the SLA target for a scope ("global", "team:<teamID>" or "template:<name>")
+func (s *KVStore) GetSLATarget(scope string) (*approval.SLATarget, error) {
+	if scope == "" {
+		return nil, fmt.Errorf("SLA scope is required")
+	}
+
+	data, appErr := s.api.KVGet(makeSLATargetKey(scope))
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get SLA target %s: %w", scope, appErr)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("SLA target %s: %w", scope, approval.ErrSLATargetNotFound)
+	}
+
+	var target approval.SLATarget
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SLA target %s: %w", scope, err)
+	}
+
+	return &target, nil
+}
+
+// ListSLATargets retrieves all SLA targets sorted by scope
+func (s *KVStore) ListSLATargets() ([]*approval.SLATarget, error) {
+	keys, err := s.listKeysWithPrefix(slaTargetKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SLA targets: %w", err)
+	}
+
+	targets := make([]*approval.SLATarget, 0, len(keys))
+	for _, key := range keys {
+		target, err := s.GetSLATarget(strings.TrimPrefix(key, slaTargetKeyPrefix))
+		if err != nil {
+			s.api.LogWarn("Failed to retrieve SLA target during ListSLATargets",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		targets = append(targets, target)
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Scope < targets[j].Scope
+	})
+
+	return targets, nil
+}
+
+// DeleteSLATarget removes the SLA target for a scope
+func (s *KVStore) DeleteSLATarget(scope string) error {
+	if scope == "" {
+		return fmt.Errorf("SLA scope is required")
+	}
+
+	if appErr := s.api.KVDelete(makeSLATargetKey(scope)); appErr != nil {
+		return fmt.Errorf("failed to delete SLA target %s: %w", scope, appErr)
+	}
+
+	return nil
+}
+
+// ClaimSLABreachAlert atomically claims the breach alert for a record.
+// Returns true for exactly one caller per record, so each breach is alerted once across the cluster.
+func (s *KVStore) ClaimSLABreachAlert(recordID string) (bool, error) {
+	if recordID == "" {
+		return false, fmt.Errorf("approval ID is required")
+	}
+
+	claimed, appErr := s.api.KVSetWithOptions(slaBreachKeyPrefix+recordID, []byte("alerted"), model.PluginKVSetOptions{
+		Atomic:   true,
+		OldValue: nil, // only set if the breach has not been alerted
+	})
+	if appErr != nil {
+		return false, fmt.Errorf("failed to claim SLA breach alert for approval %s: %w", recordID, appErr)
+	}
+
+	return claimed, nil
+}
+
+// makeSLATargetKey generates the KV store key for an SLA target
+func makeSLATargetKey(scope string) string {
+	return slaTargetKeyPrefix + scope
+}
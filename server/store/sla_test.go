@@ -0,0 +1,167 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_SaveSLATarget(t *testing.T) {
+	t.Run("saves valid target under scope key", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVSet", "approval:sla:target:team:"+testPolicyTeamID, mock.Anything).Return(nil)
+
+		err := store.SaveSLATarget(&approval.SLATarget{
+			Scope:   approval.TeamPolicyScope(testPolicyTeamID),
+			Minutes: 15,
+		})
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid target", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		err := store.SaveSLATarget(&approval.SLATarget{Scope: approval.SLAScopeGlobal})
+		assert.ErrorContains(t, err, "invalid SLA target")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns error for nil target", func(t *testing.T) {
+		assert.Error(t, NewKVStore(&plugintest.API{}).SaveSLATarget(nil))
+	})
+}
+
+func TestKVStore_GetSLATarget(t *testing.T) {
+	t.Run("retrieves target", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		data, _ := json.Marshal(&approval.SLATarget{Scope: approval.SLAScopeGlobal, Minutes: 30})
+		api.On("KVGet", "approval:sla:target:global").Return(data, nil)
+
+		target, err := store.GetSLATarget(approval.SLAScopeGlobal)
+		require.NoError(t, err)
+		assert.Equal(t, 30, target.Minutes)
+	})
+
+	t.Run("returns ErrSLATargetNotFound for missing target", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:sla:target:global").Return(nil, nil)
+
+		_, err := store.GetSLATarget(approval.SLAScopeGlobal)
+		assert.True(t, errors.Is(err, approval.ErrSLATargetNotFound))
+	})
+
+	t.Run("returns error when KV store fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:sla:target:global").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetSLATarget(approval.SLAScopeGlobal)
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, approval.ErrSLATargetNotFound))
+	})
+}
+
+func TestKVStore_ListSLATargets(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	global, _ := json.Marshal(&approval.SLATarget{Scope: approval.SLAScopeGlobal, Minutes: 60})
+	template, _ := json.Marshal(&approval.SLATarget{Scope: "template:prod-access", Minutes: 15})
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+		"approval:sla:target:template:prod-access",
+		"approval:sla:breach:record123",
+		"approval:sla:target:global",
+	}, nil)
+	api.On("KVGet", "approval:sla:target:global").Return(global, nil)
+	api.On("KVGet", "approval:sla:target:template:prod-access").Return(template, nil)
+
+	targets, err := store.ListSLATargets()
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Equal(t, approval.SLAScopeGlobal, targets[0].Scope)
+	assert.Equal(t, "template:prod-access", targets[1].Scope)
+}
+
+func TestKVStore_ListSLATargets_Paged(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+	firstPage := make([]string, MaxApprovalRecordsLimit)
+	for i := range firstPage {
+		firstPage[i] = fmt.Sprintf("approval:record:record%05d", i)
+	}
+	global, _ := json.Marshal(&approval.SLATarget{Scope: approval.SLAScopeGlobal, Minutes: 60})
+	api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+	api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:sla:target:global"}, nil)
+	api.On("KVGet", "approval:sla:target:global").Return(global, nil)
+
+	targets, err := store.ListSLATargets()
+	require.NoError(t, err)
+	require.Len(t, targets, 1, "targets past the first KVList page are found")
+	assert.Equal(t, approval.SLAScopeGlobal, targets[0].Scope)
+}
+
+func TestKVStore_DeleteSLATarget(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	api.On("KVDelete", "approval:sla:target:global").Return(nil)
+
+	assert.NoError(t, store.DeleteSLATarget(approval.SLAScopeGlobal))
+	assert.Error(t, store.DeleteSLATarget(""))
+	api.AssertExpectations(t)
+}
+
+func TestKVStore_ClaimSLABreachAlert(t *testing.T) {
+	t.Run("first claim succeeds atomically without expiry", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:sla:breach:record123", []byte("alerted"), mock.MatchedBy(func(opts model.PluginKVSetOptions) bool {
+			return opts.Atomic && opts.OldValue == nil && opts.ExpireInSeconds == 0
+		})).Return(true, nil)
+
+		claimed, err := store.ClaimSLABreachAlert("record123")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("breach already alerted", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", "approval:sla:breach:record123", mock.Anything, mock.Anything).Return(false, nil)
+
+		claimed, err := store.ClaimSLABreachAlert("record123")
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("KV error is returned", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVSetWithOptions", mock.Anything, mock.Anything, mock.Anything).Return(false, &model.AppError{Message: "KV error"})
+
+		_, err := store.ClaimSLABreachAlert("record123")
+		assert.ErrorContains(t, err, "failed to claim SLA breach alert")
+	})
+
+	t.Run("requires a record ID", func(t *testing.T) {
+		_, err := NewKVStore(&plugintest.API{}).ClaimSLABreachAlert("")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	// sodViolationsKey stores the most recent separation-of-duties violations (newest first)
+	sodViolationsKey = "approval:sod:violations"
+
+	// MaxSoDViolations caps the number of stored violations
+	MaxSoDViolations = 100
+
+	// maxSoDViolationAttempts bounds the compare-and-set retries when violations are recorded concurrently
+	maxSoDViolationAttempts = 5
+)
+
+// AppendSoDViolation stores a separation-of-duties violation, keeping the most recent MaxSoDViolations.
+// The list is written with a compare-and-set so violations recorded concurrently are not lost.
+func (s *KVStore) AppendSoDViolation(violation *approval.SoDViolation) error {
+	if violation == nil {
+		return fmt.Errorf("cannot save nil separation of duties violation")
+	}
+
+	for range maxSoDViolationAttempts {
+		oldValue, appErr := s.api.KVGet(sodViolationsKey)
+		if appErr != nil {
+			return fmt.Errorf("failed to get separation of duties violations: %w", appErr)
+		}
+
+		var violations []*approval.SoDViolation
+		if oldValue != nil {
+			if err := json.Unmarshal(oldValue, &violations); err != nil {
+				return fmt.Errorf("failed to unmarshal separation of duties violations: %w", err)
+			}
+		}
+
+		violations = append([]*approval.SoDViolation{violation}, violations...)
+		if len(violations) > MaxSoDViolations {
+			violations = violations[:MaxSoDViolations]
+		}
+
+		newValue, err := json.Marshal(violations)
+		if err != nil {
+			return fmt.Errorf("failed to marshal separation of duties violations: %w", err)
+		}
+
+		saved, appErr := s.api.KVSetWithOptions(sodViolationsKey, newValue, model.PluginKVSetOptions{
+			Atomic:   true,
+			OldValue: oldValue,
+		})
+		if appErr != nil {
+			return fmt.Errorf("failed to save separation of duties violations: %w", appErr)
+		}
+		if saved {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to save separation of duties violations: concurrent updates")
+}
+
+// GetSoDViolations retrieves stored separation-of-duties violations, newest first
+func (s *KVStore) GetSoDViolations() ([]*approval.SoDViolation, error) {
+	data, appErr := s.api.KVGet(sodViolationsKey)
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get separation of duties violations: %w", appErr)
+	}
+
+	violations := make([]*approval.SoDViolation, 0)
+	if data == nil {
+		return violations, nil
+	}
+
+	if err := json.Unmarshal(data, &violations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal separation of duties violations: %w", err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,116 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_AppendSoDViolation(t *testing.T) {
+	t.Run("prepends to existing violations", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		existing, _ := json.Marshal([]*approval.SoDViolation{{Rule: approval.SoDRuleSelfApproval, UserID: "user1"}})
+		api.On("KVGet", "approval:sod:violations").Return(existing, nil)
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.MatchedBy(func(data []byte) bool {
+			var saved []*approval.SoDViolation
+			return json.Unmarshal(data, &saved) == nil &&
+				len(saved) == 2 &&
+				saved[0].UserID == "user2" &&
+				saved[1].UserID == "user1"
+		}), model.PluginKVSetOptions{Atomic: true, OldValue: existing}).Return(true, nil)
+
+		err := store.AppendSoDViolation(&approval.SoDViolation{Rule: approval.SoDRuleReciprocalApproval, UserID: "user2"})
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("caps stored violations", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		full := make([]*approval.SoDViolation, MaxSoDViolations)
+		for i := range full {
+			full[i] = &approval.SoDViolation{UserID: fmt.Sprintf("old%d", i)}
+		}
+		existing, _ := json.Marshal(full)
+		api.On("KVGet", "approval:sod:violations").Return(existing, nil)
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.MatchedBy(func(data []byte) bool {
+			var saved []*approval.SoDViolation
+			return json.Unmarshal(data, &saved) == nil &&
+				len(saved) == MaxSoDViolations &&
+				saved[0].UserID == "new" &&
+				saved[MaxSoDViolations-1].UserID == fmt.Sprintf("old%d", MaxSoDViolations-2)
+		}), mock.Anything).Return(true, nil)
+
+		assert.NoError(t, store.AppendSoDViolation(&approval.SoDViolation{UserID: "new"}))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("retries after a concurrent append", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		concurrent, _ := json.Marshal([]*approval.SoDViolation{{Rule: approval.SoDRuleSelfApproval, UserID: "user1"}})
+		api.On("KVGet", "approval:sod:violations").Return(nil, nil).Once()
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.Anything, mock.Anything).Return(false, nil).Once()
+		api.On("KVGet", "approval:sod:violations").Return(concurrent, nil).Once()
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.MatchedBy(func(data []byte) bool {
+			var saved []*approval.SoDViolation
+			return json.Unmarshal(data, &saved) == nil &&
+				len(saved) == 2 &&
+				saved[0].UserID == "user2" &&
+				saved[1].UserID == "user1"
+		}), model.PluginKVSetOptions{Atomic: true, OldValue: concurrent}).Return(true, nil).Once()
+
+		err := store.AppendSoDViolation(&approval.SoDViolation{Rule: approval.SoDRuleReciprocalApproval, UserID: "user2"})
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("gives up after repeated conflicts", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:sod:violations").Return(nil, nil)
+		api.On("KVSetWithOptions", "approval:sod:violations", mock.Anything, mock.Anything).Return(false, nil)
+
+		err := store.AppendSoDViolation(&approval.SoDViolation{UserID: "user2"})
+		assert.ErrorContains(t, err, "concurrent updates")
+		api.AssertNumberOfCalls(t, "KVSetWithOptions", maxSoDViolationAttempts)
+	})
+
+	t.Run("returns error for nil violation", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		assert.Error(t, store.AppendSoDViolation(nil))
+	})
+}
+
+func TestKVStore_GetSoDViolations(t *testing.T) {
+	t.Run("returns empty list when none stored", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:sod:violations").Return(nil, nil)
+
+		violations, err := store.GetSoDViolations()
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("returns error when KV store fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+		api.On("KVGet", "approval:sod:violations").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetSoDViolations()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+)
+
+// templateKeyPrefix is the KV key prefix for request templates
+const templateKeyPrefix = "approval:template:"
+
+// SaveTemplate persists a request template, replacing any existing template with the same name
+func (s *KVStore) SaveTemplate(tmpl *approval.RequestTemplate) error {
+	if tmpl == nil {
+		return fmt.Errorf("cannot save nil request template")
+	}
+
+	if err := approval.ValidateTemplate(tmpl); err != nil {
+		return fmt.Errorf("invalid request template: %w", err)
+	}
+
+	data, err := json.Marshal(tmpl)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request template: %w", err)
+	}
+
+	if appErr := s.api.KVSet(makeTemplateKey(tmpl.Name), data); appErr != nil {
+		return fmt.Errorf("failed to save request template %s: %w", tmpl.Name, appErr)
+	}
+
+	return nil
+}
+
+// GetTemplate retrieves a request template by name
+func (s *KVStore) GetTemplate(name string) (*approval.RequestTemplate, error) {
+	if name == "" {
+		return nil, fmt.Errorf("template name is required")
+	}
+
+	data, appErr := s.api.KVGet(makeTemplateKey(name))
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to get request template %s: %w", name, appErr)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("request template %s: %w", name, approval.ErrTemplateNotFound)
+	}
+
+	var tmpl approval.RequestTemplate
+	if err := json.Unmarshal(data, &tmpl); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request template %s: %w", name, err)
+	}
+
+	return &tmpl, nil
+}
+
+// ListTemplates retrieves all request templates sorted by name
+func (s *KVStore) ListTemplates() ([]*approval.RequestTemplate, error) {
+	keys, err := s.listKeysWithPrefix(templateKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list request templates: %w", err)
+	}
+
+	templates := make([]*approval.RequestTemplate, 0, len(keys))
+	for _, key := range keys {
+		tmpl, err := s.GetTemplate(strings.TrimPrefix(key, templateKeyPrefix))
+		if err != nil {
+			s.api.LogWarn("Failed to retrieve request template during ListTemplates",
+				"key", key,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		templates = append(templates, tmpl)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+// DeleteTemplate removes a request template. Existing records keep their snapshotted field values.
+func (s *KVStore) DeleteTemplate(name string) error {
+	if name == "" {
+		return fmt.Errorf("template name is required")
+	}
+
+	if appErr := s.api.KVDelete(makeTemplateKey(name)); appErr != nil {
+		return fmt.Errorf("failed to delete request template %s: %w", name, appErr)
+	}
+
+	return nil
+}
+
+// makeTemplateKey generates the KV store key for a request template
+func makeTemplateKey(name string) string {
+	return templateKeyPrefix + name
+}
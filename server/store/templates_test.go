@@ -0,0 +1,137 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_SaveTemplate(t *testing.T) {
+	t.Run("saves valid template under template key", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		tmpl := &approval.RequestTemplate{
+			Name:   "expense",
+			Fields: []approval.CustomField{{Name: "amount", Label: "Amount", Type: approval.FieldTypeNumber, Required: true}},
+		}
+		api.On("KVSet", "approval:template:expense", mock.Anything).Return(nil)
+
+		err := store.SaveTemplate(tmpl)
+		assert.NoError(t, err)
+		api.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid template", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		err := store.SaveTemplate(&approval.RequestTemplate{Name: "Bad Name"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid request template")
+		api.AssertNotCalled(t, "KVSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns error for nil template", func(t *testing.T) {
+		store := NewKVStore(&plugintest.API{})
+
+		err := store.SaveTemplate(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestKVStore_GetTemplate(t *testing.T) {
+	t.Run("retrieves template", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		data, _ := json.Marshal(&approval.RequestTemplate{Name: "deploy", DisplayName: "Deployment"})
+		api.On("KVGet", "approval:template:deploy").Return(data, nil)
+
+		tmpl, err := store.GetTemplate("deploy")
+		require.NoError(t, err)
+		assert.Equal(t, "Deployment", tmpl.DisplayName)
+	})
+
+	t.Run("returns ErrTemplateNotFound for missing template", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:template:missing").Return(nil, nil)
+
+		_, err := store.GetTemplate("missing")
+		assert.True(t, errors.Is(err, approval.ErrTemplateNotFound))
+	})
+
+	t.Run("returns error when KV store fails", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		api.On("KVGet", "approval:template:deploy").Return(nil, &model.AppError{Message: "KV error"})
+
+		_, err := store.GetTemplate("deploy")
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, approval.ErrTemplateNotFound))
+	})
+}
+
+func TestKVStore_ListTemplates(t *testing.T) {
+	t.Run("returns templates sorted by name and skips other keys", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		deploy, _ := json.Marshal(&approval.RequestTemplate{Name: "deploy"})
+		expense, _ := json.Marshal(&approval.RequestTemplate{Name: "expense"})
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return([]string{
+			"approval:template:expense",
+			"approval:record:record123",
+			"approval:template:deploy",
+		}, nil)
+		api.On("KVGet", "approval:template:expense").Return(expense, nil)
+		api.On("KVGet", "approval:template:deploy").Return(deploy, nil)
+
+		templates, err := store.ListTemplates()
+		require.NoError(t, err)
+		require.Len(t, templates, 2)
+		assert.Equal(t, "deploy", templates[0].Name)
+		assert.Equal(t, "expense", templates[1].Name)
+	})
+
+	t.Run("finds templates past the first KVList page", func(t *testing.T) {
+		api := &plugintest.API{}
+		store := NewKVStore(api)
+
+		firstPage := make([]string, MaxApprovalRecordsLimit)
+		for i := range firstPage {
+			firstPage[i] = fmt.Sprintf("approval:code:A-%06d", i)
+		}
+		deploy, _ := json.Marshal(&approval.RequestTemplate{Name: "deploy"})
+		api.On("KVList", 0, MaxApprovalRecordsLimit).Return(firstPage, nil)
+		api.On("KVList", 1, MaxApprovalRecordsLimit).Return([]string{"approval:template:deploy"}, nil)
+		api.On("KVGet", "approval:template:deploy").Return(deploy, nil)
+
+		templates, err := store.ListTemplates()
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		assert.Equal(t, "deploy", templates[0].Name)
+	})
+}
+
+func TestKVStore_DeleteTemplate(t *testing.T) {
+	api := &plugintest.API{}
+	store := NewKVStore(api)
+
+	api.On("KVDelete", "approval:template:deploy").Return(nil)
+
+	assert.NoError(t, store.DeleteTemplate("deploy"))
+	assert.Error(t, store.DeleteTemplate(""))
+	api.AssertExpectations(t)
+}
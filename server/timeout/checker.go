@@ -3,9 +3,11 @@ package timeout
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-approver2/server/approval"
+	"github.com/mattermost/mattermost-plugin-approver2/server/metrics"
 	"github.com/mattermost/mattermost-plugin-approver2/server/notifications"
 	"github.com/mattermost/mattermost-plugin-approver2/server/store"
 	"github.com/mattermost/mattermost/server/public/plugin"
@@ -16,7 +18,19 @@ import (
 // - Default timeout duration (e.g., 15min, 30min, 1hr, 24hr)
 // - Per-channel timeout overrides
 // - Disable timeout feature entirely
-const DefaultTimeoutDuration = 30 * time.Minute // Hardcoded for MVP
+const DefaultTimeoutDuration = approval.PendingTimeout // Hardcoded for MVP
+
+// CheckInterval is how often the checker scans for timed-out requests
+const CheckInterval = 5 * time.Minute
+
+// ScanStatus reports the health of the checker's periodic scans
+type ScanStatus struct {
+	StartedAt         time.Time // When the checker started
+	LastSuccessAt     time.Time // Zero until the first scan succeeds
+	LastError         string    // Error of the most recent failed scan
+	ErrorCount        int       // Failed scans since the checker started
+	ConsecutiveErrors int       // Failed scans since the last successful one
+}
 
 // TimeoutChecker periodically scans for timed-out pending approval requests
 // and automatically cancels them with notification to the requester.
@@ -25,18 +39,25 @@ type TimeoutChecker struct {
 	service   *approval.Service
 	api       plugin.API
 	botUserID string
+	metrics   *metrics.Metrics
 	ctx       context.Context
 	cancel    context.CancelFunc
 	done      chan struct{}
+
+	// statusLock guards status, which is read by the health endpoint
+	statusLock sync.Mutex
+	status     ScanStatus
 }
 
-// NewChecker creates a new TimeoutChecker instance.
-func NewChecker(store *store.KVStore, service *approval.Service, api plugin.API, botUserID string) *TimeoutChecker {
+// NewChecker creates a new TimeoutChecker instance. Failed timeout DMs are counted in metrics,
+// which may be nil.
+func NewChecker(store *store.KVStore, service *approval.Service, api plugin.API, botUserID string, metrics *metrics.Metrics) *TimeoutChecker {
 	return &TimeoutChecker{
 		store:     store,
 		service:   service,
 		api:       api,
 		botUserID: botUserID,
+		metrics:   metrics,
 		done:      make(chan struct{}),
 	}
 }
@@ -46,6 +67,10 @@ func NewChecker(store *store.KVStore, service *approval.Service, api plugin.API,
 func (tc *TimeoutChecker) Start() {
 	tc.ctx, tc.cancel = context.WithCancel(context.Background())
 
+	tc.statusLock.Lock()
+	tc.status = ScanStatus{StartedAt: time.Now()}
+	tc.statusLock.Unlock()
+
 	go tc.run()
 
 	tc.api.LogInfo("Timeout checker started", "check_interval", "5m", "timeout_duration", "30m")
@@ -62,6 +87,29 @@ func (tc *TimeoutChecker) Stop() {
 	tc.api.LogInfo("Timeout checker stopped")
 }
 
+// Status returns a snapshot of the scan status.
+func (tc *TimeoutChecker) Status() ScanStatus {
+	tc.statusLock.Lock()
+	defer tc.statusLock.Unlock()
+	return tc.status
+}
+
+// recordScan updates the scan status with the result of a scan
+func (tc *TimeoutChecker) recordScan(err error, now time.Time) {
+	tc.statusLock.Lock()
+	defer tc.statusLock.Unlock()
+
+	if err != nil {
+		tc.status.LastError = err.Error()
+		tc.status.ErrorCount++
+		tc.status.ConsecutiveErrors++
+		return
+	}
+
+	tc.status.LastSuccessAt = now
+	tc.status.ConsecutiveErrors = 0
+}
+
 // run is the main loop that periodically checks for timed-out requests.
 func (tc *TimeoutChecker) run() {
 	defer close(tc.done)
@@ -71,7 +119,7 @@ func (tc *TimeoutChecker) run() {
 		}
 	}()
 
-	ticker := time.NewTicker(5 * time.Minute)
+	ticker := time.NewTicker(CheckInterval)
 	defer ticker.Stop()
 
 	for {
@@ -79,9 +127,11 @@ func (tc *TimeoutChecker) run() {
 		case <-tc.ctx.Done():
 			return
 		case <-ticker.C:
-			if err := tc.checkTimeouts(); err != nil {
+			err := tc.checkTimeouts()
+			if err != nil {
 				tc.api.LogError("Timeout check failed", "error", err.Error())
 			}
+			tc.recordScan(err, time.Now())
 		}
 	}
 }
@@ -142,10 +192,13 @@ func (tc *TimeoutChecker) checkTimeouts() error {
 
 		// Send timeout notification to requester (best-effort, graceful degradation)
 		if _, err := notifications.SendTimeoutNotificationDM(tc.api, tc.botUserID, updatedRecord); err != nil {
+			errorType, _ := notifications.ClassifyDMError(err)
+			tc.metrics.NotificationFailed(errorType)
 			tc.api.LogWarn("Failed to send timeout notification",
 				"approval_id", record.ID,
 				"approval_code", record.Code,
 				"requester_id", record.RequesterID,
+				"error_type", errorType,
 				"error", err.Error())
 			// Continue - notification failure doesn't affect cancellation
 		}
@@ -160,6 +213,15 @@ func (tc *TimeoutChecker) checkTimeouts() error {
 				"error", err.Error())
 			// Continue - post update failure doesn't affect cancellation
 		}
+
+		// Mark the channel status card as timed out (best-effort)
+		if err := notifications.UpdateChannelStatusCard(tc.api, updatedRecord); err != nil {
+			tc.api.LogWarn("Failed to update channel status card after timeout",
+				"approval_id", record.ID,
+				"approval_code", record.Code,
+				"post_id", updatedRecord.ChannelPostID,
+				"error", err.Error())
+		}
 	}
 
 	// Log completion summary with aggregate metrics
@@ -21,7 +21,7 @@ func TestNewChecker(t *testing.T) {
 	mockService := approval.NewService(mockStore, mockAPI, "bot123")
 	botUserID := "bot123"
 
-	checker := NewChecker(mockStore, mockService, mockAPI, botUserID)
+	checker := NewChecker(mockStore, mockService, mockAPI, botUserID, nil)
 
 	assert.NotNil(t, checker)
 	assert.Equal(t, mockStore, checker.store)
@@ -40,7 +40,7 @@ func TestStartStop(t *testing.T) {
 	// Mock LogInfo for lifecycle messages
 	mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	checker := NewChecker(mockStore, mockService, mockAPI, "bot123")
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
 
 	// Start checker
 	checker.Start()
@@ -64,6 +64,32 @@ func TestStartStop(t *testing.T) {
 	mockAPI.AssertExpectations(t)
 }
 
+// TestScanStatus verifies scan results are tracked for the health endpoint
+func TestScanStatus(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockStore := store.NewKVStore(mockAPI)
+	checker := NewChecker(mockStore, approval.NewService(mockStore, mockAPI, "bot123"), mockAPI, "bot123", nil)
+	start := time.Now()
+
+	assert.True(t, checker.Status().LastSuccessAt.IsZero())
+
+	checker.recordScan(assert.AnError, start)
+	checker.recordScan(assert.AnError, start.Add(CheckInterval))
+
+	status := checker.Status()
+	assert.True(t, status.LastSuccessAt.IsZero())
+	assert.Equal(t, assert.AnError.Error(), status.LastError)
+	assert.Equal(t, 2, status.ErrorCount)
+	assert.Equal(t, 2, status.ConsecutiveErrors)
+
+	checker.recordScan(nil, start.Add(2*CheckInterval))
+
+	status = checker.Status()
+	assert.Equal(t, start.Add(2*CheckInterval), status.LastSuccessAt)
+	assert.Equal(t, 2, status.ErrorCount)
+	assert.Equal(t, 0, status.ConsecutiveErrors)
+}
+
 // TestCheckTimeoutsNoIndexKeys verifies behavior when no index keys are returned from KVList
 func TestCheckTimeoutsNoIndexKeys(t *testing.T) {
 	mockAPI := &plugintest.API{}
@@ -75,7 +101,7 @@ func TestCheckTimeoutsNoIndexKeys(t *testing.T) {
 	// Mock LogDebug with all expected arguments (variadic keyvals)
 	mockAPI.On("LogDebug", "Completed timeout scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	checker := NewChecker(mockStore, mockService, mockAPI, "bot123")
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
 
 	err := checker.checkTimeouts()
 
@@ -113,7 +139,7 @@ func TestCheckTimeoutsSkipsNonPendingRequests(t *testing.T) {
 	// Mock LogDebug with all expected arguments (variadic keyvals)
 	mockAPI.On("LogDebug", "Completed timeout scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	checker := NewChecker(mockStore, mockService, mockAPI, "bot123")
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
 
 	err := checker.checkTimeouts()
 
@@ -152,7 +178,7 @@ func TestCheckTimeoutsSkipsNewRequests(t *testing.T) {
 	// Mock LogDebug with all expected arguments (variadic keyvals)
 	mockAPI.On("LogDebug", "Completed timeout scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	checker := NewChecker(mockStore, mockService, mockAPI, "bot123")
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
 
 	err := checker.checkTimeouts()
 
@@ -249,7 +275,7 @@ func TestCheckTimeoutsHappyPath(t *testing.T) {
 	mockAPI.On("LogDebug", "Processing timed-out requests", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockAPI.On("LogDebug", "Completed timeout scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	checker := NewChecker(mockStore, mockService, mockAPI, "bot123")
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
 
 	err := checker.checkTimeouts()
 
@@ -257,6 +283,57 @@ func TestCheckTimeoutsHappyPath(t *testing.T) {
 	mockAPI.AssertExpectations(t)
 }
 
+func TestCheckTimeoutsUpdatesChannelStatusCard(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockStore := store.NewKVStore(mockAPI)
+	mockService := approval.NewService(mockStore, mockAPI, "bot123")
+
+	timedOutRecord := &approval.ApprovalRecord{
+		ID:                "record123",
+		Code:              "A-X7K9Q2",
+		Status:            approval.StatusPending,
+		RequesterID:       "user123",
+		RequesterUsername: "johndoe",
+		ApproverID:        "approver123",
+		ApproverUsername:  "janedoe",
+		Description:       "Test approval request",
+		CreatedAt:         time.Now().Add(-31 * time.Minute).UnixMilli(),
+		RequestChannelID:  "channel123",
+		ShareInChannel:    true,
+		ChannelPostID:     "card123",
+	}
+	canceledRecord := *timedOutRecord
+	canceledRecord.Status = approval.StatusCanceled
+	canceledRecord.CanceledReason = "Auto-canceled: No response within 30 minutes"
+	canceledRecord.CanceledAt = time.Now().UnixMilli()
+	canceledRecord.DecidedAt = canceledRecord.CanceledAt
+
+	indexKey := "approval:index:approver:approver123:0000000000001:record123"
+	mockAPI.On("KVList", 0, store.MaxApprovalRecordsLimit).Return([]string{indexKey}, nil)
+	mockAPI.On("KVGet", indexKey).Return([]byte(`"record123"`), nil)
+	mockAPI.On("KVGet", "approval:record:record123").Return(mustMarshalJSON(t, timedOutRecord), nil).Times(3)
+	mockAPI.On("KVGet", "approval:record:record123").Return(mustMarshalJSON(t, &canceledRecord), nil).Once()
+	mockAPI.On("KVSet", mock.Anything, mock.Anything).Return(nil)
+	mockAPI.On("GetDirectChannel", "bot123", "user123").Return(&model.Channel{Id: "dm_channel_123"}, nil)
+	mockAPI.On("CreatePost", mock.Anything).Return(&model.Post{Id: "notification_post_123"}, nil)
+	mockAPI.On("GetPost", "card123").Return(&model.Post{Id: "card123", ChannelId: "channel123"}, nil)
+	mockAPI.On("UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+		return post.Id == "card123" && strings.Contains(post.Message, "⏱️ Timed out (no response)")
+	})).Return(&model.Post{Id: "card123"}, nil)
+	mockAPI.On("LogInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockAPI.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	mockAPI.On("LogWarn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	checker := NewChecker(mockStore, mockService, mockAPI, "bot123", nil)
+
+	err := checker.checkTimeouts()
+
+	assert.NoError(t, err)
+	mockAPI.AssertCalled(t, "UpdatePost", mock.MatchedBy(func(post *model.Post) bool {
+		return post.Id == "card123"
+	}))
+}
+
 // TODO: Add integration tests for error paths and graceful degradation scenarios
 // These require complex mocking of the full SaveApproval flow including KVSet calls
 // Deferred to integration testing phase with actual Mattermost instance